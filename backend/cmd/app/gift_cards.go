@@ -0,0 +1,47 @@
+package main
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/giftcard"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+)
+
+// giftCardExpiryInterval is how often expired gift cards are emptied.
+const giftCardExpiryInterval = time.Hour
+
+// giftCardTerms reads how many months cards last from
+// GIFT_CARD_VALID_MONTHS, the least a bought card may be worth from
+// GIFT_CARD_MIN_AMOUNT and the most any card may be worth from
+// GIFT_CARD_MAX_AMOUNT.
+func giftCardTerms() giftcard.Terms {
+	terms := giftcard.DefaultTerms
+	if raw := os.Getenv("GIFT_CARD_VALID_MONTHS"); raw != "" {
+		months, err := strconv.Atoi(raw)
+		if err != nil || months <= 0 {
+			log.Fatalf("invalid GIFT_CARD_VALID_MONTHS: %q", raw)
+		}
+		terms.ValidMonths = months
+	}
+	terms.MinAmount = amountFromEnv("GIFT_CARD_MIN_AMOUNT", terms.MinAmount)
+	terms.MaxAmount = amountFromEnv("GIFT_CARD_MAX_AMOUNT", terms.MaxAmount)
+	if terms.MinAmount > terms.MaxAmount {
+		log.Fatalf("GIFT_CARD_MIN_AMOUNT is more than GIFT_CARD_MAX_AMOUNT")
+	}
+	return terms
+}
+
+func amountFromEnv(name string, fallback money.Amount) money.Amount {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return fallback
+	}
+	amount, err := money.Parse(raw)
+	if err != nil || amount <= 0 {
+		log.Fatalf("invalid %s: %q", name, raw)
+	}
+	return amount
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/repository"
+	"github.com/uptrace/bun"
+)
+
+// runImportBooks implements the "import-books" subcommand:
+//
+//	app import-books -file books.csv [-dry-run]
+func runImportBooks(database *bun.DB, args []string) int {
+	flags := flag.NewFlagSet("import-books", flag.ExitOnError)
+	path := flags.String("file", "", "path to the CSV file")
+	dryRun := flags.Bool("dry-run", false, "validate the file without writing anything")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *path == "" {
+		flags.Usage()
+		return 2
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		log.Printf("failed to open file: %v", err)
+		return 1
+	}
+	defer func() { _ = file.Close() }()
+
+	auditService := services.NewAuditService(repository.NewAuditRepository(database))
+	importService := services.NewImportService(repository.NewImportRepository(database), newEditionListeners(database, auditService), auditService)
+
+	report, err := importService.ImportBooks(context.Background(), file, *dryRun)
+	if err != nil {
+		log.Printf("import failed: %v", err)
+		return 1
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Printf("failed to write report: %v", err)
+		return 1
+	}
+	fmt.Fprintf(os.Stderr, "rows: %d, valid: %d, invalid: %d, imported: %d\n",
+		report.Total, report.Valid, report.Invalid, report.Imported)
+	if report.Invalid > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/blob"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/invoice"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/pdf"
+)
+
+// newDocumentStore picks where invoices are kept. It follows BLOB_STORE but
+// never shares a place with media, which is served publicly: "local" uses
+// DOCUMENTS_DIR, "s3" the bucket in S3_DOCUMENTS_BUCKET.
+func newDocumentStore() (interfaces.BlobStore, error) {
+	switch kind := os.Getenv("BLOB_STORE"); kind {
+	case "", "local":
+		dir := os.Getenv("DOCUMENTS_DIR")
+		if dir == "" {
+			dir = "documents"
+		}
+		return blob.NewLocalStore(dir)
+	case "s3":
+		bucket := os.Getenv("S3_DOCUMENTS_BUCKET")
+		if bucket == "" || bucket == os.Getenv("S3_BUCKET") {
+			return nil, fmt.Errorf("S3_DOCUMENTS_BUCKET must be set and differ from S3_BUCKET")
+		}
+		return blob.NewS3Store(blob.S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Bucket:          bucket,
+			Region:          os.Getenv("S3_REGION"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+		})
+	default:
+		return nil, fmt.Errorf("unknown BLOB_STORE %q", kind)
+	}
+}
+
+// newInvoiceRenderer prints the requisites from the COMPANY_* variables.
+// INVOICE_FONT and INVOICE_FONT_BOLD name TrueType files to draw with
+// instead of the bundled DejaVu Sans.
+func newInvoiceRenderer() (*invoice.Renderer, error) {
+	company := invoice.Company{
+		Name:                 os.Getenv("COMPANY_NAME"),
+		Address:              os.Getenv("COMPANY_ADDRESS"),
+		INN:                  os.Getenv("COMPANY_INN"),
+		KPP:                  os.Getenv("COMPANY_KPP"),
+		OGRN:                 os.Getenv("COMPANY_OGRN"),
+		Bank:                 os.Getenv("COMPANY_BANK"),
+		BIK:                  os.Getenv("COMPANY_BIK"),
+		Account:              os.Getenv("COMPANY_ACCOUNT"),
+		CorrespondentAccount: os.Getenv("COMPANY_CORRESPONDENT_ACCOUNT"),
+		Phone:                os.Getenv("COMPANY_PHONE"),
+		Email:                os.Getenv("COMPANY_EMAIL"),
+	}
+	regular, err := loadFont("INVOICE_FONT")
+	if err != nil {
+		return nil, err
+	}
+	bold, err := loadFont("INVOICE_FONT_BOLD")
+	if err != nil {
+		return nil, err
+	}
+	return invoice.NewRenderer(company, regular, bold), nil
+}
+
+func loadFont(variable string) (*pdf.TrueType, error) {
+	path := os.Getenv(variable)
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", variable, err)
+	}
+	font, err := pdf.ParseTrueType(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", variable, err)
+	}
+	return font, nil
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/loyalty"
+)
+
+// loyaltyExpiryInterval is how often expired loyalty points are written off.
+const loyaltyExpiryInterval = time.Hour
+
+// loyaltyProgram reads the percent of an order's total earned as points
+// from LOYALTY_EARN_PERCENT and how many months they last from
+// LOYALTY_POINTS_VALID_MONTHS.
+func loyaltyProgram() loyalty.Program {
+	program := loyalty.DefaultProgram
+	program.EarnPercent = percentFromEnv("LOYALTY_EARN_PERCENT", program.EarnPercent)
+	if raw := os.Getenv("LOYALTY_POINTS_VALID_MONTHS"); raw != "" {
+		months, err := strconv.Atoi(raw)
+		if err != nil || months <= 0 {
+			log.Fatalf("invalid LOYALTY_POINTS_VALID_MONTHS: %q", raw)
+		}
+		program.ValidMonths = months
+	}
+	return program
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 	"strconv"
@@ -24,6 +25,29 @@ func main() {
         }
     }()
 
+    // subcommands
+    if len(os.Args) > 1 && os.Args[1] == "import-books" {
+        code := runImportBooks(database, os.Args[2:])
+        if err := database.Close(); err != nil {
+            log.Printf("failed to close db: %v", err)
+        }
+        os.Exit(code)
+    }
+    if len(os.Args) > 1 && os.Args[1] == "import-rates" {
+        code := runImportRates(database, os.Args[2:])
+        if err := database.Close(); err != nil {
+            log.Printf("failed to close db: %v", err)
+        }
+        os.Exit(code)
+    }
+    if len(os.Args) > 1 && os.Args[1] == "refresh-recommendations" {
+        code := runRefreshRecommendations(database)
+        if err := database.Close(); err != nil {
+            log.Printf("failed to close db: %v", err)
+        }
+        os.Exit(code)
+    }
+
     // read config from env
     jwtSecret := os.Getenv("JWT_SECRET")
     jwtExpiration, err := strconv.Atoi(os.Getenv("JWT_EXPIRATION"))
@@ -38,25 +62,108 @@ func main() {
     publisherRepo       := repository.NewPublisherRepository(database)
     categoryRepo        := repository.NewCategoryRepository(database)
     bookRepo            := repository.NewBookRepository(database)
+    editionRepo         := repository.NewEditionRepository(database)
+    reviewRepo          := repository.NewReviewRepository(database)
+    wishlistRepo        := repository.NewWishlistRepository(database)
+    subscriptionRepo    := repository.NewSubscriptionRepository(database)
+    outboxRepo          := repository.NewOutboxRepository(database)
+    recommendationRepo  := repository.NewRecommendationRepository(database)
+    seriesRepo          := repository.NewSeriesRepository(database)
+    mediaRepo           := repository.NewMediaRepository(database)
+    auditRepo           := repository.NewAuditRepository(database)
+    importRepo          := repository.NewImportRepository(database)
+    exportRepo          := repository.NewExportRepository(database)
+    currencyRepo        := repository.NewCurrencyRepository(database)
+    promotionRepo       := repository.NewPromotionRepository(database)
+    cartRepo            := repository.NewCartRepository(database)
+    orderRepo           := repository.NewOrderRepository(database)
+    invoiceRepo         := repository.NewInvoiceRepository(database)
+    loyaltyRepo         := repository.NewLoyaltyRepository(database)
+    giftCardRepo        := repository.NewGiftCardRepository(database)
+    shippingRepo        := repository.NewShippingRepository(database)
+    warehouseRepo       := repository.NewWarehouseRepository(database)
+
+    blobStore, err := newBlobStore()
+    if err != nil {
+        log.Fatalf("failed to configure blob store: %v", err)
+    }
+    documentStore, err := newDocumentStore()
+    if err != nil {
+        log.Fatalf("failed to configure document store: %v", err)
+    }
+    invoiceRenderer, err := newInvoiceRenderer()
+    if err != nil {
+        log.Fatalf("failed to configure invoices: %v", err)
+    }
 
     // services
     jwtService          := services.NewJWTService(jwtSecret, jwtExpiration)
+    auditService        := services.NewAuditService(auditRepo)
     authService         := services.NewAuthService(userRepo, jwtService)
-    userService         := services.NewUserService(userRepo)
-    authorService       := services.NewAuthorService(authorRepo)
-    publisherService    := services.NewPublisherService(publisherRepo)
-    categoryService     := services.NewCategoryService(categoryRepo)
-    bookService         := services.NewBookService(bookRepo)
+    userService         := services.NewUserService(userRepo, auditService)
+    authorService       := services.NewAuthorService(authorRepo, blobStore, auditService)
+    publisherService    := services.NewPublisherService(publisherRepo, auditService)
+    categoryService     := services.NewCategoryService(categoryRepo, auditService)
+    currencyService     := services.NewCurrencyService(currencyRepo, editionRepo, auditService)
+    bookService         := services.NewBookService(bookRepo, seriesRepo, currencyService, blobStore, auditService)
+    seriesService       := services.NewSeriesService(seriesRepo, auditService)
+    mediaService        := services.NewMediaService(blobStore, mediaRepo, bookRepo, authorRepo, mediaBaseURL(), auditService)
+    reviewService       := services.NewReviewService(reviewRepo, bookRepo, auditService)
+    wishlistService     := services.NewWishlistService(wishlistRepo, editionRepo, services.NewOutboxNotifier(outboxRepo), auditService)
+    subscriptionService := services.NewSubscriptionService(subscriptionRepo, bookRepo, unsubscribeBaseURL(), auditService)
+    editionListeners    := services.EditionListeners{wishlistService, subscriptionService}
+    editionService      := services.NewEditionService(editionRepo, bookRepo, editionListeners, currencyService, auditService)
+    importService       := services.NewImportService(importRepo, editionListeners, auditService)
+    outboxDispatcher    := services.NewOutboxDispatcher(outboxRepo, services.NewLogNotifier())
+    recommendationService := services.NewRecommendationService(recommendationRepo, bookRepo, currencyService)
+    exportService       := services.NewExportService(exportRepo, currencyService)
+    promotionService    := services.NewPromotionService(promotionRepo, auditService)
+    taxRates            := taxRates()
+    cartService         := services.NewCartService(cartRepo, editionRepo, promotionRepo, currencyService, taxRates)
+    shippingService     := services.NewShippingService(shippingRepo, cartService, taxRates, auditService)
+    warehouseService    := services.NewWarehouseService(warehouseRepo, editionRepo, shippingRepo, editionListeners, auditService)
+    loyaltyProgram      := loyaltyProgram()
+    giftCardTerms       := giftCardTerms()
+    pickupCodes         := pickupCodes(jwtSecret)
+    orderService        := services.NewOrderService(orderRepo, cartService, editionRepo, editionListeners, shippingService, warehouseService, loyaltyRepo, loyaltyProgram, giftCardRepo, giftCardTerms, pickupCodes, auditService)
+    loyaltyService      := services.NewLoyaltyService(loyaltyRepo, userRepo, loyaltyProgram, auditService)
+    giftCardService     := services.NewGiftCardService(giftCardRepo, userRepo, giftCardTerms, auditService)
+    invoiceService      := services.NewInvoiceService(invoiceRepo, orderRepo, userRepo, documentStore, invoiceRenderer, auditService)
 
     // handlers
     authHandler         := handlers.NewAuthHandler(authService)
-    userHandler         := handlers.NewUserHandler(userService)
+    userHandler         := handlers.NewUserHandler(userService, repository.EMPLOYEE_ROLES)
     authorHandler       := handlers.NewAuthorHandler(authorService)
     publisherHandler    := handlers.NewPublisherHandler(publisherService)
     categoryHandler     := handlers.NewCategoryHandler(categoryService)
     bookHandler         := handlers.NewBookHandler(bookService)
+    editionHandler      := handlers.NewEditionHandler(editionService)
+    seriesHandler       := handlers.NewSeriesHandler(seriesService)
+    mediaHandler        := handlers.NewMediaHandler(mediaService)
+    reviewHandler       := handlers.NewReviewHandler(reviewService)
+    wishlistHandler     := handlers.NewWishlistHandler(wishlistService)
+    subscriptionHandler := handlers.NewSubscriptionHandler(subscriptionService)
+    recommendationHandler := handlers.NewRecommendationHandler(recommendationService)
+    auditHandler        := handlers.NewAuditHandler(auditService)
+    importHandler       := handlers.NewImportHandler(importService)
+    exportHandler       := handlers.NewExportHandler(exportService)
+    currencyHandler     := handlers.NewCurrencyHandler(currencyService)
+    promotionHandler    := handlers.NewPromotionHandler(promotionService)
+    cartHandler         := handlers.NewCartHandler(cartService)
+    shippingHandler     := handlers.NewShippingHandler(shippingService)
+    warehouseHandler    := handlers.NewWarehouseHandler(warehouseService)
+    orderHandler        := handlers.NewOrderHandler(orderService)
+    invoiceHandler      := handlers.NewInvoiceHandler(invoiceService, repository.EMPLOYEE_ROLES)
+    loyaltyHandler      := handlers.NewLoyaltyHandler(loyaltyService)
+    giftCardHandler     := handlers.NewGiftCardHandler(giftCardService)
+
+    go outboxDispatcher.Run(context.Background(), notificationInterval)
+    go recommendationService.Run(context.Background(), recommendationInterval)
+    go loyaltyService.Run(context.Background(), loyaltyExpiryInterval)
+    go giftCardService.Run(context.Background(), giftCardExpiryInterval)
 
     router := gin.Default()
+    router.Use(middleware.RequestID(), middleware.Currency())
 
     // public routes
     public := router.Group("/api/v1")
@@ -67,10 +174,25 @@ func main() {
         public.GET("/authors",          authorHandler.GetAll)
         public.GET("/publishers/:id",   publisherHandler.GetByID)
         public.GET("/publishers",       publisherHandler.GetAll)
+        public.GET("/categories/tree",  categoryHandler.GetTree)
+        public.GET("/categories/slug/:slug", categoryHandler.GetBySlug)
         public.GET("/categories/:id",   categoryHandler.GetByID)
+        public.GET("/categories/:id/breadcrumbs", categoryHandler.GetBreadcrumbs)
         public.GET("/categories",       categoryHandler.GetAll)
+        public.GET("/books/isbn/:isbn", bookHandler.GetByISBN)
         public.GET("/books/:id",        bookHandler.GetByID)
+        public.GET("/books/:id/reviews", reviewHandler.GetForBook)
+        public.GET("/books/:id/related", recommendationHandler.GetRelated)
         public.GET("/books",            bookHandler.GetAll)
+        public.GET("/editions/:id",     editionHandler.GetByID)
+        public.GET("/currencies",       currencyHandler.GetAll)
+        public.GET("/series/:id",       seriesHandler.GetByID)
+        public.GET("/series",           seriesHandler.GetAll)
+        public.GET("/pickup-points",    shippingHandler.GetPickupPoints)
+        public.GET("/pickup-points/:id", shippingHandler.GetPickupPointByID)
+        public.GET("/media/*key",       mediaHandler.Serve)
+        public.GET("/wishlists/shared/:token", wishlistHandler.GetShared)
+        public.POST("/subscriptions/unsubscribe/:token", subscriptionHandler.UnsubscribeByToken)
 
     }
 
@@ -80,6 +202,38 @@ func main() {
     {
         private.GET("/users/:id",   userHandler.GetProfile)
         private.PUT("/users/:id",   userHandler.Update)
+        private.PATCH("/users/:id", userHandler.Patch)
+        private.POST("/books/:id/reviews", reviewHandler.Create)
+        private.GET("/reviews/mine",    reviewHandler.GetMine)
+        private.PUT("/reviews/:id",     reviewHandler.Update)
+        private.DELETE("/reviews/:id",  reviewHandler.Delete)
+        private.GET("/wishlists",       wishlistHandler.GetAll)
+        private.POST("/wishlists",      wishlistHandler.Create)
+        private.GET("/wishlists/:id",   wishlistHandler.GetByID)
+        private.PUT("/wishlists/:id",   wishlistHandler.Update)
+        private.DELETE("/wishlists/:id", wishlistHandler.Delete)
+        private.PUT("/wishlists/:id/share",    wishlistHandler.Share)
+        private.DELETE("/wishlists/:id/share", wishlistHandler.Unshare)
+        private.POST("/wishlists/:id/items",   wishlistHandler.AddItem)
+        private.DELETE("/wishlists/:id/items/:edition_id", wishlistHandler.RemoveItem)
+        private.POST("/wishlists/:id/items/:edition_id/cart", wishlistHandler.MoveToCart)
+        private.POST("/books/:id/subscriptions", subscriptionHandler.Subscribe)
+        private.GET("/subscriptions",   subscriptionHandler.GetMine)
+        private.DELETE("/subscriptions/:id", subscriptionHandler.Unsubscribe)
+        private.GET("/recommendations", recommendationHandler.GetForUser)
+        private.GET("/cart",            cartHandler.Get)
+        private.PUT("/cart/items/:edition_id",    cartHandler.SetItem)
+        private.DELETE("/cart/items/:edition_id", cartHandler.RemoveItem)
+        private.GET("/cart/shipping",   shippingHandler.Quote)
+        private.POST("/checkout",       orderHandler.Checkout)
+        private.GET("/orders",          orderHandler.GetMine)
+        private.GET("/orders/:id",      orderHandler.GetByID)
+        private.GET("/orders/:id/invoice.pdf", invoiceHandler.Download)
+        private.GET("/loyalty",         loyaltyHandler.GetBalance)
+        private.GET("/loyalty/history", loyaltyHandler.GetHistory)
+        private.GET("/gift-cards",      giftCardHandler.GetMine)
+        private.GET("/gift-cards/:id",  giftCardHandler.GetByID)
+        private.POST("/gift-cards/check", giftCardHandler.Check)
     }
 
     // private routes for employees
@@ -91,16 +245,93 @@ func main() {
         employee.DELETE("/users/:id",       userHandler.Delete)
         employee.POST("/authors",           authorHandler.Create)
         employee.PUT("/authors/:id",        authorHandler.Update)
+        employee.PATCH("/authors/:id",      authorHandler.Patch)
         employee.DELETE("/authors/:id",     authorHandler.Delete)
+        employee.PUT("/authors/:id/photo",  mediaHandler.UploadAuthorPhoto)
+        employee.DELETE("/authors/:id/photo", mediaHandler.DeleteAuthorPhoto)
         employee.POST("/publishers",        publisherHandler.Create)
         employee.PUT("/publishers/:id",     publisherHandler.Update)
+        employee.PATCH("/publishers/:id",   publisherHandler.Patch)
         employee.DELETE("/publishers/:id",  publisherHandler.Delete)
         employee.POST("/categories",        categoryHandler.Create)
         employee.PUT("/categories/:id",     categoryHandler.Update)
+        employee.PATCH("/categories/:id",   categoryHandler.Patch)
         employee.DELETE("/categories/:id",  categoryHandler.Delete)
         employee.POST("/books",             bookHandler.Create)
+        employee.POST("/books/import",      importHandler.ImportBooks)
         employee.PUT("/books/:id",          bookHandler.Update)
+        employee.PATCH("/books/:id",        bookHandler.Patch)
         employee.DELETE("/books/:id",       bookHandler.Delete)
+        employee.PUT("/books/:id/cover",    mediaHandler.UploadBookCover)
+        employee.DELETE("/books/:id/cover", mediaHandler.DeleteBookCover)
+        employee.POST("/books/:id/editions", editionHandler.Create)
+        employee.PUT("/editions/:id",       editionHandler.Update)
+        employee.PATCH("/editions/:id",     editionHandler.Patch)
+        employee.DELETE("/editions/:id",    editionHandler.Delete)
+        employee.GET("/editions/:id/prices", currencyHandler.GetEditionPrices)
+        employee.PUT("/editions/:id/prices/:code", currencyHandler.SetEditionPrice)
+        employee.DELETE("/editions/:id/prices/:code", currencyHandler.DeleteEditionPrice)
+        employee.POST("/currencies",        currencyHandler.Create)
+        employee.POST("/currencies/rates/import", currencyHandler.ImportRates)
+        employee.GET("/currencies/:code/rates", currencyHandler.GetRates)
+        employee.POST("/currencies/:code/rates", currencyHandler.AddRate)
+        employee.GET("/promotions",         promotionHandler.GetAll)
+        employee.POST("/promotions",        promotionHandler.Create)
+        employee.GET("/promotions/:id",     promotionHandler.GetByID)
+        employee.PUT("/promotions/:id",     promotionHandler.Update)
+        employee.DELETE("/promotions/:id",  promotionHandler.Delete)
+        employee.GET("/shipping-zones",     shippingHandler.GetZones)
+        employee.POST("/shipping-zones",    shippingHandler.CreateZone)
+        employee.PUT("/shipping-zones/:id", shippingHandler.UpdateZone)
+        employee.DELETE("/shipping-zones/:id", shippingHandler.DeleteZone)
+        employee.GET("/shipping-methods",   shippingHandler.GetMethods)
+        employee.POST("/shipping-methods",  shippingHandler.CreateMethod)
+        employee.GET("/shipping-methods/:id", shippingHandler.GetMethodByID)
+        employee.PUT("/shipping-methods/:id", shippingHandler.UpdateMethod)
+        employee.DELETE("/shipping-methods/:id", shippingHandler.DeleteMethod)
+        employee.GET("/pickup-points/all",  shippingHandler.GetAllPickupPoints)
+        employee.POST("/pickup-points",     shippingHandler.CreatePickupPoint)
+        employee.PUT("/pickup-points/:id",  shippingHandler.UpdatePickupPoint)
+        employee.DELETE("/pickup-points/:id", shippingHandler.DeletePickupPoint)
+        employee.GET("/warehouses",         warehouseHandler.GetAll)
+        employee.POST("/warehouses",        warehouseHandler.Create)
+        employee.GET("/warehouses/:id",     warehouseHandler.GetByID)
+        employee.PUT("/warehouses/:id",     warehouseHandler.Update)
+        employee.GET("/warehouses/:id/stock", warehouseHandler.GetStock)
+        employee.PUT("/warehouses/:id/stock/:edition_id", warehouseHandler.SetStock)
+        employee.GET("/editions/:id/stock", warehouseHandler.GetEditionStock)
+        employee.GET("/stock-transfers",    warehouseHandler.GetTransfers)
+        employee.POST("/stock-transfers",   warehouseHandler.Transfer)
+        employee.PUT("/orders/:id/status",  orderHandler.UpdateStatus)
+        employee.POST("/orders/:id/pickup", orderHandler.HandOver)
+        employee.POST("/orders/:id/payment", orderHandler.MarkPaid)
+        employee.POST("/gift-cards/issue",  giftCardHandler.Issue)
+        employee.POST("/series",            seriesHandler.Create)
+        employee.PUT("/series/:id",         seriesHandler.Update)
+        employee.PATCH("/series/:id",       seriesHandler.Patch)
+        employee.DELETE("/series/:id",      seriesHandler.Delete)
+        employee.GET("/export/books",       exportHandler.ExportBooks)
+        employee.GET("/export/authors",     exportHandler.ExportAuthors)
+        employee.GET("/export/publishers",  exportHandler.ExportPublishers)
+        employee.GET("/export/customers",   exportHandler.ExportCustomers)
+    }
+
+    // private routes for moderators
+    moderator := router.Group("/api/v1")
+    moderator.Use(middleware.AuthMiddleware(jwtService), middleware.RequireRoles(&repository.MODERATOR_ROLES))
+    {
+        moderator.GET("/reviews/moderation",      reviewHandler.GetModerationQueue)
+        moderator.POST("/reviews/:id/moderation", reviewHandler.Moderate)
+    }
+
+    // private routes for admins
+    admin := router.Group("/api/v1")
+    admin.Use(middleware.AuthMiddleware(jwtService), middleware.RequireRoles(&repository.ADMIN_ROLES))
+    {
+        admin.GET("/audit-logs",            auditHandler.GetAll)
+        admin.GET("/users/:id/loyalty",     loyaltyHandler.GetBalance)
+        admin.GET("/users/:id/loyalty/history", loyaltyHandler.GetHistory)
+        admin.POST("/users/:id/loyalty/adjustments", loyaltyHandler.Adjust)
     }
 
     if err := router.Run(":8080"); err != nil {
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/blob"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+)
+
+// newBlobStore picks the blob store from BLOB_STORE: "local" (the default)
+// keeps files in MEDIA_DIR, "s3" uses the bucket described by the S3_*
+// variables.
+func newBlobStore() (interfaces.BlobStore, error) {
+	switch kind := os.Getenv("BLOB_STORE"); kind {
+	case "", "local":
+		dir := os.Getenv("MEDIA_DIR")
+		if dir == "" {
+			dir = "media"
+		}
+		return blob.NewLocalStore(dir)
+	case "s3":
+		return blob.NewS3Store(blob.S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			Region:          os.Getenv("S3_REGION"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+		})
+	default:
+		return nil, fmt.Errorf("unknown BLOB_STORE %q", kind)
+	}
+}
+
+// mediaBaseURL is the prefix of image URLs in API responses. It defaults to
+// the API's own media route; set MEDIA_BASE_URL to serve through a CDN.
+func mediaBaseURL() string {
+	if url := os.Getenv("MEDIA_BASE_URL"); url != "" {
+		return url
+	}
+	return "/api/v1/media"
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/repository"
+	"github.com/uptrace/bun"
+)
+
+// notificationInterval is how often the outbox is checked for notifications
+// to send.
+const notificationInterval = 10 * time.Second
+
+// unsubscribeBaseURL is the prefix of the unsubscribe links in alerts. Set
+// UNSUBSCRIBE_BASE_URL to point them at the storefront instead of the API.
+func unsubscribeBaseURL() string {
+	if url := os.Getenv("UNSUBSCRIBE_BASE_URL"); url != "" {
+		return url
+	}
+	return "/api/v1/subscriptions/unsubscribe"
+}
+
+// newEditionListeners builds the services that react to edition changes
+// for code paths outside the HTTP server.
+func newEditionListeners(database *bun.DB, auditService *services.AuditService) services.EditionListeners {
+	outbox := services.NewOutboxNotifier(repository.NewOutboxRepository(database))
+	return services.EditionListeners{
+		services.NewWishlistService(repository.NewWishlistRepository(database), repository.NewEditionRepository(database), outbox, auditService),
+		services.NewSubscriptionService(repository.NewSubscriptionRepository(database), repository.NewBookRepository(database), unsubscribeBaseURL(), auditService),
+	}
+}
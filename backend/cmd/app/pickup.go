@@ -0,0 +1,20 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/pickup"
+)
+
+// pickupCodes makes pickup codes with the key in PICKUP_CODE_SECRET.
+// Changing it changes the codes of orders waiting to be collected. Without
+// it the key is derived from jwtSecret, so rotating that one would change
+// them too; this is logged at startup.
+func pickupCodes(jwtSecret string) pickup.Codes {
+	if secret := os.Getenv("PICKUP_CODE_SECRET"); secret != "" {
+		return pickup.NewCodes(secret)
+	}
+	log.Printf("PICKUP_CODE_SECRET is not set, deriving the pickup code key from JWT_SECRET; rotating JWT_SECRET will change the codes of orders waiting to be collected")
+	return pickup.NewCodes(jwtSecret)
+}
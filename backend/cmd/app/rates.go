@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/repository"
+	"github.com/uptrace/bun"
+)
+
+// runImportRates implements the "import-rates" subcommand:
+//
+//	app import-rates -file rates.csv
+func runImportRates(database *bun.DB, args []string) int {
+	flags := flag.NewFlagSet("import-rates", flag.ExitOnError)
+	path := flags.String("file", "", "path to the CSV file with currency, rate and valid_from columns")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *path == "" {
+		flags.Usage()
+		return 2
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		log.Printf("failed to open file: %v", err)
+		return 1
+	}
+	defer func() { _ = file.Close() }()
+
+	auditService := services.NewAuditService(repository.NewAuditRepository(database))
+	currencyService := services.NewCurrencyService(repository.NewCurrencyRepository(database), repository.NewEditionRepository(database), auditService)
+
+	report, err := currencyService.ImportRates(context.Background(), file)
+	if err != nil {
+		log.Printf("import failed: %v", err)
+		return 1
+	}
+	fmt.Fprintf(os.Stderr, "imported: %d\n", report.Imported)
+	return 0
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/repository"
+	"github.com/uptrace/bun"
+)
+
+// recommendationInterval is how often related books are recomputed from
+// order history.
+const recommendationInterval = time.Hour
+
+// runRefreshRecommendations implements the "refresh-recommendations"
+// subcommand, which rebuilds the related books once, e.g. from cron when
+// the server's own schedule is not enough.
+func runRefreshRecommendations(database *bun.DB) int {
+	auditService := services.NewAuditService(repository.NewAuditRepository(database))
+	currencyService := services.NewCurrencyService(repository.NewCurrencyRepository(database), repository.NewEditionRepository(database), auditService)
+	service := services.NewRecommendationService(repository.NewRecommendationRepository(database), repository.NewBookRepository(database), currencyService)
+	if err := service.Refresh(context.Background()); err != nil {
+		log.Printf("failed to refresh recommendations: %v", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/tax"
+)
+
+// taxRates reads the VAT rates in percent from VAT_STANDARD_RATE and
+// VAT_REDUCED_RATE, falling back to the current Russian ones.
+func taxRates() tax.Rates {
+	rates := tax.DefaultRates
+	rates.Standard = percentFromEnv("VAT_STANDARD_RATE", rates.Standard)
+	rates.Reduced = percentFromEnv("VAT_REDUCED_RATE", rates.Reduced)
+	return rates
+}
+
+func percentFromEnv(name string, fallback int) int {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return fallback
+	}
+	percent, err := strconv.Atoi(raw)
+	if err != nil || percent < 0 || percent > 100 {
+		log.Fatalf("invalid %s: %q", name, raw)
+	}
+	return percent
+}
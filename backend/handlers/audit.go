@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+)
+
+type AuditHandler struct {
+	service interfaces.AuditServiceInterface
+}
+
+func NewAuditHandler(service interfaces.AuditServiceInterface) *AuditHandler {
+	return &AuditHandler{service: service}
+}
+
+func (h *AuditHandler) GetAll(c *gin.Context) {
+	var filter dto.AuditFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	entries, err := h.service.GetAll(c.Request.Context(), filter)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, entries)
+}
@@ -29,6 +29,7 @@ func (h *AuthorHandler) Create(c *gin.Context) {
 		apperrors.RespondeError(c, err)
 		return
 	}
+	setETag(c, author.Version)
 	c.JSON(http.StatusCreated, author)
 }
 
@@ -43,6 +44,10 @@ func (h *AuthorHandler) GetByID(c *gin.Context) {
 		apperrors.RespondeError(c, err)
 		return
 	}
+	if notModified(c, author.Version) {
+		return
+	}
+	setETag(c, author.Version)
 	c.JSON(http.StatusOK, author)
 }
 
@@ -61,16 +66,47 @@ func (h *AuthorHandler) Update(c *gin.Context) {
 		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid author ID: " + err.Error()))
 		return
 	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
 	var input dto.AuthorInput
 	if err := c.ShouldBindJSON(&input); err != nil {
 		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
 		return
 	}
-	author, err := h.authorService.Update(c.Request.Context(), id, input)
+	author, err := h.authorService.Update(c.Request.Context(), id, version, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, author.Version)
+	c.JSON(http.StatusOK, author)
+}
+
+func (h *AuthorHandler) Patch(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid author ID: " + err.Error()))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	patch, err := readMergePatch(c)
 	if err != nil {
 		apperrors.RespondeError(c, err)
 		return
 	}
+	author, err := h.authorService.Patch(c.Request.Context(), id, version, patch)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, author.Version)
 	c.JSON(http.StatusOK, author)
 }
 
@@ -80,7 +116,12 @@ func (h *AuthorHandler) Delete(c *gin.Context) {
 		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid author ID: " + err.Error()))
 		return
 	}
-	err = h.authorService.Delete(c.Request.Context(), id)
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	err = h.authorService.Delete(c.Request.Context(), id, version)
 	if err != nil {
 		apperrors.RespondeError(c, err)
 		return
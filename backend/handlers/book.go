@@ -29,6 +29,7 @@ func (h *BookHandler) Create(c *gin.Context) {
 		apperrors.RespondeError(c, err)
 		return
 	}
+	setETag(c, book.Version)
 	c.JSON(http.StatusCreated, book)
 }
 
@@ -38,12 +39,21 @@ func (h *BookHandler) GetByID(c *gin.Context) {
 		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid book ID"))
 		return
 	}
-	book, err := h.service.GetByID(c.Request.Context(), id)
+	book, err := h.service.GetDetails(c.Request.Context(), id)
 	if err != nil {
 		apperrors.RespondeError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, book)
+	respondTagged(c, book.Version, book)
+}
+
+func (h *BookHandler) GetByISBN(c *gin.Context) {
+	book, err := h.service.GetByISBN(c.Request.Context(), c.Param("isbn"))
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	respondTagged(c, book.Version, book)
 }
 
 func (h *BookHandler) GetAll(c *gin.Context) {
@@ -66,16 +76,47 @@ func (h *BookHandler) Update(c *gin.Context) {
 		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid book ID"))
 		return
 	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
 	var input dto.BookInput
 	if err := c.ShouldBindJSON(&input); err != nil {
 		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
 		return
 	}
-	book, err := h.service.Update(c.Request.Context(), id, input)
+	book, err := h.service.Update(c.Request.Context(), id, version, input)
 	if err != nil {
 		apperrors.RespondeError(c, err)
 		return
 	}
+	setETag(c, book.Version)
+	c.JSON(http.StatusOK, book)
+}
+
+func (h *BookHandler) Patch(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid book ID"))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	patch, err := readMergePatch(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	book, err := h.service.Patch(c.Request.Context(), id, version, patch)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, book.Version)
 	c.JSON(http.StatusOK, book)
 }
 
@@ -85,7 +126,12 @@ func (h *BookHandler) Delete(c *gin.Context) {
 		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid book ID"))
 		return
 	}
-	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if err := h.service.Delete(c.Request.Context(), id, version); err != nil {
 		apperrors.RespondeError(c, err)
 		return
 	}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CartHandler struct {
+	service interfaces.CartServiceInterface
+}
+
+func NewCartHandler(service interfaces.CartServiceInterface) *CartHandler {
+	return &CartHandler{service: service}
+}
+
+// Get returns the priced cart; ?coupon= previews a coupon code, and the
+// response says why it does not apply if it does not.
+func (h *CartHandler) Get(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	cart, err := h.service.Get(c.Request.Context(), userID, c.Query("coupon"))
+	h.respond(c, cart, err)
+}
+
+func (h *CartHandler) SetItem(c *gin.Context) {
+	userID, editionID, ok := cartItemParams(c)
+	if !ok {
+		return
+	}
+	var input dto.CartItemInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	cart, err := h.service.SetItem(c.Request.Context(), userID, editionID, input)
+	h.respond(c, cart, err)
+}
+
+func (h *CartHandler) RemoveItem(c *gin.Context) {
+	userID, editionID, ok := cartItemParams(c)
+	if !ok {
+		return
+	}
+	cart, err := h.service.RemoveItem(c.Request.Context(), userID, editionID)
+	h.respond(c, cart, err)
+}
+
+func (h *CartHandler) respond(c *gin.Context, cart *dto.Cart, err error) {
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, cart)
+}
+
+// cartItemParams reads the current user and the edition ID, responding
+// with an error if either is missing.
+func cartItemParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return uuid.Nil, uuid.Nil, false
+	}
+	editionID, err := uuid.Parse(c.Param("edition_id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid edition ID"))
+		return uuid.Nil, uuid.Nil, false
+	}
+	return userID, editionID, true
+}
@@ -4,6 +4,7 @@ import (
     "net/http"
 
     "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+    "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
     "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
     "github.com/gin-gonic/gin"
     "github.com/google/uuid"
@@ -18,16 +19,17 @@ func NewCategoryHandler(service interfaces.CategoryServiceInterface) *CategoryHa
 }
 
 func (h *CategoryHandler) Create(c *gin.Context) {
-    var name string
-    if err := c.ShouldBindJSON(&name); err != nil {
+    var input dto.CategoryInput
+    if err := c.ShouldBindJSON(&input); err != nil {
         apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
         return
     }
-    category, err := h.service.Create(c.Request.Context(), name)
+    category, err := h.service.Create(c.Request.Context(), input)
     if err != nil {
         apperrors.RespondeError(c, err)
         return
     }
+    setETag(c, category.Version)
     c.JSON(http.StatusCreated, category)
 }
 
@@ -40,6 +42,42 @@ func (h *CategoryHandler) GetAll(c *gin.Context) {
     c.JSON(http.StatusOK, categories)
 }
 
+func (h *CategoryHandler) GetTree(c *gin.Context) {
+    tree, err := h.service.GetTree(c.Request.Context())
+    if err != nil {
+        apperrors.RespondeError(c, err)
+        return
+    }
+    c.JSON(http.StatusOK, tree)
+}
+
+func (h *CategoryHandler) GetBySlug(c *gin.Context) {
+    category, err := h.service.GetBySlug(c.Request.Context(), c.Param("slug"))
+    if err != nil {
+        apperrors.RespondeError(c, err)
+        return
+    }
+    if notModified(c, category.Version) {
+        return
+    }
+    setETag(c, category.Version)
+    c.JSON(http.StatusOK, category)
+}
+
+func (h *CategoryHandler) GetBreadcrumbs(c *gin.Context) {
+    id, err := uuid.Parse(c.Param("id"))
+    if err != nil {
+        apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid category ID"))
+        return
+    }
+    path, err := h.service.GetBreadcrumbs(c.Request.Context(), id)
+    if err != nil {
+        apperrors.RespondeError(c, err)
+        return
+    }
+    c.JSON(http.StatusOK, path)
+}
+
 func (h *CategoryHandler) GetByID(c *gin.Context) {
     id, err := uuid.Parse(c.Param("id"))
     if err != nil {
@@ -51,6 +89,10 @@ func (h *CategoryHandler) GetByID(c *gin.Context) {
         apperrors.RespondeError(c, err)
         return
     }
+    if notModified(c, category.Version) {
+        return
+    }
+    setETag(c, category.Version)
     c.JSON(http.StatusOK, category)
 }
 
@@ -60,16 +102,47 @@ func (h *CategoryHandler) Update(c *gin.Context) {
         apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid category ID"))
         return
     }
-    var name string
-	if err := c.ShouldBindJSON(&name); err != nil {
-		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
-		return
-	}
-    category, err := h.service.Update(c.Request.Context(), id, name)
+    version, err := ifMatchVersion(c)
     if err != nil {
         apperrors.RespondeError(c, err)
         return
     }
+    var input dto.CategoryInput
+    if err := c.ShouldBindJSON(&input); err != nil {
+        apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+        return
+    }
+    category, err := h.service.Update(c.Request.Context(), id, version, input)
+    if err != nil {
+        apperrors.RespondeError(c, err)
+        return
+    }
+    setETag(c, category.Version)
+    c.JSON(http.StatusOK, category)
+}
+
+func (h *CategoryHandler) Patch(c *gin.Context) {
+    id, err := uuid.Parse(c.Param("id"))
+    if err != nil {
+        apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid category ID"))
+        return
+    }
+    version, err := ifMatchVersion(c)
+    if err != nil {
+        apperrors.RespondeError(c, err)
+        return
+    }
+    patch, err := readMergePatch(c)
+    if err != nil {
+        apperrors.RespondeError(c, err)
+        return
+    }
+    category, err := h.service.Patch(c.Request.Context(), id, version, patch)
+    if err != nil {
+        apperrors.RespondeError(c, err)
+        return
+    }
+    setETag(c, category.Version)
     c.JSON(http.StatusOK, category)
 }
 
@@ -79,7 +152,12 @@ func (h *CategoryHandler) Delete(c *gin.Context) {
         apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid category ID"))
         return
     }
-    if err := h.service.Delete(c.Request.Context(), id); err != nil {
+    version, err := ifMatchVersion(c)
+    if err != nil {
+        apperrors.RespondeError(c, err)
+        return
+    }
+    if err := h.service.Delete(c.Request.Context(), id, version); err != nil {
         apperrors.RespondeError(c, err)
         return
     }
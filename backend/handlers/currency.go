@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CurrencyHandler struct {
+	service interfaces.CurrencyServiceInterface
+}
+
+func NewCurrencyHandler(service interfaces.CurrencyServiceInterface) *CurrencyHandler {
+	return &CurrencyHandler{service: service}
+}
+
+func (h *CurrencyHandler) GetAll(c *gin.Context) {
+	currencies, err := h.service.GetAll(c.Request.Context())
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, currencies)
+}
+
+func (h *CurrencyHandler) Create(c *gin.Context) {
+	var input dto.CurrencyInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	currency, err := h.service.Create(c.Request.Context(), input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, currency)
+}
+
+// GetRates returns the rate history of the currency given by the :code
+// path parameter.
+func (h *CurrencyHandler) GetRates(c *gin.Context) {
+	rates, err := h.service.GetRates(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, rates)
+}
+
+func (h *CurrencyHandler) AddRate(c *gin.Context) {
+	var input dto.ExchangeRateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	rate, err := h.service.AddRate(c.Request.Context(), c.Param("code"), input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, rate)
+}
+
+// ImportRates accepts a CSV file of rates either as multipart field "file"
+// or as a text/csv request body.
+func (h *CurrencyHandler) ImportRates(c *gin.Context) {
+	var body io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			apperrors.RespondeError(c, apperrors.ErrBadRequest("file is required: " + err.Error()))
+			return
+		}
+		file, err := header.Open()
+		if err != nil {
+			apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+			return
+		}
+		defer func() { _ = file.Close() }()
+		body = file
+	}
+
+	report, err := h.service.ImportRates(c.Request.Context(), body)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+func (h *CurrencyHandler) GetEditionPrices(c *gin.Context) {
+	editionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid edition ID"))
+		return
+	}
+	prices, err := h.service.GetEditionPrices(c.Request.Context(), editionID)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, prices)
+}
+
+// SetEditionPrice fixes the price of the edition given by :id in the
+// currency given by :code.
+func (h *CurrencyHandler) SetEditionPrice(c *gin.Context) {
+	editionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid edition ID"))
+		return
+	}
+	var input dto.EditionPriceInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	price, err := h.service.SetEditionPrice(c.Request.Context(), editionID, c.Param("code"), input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, price)
+}
+
+func (h *CurrencyHandler) DeleteEditionPrice(c *gin.Context) {
+	editionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid edition ID"))
+		return
+	}
+	if err := h.service.DeleteEditionPrice(c.Request.Context(), editionID, c.Param("code")); err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// currentUserID returns the ID of the user the request was authenticated as.
+func currentUserID(c *gin.Context) (uuid.UUID, error) {
+	raw, exists := c.Get("user_id")
+	if !exists {
+		return uuid.Nil, apperrors.ErrUnauthorized("missing user_id in token")
+	}
+	id, ok := raw.(uuid.UUID)
+	if !ok {
+		return uuid.Nil, apperrors.ErrUnauthorized("invalid user_id in token")
+	}
+	return id, nil
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type EditionHandler struct {
+	service interfaces.EditionServiceInterface
+}
+
+func NewEditionHandler(service interfaces.EditionServiceInterface) *EditionHandler {
+	return &EditionHandler{service: service}
+}
+
+// Create adds an edition to the book given by the :id path parameter.
+func (h *EditionHandler) Create(c *gin.Context) {
+	bookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid book ID"))
+		return
+	}
+	var input dto.EditionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	edition, err := h.service.Create(c.Request.Context(), bookID, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, edition.Version)
+	c.JSON(http.StatusCreated, edition)
+}
+
+func (h *EditionHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid edition ID"))
+		return
+	}
+	edition, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	respondTagged(c, edition.Version, edition)
+}
+
+func (h *EditionHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid edition ID"))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var input dto.EditionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	edition, err := h.service.Update(c.Request.Context(), id, version, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, edition.Version)
+	c.JSON(http.StatusOK, edition)
+}
+
+func (h *EditionHandler) Patch(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid edition ID"))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	patch, err := readMergePatch(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	edition, err := h.service.Patch(c.Request.Context(), id, version, patch)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, edition.Version)
+	c.JSON(http.StatusOK, edition)
+}
+
+func (h *EditionHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid edition ID"))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if err := h.service.Delete(c.Request.Context(), id, version); err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusNoContent, nil)
+}
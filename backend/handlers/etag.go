@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/gin-gonic/gin"
+)
+
+func etag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+func setETag(c *gin.Context, version int64) {
+	c.Header("ETag", etag(version))
+}
+
+// notModified answers 304 if the client already has the current version
+// of the resource.
+func notModified(c *gin.Context, version int64) bool {
+	if !matchesETag(c.GetHeader("If-None-Match"), etag(version)) {
+		return false
+	}
+	setETag(c, version)
+	c.Status(http.StatusNotModified)
+	return true
+}
+
+// respondTagged answers with a resource whose body depends on more than its
+// version: records it embeds, which change without it, or the currency it
+// is priced in. Its ETag is the version, which If-Match takes, followed by
+// a hash of the body, and 304 is answered only if the client has that very
+// body.
+func respondTagged(c *gin.Context, version int64, body any) {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrInternal(err))
+		return
+	}
+	sum := sha256.Sum256(raw)
+	tag := `"` + strconv.FormatInt(version, 10) + "-" + hex.EncodeToString(sum[:8]) + `"`
+	c.Header("ETag", tag)
+	if matchesETag(c.GetHeader("If-None-Match"), tag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", raw)
+}
+
+// matchesETag reports whether an If-None-Match header lists the tag.
+func matchesETag(header, current string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag != "" && (tag == "*" || tag == current) {
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatchVersion returns the version the client expects from the If-Match header.
+func ifMatchVersion(c *gin.Context) (int64, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" {
+		return 0, apperrors.ErrPreconditionRequired("If-Match header is required")
+	}
+	tag := strings.Trim(strings.TrimPrefix(header, "W/"), `"`)
+	// tags given by respondTagged carry a hash of the body after the version
+	tag, _, _ = strings.Cut(tag, "-")
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil {
+		return 0, apperrors.ErrPreconditionFailed("invalid If-Match header")
+	}
+	return version, nil
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/export"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+)
+
+type ExportHandler struct {
+	service interfaces.ExportServiceInterface
+}
+
+func NewExportHandler(service interfaces.ExportServiceInterface) *ExportHandler {
+	return &ExportHandler{service: service}
+}
+
+func (h *ExportHandler) ExportBooks(c *gin.Context) {
+	var filter dto.BookFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	h.stream(c, "books", func(format string, w io.Writer) error {
+		return h.service.ExportBooks(c.Request.Context(), format, filter, w)
+	})
+}
+
+func (h *ExportHandler) ExportAuthors(c *gin.Context) {
+	h.stream(c, "authors", func(format string, w io.Writer) error {
+		return h.service.ExportAuthors(c.Request.Context(), format, w)
+	})
+}
+
+func (h *ExportHandler) ExportPublishers(c *gin.Context) {
+	h.stream(c, "publishers", func(format string, w io.Writer) error {
+		return h.service.ExportPublishers(c.Request.Context(), format, w)
+	})
+}
+
+func (h *ExportHandler) ExportCustomers(c *gin.Context) {
+	h.stream(c, "customers", func(format string, w io.Writer) error {
+		return h.service.ExportCustomers(c.Request.Context(), format, w)
+	})
+}
+
+// stream writes the export straight into the response. Once the first byte
+// is sent the status can no longer change, so later failures only abort the
+// connection and are logged.
+func (h *ExportHandler) stream(c *gin.Context, name string, run func(format string, w io.Writer) error) {
+	format := c.DefaultQuery("format", export.FormatCSV)
+	contentType, ok := export.ContentType(format)
+	if !ok {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("unsupported export format: "+format))
+		return
+	}
+
+	filename := fmt.Sprintf("%s-%s.%s", name, time.Now().UTC().Format("20060102-150405"), format)
+	c.Header("Content-Type", contentType)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Status(http.StatusOK)
+
+	if err := run(format, c.Writer); err != nil {
+		if !c.Writer.Written() {
+			c.Header("Content-Type", "")
+			c.Header("Content-Disposition", "")
+			apperrors.RespondeError(c, err)
+			return
+		}
+		log.Printf("export of %s failed: %v", name, err)
+		_ = c.Error(err)
+		c.Abort()
+	}
+}
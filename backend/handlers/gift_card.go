@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type GiftCardHandler struct {
+	service interfaces.GiftCardServiceInterface
+}
+
+func NewGiftCardHandler(service interfaces.GiftCardServiceInterface) *GiftCardHandler {
+	return &GiftCardHandler{service: service}
+}
+
+func (h *GiftCardHandler) Issue(c *gin.Context) {
+	employeeID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var input dto.GiftCardIssueInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	issued, err := h.service.Issue(c.Request.Context(), employeeID, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, issued)
+}
+
+// Check takes the code in the body rather than the path, so that it does
+// not end up in access logs.
+func (h *GiftCardHandler) Check(c *gin.Context) {
+	var input dto.GiftCardCodeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	balance, err := h.service.Check(c.Request.Context(), input.Code)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, balance)
+}
+
+func (h *GiftCardHandler) GetMine(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	cards, err := h.service.GetMine(c.Request.Context(), userID)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, cards)
+}
+
+func (h *GiftCardHandler) GetByID(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid gift card ID"))
+		return
+	}
+	card, err := h.service.GetByID(c.Request.Context(), userID, id)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, card)
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+)
+
+type ImportHandler struct {
+	service interfaces.ImportServiceInterface
+}
+
+func NewImportHandler(service interfaces.ImportServiceInterface) *ImportHandler {
+	return &ImportHandler{service: service}
+}
+
+// ImportBooks accepts a CSV file either as multipart field "file" or as a
+// text/csv request body. With ?dry_run=true only the report is returned.
+func (h *ImportHandler) ImportBooks(c *gin.Context) {
+	dryRun := false
+	if raw := c.Query("dry_run"); raw != "" {
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid dry_run value"))
+			return
+		}
+		dryRun = value
+	}
+
+	var body io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			apperrors.RespondeError(c, apperrors.ErrBadRequest("file is required: " + err.Error()))
+			return
+		}
+		file, err := header.Open()
+		if err != nil {
+			apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+			return
+		}
+		defer func() { _ = file.Close() }()
+		body = file
+	}
+
+	report, err := h.service.ImportBooks(c.Request.Context(), body, dryRun)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if report.Invalid > 0 && !dryRun {
+		c.JSON(http.StatusUnprocessableEntity, report)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
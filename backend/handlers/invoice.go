@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type InvoiceHandler struct {
+	service       interfaces.InvoiceServiceInterface
+	employeeRoles []string
+}
+
+// NewInvoiceHandler creates the handler; users with one of employeeRoles
+// may download the invoice of any order.
+func NewInvoiceHandler(service interfaces.InvoiceServiceInterface, employeeRoles []string) *InvoiceHandler {
+	return &InvoiceHandler{service: service, employeeRoles: employeeRoles}
+}
+
+func (h *InvoiceHandler) Download(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid order ID"))
+		return
+	}
+	employee := slices.Contains(h.employeeRoles, c.GetString("role"))
+
+	invoice, object, err := h.service.Open(c.Request.Context(), userID, orderID, employee)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	defer func() { _ = object.Body.Close() }()
+
+	c.Header("Cache-Control", "private, no-cache")
+	if object.ETag != "" {
+		c.Header("ETag", object.ETag)
+		if c.GetHeader("If-None-Match") == object.ETag {
+			c.Status(http.StatusNotModified)
+			return
+		}
+	}
+	if object.Size >= 0 {
+		c.Header("Content-Length", strconv.FormatInt(object.Size, 10))
+	}
+	c.Header("Content-Disposition", `attachment; filename="invoice-`+invoice.Code+`.pdf"`)
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Content-Type", "application/pdf")
+	c.Status(http.StatusOK)
+	_, _ = io.Copy(c.Writer, object.Body)
+}
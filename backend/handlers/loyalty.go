@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type LoyaltyHandler struct {
+	service interfaces.LoyaltyServiceInterface
+}
+
+func NewLoyaltyHandler(service interfaces.LoyaltyServiceInterface) *LoyaltyHandler {
+	return &LoyaltyHandler{service: service}
+}
+
+// GetBalance serves the signed-in customer's points, or with an :id those
+// of any customer to admins.
+func (h *LoyaltyHandler) GetBalance(c *gin.Context) {
+	userID, err := loyaltyUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	balance, err := h.service.GetBalance(c.Request.Context(), userID)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, balance)
+}
+
+func (h *LoyaltyHandler) GetHistory(c *gin.Context) {
+	userID, err := loyaltyUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var query dto.LoyaltyHistoryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	page, err := h.service.GetHistory(c.Request.Context(), userID, query)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, page)
+}
+
+func (h *LoyaltyHandler) Adjust(c *gin.Context) {
+	adminID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid user ID"))
+		return
+	}
+	var input dto.LoyaltyAdjustmentInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	entry, err := h.service.Adjust(c.Request.Context(), adminID, userID, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, entry)
+}
+
+// loyaltyUserID is the user in the path on admin routes and the signed-in
+// user otherwise.
+func loyaltyUserID(c *gin.Context) (uuid.UUID, error) {
+	if raw := c.Param("id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return uuid.Nil, apperrors.ErrBadRequest("invalid user ID")
+		}
+		return id, nil
+	}
+	return currentUserID(c)
+}
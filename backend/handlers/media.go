@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/imaging"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// mediaCacheControl is sent with every blob. Uploads never overwrite a key,
+// so clients may cache them for a year without revalidating.
+const mediaCacheControl = "public, max-age=31536000, immutable"
+
+// multipartOverhead is what an upload's body may carry on top of the file:
+// the boundaries and part headers.
+const multipartOverhead = 64 << 10
+
+type MediaHandler struct {
+	service interfaces.MediaServiceInterface
+}
+
+func NewMediaHandler(service interfaces.MediaServiceInterface) *MediaHandler {
+	return &MediaHandler{service: service}
+}
+
+// UploadBookCover accepts the image as multipart field "file".
+func (h *MediaHandler) UploadBookCover(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid book ID"))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	data, err := readUpload(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	book, err := h.service.UploadBookCover(c.Request.Context(), id, version, data)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, book.Version)
+	c.JSON(http.StatusOK, book)
+}
+
+func (h *MediaHandler) DeleteBookCover(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid book ID"))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	book, err := h.service.DeleteBookCover(c.Request.Context(), id, version)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, book.Version)
+	c.JSON(http.StatusOK, book)
+}
+
+// UploadAuthorPhoto accepts the image as multipart field "file".
+func (h *MediaHandler) UploadAuthorPhoto(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid author ID"))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	data, err := readUpload(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	author, err := h.service.UploadAuthorPhoto(c.Request.Context(), id, version, data)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, author.Version)
+	c.JSON(http.StatusOK, author)
+}
+
+func (h *MediaHandler) DeleteAuthorPhoto(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid author ID"))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	author, err := h.service.DeleteAuthorPhoto(c.Request.Context(), id, version)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, author.Version)
+	c.JSON(http.StatusOK, author)
+}
+
+// Serve streams a stored image, answering conditional requests with 304.
+func (h *MediaHandler) Serve(c *gin.Context) {
+	object, err := h.service.Open(c.Request.Context(), strings.TrimPrefix(c.Param("key"), "/"))
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	defer func() { _ = object.Body.Close() }()
+
+	c.Header("Cache-Control", mediaCacheControl)
+	if object.ETag != "" {
+		c.Header("ETag", object.ETag)
+		if c.GetHeader("If-None-Match") == object.ETag {
+			c.Status(http.StatusNotModified)
+			return
+		}
+	}
+	if !object.ModTime.IsZero() {
+		c.Header("Last-Modified", object.ModTime.UTC().Format(http.TimeFormat))
+	}
+	if object.Size >= 0 {
+		c.Header("Content-Length", strconv.FormatInt(object.Size, 10))
+	}
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Content-Type", object.ContentType)
+	c.Status(http.StatusOK)
+	_, _ = io.Copy(c.Writer, object.Body)
+}
+
+// readUpload reads multipart field "file", refusing bodies above the
+// upload limit before parsing them.
+func readUpload(c *gin.Context) ([]byte, error) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, imaging.MaxUploadSize+multipartOverhead)
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, apperrors.ErrPayloadTooLarge("image file is too large")
+		}
+		return nil, apperrors.ErrBadRequest("file is required: " + err.Error())
+	}
+	if header.Size > imaging.MaxUploadSize {
+		return nil, apperrors.ErrPayloadTooLarge("image file is too large")
+	}
+	file, err := header.Open()
+	if err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+	defer func() { _ = file.Close() }()
+	data, err := io.ReadAll(io.LimitReader(file, imaging.MaxUploadSize+1))
+	if err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+	return data, nil
+}
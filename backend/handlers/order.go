@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type OrderHandler struct {
+	service interfaces.OrderServiceInterface
+}
+
+func NewOrderHandler(service interfaces.OrderServiceInterface) *OrderHandler {
+	return &OrderHandler{service: service}
+}
+
+func (h *OrderHandler) Checkout(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var input dto.CheckoutInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	order, err := h.service.Checkout(c.Request.Context(), userID, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if len(order.GiftCards) > 0 {
+		// The response carries the codes of the gift cards bought.
+		c.Header("Cache-Control", "no-store")
+	}
+	c.JSON(http.StatusCreated, order)
+}
+
+func (h *OrderHandler) GetMine(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	orders, err := h.service.GetMine(c.Request.Context(), userID)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, orders)
+}
+
+func (h *OrderHandler) GetByID(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid order ID"))
+		return
+	}
+	order, err := h.service.GetByID(c.Request.Context(), userID, id)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, order)
+}
+
+func (h *OrderHandler) UpdateStatus(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid order ID"))
+		return
+	}
+	var input dto.OrderStatusInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	order, err := h.service.UpdateStatus(c.Request.Context(), id, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, order)
+}
+
+// HandOver delivers a pickup order to the customer once the pickup code
+// they tell is checked.
+func (h *OrderHandler) HandOver(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid order ID"))
+		return
+	}
+	var input dto.PickupInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	order, err := h.service.HandOver(c.Request.Context(), id, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, order)
+}
+
+// MarkPaid records that the order's payment has been received.
+func (h *OrderHandler) MarkPaid(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid order ID"))
+		return
+	}
+	order, err := h.service.MarkPaid(c.Request.Context(), id)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, order)
+}
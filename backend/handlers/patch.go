@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/gin-gonic/gin"
+)
+
+const mergePatchContentType = "application/merge-patch+json"
+
+// readMergePatch returns the raw body of a PATCH request. Both
+// application/merge-patch+json and plain application/json are accepted.
+func readMergePatch(c *gin.Context) ([]byte, error) {
+	mediaType, _, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	if err != nil || (mediaType != mergePatchContentType && mediaType != "application/json") {
+		return nil, &apperrors.AppError{
+			Code:    http.StatusUnsupportedMediaType,
+			Message: "content type must be " + mergePatchContentType,
+		}
+	}
+	body, err := c.GetRawData()
+	if err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+	return body, nil
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type PromotionHandler struct {
+	service interfaces.PromotionServiceInterface
+}
+
+func NewPromotionHandler(service interfaces.PromotionServiceInterface) *PromotionHandler {
+	return &PromotionHandler{service: service}
+}
+
+func (h *PromotionHandler) Create(c *gin.Context) {
+	var input dto.PromotionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	promotion, err := h.service.Create(c.Request.Context(), input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, promotion.Version)
+	c.JSON(http.StatusCreated, promotion)
+}
+
+// GetAll lists promotions; ?current=true leaves only the running ones.
+func (h *PromotionHandler) GetAll(c *gin.Context) {
+	var filter dto.PromotionFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	promotions, err := h.service.GetAll(c.Request.Context(), filter)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, promotions)
+}
+
+func (h *PromotionHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid promotion ID: "+err.Error()))
+		return
+	}
+	promotion, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if notModified(c, promotion.Version) {
+		return
+	}
+	setETag(c, promotion.Version)
+	c.JSON(http.StatusOK, promotion)
+}
+
+func (h *PromotionHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid promotion ID: "+err.Error()))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var input dto.PromotionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	promotion, err := h.service.Update(c.Request.Context(), id, version, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, promotion.Version)
+	c.JSON(http.StatusOK, promotion)
+}
+
+func (h *PromotionHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid promotion ID: "+err.Error()))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if err := h.service.Delete(c.Request.Context(), id, version); err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
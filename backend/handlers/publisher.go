@@ -29,6 +29,7 @@ func (h *PublisherHandler) Create(c *gin.Context) {
 		apperrors.RespondeError(c, err)
 		return
 	}
+	setETag(c, publisher.Version)
 	c.JSON(http.StatusCreated, publisher)
 }
 
@@ -43,6 +44,10 @@ func (h *PublisherHandler) GetByID(c *gin.Context) {
 		apperrors.RespondeError(c, err)
 		return
 	}
+	if notModified(c, publisher.Version) {
+		return
+	}
+	setETag(c, publisher.Version)
 	c.JSON(http.StatusOK, publisher)
 }
 
@@ -61,16 +66,47 @@ func (h *PublisherHandler) Update(c *gin.Context) {
 		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid publisher ID: " + err.Error()))
 		return
 	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
 	var input dto.PublisherInput
 	if err := c.ShouldBindJSON(&input); err != nil {
 		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
 		return
 	}
-	publisher, err := h.publisherService.Update(c.Request.Context(), id, input)
+	publisher, err := h.publisherService.Update(c.Request.Context(), id, version, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, publisher.Version)
+	c.JSON(http.StatusOK, publisher)
+}
+
+func (h *PublisherHandler) Patch(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid publisher ID: " + err.Error()))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	patch, err := readMergePatch(c)
 	if err != nil {
 		apperrors.RespondeError(c, err)
 		return
 	}
+	publisher, err := h.publisherService.Patch(c.Request.Context(), id, version, patch)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, publisher.Version)
 	c.JSON(http.StatusOK, publisher)
 }
 
@@ -80,7 +116,12 @@ func (h *PublisherHandler) Delete(c *gin.Context) {
 		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid publisher ID: " + err.Error()))
 		return
 	}
-	err = h.publisherService.Delete(c.Request.Context(), id)
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	err = h.publisherService.Delete(c.Request.Context(), id, version)
 	if err != nil {
 		apperrors.RespondeError(c, err)
 		return
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RecommendationHandler struct {
+	service interfaces.RecommendationServiceInterface
+}
+
+func NewRecommendationHandler(service interfaces.RecommendationServiceInterface) *RecommendationHandler {
+	return &RecommendationHandler{service: service}
+}
+
+func (h *RecommendationHandler) GetRelated(c *gin.Context) {
+	bookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid book ID"))
+		return
+	}
+	var query dto.RecommendationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	books, err := h.service.GetRelated(c.Request.Context(), bookID, query)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, books)
+}
+
+func (h *RecommendationHandler) GetForUser(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var query dto.RecommendationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	books, err := h.service.GetForUser(c.Request.Context(), userID, query)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Header("Cache-Control", "private, no-cache")
+	c.JSON(http.StatusOK, books)
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SeriesHandler struct {
+	service interfaces.SeriesServiceInterface
+}
+
+func NewSeriesHandler(service interfaces.SeriesServiceInterface) *SeriesHandler {
+	return &SeriesHandler{service: service}
+}
+
+func (h *SeriesHandler) Create(c *gin.Context) {
+	var input dto.SeriesInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	series, err := h.service.Create(c.Request.Context(), input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, series.Version)
+	c.JSON(http.StatusCreated, series)
+}
+
+// GetByID returns the series with its books in reading order. The ETag only
+// tracks the series itself, so conditional GETs are not answered with 304:
+// the book list changes without touching the series version.
+func (h *SeriesHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid series ID"))
+		return
+	}
+	series, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, series.Version)
+	c.JSON(http.StatusOK, series)
+}
+
+func (h *SeriesHandler) GetAll(c *gin.Context) {
+	series, err := h.service.GetAll(c.Request.Context())
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, series)
+}
+
+func (h *SeriesHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid series ID"))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var input dto.SeriesInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	series, err := h.service.Update(c.Request.Context(), id, version, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, series.Version)
+	c.JSON(http.StatusOK, series)
+}
+
+func (h *SeriesHandler) Patch(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid series ID"))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	patch, err := readMergePatch(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	series, err := h.service.Patch(c.Request.Context(), id, version, patch)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, series.Version)
+	c.JSON(http.StatusOK, series)
+}
+
+func (h *SeriesHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid series ID"))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if err := h.service.Delete(c.Request.Context(), id, version); err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,301 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ShippingHandler struct {
+	service interfaces.ShippingServiceInterface
+}
+
+func NewShippingHandler(service interfaces.ShippingServiceInterface) *ShippingHandler {
+	return &ShippingHandler{service: service}
+}
+
+// Quote lists what the signed-in customer's cart costs to ship to
+// ?postal_code=, with the ?coupon= applied to its total.
+func (h *ShippingHandler) Quote(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var query dto.ShippingQuoteQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	quotes, err := h.service.Quote(c.Request.Context(), userID, query)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, quotes)
+}
+
+func (h *ShippingHandler) CreateZone(c *gin.Context) {
+	var input dto.ShippingZoneInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	zone, err := h.service.CreateZone(c.Request.Context(), input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, zone.Version)
+	c.JSON(http.StatusCreated, zone)
+}
+
+func (h *ShippingHandler) GetZones(c *gin.Context) {
+	zones, err := h.service.GetZones(c.Request.Context())
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, zones)
+}
+
+func (h *ShippingHandler) UpdateZone(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid shipping zone ID: "+err.Error()))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var input dto.ShippingZoneInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	zone, err := h.service.UpdateZone(c.Request.Context(), id, version, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, zone.Version)
+	c.JSON(http.StatusOK, zone)
+}
+
+func (h *ShippingHandler) DeleteZone(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid shipping zone ID: "+err.Error()))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if err := h.service.DeleteZone(c.Request.Context(), id, version); err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ShippingHandler) CreateMethod(c *gin.Context) {
+	var input dto.ShippingMethodInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	method, err := h.service.CreateMethod(c.Request.Context(), input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, method.Version)
+	c.JSON(http.StatusCreated, method)
+}
+
+// GetMethods lists shipping methods; ?active=true leaves only those offered
+// to customers.
+func (h *ShippingHandler) GetMethods(c *gin.Context) {
+	var filter dto.ShippingMethodFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	methods, err := h.service.GetMethods(c.Request.Context(), filter)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, methods)
+}
+
+func (h *ShippingHandler) GetMethodByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid shipping method ID: "+err.Error()))
+		return
+	}
+	method, err := h.service.GetMethodByID(c.Request.Context(), id)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if notModified(c, method.Version) {
+		return
+	}
+	setETag(c, method.Version)
+	c.JSON(http.StatusOK, method)
+}
+
+func (h *ShippingHandler) UpdateMethod(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid shipping method ID: "+err.Error()))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var input dto.ShippingMethodInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	method, err := h.service.UpdateMethod(c.Request.Context(), id, version, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, method.Version)
+	c.JSON(http.StatusOK, method)
+}
+
+func (h *ShippingHandler) DeleteMethod(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid shipping method ID: "+err.Error()))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if err := h.service.DeleteMethod(c.Request.Context(), id, version); err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ShippingHandler) CreatePickupPoint(c *gin.Context) {
+	var input dto.PickupPointInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	point, err := h.service.CreatePickupPoint(c.Request.Context(), input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, point.Version)
+	c.JSON(http.StatusCreated, point)
+}
+
+// GetPickupPoints lists the open pickup points, nearest to ?latitude= and
+// ?longitude= first if they are given.
+func (h *ShippingHandler) GetPickupPoints(c *gin.Context) {
+	var query dto.PickupPointQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	points, err := h.service.GetPickupPoints(c.Request.Context(), query)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, points)
+}
+
+// GetAllPickupPoints lists every pickup point, the inactive ones included.
+func (h *ShippingHandler) GetAllPickupPoints(c *gin.Context) {
+	points, err := h.service.GetAllPickupPoints(c.Request.Context())
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, points)
+}
+
+func (h *ShippingHandler) GetPickupPointByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid pickup point ID: "+err.Error()))
+		return
+	}
+	point, err := h.service.GetPickupPointByID(c.Request.Context(), id)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if notModified(c, point.Version) {
+		return
+	}
+	setETag(c, point.Version)
+	c.JSON(http.StatusOK, point)
+}
+
+func (h *ShippingHandler) UpdatePickupPoint(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid pickup point ID: "+err.Error()))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var input dto.PickupPointInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	point, err := h.service.UpdatePickupPoint(c.Request.Context(), id, version, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, point.Version)
+	c.JSON(http.StatusOK, point)
+}
+
+func (h *ShippingHandler) DeletePickupPoint(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid pickup point ID: "+err.Error()))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if err := h.service.DeletePickupPoint(c.Request.Context(), id, version); err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -25,6 +25,7 @@ func setupAuthorRouter(h *handlers.AuthorHandler) *gin.Engine {
 	r.GET("/authors", h.GetAll)
 	r.GET("/authors/:id", h.GetByID)
 	r.PUT("/authors/:id", h.Update)
+	r.PATCH("/authors/:id", h.Patch)
 	r.DELETE("/authors/:id", h.Delete)
 	return r
 }
@@ -183,6 +184,41 @@ func TestAuthorHandler_GetByID_NotFound(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
 
+func TestAuthorHandler_GetByID_ETag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockAuthorServiceInterface(ctrl)
+	h := handlers.NewAuthorHandler(mockSvc)
+	r := setupAuthorRouter(h)
+
+	id := uuid.New()
+	mockSvc.EXPECT().GetByID(gomock.Any(), id).Return(&models.Author{ID: id, Version: 7}, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/authors/"+id.String(), nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"7"`, w.Header().Get("ETag"))
+}
+
+func TestAuthorHandler_GetByID_NotModified(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockAuthorServiceInterface(ctrl)
+	h := handlers.NewAuthorHandler(mockSvc)
+	r := setupAuthorRouter(h)
+
+	id := uuid.New()
+	mockSvc.EXPECT().GetByID(gomock.Any(), id).Return(&models.Author{ID: id, Version: 7}, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/authors/"+id.String(), nil)
+	req.Header.Set("If-None-Match", `"7"`)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+}
+
 // --- Update ---
 
 func TestAuthorHandler_Update_Success(t *testing.T) {
@@ -195,17 +231,54 @@ func TestAuthorHandler_Update_Success(t *testing.T) {
 	input := dto.AuthorInput{Surname: "Новый", Name: "Автор", Patronymic: "Отчество"}
 	expected := &models.Author{ID: id, Surname: "Новый", Name: "Автор", Patronymic: "Отчество"}
 
-	mockSvc.EXPECT().Update(gomock.Any(), id, input).Return(expected, nil)
+	mockSvc.EXPECT().Update(gomock.Any(), id, int64(1), input).Return(expected, nil)
 
 	b, _ := json.Marshal(input)
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPut, "/authors/"+id.String(), bytes.NewBuffer(b))
+	req.Header.Set("If-Match", `"1"`)
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestAuthorHandler_Update_MissingIfMatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockAuthorServiceInterface(ctrl)
+	h := handlers.NewAuthorHandler(mockSvc)
+	r := setupAuthorRouter(h)
+
+	id := uuid.New()
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/authors/"+id.String(), bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusPreconditionRequired, w.Code)
+}
+
+func TestAuthorHandler_Update_PreconditionFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockAuthorServiceInterface(ctrl)
+	h := handlers.NewAuthorHandler(mockSvc)
+	r := setupAuthorRouter(h)
+
+	id := uuid.New()
+	input := dto.AuthorInput{Surname: "Новый", Name: "Автор", Patronymic: "Отчество"}
+
+	mockSvc.EXPECT().Update(gomock.Any(), id, int64(4), input).Return(nil, apperrors.ErrPreconditionFailed("author has been modified"))
+
+	b, _ := json.Marshal(input)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/authors/"+id.String(), bytes.NewBuffer(b))
+	req.Header.Set("If-Match", `"4"`)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+}
+
 func TestAuthorHandler_Update_InvalidUUID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockSvc := mocks.NewMockAuthorServiceInterface(ctrl)
@@ -214,6 +287,7 @@ func TestAuthorHandler_Update_InvalidUUID(t *testing.T) {
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPut, "/authors/bad-id", bytes.NewBufferString(`{}`))
+	req.Header.Set("If-Match", `"1"`)
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
@@ -229,6 +303,7 @@ func TestAuthorHandler_Update_InvalidBody(t *testing.T) {
 	id := uuid.New()
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPut, "/authors/"+id.String(), bytes.NewBufferString(`{invalid}`))
+	req.Header.Set("If-Match", `"1"`)
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
@@ -244,17 +319,58 @@ func TestAuthorHandler_Update_NotFound(t *testing.T) {
 	id := uuid.New()
 	input := dto.AuthorInput{Surname: "Новый", Name: "Автор", Patronymic: "Отчество"}
 
-	mockSvc.EXPECT().Update(gomock.Any(), id, input).Return(nil, apperrors.ErrNotFound("author not found"))
+	mockSvc.EXPECT().Update(gomock.Any(), id, int64(1), input).Return(nil, apperrors.ErrNotFound("author not found"))
 
 	b, _ := json.Marshal(input)
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPut, "/authors/"+id.String(), bytes.NewBuffer(b))
+	req.Header.Set("If-Match", `"1"`)
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
 
+// --- Patch ---
+
+func TestAuthorHandler_Patch_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockAuthorServiceInterface(ctrl)
+	h := handlers.NewAuthorHandler(mockSvc)
+	r := setupAuthorRouter(h)
+
+	id := uuid.New()
+	patch := `{"info":null}`
+	expected := &models.Author{ID: id, Version: 2, Surname: "Толстой"}
+
+	mockSvc.EXPECT().Patch(gomock.Any(), id, int64(1), []byte(patch)).Return(expected, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/authors/"+id.String(), bytes.NewBufferString(patch))
+	req.Header.Set("If-Match", `"1"`)
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+}
+
+func TestAuthorHandler_Patch_UnsupportedMediaType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockAuthorServiceInterface(ctrl)
+	h := handlers.NewAuthorHandler(mockSvc)
+	r := setupAuthorRouter(h)
+
+	id := uuid.New()
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/authors/"+id.String(), bytes.NewBufferString(`{}`))
+	req.Header.Set("If-Match", `"1"`)
+	req.Header.Set("Content-Type", "text/plain")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+}
+
 // --- Delete ---
 
 func TestAuthorHandler_Delete_Success(t *testing.T) {
@@ -264,10 +380,11 @@ func TestAuthorHandler_Delete_Success(t *testing.T) {
 	r := setupAuthorRouter(h)
 
 	id := uuid.New()
-	mockSvc.EXPECT().Delete(gomock.Any(), id).Return(nil)
+	mockSvc.EXPECT().Delete(gomock.Any(), id, int64(1)).Return(nil)
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodDelete, "/authors/"+id.String(), nil)
+	req.Header.Set("If-Match", `"1"`)
 	r.ServeHTTP(w, req)
 
 	// handler вызывает c.JSON(http.StatusNoContent, nil) — это 204
@@ -282,6 +399,7 @@ func TestAuthorHandler_Delete_InvalidUUID(t *testing.T) {
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodDelete, "/authors/bad-id", nil)
+	req.Header.Set("If-Match", `"1"`)
 	r.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -294,10 +412,11 @@ func TestAuthorHandler_Delete_NotFound(t *testing.T) {
 	r := setupAuthorRouter(h)
 
 	id := uuid.New()
-	mockSvc.EXPECT().Delete(gomock.Any(), id).Return(apperrors.ErrNotFound("author not found"))
+	mockSvc.EXPECT().Delete(gomock.Any(), id, int64(1)).Return(apperrors.ErrNotFound("author not found"))
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodDelete, "/authors/"+id.String(), nil)
+	req.Header.Set("If-Match", `"1"`)
 	r.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
@@ -310,10 +429,11 @@ func TestAuthorHandler_Delete_ServiceError(t *testing.T) {
 	r := setupAuthorRouter(h)
 
 	id := uuid.New()
-	mockSvc.EXPECT().Delete(gomock.Any(), id).Return(apperrors.ErrInternal(assert.AnError))
+	mockSvc.EXPECT().Delete(gomock.Any(), id, int64(1)).Return(apperrors.ErrInternal(assert.AnError))
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodDelete, "/authors/"+id.String(), nil)
+	req.Header.Set("If-Match", `"1"`)
 	r.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
@@ -0,0 +1,136 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/handlers"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupBookRouter(h *handlers.BookHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/books", h.GetAll)
+	r.GET("/books/isbn/:isbn", h.GetByISBN)
+	r.GET("/books/:id", h.GetByID)
+	return r
+}
+
+// --- GetByISBN ---
+
+func TestBookHandler_GetByISBN_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockBookServiceInterface(ctrl)
+	r := setupBookRouter(handlers.NewBookHandler(mockSvc))
+
+	mockSvc.EXPECT().GetByISBN(gomock.Any(), "978-0-306-40615-7").
+		Return(&models.Book{ID: uuid.New(), Title: "Книга", Version: 2}, nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/books/isbn/978-0-306-40615-7", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, strings.HasPrefix(w.Header().Get("ETag"), `"2-`))
+}
+
+func TestBookHandler_GetByISBN_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockBookServiceInterface(ctrl)
+	r := setupBookRouter(handlers.NewBookHandler(mockSvc))
+
+	mockSvc.EXPECT().GetByISBN(gomock.Any(), "123").Return(nil, apperrors.ErrBadRequest("invalid ISBN"))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/books/isbn/123", nil))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestBookHandler_GetByID_NotShadowedByISBNRoute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockBookServiceInterface(ctrl)
+	r := setupBookRouter(handlers.NewBookHandler(mockSvc))
+	id := uuid.New()
+
+	mockSvc.EXPECT().GetDetails(gomock.Any(), id).Return(&dto.BookDetails{Book: &models.Book{ID: id}}, nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/books/"+id.String(), nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestBookHandler_GetByID_EmbedsSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockBookServiceInterface(ctrl)
+	r := setupBookRouter(handlers.NewBookHandler(mockSvc))
+	id, seriesID, nextID := uuid.New(), uuid.New(), uuid.New()
+	position, nextPosition := 2.0, 2.5
+
+	mockSvc.EXPECT().GetDetails(gomock.Any(), id).Return(&dto.BookDetails{
+		Book: &models.Book{ID: id, Title: "Две башни", SeriesID: &seriesID, SeriesPosition: &position},
+		Series: &dto.SeriesInfo{
+			ID:       seriesID,
+			Name:     "Властелин колец",
+			Position: &position,
+			Next:     &dto.SeriesLink{ID: nextID, Title: "Новелла", Position: &nextPosition},
+		},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/books/"+id.String(), nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		ID     uuid.UUID
+		Title  string
+		Series dto.SeriesInfo `json:"series"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, id, body.ID)
+	assert.Equal(t, "Две башни", body.Title)
+	assert.Equal(t, seriesID, body.Series.ID)
+	assert.Nil(t, body.Series.Previous)
+	if assert.NotNil(t, body.Series.Next) {
+		assert.Equal(t, nextID, body.Series.Next.ID)
+		assert.Equal(t, 2.5, *body.Series.Next.Position)
+	}
+}
+
+func TestBookHandler_GetByID_EditionChangeIsNotCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockBookServiceInterface(ctrl)
+	r := setupBookRouter(handlers.NewBookHandler(mockSvc))
+	id, editionID := uuid.New(), uuid.New()
+
+	gomock.InOrder(
+		mockSvc.EXPECT().GetDetails(gomock.Any(), id).Return(&dto.BookDetails{Book: &models.Book{ID: id, Version: 3, Editions: []*models.Edition{
+			{ID: editionID, Stock: 2, Version: 5},
+		}}}, nil),
+		mockSvc.EXPECT().GetDetails(gomock.Any(), id).Return(&dto.BookDetails{Book: &models.Book{ID: id, Version: 3, Editions: []*models.Edition{
+			{ID: editionID, Stock: 0, Version: 6},
+		}}}, nil),
+	)
+
+	first := httptest.NewRecorder()
+	r.ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/books/"+id.String(), nil))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/books/"+id.String(), nil)
+	req.Header.Set("If-None-Match", first.Header().Get("ETag"))
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, strings.HasPrefix(w.Header().Get("ETag"), `"3-`))
+}
@@ -0,0 +1,143 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/handlers"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupEditionRouter(h *handlers.EditionHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/books/:id/editions", h.Create)
+	r.GET("/editions/:id", h.GetByID)
+	r.PUT("/editions/:id", h.Update)
+	r.DELETE("/editions/:id", h.Delete)
+	return r
+}
+
+// --- Create ---
+
+func TestEditionHandler_Create_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockEditionServiceInterface(ctrl)
+	r := setupEditionRouter(handlers.NewEditionHandler(mockSvc))
+	bookID := uuid.New()
+
+	input := dto.EditionInput{Format: models.EditionFormatEbook, PublisherID: uuid.New(), Price: 299}
+	mockSvc.EXPECT().Create(gomock.Any(), bookID, input).
+		Return(&models.Edition{ID: uuid.New(), BookID: bookID, Format: models.EditionFormatEbook, Version: 1}, nil)
+
+	b, _ := json.Marshal(input)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/books/"+bookID.String()+"/editions", bytes.NewBuffer(b))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, `"1"`, w.Header().Get("ETag"))
+}
+
+func TestEditionHandler_Create_InvalidBookID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockEditionServiceInterface(ctrl)
+	r := setupEditionRouter(handlers.NewEditionHandler(mockSvc))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/books/not-a-uuid/editions", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// --- GetByID ---
+
+func TestEditionHandler_GetByID_NotModified(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockEditionServiceInterface(ctrl)
+	r := setupEditionRouter(handlers.NewEditionHandler(mockSvc))
+	id := uuid.New()
+
+	mockSvc.EXPECT().GetByID(gomock.Any(), id).Return(&models.Edition{ID: id, Version: 4, Price: 50000}, nil).Times(2)
+
+	first := httptest.NewRecorder()
+	r.ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/editions/"+id.String(), nil))
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.True(t, strings.HasPrefix(first.Header().Get("ETag"), `"4-`))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/editions/"+id.String(), nil)
+	req.Header.Set("If-None-Match", first.Header().Get("ETag"))
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotModified, w.Code)
+}
+
+func TestEditionHandler_GetByID_PricedInAnotherCurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockEditionServiceInterface(ctrl)
+	r := setupEditionRouter(handlers.NewEditionHandler(mockSvc))
+	id := uuid.New()
+
+	gomock.InOrder(
+		mockSvc.EXPECT().GetByID(gomock.Any(), id).Return(&models.Edition{ID: id, Version: 4, Price: 50000, Currency: "RUB"}, nil),
+		mockSvc.EXPECT().GetByID(gomock.Any(), id).Return(&models.Edition{ID: id, Version: 4, Price: 625, Currency: "USD"}, nil),
+	)
+
+	first := httptest.NewRecorder()
+	r.ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/editions/"+id.String(), nil))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/editions/"+id.String(), nil)
+	req.Header.Set("If-None-Match", first.Header().Get("ETag"))
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEqual(t, first.Header().Get("ETag"), w.Header().Get("ETag"))
+}
+
+// --- Update ---
+
+func TestEditionHandler_Update_MissingIfMatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockEditionServiceInterface(ctrl)
+	r := setupEditionRouter(handlers.NewEditionHandler(mockSvc))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/editions/"+uuid.New().String(), bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusPreconditionRequired, w.Code)
+}
+
+// --- Delete ---
+
+func TestEditionHandler_Delete_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockEditionServiceInterface(ctrl)
+	r := setupEditionRouter(handlers.NewEditionHandler(mockSvc))
+	id := uuid.New()
+
+	mockSvc.EXPECT().Delete(gomock.Any(), id, int64(2)).Return(nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, "/editions/"+id.String(), nil)
+	req.Header.Set("If-Match", `"2"`)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
@@ -0,0 +1,142 @@
+package handlers_test
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/handlers"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/blob"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/imaging"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupMediaRouter(h *handlers.MediaHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/media/*key", h.Serve)
+	r.PUT("/books/:id/cover", h.UploadBookCover)
+	return r
+}
+
+func TestMediaHandler_Serve(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockMediaServiceInterface(ctrl)
+	r := setupMediaRouter(handlers.NewMediaHandler(mockSvc))
+
+	mockSvc.EXPECT().Open(gomock.Any(), "books/1/cover/abc/small.jpg").Return(&blob.Object{
+		Body:        io.NopCloser(bytes.NewReader([]byte("jpeg"))),
+		ContentType: "image/jpeg",
+		Size:        4,
+		ETag:        `"v1"`,
+	}, nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/media/books/1/cover/abc/small.jpg", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "jpeg", w.Body.String())
+	assert.Equal(t, "image/jpeg", w.Header().Get("Content-Type"))
+	assert.Equal(t, `"v1"`, w.Header().Get("ETag"))
+	assert.Contains(t, w.Header().Get("Cache-Control"), "immutable")
+}
+
+func TestMediaHandler_Serve_NotModified(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockMediaServiceInterface(ctrl)
+	r := setupMediaRouter(handlers.NewMediaHandler(mockSvc))
+
+	mockSvc.EXPECT().Open(gomock.Any(), "a.jpg").Return(&blob.Object{
+		Body:        io.NopCloser(bytes.NewReader([]byte("jpeg"))),
+		ContentType: "image/jpeg",
+		Size:        4,
+		ETag:        `"v1"`,
+	}, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/media/a.jpg", nil)
+	req.Header.Set("If-None-Match", `"v1"`)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+}
+
+func TestMediaHandler_Serve_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockMediaServiceInterface(ctrl)
+	r := setupMediaRouter(handlers.NewMediaHandler(mockSvc))
+
+	mockSvc.EXPECT().Open(gomock.Any(), "missing.jpg").Return(nil, apperrors.ErrNotFound("media not found"))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/media/missing.jpg", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestMediaHandler_UploadBookCover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockMediaServiceInterface(ctrl)
+	r := setupMediaRouter(handlers.NewMediaHandler(mockSvc))
+	id := uuid.New()
+
+	mockSvc.EXPECT().UploadBookCover(gomock.Any(), id, int64(2), []byte("image bytes")).Return(&models.Book{ID: id, Version: 3}, nil)
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, _ := form.CreateFormFile("file", "cover.png")
+	_, _ = part.Write([]byte("image bytes"))
+	_ = form.Close()
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/books/"+id.String()+"/cover", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.Header.Set("If-Match", `"2"`)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"3"`, w.Header().Get("ETag"))
+}
+
+func TestMediaHandler_UploadBookCover_MissingFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockMediaServiceInterface(ctrl)
+	r := setupMediaRouter(handlers.NewMediaHandler(mockSvc))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/books/"+uuid.NewString()+"/cover", nil)
+	req.Header.Set("If-Match", `"1"`)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestMediaHandler_UploadBookCover_TooLarge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockMediaServiceInterface(ctrl)
+	r := setupMediaRouter(handlers.NewMediaHandler(mockSvc))
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, _ := form.CreateFormFile("file", "cover.png")
+	_, _ = part.Write(make([]byte, imaging.MaxUploadSize+128<<10))
+	_ = form.Close()
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/books/"+uuid.NewString()+"/cover", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.Header.Set("If-Match", `"1"`)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
@@ -195,11 +195,12 @@ func TestPublisherHandler_Update_Success(t *testing.T) {
 	input := dto.PublisherInput{Name: "Новое", Address: "Новый адрес, ул. Свежая, 10"}
 	expected := &models.Publisher{ID: id, Name: "Новое", Address: "Новый адрес, ул. Свежая, 10"}
 
-	mockSvc.EXPECT().Update(gomock.Any(), id, input).Return(expected, nil)
+	mockSvc.EXPECT().Update(gomock.Any(), id, int64(1), input).Return(expected, nil)
 
 	b, _ := json.Marshal(input)
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPut, "/publishers/"+id.String(), bytes.NewBuffer(b))
+	req.Header.Set("If-Match", `"1"`)
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
@@ -214,6 +215,7 @@ func TestPublisherHandler_Update_InvalidUUID(t *testing.T) {
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPut, "/publishers/bad-id", bytes.NewBufferString(`{}`))
+	req.Header.Set("If-Match", `"1"`)
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
@@ -229,6 +231,7 @@ func TestPublisherHandler_Update_InvalidBody(t *testing.T) {
 	id := uuid.New()
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPut, "/publishers/"+id.String(), bytes.NewBufferString(`{invalid}`))
+	req.Header.Set("If-Match", `"1"`)
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
@@ -244,11 +247,12 @@ func TestPublisherHandler_Update_NotFound(t *testing.T) {
 	id := uuid.New()
 	input := dto.PublisherInput{Name: "Новое", Address: "Новый адрес, ул. Свежая, 10"}
 
-	mockSvc.EXPECT().Update(gomock.Any(), id, input).Return(nil, apperrors.ErrNotFound("publisher not found"))
+	mockSvc.EXPECT().Update(gomock.Any(), id, int64(1), input).Return(nil, apperrors.ErrNotFound("publisher not found"))
 
 	b, _ := json.Marshal(input)
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPut, "/publishers/"+id.String(), bytes.NewBuffer(b))
+	req.Header.Set("If-Match", `"1"`)
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
@@ -264,10 +268,11 @@ func TestPublisherHandler_Delete_Success(t *testing.T) {
 	r := setupPublisherRouter(h)
 
 	id := uuid.New()
-	mockSvc.EXPECT().Delete(gomock.Any(), id).Return(nil)
+	mockSvc.EXPECT().Delete(gomock.Any(), id, int64(1)).Return(nil)
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodDelete, "/publishers/"+id.String(), nil)
+	req.Header.Set("If-Match", `"1"`)
 	r.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusNoContent, w.Code)
@@ -281,6 +286,7 @@ func TestPublisherHandler_Delete_InvalidUUID(t *testing.T) {
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodDelete, "/publishers/bad-id", nil)
+	req.Header.Set("If-Match", `"1"`)
 	r.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -293,10 +299,11 @@ func TestPublisherHandler_Delete_NotFound(t *testing.T) {
 	r := setupPublisherRouter(h)
 
 	id := uuid.New()
-	mockSvc.EXPECT().Delete(gomock.Any(), id).Return(apperrors.ErrNotFound("publisher not found"))
+	mockSvc.EXPECT().Delete(gomock.Any(), id, int64(1)).Return(apperrors.ErrNotFound("publisher not found"))
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodDelete, "/publishers/"+id.String(), nil)
+	req.Header.Set("If-Match", `"1"`)
 	r.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
@@ -309,10 +316,11 @@ func TestPublisherHandler_Delete_ServiceError(t *testing.T) {
 	r := setupPublisherRouter(h)
 
 	id := uuid.New()
-	mockSvc.EXPECT().Delete(gomock.Any(), id).Return(apperrors.ErrInternal(assert.AnError))
+	mockSvc.EXPECT().Delete(gomock.Any(), id, int64(1)).Return(apperrors.ErrInternal(assert.AnError))
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodDelete, "/publishers/"+id.String(), nil)
+	req.Header.Set("If-Match", `"1"`)
 	r.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
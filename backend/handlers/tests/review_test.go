@@ -0,0 +1,91 @@
+package handlers_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/handlers"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupReviewRouter(h *handlers.ReviewHandler, userID *uuid.UUID) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if userID != nil {
+			c.Set("user_id", *userID)
+		}
+		c.Next()
+	})
+	r.GET("/books/:id/reviews", h.GetForBook)
+	r.POST("/books/:id/reviews", h.Create)
+	r.PUT("/reviews/:id", h.Update)
+	return r
+}
+
+func TestReviewHandler_GetForBook_BindsQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockReviewServiceInterface(ctrl)
+	r := setupReviewRouter(handlers.NewReviewHandler(mockSvc), nil)
+	bookID := uuid.New()
+
+	mockSvc.EXPECT().GetForBook(gomock.Any(), bookID, dto.ReviewQuery{Sort: "oldest", Limit: 5, Offset: 10}).
+		Return(&dto.ReviewPage{Items: []models.Review{}, Total: 12, Limit: 5, Offset: 10}, nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/books/"+bookID.String()+"/reviews?sort=oldest&limit=5&offset=10", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"total":12`)
+}
+
+func TestReviewHandler_Create_UsesTokenUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockReviewServiceInterface(ctrl)
+	userID, bookID := uuid.New(), uuid.New()
+	r := setupReviewRouter(handlers.NewReviewHandler(mockSvc), &userID)
+
+	mockSvc.EXPECT().Create(gomock.Any(), userID, bookID, dto.ReviewInput{Rating: 4, Text: "Хорошо"}).
+		Return(&models.Review{ID: uuid.New(), Rating: 4, Status: models.ReviewStatusPending, Version: 1}, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/books/"+bookID.String()+"/reviews", bytes.NewBufferString(`{"rating":4,"text":"Хорошо"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, `"1"`, w.Header().Get("ETag"))
+}
+
+func TestReviewHandler_Create_Unauthenticated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockReviewServiceInterface(ctrl)
+	r := setupReviewRouter(handlers.NewReviewHandler(mockSvc), nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/books/"+uuid.NewString()+"/reviews", bytes.NewBufferString(`{"rating":4}`))
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestReviewHandler_Update_RequiresIfMatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := mocks.NewMockReviewServiceInterface(ctrl)
+	userID := uuid.New()
+	r := setupReviewRouter(handlers.NewReviewHandler(mockSvc), &userID)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/reviews/"+uuid.NewString(), bytes.NewBufferString(`{"rating":4}`))
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusPreconditionRequired, w.Code)
+}
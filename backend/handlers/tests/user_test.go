@@ -25,6 +25,7 @@ func setupRouter(h *handlers.UserHandler, middleware ...gin.HandlerFunc) *gin.En
     r.GET("/users/employees", h.GetAllEmployees)
     r.GET("/profile", append(middleware, h.GetProfile)...)
     r.PUT("/users/:id", h.Update)
+    r.PATCH("/users/:id", append(middleware, h.Patch)...)
     r.DELETE("/users/:id", h.Delete)
     return r
 }
@@ -36,12 +37,19 @@ func setUserID(id uuid.UUID) gin.HandlerFunc {
     }
 }
 
+func setRole(role string) gin.HandlerFunc {
+    return func(c *gin.Context) {
+        c.Set("role", role)
+        c.Next()
+    }
+}
+
 // --- GetAllCustomers ---
 
 func TestHandler_GetAllCustomers_Success(t *testing.T) {
     ctrl := gomock.NewController(t)
     mockSvc := mocks.NewMockUserServiceInterface(ctrl)
-    h := handlers.NewUserHandler(mockSvc)
+    h := handlers.NewUserHandler(mockSvc, nil)
     r := setupRouter(h)
 
     expected := []models.User{{ID: uuid.New(), Username: "alice"}}
@@ -57,7 +65,7 @@ func TestHandler_GetAllCustomers_Success(t *testing.T) {
 func TestHandler_GetAllCustomers_Error(t *testing.T) {
     ctrl := gomock.NewController(t)
     mockSvc := mocks.NewMockUserServiceInterface(ctrl)
-    h := handlers.NewUserHandler(mockSvc)
+    h := handlers.NewUserHandler(mockSvc, nil)
     r := setupRouter(h)
 
     mockSvc.EXPECT().
@@ -76,7 +84,7 @@ func TestHandler_GetAllCustomers_Error(t *testing.T) {
 func TestHandler_GetProfile_Success(t *testing.T) {
     ctrl := gomock.NewController(t)
     mockSvc := mocks.NewMockUserServiceInterface(ctrl)
-    h := handlers.NewUserHandler(mockSvc)
+    h := handlers.NewUserHandler(mockSvc, nil)
 
     id := uuid.New()
     phone := "89123456789"
@@ -101,7 +109,7 @@ func TestHandler_GetProfile_Success(t *testing.T) {
 func TestHandler_GetProfile_NotFound(t *testing.T) {
     ctrl := gomock.NewController(t)
     mockSvc := mocks.NewMockUserServiceInterface(ctrl)
-    h := handlers.NewUserHandler(mockSvc)
+    h := handlers.NewUserHandler(mockSvc, nil)
 
     id := uuid.New()
     r := setupRouter(h, setUserID(id))
@@ -117,7 +125,7 @@ func TestHandler_GetProfile_NotFound(t *testing.T) {
 func TestHandler_GetByID_Success(t *testing.T) {
     ctrl := gomock.NewController(t)
     mockSvc := mocks.NewMockUserServiceInterface(ctrl)
-    h := handlers.NewUserHandler(mockSvc)
+    h := handlers.NewUserHandler(mockSvc, nil)
     r := setupRouter(h)
 
     id := uuid.New()
@@ -139,7 +147,7 @@ func TestHandler_GetByID_Success(t *testing.T) {
 func TestHandler_GetByID_InvalidUUID(t *testing.T) {
     ctrl := gomock.NewController(t)
     mockSvc := mocks.NewMockUserServiceInterface(ctrl)
-    h := handlers.NewUserHandler(mockSvc)
+    h := handlers.NewUserHandler(mockSvc, nil)
     r := setupRouter(h)
 
     w := httptest.NewRecorder()
@@ -152,7 +160,7 @@ func TestHandler_GetByID_InvalidUUID(t *testing.T) {
 func TestHandler_GetByID_NotFound(t *testing.T) {
     ctrl := gomock.NewController(t)
     mockSvc := mocks.NewMockUserServiceInterface(ctrl)
-    h := handlers.NewUserHandler(mockSvc)
+    h := handlers.NewUserHandler(mockSvc, nil)
     r := setupRouter(h)
 
     id := uuid.New()
@@ -172,7 +180,7 @@ func TestHandler_GetByID_NotFound(t *testing.T) {
 func TestHandler_Update_Success(t *testing.T) {
     ctrl := gomock.NewController(t)
     mockSvc := mocks.NewMockUserServiceInterface(ctrl)
-    h := handlers.NewUserHandler(mockSvc)
+    h := handlers.NewUserHandler(mockSvc, nil)
     r := setupRouter(h)
 
     id := uuid.New()
@@ -193,7 +201,7 @@ func TestHandler_Update_Success(t *testing.T) {
 func TestHandler_Update_InvalidUUID(t *testing.T) {
     ctrl := gomock.NewController(t)
     mockSvc := mocks.NewMockUserServiceInterface(ctrl)
-    h := handlers.NewUserHandler(mockSvc)
+    h := handlers.NewUserHandler(mockSvc, nil)
     r := setupRouter(h)
 
     w := httptest.NewRecorder()
@@ -207,7 +215,7 @@ func TestHandler_Update_InvalidUUID(t *testing.T) {
 func TestHandler_Update_NotFound(t *testing.T) {
     ctrl := gomock.NewController(t)
     mockSvc := mocks.NewMockUserServiceInterface(ctrl)
-    h := handlers.NewUserHandler(mockSvc)
+    h := handlers.NewUserHandler(mockSvc, nil)
     r := setupRouter(h)
 
     id := uuid.New()
@@ -229,7 +237,7 @@ func TestHandler_Update_NotFound(t *testing.T) {
 func TestHandler_Delete_Success(t *testing.T) {
     ctrl := gomock.NewController(t)
     mockSvc := mocks.NewMockUserServiceInterface(ctrl)
-    h := handlers.NewUserHandler(mockSvc)
+    h := handlers.NewUserHandler(mockSvc, nil)
     r := setupRouter(h)
 
     id := uuid.New()
@@ -245,7 +253,7 @@ func TestHandler_Delete_Success(t *testing.T) {
 func TestHandler_Delete_NotFound(t *testing.T) {
     ctrl := gomock.NewController(t)
     mockSvc := mocks.NewMockUserServiceInterface(ctrl)
-    h := handlers.NewUserHandler(mockSvc)
+    h := handlers.NewUserHandler(mockSvc, nil)
     r := setupRouter(h)
 
     id := uuid.New()
@@ -263,7 +271,7 @@ func TestHandler_Delete_NotFound(t *testing.T) {
 func TestHandler_Delete_InvalidUUID(t *testing.T) {
     ctrl := gomock.NewController(t)
     mockSvc := mocks.NewMockUserServiceInterface(ctrl)
-    h := handlers.NewUserHandler(mockSvc)
+    h := handlers.NewUserHandler(mockSvc, nil)
     r := setupRouter(h)
 
     w := httptest.NewRecorder()
@@ -271,4 +279,49 @@ func TestHandler_Delete_InvalidUUID(t *testing.T) {
     r.ServeHTTP(w, req)
 
     assert.Equal(t, http.StatusBadRequest, w.Code)
-}
\ No newline at end of file
+}
+// --- Patch ---
+
+func patchUser(r *gin.Engine, id uuid.UUID, body string) *httptest.ResponseRecorder {
+    w := httptest.NewRecorder()
+    req := httptest.NewRequest(http.MethodPatch, "/users/"+id.String(), bytes.NewBufferString(body))
+    req.Header.Set("Content-Type", "application/merge-patch+json")
+    r.ServeHTTP(w, req)
+    return w
+}
+
+func TestHandler_Patch_Self(t *testing.T) {
+    ctrl := gomock.NewController(t)
+    mockSvc := mocks.NewMockUserServiceInterface(ctrl)
+    id := uuid.New()
+    r := setupRouter(handlers.NewUserHandler(mockSvc, []string{"manager"}), setUserID(id), setRole("customer"))
+
+    mockSvc.EXPECT().Patch(gomock.Any(), id, []byte(`{"username":"bob"}`)).Return(&models.User{ID: id, Username: "bob"}, nil)
+
+    w := patchUser(r, id, `{"username":"bob"}`)
+
+    assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_Patch_OtherUserForbidden(t *testing.T) {
+    ctrl := gomock.NewController(t)
+    mockSvc := mocks.NewMockUserServiceInterface(ctrl)
+    r := setupRouter(handlers.NewUserHandler(mockSvc, []string{"manager"}), setUserID(uuid.New()), setRole("customer"))
+
+    w := patchUser(r, uuid.New(), `{"email":"mallory@mail.com"}`)
+
+    assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestHandler_Patch_EmployeePatchesOtherUser(t *testing.T) {
+    ctrl := gomock.NewController(t)
+    mockSvc := mocks.NewMockUserServiceInterface(ctrl)
+    id := uuid.New()
+    r := setupRouter(handlers.NewUserHandler(mockSvc, []string{"manager"}), setUserID(uuid.New()), setRole("manager"))
+
+    mockSvc.EXPECT().Patch(gomock.Any(), id, gomock.Any()).Return(&models.User{ID: id}, nil)
+
+    w := patchUser(r, id, `{"phone":null}`)
+
+    assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"slices"
 
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
@@ -11,11 +12,14 @@ import (
 )
 
 type UserHandler struct {
-	userService interfaces.UserServiceInterface
+	userService   interfaces.UserServiceInterface
+	employeeRoles []string
 }
 
-func NewUserHandler(userService interfaces.UserServiceInterface) *UserHandler {
-	return &UserHandler{userService: userService}
+// NewUserHandler creates the handler; users with one of employeeRoles may
+// patch any user, others only themselves.
+func NewUserHandler(userService interfaces.UserServiceInterface, employeeRoles []string) *UserHandler {
+	return &UserHandler{userService: userService, employeeRoles: employeeRoles}
 }
 
 func (h *UserHandler) GetProfile(c *gin.Context) {
@@ -90,6 +94,37 @@ func (h *UserHandler) Update(c *gin.Context) {
 	c.JSON(http.StatusOK, user)
 }
 
+func (h *UserHandler) Patch(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid user ID: " + err.Error()))
+		return
+	}
+
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if userID != id && !slices.Contains(h.employeeRoles, c.GetString("role")) {
+		apperrors.RespondeError(c, apperrors.ErrForbidden("you can only change your own profile"))
+		return
+	}
+
+	patch, err := readMergePatch(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+
+	user, err := h.userService.Patch(c.Request.Context(), id, patch)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, user)
+}
+
 func (h *UserHandler) Delete(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WarehouseHandler struct {
+	service interfaces.WarehouseServiceInterface
+}
+
+func NewWarehouseHandler(service interfaces.WarehouseServiceInterface) *WarehouseHandler {
+	return &WarehouseHandler{service: service}
+}
+
+func (h *WarehouseHandler) Create(c *gin.Context) {
+	var input dto.WarehouseInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	warehouse, err := h.service.Create(c.Request.Context(), input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, warehouse.Version)
+	c.JSON(http.StatusCreated, warehouse)
+}
+
+func (h *WarehouseHandler) GetAll(c *gin.Context) {
+	warehouses, err := h.service.GetAll(c.Request.Context())
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, warehouses)
+}
+
+func (h *WarehouseHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid warehouse ID: "+err.Error()))
+		return
+	}
+	warehouse, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, warehouse.Version)
+	c.JSON(http.StatusOK, warehouse)
+}
+
+func (h *WarehouseHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid warehouse ID: "+err.Error()))
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var input dto.WarehouseInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	warehouse, err := h.service.Update(c.Request.Context(), id, version, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, warehouse.Version)
+	c.JSON(http.StatusOK, warehouse)
+}
+
+// GetStock lists the editions the warehouse has copies of.
+func (h *WarehouseHandler) GetStock(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid warehouse ID: "+err.Error()))
+		return
+	}
+	stock, err := h.service.GetStock(c.Request.Context(), id)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, stock)
+}
+
+// SetStock sets the warehouse's copies of an edition and responds with the
+// edition and its new total stock.
+func (h *WarehouseHandler) SetStock(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid warehouse ID: "+err.Error()))
+		return
+	}
+	editionID, err := uuid.Parse(c.Param("edition_id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid edition ID: "+err.Error()))
+		return
+	}
+	var input dto.StockInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	edition, err := h.service.SetStock(c.Request.Context(), id, editionID, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, edition.Version)
+	c.JSON(http.StatusOK, edition)
+}
+
+// GetEditionStock lists the copies of an edition in each warehouse.
+func (h *WarehouseHandler) GetEditionStock(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid edition ID: "+err.Error()))
+		return
+	}
+	stock, err := h.service.GetEditionStock(c.Request.Context(), id)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, stock)
+}
+
+func (h *WarehouseHandler) Transfer(c *gin.Context) {
+	var input dto.StockTransferInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	transfer, err := h.service.Transfer(c.Request.Context(), input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, transfer)
+}
+
+// GetTransfers lists stock transfers, newest first; ?warehouse_id= leaves
+// those from or to the warehouse.
+func (h *WarehouseHandler) GetTransfers(c *gin.Context) {
+	var filter dto.StockTransferFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	transfers, err := h.service.GetTransfers(c.Request.Context(), filter)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, transfers)
+}
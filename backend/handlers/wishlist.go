@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WishlistHandler struct {
+	service interfaces.WishlistServiceInterface
+}
+
+func NewWishlistHandler(service interfaces.WishlistServiceInterface) *WishlistHandler {
+	return &WishlistHandler{service: service}
+}
+
+func (h *WishlistHandler) Create(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var input dto.WishlistInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	wishlist, err := h.service.Create(c.Request.Context(), userID, input)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, wishlist.Version)
+	c.JSON(http.StatusCreated, wishlist)
+}
+
+func (h *WishlistHandler) GetAll(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	wishlists, err := h.service.GetAll(c.Request.Context(), userID)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, wishlists)
+}
+
+// GetByID returns the wishlist with its items. As with series, the ETag
+// only tracks the wishlist itself, so conditional GETs are not answered
+// with 304.
+func (h *WishlistHandler) GetByID(c *gin.Context) {
+	userID, id, ok := wishlistParams(c)
+	if !ok {
+		return
+	}
+	wishlist, err := h.service.GetByID(c.Request.Context(), userID, id)
+	h.respond(c, wishlist, err)
+}
+
+// GetShared is the public view of a wishlist opened through its share link.
+func (h *WishlistHandler) GetShared(c *gin.Context) {
+	wishlist, err := h.service.GetShared(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, wishlist)
+}
+
+func (h *WishlistHandler) Update(c *gin.Context) {
+	userID, id, ok := wishlistParams(c)
+	if !ok {
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	var input dto.WishlistInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	wishlist, err := h.service.Update(c.Request.Context(), userID, id, version, input)
+	h.respond(c, wishlist, err)
+}
+
+func (h *WishlistHandler) Delete(c *gin.Context) {
+	userID, id, ok := wishlistParams(c)
+	if !ok {
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	if err := h.service.Delete(c.Request.Context(), userID, id, version); err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *WishlistHandler) Share(c *gin.Context) {
+	userID, id, ok := wishlistParams(c)
+	if !ok {
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	wishlist, err := h.service.Share(c.Request.Context(), userID, id, version)
+	h.respond(c, wishlist, err)
+}
+
+func (h *WishlistHandler) Unshare(c *gin.Context) {
+	userID, id, ok := wishlistParams(c)
+	if !ok {
+		return
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	wishlist, err := h.service.Unshare(c.Request.Context(), userID, id, version)
+	h.respond(c, wishlist, err)
+}
+
+func (h *WishlistHandler) AddItem(c *gin.Context) {
+	userID, id, ok := wishlistParams(c)
+	if !ok {
+		return
+	}
+	var input dto.WishlistItemInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	wishlist, err := h.service.AddItem(c.Request.Context(), userID, id, input)
+	h.respond(c, wishlist, err)
+}
+
+func (h *WishlistHandler) RemoveItem(c *gin.Context) {
+	userID, id, ok := wishlistParams(c)
+	if !ok {
+		return
+	}
+	editionID, err := uuid.Parse(c.Param("edition_id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid edition ID"))
+		return
+	}
+	wishlist, err := h.service.RemoveItem(c.Request.Context(), userID, id, editionID)
+	h.respond(c, wishlist, err)
+}
+
+// MoveToCart accepts an optional body with the quantity to add.
+func (h *WishlistHandler) MoveToCart(c *gin.Context) {
+	userID, id, ok := wishlistParams(c)
+	if !ok {
+		return
+	}
+	editionID, err := uuid.Parse(c.Param("edition_id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid edition ID"))
+		return
+	}
+	var input dto.MoveToCartInput
+	if err := c.ShouldBindJSON(&input); err != nil && !errors.Is(err, io.EOF) {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest(err.Error()))
+		return
+	}
+	wishlist, err := h.service.MoveToCart(c.Request.Context(), userID, id, editionID, input)
+	h.respond(c, wishlist, err)
+}
+
+func (h *WishlistHandler) respond(c *gin.Context, wishlist *models.Wishlist, err error) {
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return
+	}
+	setETag(c, wishlist.Version)
+	c.JSON(http.StatusOK, wishlist)
+}
+
+// wishlistParams reads the current user and the wishlist ID, responding
+// with an error if either is missing.
+func wishlistParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		apperrors.RespondeError(c, err)
+		return uuid.Nil, uuid.Nil, false
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apperrors.RespondeError(c, apperrors.ErrBadRequest("invalid wishlist ID"))
+		return uuid.Nil, uuid.Nil, false
+	}
+	return userID, id, true
+}
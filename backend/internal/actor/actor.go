@@ -0,0 +1,27 @@
+package actor
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Actor describes who performs the current request. It is attached to the
+// request context by the auth middleware so services can record it.
+type Actor struct {
+	UserID    uuid.UUID
+	Role      string
+	IP        string
+	RequestID string
+}
+
+type contextKey struct{}
+
+func WithActor(ctx context.Context, a Actor) context.Context {
+	return context.WithValue(ctx, contextKey{}, a)
+}
+
+func FromContext(ctx context.Context) (Actor, bool) {
+	a, ok := ctx.Value(contextKey{}).(Actor)
+	return a, ok
+}
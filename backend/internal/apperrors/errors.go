@@ -8,6 +8,42 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// ErrVersionConflict is returned by repositories when a row was changed
+// by someone else since it has been read.
+var ErrVersionConflict = errors.New("version conflict")
+
+// ErrOutOfStock and ErrCouponUsedUp are returned by the order repository
+// when stock or a coupon ran out between pricing the cart and placing the
+// order.
+var (
+	ErrOutOfStock   = errors.New("out of stock")
+	ErrCouponUsedUp = errors.New("coupon usage limit reached")
+)
+
+// ErrNotEnoughPoints is returned when a customer spends more loyalty points
+// than they have.
+var ErrNotEnoughPoints = errors.New("not enough loyalty points")
+
+// ErrGiftCardUsedUp is returned when a gift card no longer has the balance
+// an order was to take from it, or has expired.
+var ErrGiftCardUsedUp = errors.New("gift card balance is used up")
+
+// ErrForeignKey and ErrDuplicate are returned by repositories when a write
+// refers to a row that does not exist, deletes one that is still referred
+// to, or repeats a unique value.
+var (
+	ErrForeignKey = errors.New("foreign key violation")
+	ErrDuplicate  = errors.New("duplicate key")
+)
+
+// ErrNoWarehouse is returned when stock is to be put in the main warehouse
+// but no warehouse is active.
+var ErrNoWarehouse = errors.New("no active warehouse")
+
+// ErrCategoryCycle is returned when a category is moved under one of its
+// own subcategories.
+var ErrCategoryCycle = errors.New("category cycle")
+
 type AppError struct {
 	Code	int
 	Message string
@@ -30,6 +66,26 @@ func ErrUnauthorized(msg string) *AppError {
 	return &AppError{Code: http.StatusUnauthorized, Message: msg}
 }
 
+func ErrForbidden(msg string) *AppError {
+	return &AppError{Code: http.StatusForbidden, Message: msg}
+}
+
+func ErrPreconditionFailed(msg string) *AppError {
+	return &AppError{Code: http.StatusPreconditionFailed, Message: msg}
+}
+
+func ErrPreconditionRequired(msg string) *AppError {
+	return &AppError{Code: http.StatusPreconditionRequired, Message: msg}
+}
+
+func ErrPayloadTooLarge(msg string) *AppError {
+	return &AppError{Code: http.StatusRequestEntityTooLarge, Message: msg}
+}
+
+func ErrUnsupportedMediaType(msg string) *AppError {
+	return &AppError{Code: http.StatusUnsupportedMediaType, Message: msg}
+}
+
 func ErrNotFound(msg string) *AppError {
 	return &AppError{Code: http.StatusNotFound, Message: msg}
 }
@@ -0,0 +1,38 @@
+// Package blob stores binary objects such as images under slash-separated
+// keys. LocalStore keeps them on disk, S3Store in an S3-compatible bucket.
+package blob
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// Object is a stored blob opened for reading. The caller closes Body.
+type Object struct {
+	Body        io.ReadCloser
+	ContentType string
+	Size        int64
+	ETag        string
+	ModTime     time.Time
+}
+
+// ValidKey reports whether key is a relative slash-separated path without
+// empty, "." or ".." segments.
+func ValidKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+	}
+	return true
+}
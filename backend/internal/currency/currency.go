@@ -0,0 +1,44 @@
+// Package currency carries the customer's preferred currency through the
+// request context.
+package currency
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// Base is the currency catalog prices are kept in; other currencies are
+// converted from it.
+const Base = "RUB"
+
+var ErrInvalidCode = errors.New("currency must be a three-letter ISO 4217 code")
+
+// Normalize upper-cases an ISO 4217 code and checks its form. Whether the
+// store sells in the currency is up to the caller.
+func Normalize(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 3 {
+		return "", ErrInvalidCode
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return "", ErrInvalidCode
+		}
+	}
+	return code, nil
+}
+
+type contextKey struct{}
+
+func WithPreferred(ctx context.Context, code string) context.Context {
+	return context.WithValue(ctx, contextKey{}, code)
+}
+
+// Preferred returns the currency the request asked for, Base if none.
+func Preferred(ctx context.Context) string {
+	if code, ok := ctx.Value(contextKey{}).(string); ok {
+		return code
+	}
+	return Base
+}
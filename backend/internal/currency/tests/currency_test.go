@@ -0,0 +1,29 @@
+package currency_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/currency"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	code, err := currency.Normalize(" byn ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "BYN", code)
+}
+
+func TestNormalize_Invalid(t *testing.T) {
+	for _, input := range []string{"", "RU", "RUBL", "R1B", "руб"} {
+		_, err := currency.Normalize(input)
+
+		assert.ErrorIs(t, err, currency.ErrInvalidCode, input)
+	}
+}
+
+func TestPreferred(t *testing.T) {
+	assert.Equal(t, currency.Base, currency.Preferred(context.Background()))
+	assert.Equal(t, "KZT", currency.Preferred(currency.WithPreferred(context.Background(), "KZT")))
+}
@@ -18,14 +18,41 @@ func main() {
 		&models.Publisher{},
 		&models.Category{},
 		&models.User{},
+		&models.Series{},
 		&models.Book{},
+		&models.Currency{},
+		&models.ExchangeRate{},
+		&models.Edition{},
+		&models.EditionPrice{},
 		&models.BookToCategory{},
+		&models.BookContributor{},
+		&models.Review{},
+		&models.Wishlist{},
+		&models.WishlistItem{},
+		&models.StockSubscription{},
+		&models.OutboxMessage{},
+		&models.RelatedBook{},
 		&models.Cart{},
 		&models.CartItem{},
+		&models.ShippingZone{},
+		&models.ShippingMethod{},
+		&models.PickupPoint{},
+		&models.Warehouse{},
+		&models.EditionStock{},
+		&models.StockTransfer{},
 		&models.Order{},
 		&models.OrderItem{},
+		&models.StockAllocation{},
+		&models.Promotion{},
+		&models.CouponRedemption{},
+		&models.Invoice{},
+		&models.InvoiceCounter{},
+		&models.LoyaltyTransaction{},
+		&models.GiftCard{},
+		&models.GiftCardTransaction{},
 		&models.Payment{},
 		&models.Delivery{},
+		&models.AuditLog{},
 	)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to load schema: %v\n", err)
@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditFilter struct {
+	EntityType	*string		`form:"entity_type"`
+	EntityID	*uuid.UUID	`form:"entity_id"`
+	ActorID		*uuid.UUID	`form:"actor_id"`
+	From		*time.Time	`form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To			*time.Time	`form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Limit		int			`form:"limit"`
+	Offset		int			`form:"offset"`
+}
+
+// FieldChange always has both sides, so a cleared field shows its empty
+// new value.
+type FieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
@@ -1,21 +1,43 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/google/uuid"
+)
 
 type BookInput struct {
 	Title		string		`json:"title"`
 	Description	*string		`json:"description"`
-	Price		float64		`json:"price"`
-	Stock		int			`json:"stock"`
-	AuthorID	uuid.UUID	`json:"author_id"`
-	PublisherID uuid.UUID	`json:"publisher_id"`
+	// Language is an ISO 639-1 code, "ru" if empty.
+	Language	string		`json:"language"`
+	Contributors	[]ContributorInput	`json:"contributors"`
 	CategoryIDs []uuid.UUID `json:"category_ids"`
+	SeriesID	*uuid.UUID	`json:"series_id"`
+	SeriesPosition	*float64	`json:"series_position"`
 }
 
+// ContributorInput is one entry of a book's contributor list; the order of
+// the list is kept. An empty role means "author".
+type ContributorInput struct {
+	AuthorID	uuid.UUID	`json:"author_id"`
+	Role		string		`json:"role"`
+}
+
+// BookFilter selects books. Price, ISBN and format conditions match when
+// any edition of the book satisfies them.
 type BookFilter struct {
 	AuthorID	*uuid.UUID	`form:"author_id"`
 	CategoryID	*uuid.UUID	`form:"category_id"`
-	MinPrice	*float64	`form:"min_price"`
-	MaxPrice	*float64	`form:"max_price"`
+	// IncludeDescendants extends CategoryID to all of its subcategories.
+	IncludeDescendants	bool	`form:"include_descendants"`
+	MinPrice	*money.Amount	`form:"min_price"`
+	MaxPrice	*money.Amount	`form:"max_price"`
 	Search		*string		`form:"search"`
-}
\ No newline at end of file
+	ISBN		*string		`form:"isbn"`
+	Format		*string		`form:"format"`
+	SeriesID	*uuid.UUID	`form:"series_id"`
+	// PriceCurrency and PriceRate are set by the service: MinPrice and
+	// MaxPrice are in PriceCurrency, the base currency when it is empty.
+	PriceCurrency	string		`form:"-"`
+	PriceRate		money.Rate	`form:"-"`
+}
@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/google/uuid"
+)
+
+type CartItemInput struct {
+	Quantity	int	`json:"quantity"`
+}
+
+// CartLine is an edition in the cart priced in the customer's currency:
+// Price is one copy, Subtotal the line before and Total after promotions.
+// All of them include VAT; Tax is the VAT included in Total.
+type CartLine struct {
+	Edition		*models.Edition				`json:"edition"`
+	Quantity	int							`json:"quantity"`
+	Price		money.Amount				`json:"price"`
+	Subtotal	money.Amount				`json:"subtotal"`
+	Discount	money.Amount				`json:"discount"`
+	Total		money.Amount				`json:"total"`
+	Promotions	[]models.AppliedPromotion	`json:"promotions"`
+	TaxRate		int							`json:"tax_rate"`
+	Tax			money.Amount				`json:"tax"`
+}
+
+// Cart is the customer's cart with promotions applied. CouponError tells
+// why an entered coupon gives no discount; CouponNotice tells that it is
+// valid but other promotions save more, which does not stop checkout.
+type Cart struct {
+	Items		[]CartLine	`json:"items"`
+	Currency	string		`json:"currency"`
+	Subtotal	money.Amount	`json:"subtotal"`
+	Discount	money.Amount	`json:"discount"`
+	Total		money.Amount	`json:"total"`
+	Tax			money.Amount	`json:"tax"`
+	Taxes		[]models.TaxLine	`json:"taxes"`
+	CouponCode	*string		`json:"coupon_code,omitempty"`
+	CouponError	string		`json:"coupon_error,omitempty"`
+	CouponNotice	string		`json:"coupon_notice,omitempty"`
+
+	// Rate converted the prices from the base currency.
+	Rate		money.Rate	`json:"-"`
+	// Coupon is the promotion of the coupon if it applies.
+	Coupon		*models.Promotion	`json:"-"`
+}
+
+// CheckoutInput places the cart as an order shipped with the
+// ShippingMethodID to Address and PostalCode, or collected at the
+// PickupPointID instead. Points are loyalty points
+// paying for part of the order; then the customer's store credit, if
+// UseStoreCredit, and the GiftCardCodes pay for as much of the rest as
+// they cover, in this order. GiftCards buys a gift card worth each amount,
+// in rubles, with the order.
+type CheckoutInput struct {
+	CouponCode		*string		`json:"coupon_code"`
+	ShippingMethodID	*uuid.UUID	`json:"shipping_method_id"`
+	PickupPointID	*uuid.UUID	`json:"pickup_point_id"`
+	Address			string		`json:"address"`
+	PostalCode		string		`json:"postal_code"`
+	PaymentMethod	string		`json:"payment_method"`
+	Points			int			`json:"points"`
+	UseStoreCredit	bool		`json:"use_store_credit"`
+	GiftCardCodes	[]string	`json:"gift_card_codes"`
+	GiftCards		[]money.Amount	`json:"gift_cards"`
+}
+
+// OrderStatusInput moves an order on to Status: "ReadyForPickup",
+// "Delivered" or "Returned". A returned order is refunded to the original
+// payment method, or with Refund "store_credit" as store credit.
+type OrderStatusInput struct {
+	Status	string	`json:"status"`
+	Refund	string	`json:"refund"`
+}
+
+// PickupInput hands a pickup order over to the customer who tells its
+// Code.
+type PickupInput struct {
+	Code	string	`json:"code"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/google/uuid"
+
+// CategoryInput describes a category node. An empty slug is generated from
+// the name; a nil parent makes the category a root.
+type CategoryInput struct {
+	Name		string		`json:"name"`
+	Slug		string		`json:"slug"`
+	ParentID	*uuid.UUID	`json:"parent_id"`
+	SortOrder	int			`json:"sort_order"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+)
+
+type CurrencyInput struct {
+	Code	string	`json:"code"`
+	Name	string	`json:"name"`
+}
+
+// CurrencyInfo is a currency with the exchange rate in effect now. Rate is
+// nil for currencies that have no rate yet.
+type CurrencyInfo struct {
+	Code		string		`json:"code"`
+	Name		string		`json:"name"`
+	Base		bool		`json:"base"`
+	Rate		*money.Rate	`json:"rate"`
+	ValidFrom	*time.Time	`json:"valid_from,omitempty"`
+}
+
+// ExchangeRateInput sets a rate from ValidFrom on, or from now if it is nil.
+type ExchangeRateInput struct {
+	Rate		money.Rate	`json:"rate"`
+	ValidFrom	*time.Time	`json:"valid_from"`
+}
+
+type RateImportReport struct {
+	Imported	int		`json:"imported"`
+}
+
+type EditionPriceInput struct {
+	Price	money.Amount	`json:"price"`
+}
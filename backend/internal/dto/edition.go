@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/google/uuid"
+)
+
+// EditionInput describes an edition. Its stock is kept per warehouse and
+// set through the warehouses, so Stock is only there to reject requests
+// that still send it.
+type EditionInput struct {
+	Format			string		`json:"format"`
+	ISBN			*string		`json:"isbn"`
+	PublisherID		uuid.UUID	`json:"publisher_id"`
+	PublicationYear	*int		`json:"publication_year"`
+	PageCount		*int		`json:"page_count"`
+	// Weight is in grams.
+	Weight			*int		`json:"weight"`
+	Price			money.Amount	`json:"price"`
+	Stock			*int		`json:"stock"`
+}
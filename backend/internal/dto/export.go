@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/google/uuid"
+)
+
+// BookExportRow is an edition with its book and relations flattened to
+// plain columns.
+type BookExportRow struct {
+	ID			uuid.UUID	`bun:"id"`
+	BookID		uuid.UUID	`bun:"book_id"`
+	ISBN13		*string		`bun:"isbn13"`
+	Title		string		`bun:"title"`
+	Description	*string		`bun:"description"`
+	Format		string		`bun:"format"`
+	PublicationYear	*int	`bun:"publication_year"`
+	PageCount	*int		`bun:"page_count"`
+	Price		money.Amount	`bun:"price"`
+	Stock		int			`bun:"stock"`
+	Authors		string		`bun:"authors"`
+	Contributors	string	`bun:"contributors"`
+	Publisher	string		`bun:"publisher"`
+	Categories	string		`bun:"categories"`
+	CreatedAt	time.Time	`bun:"created_at"`
+}
+
+type CustomerExportRow struct {
+	ID			uuid.UUID	`bun:"id"`
+	Username	string		`bun:"username"`
+	Email		string		`bun:"email"`
+	Phone		*string		`bun:"phone"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/google/uuid"
+)
+
+// GiftCardIssueInput is an employee issuing a "gift_card" or "store_credit"
+// worth Amount rubles. Store credit needs the UserID it belongs to.
+type GiftCardIssueInput struct {
+	Kind	string			`json:"kind"`
+	Amount	money.Amount	`json:"amount"`
+	UserID	*uuid.UUID		`json:"user_id"`
+	Reason	string			`json:"reason"`
+}
+
+// IssuedGiftCard carries the code of a new gift card, which is shown only
+// this once.
+type IssuedGiftCard struct {
+	GiftCard	*models.GiftCard	`json:"gift_card"`
+	Code		string				`json:"code,omitempty"`
+}
+
+type GiftCardCodeInput struct {
+	Code	string	`json:"code"`
+}
+
+// GiftCardBalance is what whoever has a card's code may see of it.
+type GiftCardBalance struct {
+	Balance		money.Amount	`json:"balance"`
+	ExpiresAt	time.Time		`json:"expires_at"`
+}
+
+// GiftCards are the customer's cards; StoreCredit is the balance of their
+// store credit that can be spent now.
+type GiftCards struct {
+	StoreCredit	money.Amount		`json:"store_credit"`
+	Cards		[]models.GiftCard	`json:"cards"`
+}
@@ -0,0 +1,77 @@
+package dto
+
+import (
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/google/uuid"
+)
+
+const (
+	ImportRowValid    = "valid"
+	ImportRowInvalid  = "invalid"
+	ImportRowImported = "imported"
+)
+
+// ImportBookRow is a parsed and validated line of a catalog import file.
+type ImportBookRow struct {
+	Row					int
+	Title				string
+	Description			*string
+	ISBN13				*string
+	ISBN10				*string
+	Format				string
+	PublicationYear		*int
+	PageCount			*int
+	Price				money.Amount
+	// Stock is nil if the file has no stock for the row.
+	Stock				*int
+	AuthorSurname		string
+	AuthorName			string
+	AuthorPatronymic	string
+	PublisherName		string
+	Categories			[]string
+}
+
+// ImportedBook describes what the repository did (or would do) for one row.
+// New related records are set only when the import had to create them.
+// Previous is set when the row matched an existing edition by ISBN and
+// updated it instead of creating a new one; UpdatedBook and PreviousBook
+// when that also changed its book's title or description.
+type ImportedBook struct {
+	Edition				*models.Edition
+	Previous			*models.Edition
+	UpdatedBook			*models.Book
+	PreviousBook		*models.Book
+	CreatedBook			*models.Book
+	CreatedAuthor		*models.Author
+	CreatedPublisher	*models.Publisher
+	CreatedCategories	[]*models.Category
+}
+
+type ImportRowResult struct {
+	Row					int			`json:"row"`
+	Title				string		`json:"title"`
+	Status				string		`json:"status"`
+	Errors				[]string	`json:"errors,omitempty"`
+	BookID				*uuid.UUID	`json:"book_id,omitempty"`
+	EditionID			*uuid.UUID	`json:"edition_id,omitempty"`
+	Updated				bool		`json:"updated,omitempty"`
+	BookCreated			bool		`json:"book_created,omitempty"`
+	AuthorCreated		bool		`json:"author_created,omitempty"`
+	PublisherCreated	bool		`json:"publisher_created,omitempty"`
+	CategoriesCreated	[]string	`json:"categories_created,omitempty"`
+}
+
+type ImportReport struct {
+	DryRun				bool				`json:"dry_run"`
+	Total				int					`json:"total"`
+	Valid				int					`json:"valid"`
+	Invalid				int					`json:"invalid"`
+	Imported			int					`json:"imported"`
+	Updated				int					`json:"updated"`
+	BooksCreated		int					`json:"books_created"`
+	AuthorsCreated		int					`json:"authors_created"`
+	PublishersCreated	int					`json:"publishers_created"`
+	CategoriesCreated	int					`json:"categories_created"`
+	Rows				[]ImportRowResult	`json:"rows"`
+}
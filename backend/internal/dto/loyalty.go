@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+)
+
+// LoyaltyBalance is what a customer can spend. ExpiringPoints of them
+// expire first, at ExpiresAt.
+type LoyaltyBalance struct {
+	Points			int			`json:"points"`
+	ExpiringPoints	int			`json:"expiring_points"`
+	ExpiresAt		*time.Time	`json:"expires_at"`
+}
+
+type LoyaltyHistoryQuery struct {
+	Limit	int	`form:"limit"`
+	Offset	int	`form:"offset"`
+}
+
+type LoyaltyHistoryPage struct {
+	Items	[]models.LoyaltyTransaction	`json:"items"`
+	Total	int							`json:"total"`
+	Limit	int							`json:"limit"`
+	Offset	int							`json:"offset"`
+}
+
+// LoyaltyAdjustmentInput adds Points to a customer's balance or, if they
+// are negative, takes them off.
+type LoyaltyAdjustmentInput struct {
+	Points	int		`json:"points"`
+	Reason	string	`json:"reason"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/google/uuid"
+)
+
+const (
+	NotificationPriceDrop	= "price_drop"
+	NotificationBackInStock	= "back_in_stock"
+	NotificationPriceBelow	= "price_below"
+)
+
+// Notification tells a customer that a book or edition they are interested
+// in has become cheaper or is available again.
+type Notification struct {
+	Kind			string		`json:"kind"`
+	UserID			uuid.UUID	`json:"user_id"`
+	EditionID		uuid.UUID	`json:"edition_id"`
+	BookID			uuid.UUID	`json:"book_id"`
+	PreviousPrice	money.Amount	`json:"previous_price"`
+	Price			money.Amount	`json:"price"`
+	Stock			int			`json:"stock"`
+	Threshold		*money.Amount	`json:"threshold,omitempty"`
+	UnsubscribeURL	string		`json:"unsubscribe_url,omitempty"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/google/uuid"
+)
+
+// PromotionInput describes a promotion. Percent is used by "percent"
+// promotions and Amount by "fixed" ones; StartsAt defaults to now and a nil
+// EndsAt lets the promotion run until it is switched off. Active defaults
+// to true.
+type PromotionInput struct {
+	Name			string			`json:"name"`
+	Kind			string			`json:"kind"`
+	Percent			int				`json:"percent"`
+	Amount			money.Amount	`json:"amount"`
+	Scope			string			`json:"scope"`
+	TargetIDs		[]uuid.UUID		`json:"target_ids"`
+	StartsAt		*time.Time		`json:"starts_at"`
+	EndsAt			*time.Time		`json:"ends_at"`
+	MinOrderTotal	money.Amount	`json:"min_order_total"`
+	CouponCode		*string			`json:"coupon_code"`
+	UsageLimit		*int			`json:"usage_limit"`
+	PerUserLimit	*int			`json:"per_user_limit"`
+	Exclusive		bool			`json:"exclusive"`
+	Active			*bool			`json:"active"`
+}
+
+// PromotionFilter lists promotions; with Current only those running now.
+type PromotionFilter struct {
+	Current	bool	`form:"current"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+// RecommendationQuery limits the number of recommended books; 10 by default
+// and at most 50.
+type RecommendationQuery struct {
+	Limit	int	`form:"limit"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+
+type ReviewInput struct {
+	Rating	int		`json:"rating"`
+	Text	string	`json:"text"`
+}
+
+// ReviewQuery pages through a book's reviews. Sort is one of "newest"
+// (default), "oldest", "rating_desc" and "rating_asc".
+type ReviewQuery struct {
+	Sort	string	`form:"sort"`
+	Limit	int		`form:"limit"`
+	Offset	int		`form:"offset"`
+	// Status is only honoured in the moderation queue.
+	Status	string	`form:"status"`
+}
+
+type ReviewPage struct {
+	Items	[]models.Review	`json:"items"`
+	Total	int				`json:"total"`
+	Limit	int				`json:"limit"`
+	Offset	int				`json:"offset"`
+}
+
+// ModerationInput is a moderator's decision: "approve", "reject" (reason
+// required) or "hide".
+type ModerationInput struct {
+	Action	string	`json:"action"`
+	Reason	*string	`json:"reason"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+type SeriesInput struct {
+	Name		string		`json:"name"`
+	Description	*string		`json:"description"`
+}
+
+// BookDetails is a book together with its place in a series.
+type BookDetails struct {
+	*models.Book
+	Series		*SeriesInfo	`json:"series,omitempty"`
+}
+
+// SeriesInfo describes a book's series and the books read just before and
+// after it.
+type SeriesInfo struct {
+	ID			uuid.UUID	`json:"id"`
+	Name		string		`json:"name"`
+	Position	*float64	`json:"position"`
+	Previous	*SeriesLink	`json:"previous"`
+	Next		*SeriesLink	`json:"next"`
+}
+
+type SeriesLink struct {
+	ID			uuid.UUID	`json:"id"`
+	Title		string		`json:"title"`
+	Position	*float64	`json:"position"`
+}
@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/google/uuid"
+)
+
+// ShippingZoneInput describes a zone by the first digits of its postal
+// codes, e.g. "10" for Moscow.
+type ShippingZoneInput struct {
+	Name			string		`json:"name"`
+	PostalPrefixes	[]string	`json:"postal_prefixes"`
+}
+
+// ShippingRateInput is the cost, in rubles, of parcels up to MaxWeight
+// grams sent to the zone; leaving either out makes the rate apply to any.
+type ShippingRateInput struct {
+	ZoneID		*uuid.UUID		`json:"zone_id"`
+	MaxWeight	*int			`json:"max_weight"`
+	Cost		money.Amount	`json:"cost"`
+}
+
+// ShippingMethodInput describes a "courier", "pickup_point" or "post"
+// method. Orders worth at least FreeOver rubles ship for free. Active
+// defaults to true.
+type ShippingMethodInput struct {
+	Name		string				`json:"name"`
+	Kind		string				`json:"kind"`
+	Rates		[]ShippingRateInput	`json:"rates"`
+	FreeOver	*money.Amount		`json:"free_over"`
+	Active		*bool				`json:"active"`
+}
+
+// ShippingMethodFilter lists shipping methods; with Active only those
+// offered to customers.
+type ShippingMethodFilter struct {
+	Active	bool	`form:"active"`
+}
+
+// ShippingQuoteQuery asks what the cart costs to ship to PostalCode, with
+// the Coupon applied to its total.
+type ShippingQuoteQuery struct {
+	PostalCode	string	`form:"postal_code"`
+	Coupon		string	`form:"coupon"`
+}
+
+// ShippingQuote is what a method costs for the cart in the customer's
+// currency. FreeOver is how much the cart must be worth to ship for free,
+// Weight what the parcel weighs in grams. The cost includes VAT at
+// TaxRate.
+type ShippingQuote struct {
+	MethodID	uuid.UUID		`json:"method_id"`
+	Name		string			`json:"name"`
+	Kind		string			`json:"kind"`
+	Cost		money.Amount	`json:"cost"`
+	Currency	string			`json:"currency"`
+	FreeOver	*money.Amount	`json:"free_over,omitempty"`
+	Weight		int				`json:"weight"`
+	TaxRate		int				`json:"tax_rate"`
+	Tax			money.Amount	`json:"tax"`
+}
+
+// PickupPointInput describes a shop where customers collect their orders.
+// Hours is free text such as "Mon–Fri 10:00–21:00"; Latitude and Longitude
+// are in degrees. Active defaults to true.
+type PickupPointInput struct {
+	Name		string		`json:"name"`
+	Address		string		`json:"address"`
+	PostalCode	string		`json:"postal_code"`
+	Hours		string		`json:"hours"`
+	Latitude	*float64	`json:"latitude"`
+	Longitude	*float64	`json:"longitude"`
+	Active		*bool		`json:"active"`
+}
+
+// PickupPointQuery lists the open pickup points, the nearest to Latitude
+// and Longitude first if both are given.
+type PickupPointQuery struct {
+	Latitude	*float64	`form:"latitude"`
+	Longitude	*float64	`form:"longitude"`
+}
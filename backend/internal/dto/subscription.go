@@ -0,0 +1,17 @@
+package dto
+
+import "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+
+// SubscriptionInput subscribes to a book. Kind is "back_in_stock" or
+// "price_below"; the latter needs a threshold.
+type SubscriptionInput struct {
+	Kind		string		`json:"kind"`
+	Threshold	*money.Amount	`json:"threshold"`
+}
+
+// BookStock sums up the stock of all of a book's editions. MinPrice is the
+// lowest price of an edition in stock and nil when none is.
+type BookStock struct {
+	InStock		int
+	MinPrice	*money.Amount
+}
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+// WarehouseInput describes a warehouse. A shop's warehouse names its
+// PickupPointID, so that orders collected there are shipped from it first.
+// Orders are shipped from the warehouses with the lowest Priority first.
+// Active defaults to true.
+type WarehouseInput struct {
+	Name			string		`json:"name"`
+	Address			string		`json:"address"`
+	PickupPointID	*uuid.UUID	`json:"pickup_point_id"`
+	Priority		int			`json:"priority"`
+	Active			*bool		`json:"active"`
+}
+
+// StockInput sets how many copies of an edition a warehouse has, e.g.
+// after a stocktake.
+type StockInput struct {
+	Quantity	*int	`json:"quantity"`
+}
+
+// StockTransferInput moves the Items from one warehouse to another.
+type StockTransferInput struct {
+	FromWarehouseID	uuid.UUID					`json:"from_warehouse_id"`
+	ToWarehouseID	uuid.UUID					`json:"to_warehouse_id"`
+	Items			[]StockTransferItemInput	`json:"items"`
+	Note			*string						`json:"note"`
+}
+
+type StockTransferItemInput struct {
+	EditionID	uuid.UUID	`json:"edition_id"`
+	Quantity	int			`json:"quantity"`
+}
+
+// StockTransferFilter lists the transfers from or to WarehouseID, newest
+// first.
+type StockTransferFilter struct {
+	WarehouseID	*uuid.UUID	`form:"warehouse_id"`
+	Limit		int			`form:"limit"`
+	Offset		int			`form:"offset"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+type WishlistInput struct {
+	Name	string	`json:"name"`
+}
+
+type WishlistItemInput struct {
+	EditionID	uuid.UUID	`json:"edition_id"`
+}
+
+// MoveToCartInput sets how many copies go to the cart; zero means one.
+type MoveToCartInput struct {
+	Quantity	int	`json:"quantity"`
+}
+
+// SharedWishlist is the public view of a shared wishlist. It leaves out the
+// owner and the share token.
+type SharedWishlist struct {
+	Name	string					`json:"name"`
+	Items	[]*models.WishlistItem	`json:"items"`
+}
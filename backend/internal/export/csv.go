@@ -0,0 +1,43 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// flush the CSV buffer to the client every flushEvery records
+const flushEvery = 500
+
+type csvWriter struct {
+	w       *csv.Writer
+	record  []string
+	written int
+}
+
+func newCSVWriter(w io.Writer, header []string) (*csvWriter, error) {
+	writer := &csvWriter{w: csv.NewWriter(w), record: make([]string, len(header))}
+	if err := writer.w.Write(header); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+func (w *csvWriter) Write(record []any) error {
+	for i, value := range record {
+		w.record[i] = formatCell(value)
+	}
+	if err := w.w.Write(w.record); err != nil {
+		return err
+	}
+	w.written++
+	if w.written%flushEvery == 0 {
+		w.w.Flush()
+		return w.w.Error()
+	}
+	return nil
+}
+
+func (w *csvWriter) Close() error {
+	w.w.Flush()
+	return w.w.Error()
+}
@@ -0,0 +1,105 @@
+// Package export writes tabular data as CSV, JSON Lines or XLSX one record
+// at a time, so large exports never have to be held in memory.
+package export
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+	FormatXLSX  = "xlsx"
+)
+
+var contentTypes = map[string]string{
+	FormatCSV:   "text/csv; charset=utf-8",
+	FormatJSONL: "application/x-ndjson",
+	FormatXLSX:  "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// ContentType returns the MIME type of the format and whether it is supported.
+func ContentType(format string) (string, bool) {
+	contentType, ok := contentTypes[format]
+	return contentType, ok
+}
+
+// Writer writes records whose values are aligned with the header.
+type Writer interface {
+	Write(record []any) error
+	Close() error
+}
+
+func NewWriter(format string, w io.Writer, header []string) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, header)
+	case FormatJSONL:
+		return newJSONLWriter(w, header), nil
+	case FormatXLSX:
+		return newXLSXWriter(w, header)
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+// formulaPrefixes are the first characters that make a spreadsheet read a
+// cell as a formula.
+const formulaPrefixes = "=+-@\t\r"
+
+// formatCell renders a value for formats opened in spreadsheets. Text that
+// would be read as a formula is prefixed with a quote, so that a title or
+// a username cannot run one on the machine of whoever opens the export.
+func formatCell(value any) string {
+	text := formatValue(value)
+	switch value.(type) {
+	case string, *string:
+		if text != "" && strings.ContainsRune(formulaPrefixes, rune(text[0])) {
+			return "'" + text
+		}
+	}
+	return text
+}
+
+// formatValue renders a value for text based formats. Nil values and nil
+// pointers become an empty string.
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case *string:
+		if v == nil {
+			return ""
+		}
+		return *v
+	case int:
+		return strconv.Itoa(v)
+	case *int:
+		if v == nil {
+			return ""
+		}
+		return strconv.Itoa(*v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case uuid.UUID:
+		return v.String()
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,53 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+type jsonlWriter struct {
+	w    *bufio.Writer
+	keys [][]byte
+}
+
+func newJSONLWriter(w io.Writer, header []string) *jsonlWriter {
+	keys := make([][]byte, len(header))
+	for i, name := range header {
+		keys[i], _ = json.Marshal(name)
+	}
+	return &jsonlWriter{w: bufio.NewWriter(w), keys: keys}
+}
+
+// Write emits one JSON object per line keeping the column order of the header.
+func (w *jsonlWriter) Write(record []any) error {
+	if err := w.w.WriteByte('{'); err != nil {
+		return err
+	}
+	for i, value := range record {
+		if i > 0 {
+			if err := w.w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if _, err := w.w.Write(w.keys[i]); err != nil {
+			return err
+		}
+		if err := w.w.WriteByte(':'); err != nil {
+			return err
+		}
+		if _, err := w.w.Write(encoded); err != nil {
+			return err
+		}
+	}
+	_, err := w.w.WriteString("}\n")
+	return err
+}
+
+func (w *jsonlWriter) Close() error {
+	return w.w.Flush()
+}
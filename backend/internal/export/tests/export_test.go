@@ -0,0 +1,152 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/export"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeAll(t *testing.T, format string, header []string, records ...[]any) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer, err := export.NewWriter(format, &buf, header)
+	require.NoError(t, err)
+	for _, record := range records {
+		require.NoError(t, writer.Write(record))
+	}
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func TestContentType(t *testing.T) {
+	contentType, ok := export.ContentType(export.FormatCSV)
+	assert.True(t, ok)
+	assert.Equal(t, "text/csv; charset=utf-8", contentType)
+
+	_, ok = export.ContentType("pdf")
+	assert.False(t, ok)
+}
+
+func TestNewWriter_UnsupportedFormat(t *testing.T) {
+	_, err := export.NewWriter("pdf", io.Discard, []string{"id"})
+
+	assert.Error(t, err)
+}
+
+// --- CSV ---
+
+func TestCSV_WritesHeaderAndRecords(t *testing.T) {
+	var description *string
+	var pages *int
+	year := 1967
+	out := writeAll(t, export.FormatCSV, []string{"title", "description", "price", "stock", "year", "pages"},
+		[]any{"Мастер и Маргарита", description, 450.5, 3, &year, pages},
+		[]any{"Quote \"me\", please", "x", 10.0, 0, nil, nil},
+	)
+
+	assert.Equal(t, "title,description,price,stock,year,pages\n"+
+		"Мастер и Маргарита,,450.5,3,1967,\n"+
+		"\"Quote \"\"me\"\", please\",x,10,0,,\n", string(out))
+}
+
+func TestCSV_QuotesFormulas(t *testing.T) {
+	title := "@SUM(A1)"
+	out := writeAll(t, export.FormatCSV, []string{"title", "author", "stock"},
+		[]any{"=HYPERLINK(\"http://x\")", &title, -1},
+		[]any{"+1", "\tname", 0},
+	)
+
+	assert.Equal(t, "title,author,stock\n"+
+		"\"'=HYPERLINK(\"\"http://x\"\")\",'@SUM(A1),-1\n"+
+		"'+1,'\tname,0\n", string(out))
+}
+
+// --- JSONL ---
+
+func TestJSONL_KeepsHeaderOrder(t *testing.T) {
+	out := writeAll(t, export.FormatJSONL, []string{"title", "price", "note"},
+		[]any{"A", 1.5, nil},
+		[]any{"B", 2, "x"},
+	)
+
+	lines := strings.Split(strings.TrimSuffix(string(out), "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, `{"title":"A","price":1.5,"note":null}`, lines[0])
+	assert.Equal(t, `{"title":"B","price":2,"note":"x"}`, lines[1])
+}
+
+// --- XLSX ---
+
+func readSheet(t *testing.T, data []byte) string {
+	t.Helper()
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(archive.File))
+	var sheet string
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+		if file.Name != "xl/worksheets/sheet1.xml" {
+			continue
+		}
+		rc, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		sheet = string(content)
+	}
+	assert.Contains(t, names, "[Content_Types].xml")
+	assert.Contains(t, names, "xl/workbook.xml")
+	return sheet
+}
+
+func TestXLSX_WritesInlineStringsAndNumbers(t *testing.T) {
+	out := writeAll(t, export.FormatXLSX, []string{"title", "price"},
+		[]any{"Tom & Jerry <2>", 99.9},
+	)
+
+	sheet := readSheet(t, out)
+	assert.Contains(t, sheet, `<c r="A1" t="inlineStr"><is><t xml:space="preserve">title</t></is></c>`)
+	assert.Contains(t, sheet, `<c r="A2" t="inlineStr"><is><t xml:space="preserve">Tom &amp; Jerry &lt;2&gt;</t></is></c>`)
+	assert.Contains(t, sheet, `<c r="B2"><v>99.9</v></c>`)
+	assert.True(t, strings.HasSuffix(sheet, `</sheetData></worksheet>`))
+}
+
+func TestXLSX_QuotesFormulas(t *testing.T) {
+	out := writeAll(t, export.FormatXLSX, []string{"title", "price"},
+		[]any{"-cmd", money.Amount(-500)},
+	)
+
+	sheet := readSheet(t, out)
+	assert.Contains(t, sheet, `<c r="A2" t="inlineStr"><is><t xml:space="preserve">&#39;-cmd</t></is></c>`)
+	assert.Contains(t, sheet, `<c r="B2"><v>-5.00</v></c>`)
+}
+
+func TestXLSX_WritesAmountsAsNumbers(t *testing.T) {
+	out := writeAll(t, export.FormatXLSX, []string{"price"},
+		[]any{money.Amount(120050)},
+	)
+
+	assert.Contains(t, readSheet(t, out), `<c r="A2"><v>1200.50</v></c>`)
+}
+
+func TestXLSX_CellReferencesPastZ(t *testing.T) {
+	header := make([]string, 28)
+	for i := range header {
+		header[i] = "c"
+	}
+
+	sheet := readSheet(t, writeAll(t, export.FormatXLSX, header))
+
+	assert.Contains(t, sheet, `<c r="Z1" `)
+	assert.Contains(t, sheet, `<c r="AA1" `)
+	assert.Contains(t, sheet, `<c r="AB1" `)
+}
@@ -0,0 +1,108 @@
+// Package giftcard makes and checks gift card codes and works out how much
+// of an order gift cards pay for.
+package giftcard
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+)
+
+// alphabet leaves out 0, 1, I and O, which are easily mistaken for each
+// other; 16 characters of it give 80 random bits.
+const (
+	alphabet   = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	codeLength = 16
+	groupSize  = 4
+)
+
+// Terms are how long cards can be spent and how much a card may be worth,
+// in the base currency; MinAmount applies to cards customers buy.
+type Terms struct {
+	ValidMonths int
+	MinAmount   money.Amount
+	MaxAmount   money.Amount
+}
+
+var DefaultTerms = Terms{ValidMonths: 12, MinAmount: 500 * money.MinorUnits, MaxAmount: 100_000 * money.MinorUnits}
+
+// ExpiresAt returns when a card issued at from expires.
+func (t Terms) ExpiresAt(from time.Time) time.Time {
+	return from.AddDate(0, t.ValidMonths, 0)
+}
+
+// NewCode returns a random code in groups of four, e.g.
+// "K7QX-2MZP-9RTA-WC4H".
+func NewCode() (string, error) {
+	buf := make([]byte, codeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for i, c := range buf {
+		if i > 0 && i%groupSize == 0 {
+			b.WriteByte('-')
+		}
+		b.WriteByte(alphabet[int(c)%len(alphabet)])
+	}
+	return b.String(), nil
+}
+
+// Normalize uppercases a code as typed and drops spaces and dashes. It
+// returns "" if what is left cannot be a code.
+func Normalize(code string) string {
+	normalized := strings.Map(func(r rune) rune {
+		switch {
+		case r == '-' || r == ' ':
+			return -1
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		}
+		return r
+	}, code)
+	if len(normalized) != codeLength {
+		return ""
+	}
+	for _, r := range normalized {
+		if !strings.ContainsRune(alphabet, r) {
+			return ""
+		}
+	}
+	return normalized
+}
+
+// Hash is what is stored of a code: cards are looked up by it, and a leaked
+// table does not give the codes away.
+func Hash(code string) string {
+	sum := sha256.Sum256([]byte(Normalize(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+// LastDigits returns the end of a code, to tell cards apart.
+func LastDigits(code string) string {
+	normalized := Normalize(code)
+	return normalized[len(normalized)-groupSize:]
+}
+
+// Apply works out what a card with balance in the base currency pays of
+// due, an amount in the currency of rate. It returns the amount paid and
+// what is taken off the balance; the whole balance is taken when it does
+// not cover due.
+func Apply(balance, due money.Amount, rate money.Rate) (paid, taken money.Amount, err error) {
+	value, err := rate.Convert(balance)
+	if err != nil || value <= 0 || due <= 0 {
+		return 0, 0, err
+	}
+	if value <= due {
+		return value, balance, nil
+	}
+	taken, err = rate.ConvertBack(due)
+	if err != nil {
+		return 0, 0, err
+	}
+	return due, min(taken, balance), nil
+}
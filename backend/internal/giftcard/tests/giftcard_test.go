@@ -0,0 +1,76 @@
+package giftcard_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/giftcard"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCode(t *testing.T) {
+	code, err := giftcard.NewCode()
+
+	assert.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^[A-HJ-NP-Z2-9]{4}(-[A-HJ-NP-Z2-9]{4}){3}$`), code)
+	other, _ := giftcard.NewCode()
+	assert.NotEqual(t, code, other)
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want string
+	}{
+		{"as printed", "K7QX-2MZP-9RTA-WC4H", "K7QX2MZP9RTAWC4H"},
+		{"typed in lower case with spaces", " k7qx 2mzp 9rta wc4h ", "K7QX2MZP9RTAWC4H"},
+		{"too short", "K7QX-2MZP", ""},
+		{"letters left out of the alphabet", "K7QX-2MZP-9RTA-WC4O", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, giftcard.Normalize(tt.code))
+		})
+	}
+}
+
+func TestHash(t *testing.T) {
+	assert.Equal(t, giftcard.Hash("K7QX-2MZP-9RTA-WC4H"), giftcard.Hash("k7qx2mzp9rtawc4h"))
+	assert.NotEqual(t, giftcard.Hash("K7QX-2MZP-9RTA-WC4H"), giftcard.Hash("K7QX-2MZP-9RTA-WC4J"))
+	assert.Equal(t, "WC4H", giftcard.LastDigits("K7QX-2MZP-9RTA-WC4H"))
+}
+
+func TestTerms_ExpiresAt(t *testing.T) {
+	from := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2027, 10, 19, 12, 0, 0, 0, time.UTC), giftcard.Terms{ValidMonths: 12}.ExpiresAt(from))
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name      string
+		balance   money.Amount
+		due       money.Amount
+		rate      money.Rate
+		wantPaid  money.Amount
+		wantTaken money.Amount
+	}{
+		{"balance covers part", 20000, 45000, money.One, 20000, 20000},
+		{"balance covers all", 50000, 45000, money.One, 45000, 45000},
+		{"converted to the order's currency", 100000, 2000, money.Rate(35_400), 2000, 56497},
+		{"whole balance in another currency", 10000, 2000, money.Rate(35_400), 354, 10000},
+		{"nothing due", 10000, 0, money.One, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paid, taken, err := giftcard.Apply(tt.balance, tt.due, tt.rate)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPaid, paid)
+			assert.Equal(t, tt.wantTaken, taken)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// Package imaging validates uploaded images and renders resized variants.
+// Only JPEG and PNG are accepted; the type is sniffed from the content and
+// never taken from the client's headers.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+)
+
+const (
+	ContentTypeJPEG = "image/jpeg"
+	ContentTypePNG  = "image/png"
+)
+
+// MaxUploadSize is the largest image file accepted for upload.
+const MaxUploadSize = 10 << 20
+
+// jpegQuality is used for all generated variants.
+const jpegQuality = 85
+
+var (
+	ErrUnsupportedType = errors.New("unsupported image type")
+	ErrTooLarge        = errors.New("image file is too large")
+	ErrDimensions      = errors.New("image dimensions out of range")
+)
+
+// Rules limit what an upload may look like. MaxPixels caps width times
+// height, which is what decoding allocates memory for.
+type Rules struct {
+	MaxBytes  int64
+	MinWidth  int
+	MinHeight int
+	MaxWidth  int
+	MaxHeight int
+	MaxPixels int
+}
+
+// Variant is a generated size whose longest side is at most MaxSide pixels.
+type Variant struct {
+	Name    string
+	MaxSide int
+}
+
+// Image is a validated upload.
+type Image struct {
+	Image       image.Image
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// Decode checks the upload against the rules and decodes it. Dimensions are
+// read from the header before the pixels are decoded, so oversized images
+// are rejected without allocating memory for them.
+func Decode(data []byte, rules Rules) (*Image, error) {
+	if rules.MaxBytes > 0 && int64(len(data)) > rules.MaxBytes {
+		return nil, fmt.Errorf("%w: %d bytes, at most %d allowed", ErrTooLarge, len(data), rules.MaxBytes)
+	}
+	contentType := http.DetectContentType(data)
+	var decodeConfig func(io.Reader) (image.Config, error)
+	var decode func(io.Reader) (image.Image, error)
+	switch contentType {
+	case ContentTypeJPEG:
+		decodeConfig, decode = jpeg.DecodeConfig, jpeg.Decode
+	case ContentTypePNG:
+		decodeConfig, decode = png.DecodeConfig, png.Decode
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, contentType)
+	}
+
+	config, err := decodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedType, err)
+	}
+	if config.Width < rules.MinWidth || config.Height < rules.MinHeight ||
+		(rules.MaxWidth > 0 && config.Width > rules.MaxWidth) ||
+		(rules.MaxHeight > 0 && config.Height > rules.MaxHeight) {
+		return nil, fmt.Errorf("%w: %dx%d, expected %dx%d to %dx%d", ErrDimensions,
+			config.Width, config.Height, rules.MinWidth, rules.MinHeight, rules.MaxWidth, rules.MaxHeight)
+	}
+	if rules.MaxPixels > 0 && config.Width*config.Height > rules.MaxPixels {
+		return nil, fmt.Errorf("%w: %dx%d, at most %d pixels allowed", ErrDimensions,
+			config.Width, config.Height, rules.MaxPixels)
+	}
+
+	img, err := decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedType, err)
+	}
+	return &Image{Image: img, ContentType: contentType, Width: config.Width, Height: config.Height}, nil
+}
+
+// Resize scales the image down so that its longest side is at most maxSide,
+// averaging the source pixels covered by each target pixel. Smaller images
+// are not enlarged. Transparent areas are flattened onto white. Source rows
+// are flattened one at a time, so memory use grows with the width of the
+// source and not its area.
+func Resize(src image.Image, maxSide int) *image.RGBA {
+	bounds := src.Bounds()
+	sw, sh := bounds.Dx(), bounds.Dy()
+	dw, dh := sw, sh
+	if sw >= sh && sw > maxSide {
+		dw, dh = maxSide, max(1, sh*maxSide/sw)
+	} else if sh > sw && sh > maxSide {
+		dw, dh = max(1, sw*maxSide/sh), maxSide
+	}
+
+	row := image.NewRGBA(image.Rect(0, 0, sw, 1))
+	white := image.NewUniform(color.White)
+	sums := make([]int, dw*3)
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		y0, y1 := y*sh/dh, max((y+1)*sh/dh, y*sh/dh+1)
+		clear(sums)
+		for sy := y0; sy < y1; sy++ {
+			draw.Draw(row, row.Bounds(), white, image.Point{}, draw.Src)
+			draw.Draw(row, row.Bounds(), src, image.Pt(bounds.Min.X, bounds.Min.Y+sy), draw.Over)
+			for x := 0; x < dw; x++ {
+				x0, x1 := x*sw/dw, max((x+1)*sw/dw, x*sw/dw+1)
+				for sx := x0; sx < x1; sx++ {
+					sums[x*3] += int(row.Pix[sx*4])
+					sums[x*3+1] += int(row.Pix[sx*4+1])
+					sums[x*3+2] += int(row.Pix[sx*4+2])
+				}
+			}
+		}
+		for x := 0; x < dw; x++ {
+			n := (y1 - y0) * (max((x+1)*sw/dw, x*sw/dw+1) - x*sw/dw)
+			i := y*dst.Stride + x*4
+			dst.Pix[i] = uint8(sums[x*3] / n)
+			dst.Pix[i+1] = uint8(sums[x*3+1] / n)
+			dst.Pix[i+2] = uint8(sums[x*3+2] / n)
+			dst.Pix[i+3] = 0xff
+		}
+	}
+	return dst
+}
+
+// ResizeAll renders the variants, which must be ordered from largest to
+// smallest. Only the first is scaled from the source; each of the others
+// is scaled from the one before it.
+func ResizeAll(src image.Image, variants []Variant) []*image.RGBA {
+	images := make([]*image.RGBA, 0, len(variants))
+	for _, variant := range variants {
+		img := Resize(src, variant.MaxSide)
+		images = append(images, img)
+		src = img
+	}
+	return images
+}
+
+// EncodeJPEG encodes a generated variant.
+func EncodeJPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Extension returns the file extension for a content type accepted by Decode.
+func Extension(contentType string) string {
+	if contentType == ContentTypePNG {
+		return ".png"
+	}
+	return ".jpg"
+}
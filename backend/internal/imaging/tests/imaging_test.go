@@ -0,0 +1,127 @@
+package imaging_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/imaging"
+	"github.com/stretchr/testify/assert"
+)
+
+var rules = imaging.Rules{MaxBytes: 1 << 20, MinWidth: 100, MinHeight: 100, MaxWidth: 2000, MaxHeight: 2000}
+
+func pngBytes(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 200, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestDecode_PNG(t *testing.T) {
+	img, err := imaging.Decode(pngBytes(t, 300, 200), rules)
+
+	assert.NoError(t, err)
+	assert.Equal(t, imaging.ContentTypePNG, img.ContentType)
+	assert.Equal(t, 300, img.Width)
+	assert.Equal(t, 200, img.Height)
+}
+
+func TestDecode_JPEG(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 150, 150)), nil))
+
+	img, err := imaging.Decode(buf.Bytes(), rules)
+
+	assert.NoError(t, err)
+	assert.Equal(t, imaging.ContentTypeJPEG, img.ContentType)
+}
+
+func TestDecode_Rejections(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"not an image", []byte("GIF89a, definitely not a cover"), imaging.ErrUnsupportedType},
+		{"truncated png", pngBytes(t, 300, 300)[:40], imaging.ErrUnsupportedType},
+		{"too small", pngBytes(t, 50, 300), imaging.ErrDimensions},
+		{"too large", pngBytes(t, 2500, 100), imaging.ErrDimensions},
+		{"too many bytes", append(pngBytes(t, 100, 100), make([]byte, 1<<20)...), imaging.ErrTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img, err := imaging.Decode(tt.data, rules)
+
+			assert.Nil(t, img)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestDecode_TooManyPixels(t *testing.T) {
+	limited := rules
+	limited.MaxPixels = 1500 * 1500
+
+	img, err := imaging.Decode(pngBytes(t, 1600, 1600), limited)
+
+	assert.Nil(t, img)
+	assert.ErrorIs(t, err, imaging.ErrDimensions)
+}
+
+func TestResize_KeepsAspectRatio(t *testing.T) {
+	img, err := imaging.Decode(pngBytes(t, 400, 200), rules)
+	assert.NoError(t, err)
+
+	landscape := imaging.Resize(img.Image, 100)
+	assert.Equal(t, image.Rect(0, 0, 100, 50), landscape.Bounds())
+
+	portrait := imaging.Resize(image.NewRGBA(image.Rect(0, 0, 300, 900)), 90)
+	assert.Equal(t, image.Rect(0, 0, 30, 90), portrait.Bounds())
+}
+
+func TestResize_DoesNotEnlarge(t *testing.T) {
+	resized := imaging.Resize(image.NewRGBA(image.Rect(0, 0, 80, 60)), 800)
+
+	assert.Equal(t, image.Rect(0, 0, 80, 60), resized.Bounds())
+}
+
+func TestResize_AveragesAndFlattensTransparency(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	src.Set(0, 0, color.NRGBA{A: 255})
+	src.Set(1, 0, color.NRGBA{A: 0})
+
+	resized := imaging.Resize(src, 1)
+
+	// black and transparent-on-white average to mid grey
+	assert.Equal(t, color.RGBA{R: 127, G: 127, B: 127, A: 255}, resized.RGBAAt(0, 0))
+}
+
+func TestResizeAll_ScalesEachFromThePrevious(t *testing.T) {
+	variants := []imaging.Variant{{Name: "large", MaxSide: 400}, {Name: "medium", MaxSide: 200}, {Name: "small", MaxSide: 50}}
+
+	resized := imaging.ResizeAll(image.NewRGBA(image.Rect(0, 0, 1000, 500)), variants)
+
+	if assert.Len(t, resized, 3) {
+		assert.Equal(t, image.Rect(0, 0, 400, 200), resized[0].Bounds())
+		assert.Equal(t, image.Rect(0, 0, 200, 100), resized[1].Bounds())
+		assert.Equal(t, image.Rect(0, 0, 50, 25), resized[2].Bounds())
+	}
+}
+
+func TestEncodeJPEG(t *testing.T) {
+	data, err := imaging.EncodeJPEG(image.NewRGBA(image.Rect(0, 0, 10, 10)))
+
+	assert.NoError(t, err)
+	assert.Equal(t, imaging.ContentTypeJPEG, http.DetectContentType(data))
+}
@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_audit_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces AuditRepositoryInterface
+type AuditRepositoryInterface interface {
+	Create(ctx context.Context, entry *models.AuditLog) error
+	GetAll(ctx context.Context, filter dto.AuditFilter) ([]models.AuditLog, error)
+}
+
+//go:generate mockgen -destination=../../mocks/mock_audit_recorder.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces AuditRecorderInterface
+type AuditRecorderInterface interface {
+	Record(ctx context.Context, action string, entityType string, entityID uuid.UUID, before any, after any)
+}
+
+//go:generate mockgen -destination=../../mocks/mock_audit_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces AuditServiceInterface
+type AuditServiceInterface interface {
+	AuditRecorderInterface
+	GetAll(ctx context.Context, filter dto.AuditFilter) ([]models.AuditLog, error)
+}
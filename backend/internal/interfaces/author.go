@@ -14,7 +14,7 @@ type AuthorRepositoryInterface interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Author, error)
 	GetAll(ctx context.Context) ([]models.Author, error)
 	Update(ctx context.Context, author *models.Author) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
 }
 
 //go:generate mockgen -destination=../../mocks/mock_author_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces AuthorServiceInterface
@@ -22,6 +22,7 @@ type AuthorServiceInterface interface {
 	Create(ctx context.Context, input dto.AuthorInput) (*models.Author, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Author, error)
 	GetAll(ctx context.Context) ([]models.Author, error)
-	Update(ctx context.Context, id uuid.UUID, input dto.AuthorInput) (*models.Author, error)
-	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, version int64, input dto.AuthorInput) (*models.Author, error)
+	Patch(ctx context.Context, id uuid.UUID, version int64, patch []byte) (*models.Author, error)
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
 }
\ No newline at end of file
@@ -0,0 +1,31 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/blob"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_blob_store.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces BlobStore
+type BlobStore interface {
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	Get(ctx context.Context, key string) (*blob.Object, error)
+	Delete(ctx context.Context, key string) error
+}
+
+//go:generate mockgen -destination=../../mocks/mock_media_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces MediaRepositoryInterface
+type MediaRepositoryInterface interface {
+	SetBookCover(ctx context.Context, id uuid.UUID, version int64, cover *models.Image) error
+	SetAuthorPhoto(ctx context.Context, id uuid.UUID, version int64, photo *models.Image) error
+}
+
+//go:generate mockgen -destination=../../mocks/mock_media_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces MediaServiceInterface
+type MediaServiceInterface interface {
+	UploadBookCover(ctx context.Context, id uuid.UUID, version int64, data []byte) (*models.Book, error)
+	DeleteBookCover(ctx context.Context, id uuid.UUID, version int64) (*models.Book, error)
+	UploadAuthorPhoto(ctx context.Context, id uuid.UUID, version int64, data []byte) (*models.Author, error)
+	DeleteAuthorPhoto(ctx context.Context, id uuid.UUID, version int64) (*models.Author, error)
+	Open(ctx context.Context, key string) (*blob.Object, error)
+}
@@ -8,18 +8,24 @@ import (
 	"github.com/google/uuid"
 )
 
+//go:generate mockgen -destination=../../mocks/mock_book_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces BookRepositoryInterface
 type BookRepositoryInterface interface {
-	Create(ctx context.Context, book *models.Book, categoryIDs []uuid.UUID) error
+	Create(ctx context.Context, book *models.Book, contributors []models.BookContributor, categoryIDs []uuid.UUID) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Book, error)
+	GetByISBN(ctx context.Context, isbn13 string) (*models.Book, error)
 	GetAll(ctx context.Context, filter dto.BookFilter) ([]models.Book, error)
-	Update(ctx context.Context, author *models.Book, CategoryIDs []uuid.UUID) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, author *models.Book, contributors []models.BookContributor, CategoryIDs []uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
 }
 
+//go:generate mockgen -destination=../../mocks/mock_book_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces BookServiceInterface
 type BookServiceInterface interface {
 	Create(ctx context.Context, input dto.BookInput) (*models.Book, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Book, error)
+	GetDetails(ctx context.Context, id uuid.UUID) (*dto.BookDetails, error)
+	GetByISBN(ctx context.Context, isbn string) (*models.Book, error)
 	GetAll(ctx context.Context, filter dto.BookFilter) ([]models.Book, error)
-	Update(ctx context.Context, id uuid.UUID, input dto.BookInput) (*models.Book, error)
-	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, version int64, input dto.BookInput) (*models.Book, error)
+	Patch(ctx context.Context, id uuid.UUID, version int64, patch []byte) (*models.Book, error)
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
 }
\ No newline at end of file
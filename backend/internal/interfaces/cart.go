@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_cart_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces CartRepositoryInterface
+type CartRepositoryInterface interface {
+	GetByUser(ctx context.Context, userID uuid.UUID) (*models.Cart, error)
+	SetItem(ctx context.Context, userID, editionID uuid.UUID, quantity int) error
+	RemoveItem(ctx context.Context, userID, editionID uuid.UUID) error
+}
+
+//go:generate mockgen -destination=../../mocks/mock_cart_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces CartServiceInterface
+type CartServiceInterface interface {
+	Get(ctx context.Context, userID uuid.UUID, couponCode string) (*dto.Cart, error)
+	SetItem(ctx context.Context, userID, editionID uuid.UUID, input dto.CartItemInput) (*dto.Cart, error)
+	RemoveItem(ctx context.Context, userID, editionID uuid.UUID) (*dto.Cart, error)
+}
@@ -3,22 +3,32 @@ package interfaces
 import (
 	"context"
 
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
 	"github.com/google/uuid"
 )
 
+//go:generate mockgen -destination=../../mocks/mock_category_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces CategoryRepositoryInterface
 type CategoryRepositoryInterface interface {
 	Create(ctx context.Context, category *models.Category) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Category, error)
+	GetBySlug(ctx context.Context, slug string) (*models.Category, error)
 	GetAll(ctx context.Context) ([]models.Category, error)
+	GetAncestors(ctx context.Context, id uuid.UUID) ([]models.Category, error)
+	HasChildren(ctx context.Context, id uuid.UUID) (bool, error)
 	Update(ctx context.Context, category *models.Category) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
 }
 
+//go:generate mockgen -destination=../../mocks/mock_category_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces CategoryServiceInterface
 type CategoryServiceInterface interface {
-	Create(ctx context.Context, name string) (*models.Category, error)
+	Create(ctx context.Context, input dto.CategoryInput) (*models.Category, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Category, error)
+	GetBySlug(ctx context.Context, slug string) (*models.Category, error)
 	GetAll(ctx context.Context) ([]models.Category, error)
-	Update(ctx context.Context, id uuid.UUID, name string) (*models.Category, error)
-	Delete(ctx context.Context, id uuid.UUID) error
+	GetTree(ctx context.Context) ([]*models.Category, error)
+	GetBreadcrumbs(ctx context.Context, id uuid.UUID) ([]models.Category, error)
+	Update(ctx context.Context, id uuid.UUID, version int64, input dto.CategoryInput) (*models.Category, error)
+	Patch(ctx context.Context, id uuid.UUID, version int64, patch []byte) (*models.Category, error)
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
 }
\ No newline at end of file
@@ -0,0 +1,45 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_currency_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces CurrencyRepositoryInterface
+type CurrencyRepositoryInterface interface {
+	GetAll(ctx context.Context) ([]models.Currency, error)
+	GetByCode(ctx context.Context, code string) (*models.Currency, error)
+	Create(ctx context.Context, currency *models.Currency) error
+	GetRate(ctx context.Context, code string, at time.Time) (*models.ExchangeRate, error)
+	GetRates(ctx context.Context, code string) ([]models.ExchangeRate, error)
+	AddRates(ctx context.Context, rates []*models.ExchangeRate) error
+	GetEditionPrices(ctx context.Context, editionIDs []uuid.UUID, code string) ([]models.EditionPrice, error)
+	GetPricesForEdition(ctx context.Context, editionID uuid.UUID) ([]models.EditionPrice, error)
+	SetEditionPrice(ctx context.Context, price *models.EditionPrice) error
+	DeleteEditionPrice(ctx context.Context, editionID uuid.UUID, code string) error
+}
+
+//go:generate mockgen -destination=../../mocks/mock_currency_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces CurrencyServiceInterface
+type CurrencyServiceInterface interface {
+	GetAll(ctx context.Context) ([]dto.CurrencyInfo, error)
+	Create(ctx context.Context, input dto.CurrencyInput) (*models.Currency, error)
+	GetRates(ctx context.Context, code string) ([]models.ExchangeRate, error)
+	AddRate(ctx context.Context, code string, input dto.ExchangeRateInput) (*models.ExchangeRate, error)
+	ImportRates(ctx context.Context, r io.Reader) (*dto.RateImportReport, error)
+	GetEditionPrices(ctx context.Context, editionID uuid.UUID) ([]models.EditionPrice, error)
+	SetEditionPrice(ctx context.Context, editionID uuid.UUID, code string, input dto.EditionPriceInput) (*models.EditionPrice, error)
+	DeleteEditionPrice(ctx context.Context, editionID uuid.UUID, code string) error
+}
+
+//go:generate mockgen -destination=../../mocks/mock_price_localizer.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces PriceLocalizerInterface
+type PriceLocalizerInterface interface {
+	Localize(ctx context.Context, editions []*models.Edition) error
+	ToBase(ctx context.Context, amount money.Amount) (money.Amount, error)
+	PreferredRate(ctx context.Context) (string, money.Rate, error)
+}
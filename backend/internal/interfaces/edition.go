@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_edition_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces EditionRepositoryInterface
+type EditionRepositoryInterface interface {
+	Create(ctx context.Context, edition *models.Edition) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Edition, error)
+	GetByISBN(ctx context.Context, isbn13 string) (*models.Edition, error)
+	Update(ctx context.Context, edition *models.Edition) error
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
+}
+
+//go:generate mockgen -destination=../../mocks/mock_edition_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces EditionServiceInterface
+type EditionServiceInterface interface {
+	Create(ctx context.Context, bookID uuid.UUID, input dto.EditionInput) (*models.Edition, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Edition, error)
+	Update(ctx context.Context, id uuid.UUID, version int64, input dto.EditionInput) (*models.Edition, error)
+	Patch(ctx context.Context, id uuid.UUID, version int64, patch []byte) (*models.Edition, error)
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
+}
+
+//go:generate mockgen -destination=../../mocks/mock_edition_listener.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces EditionListenerInterface
+type EditionListenerInterface interface {
+	EditionChanged(ctx context.Context, before, after *models.Edition)
+}
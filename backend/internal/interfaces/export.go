@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_export_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces ExportRepositoryInterface
+type ExportRepositoryInterface interface {
+	ExportBooks(ctx context.Context, filter dto.BookFilter, fn func(dto.BookExportRow) error) error
+	ExportAuthors(ctx context.Context, fn func(models.Author) error) error
+	ExportPublishers(ctx context.Context, fn func(models.Publisher) error) error
+	ExportCustomers(ctx context.Context, fn func(dto.CustomerExportRow) error) error
+}
+
+//go:generate mockgen -destination=../../mocks/mock_export_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces ExportServiceInterface
+type ExportServiceInterface interface {
+	ExportBooks(ctx context.Context, format string, filter dto.BookFilter, w io.Writer) error
+	ExportAuthors(ctx context.Context, format string, w io.Writer) error
+	ExportPublishers(ctx context.Context, format string, w io.Writer) error
+	ExportCustomers(ctx context.Context, format string, w io.Writer) error
+}
@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_gift_card_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces GiftCardRepositoryInterface
+type GiftCardRepositoryInterface interface {
+	Create(ctx context.Context, card *models.GiftCard) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.GiftCard, error)
+	GetByCode(ctx context.Context, codeHash string) (*models.GiftCard, error)
+	GetAllByUser(ctx context.Context, userID uuid.UUID) ([]models.GiftCard, error)
+	GetStoreCredit(ctx context.Context, userID uuid.UUID, now time.Time) ([]models.GiftCard, error)
+	GetByOrder(ctx context.Context, orderID uuid.UUID) ([]models.GiftCardTransaction, error)
+	Expire(ctx context.Context, now time.Time) (int, error)
+}
+
+//go:generate mockgen -destination=../../mocks/mock_gift_card_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces GiftCardServiceInterface
+type GiftCardServiceInterface interface {
+	Issue(ctx context.Context, employeeID uuid.UUID, input dto.GiftCardIssueInput) (*dto.IssuedGiftCard, error)
+	Check(ctx context.Context, code string) (*dto.GiftCardBalance, error)
+	GetMine(ctx context.Context, userID uuid.UUID) (*dto.GiftCards, error)
+	GetByID(ctx context.Context, userID, id uuid.UUID) (*models.GiftCard, error)
+}
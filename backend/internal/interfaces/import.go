@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_import_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces ImportRepositoryInterface
+type ImportRepositoryInterface interface {
+	ImportBooks(ctx context.Context, rows []dto.ImportBookRow, dryRun bool) ([]dto.ImportedBook, error)
+}
+
+//go:generate mockgen -destination=../../mocks/mock_import_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces ImportServiceInterface
+type ImportServiceInterface interface {
+	ImportBooks(ctx context.Context, r io.Reader, dryRun bool) (*dto.ImportReport, error)
+}
@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/blob"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_invoice_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces InvoiceRepositoryInterface
+type InvoiceRepositoryInterface interface {
+	GetByOrder(ctx context.Context, orderID uuid.UUID) (*models.Invoice, error)
+	// Create gives the invoice the next number of its year and saves it;
+	// if saving fails, the number is not used up.
+	Create(ctx context.Context, invoice *models.Invoice) error
+	// Update saves the payment status and the stored document.
+	Update(ctx context.Context, invoice *models.Invoice) error
+}
+
+//go:generate mockgen -destination=../../mocks/mock_invoice_renderer.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces InvoiceRendererInterface
+type InvoiceRendererInterface interface {
+	Render(invoice *models.Invoice, order *models.Order) ([]byte, error)
+}
+
+//go:generate mockgen -destination=../../mocks/mock_invoice_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces InvoiceServiceInterface
+type InvoiceServiceInterface interface {
+	Open(ctx context.Context, userID, orderID uuid.UUID, anyOrder bool) (*models.Invoice, *blob.Object, error)
+}
@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_loyalty_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces LoyaltyRepositoryInterface
+type LoyaltyRepositoryInterface interface {
+	GetCredits(ctx context.Context, userID uuid.UUID, now time.Time) ([]models.LoyaltyTransaction, error)
+	GetHistory(ctx context.Context, userID uuid.UUID, limit, offset int) ([]models.LoyaltyTransaction, int, error)
+	GetByOrder(ctx context.Context, orderID uuid.UUID) ([]models.LoyaltyTransaction, error)
+	Adjust(ctx context.Context, entry *models.LoyaltyTransaction) error
+	Expire(ctx context.Context, now time.Time) (int, error)
+}
+
+//go:generate mockgen -destination=../../mocks/mock_loyalty_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces LoyaltyServiceInterface
+type LoyaltyServiceInterface interface {
+	GetBalance(ctx context.Context, userID uuid.UUID) (*dto.LoyaltyBalance, error)
+	GetHistory(ctx context.Context, userID uuid.UUID, query dto.LoyaltyHistoryQuery) (*dto.LoyaltyHistoryPage, error)
+	Adjust(ctx context.Context, adminID, userID uuid.UUID, input dto.LoyaltyAdjustmentInput) (*models.LoyaltyTransaction, error)
+}
@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_notifier.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces NotifierInterface
+type NotifierInterface interface {
+	Notify(ctx context.Context, notification dto.Notification) error
+}
@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_order_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces OrderRepositoryInterface
+type OrderRepositoryInterface interface {
+	Create(ctx context.Context, order *models.Order, warehouses []uuid.UUID, redemption *models.CouponRedemption) error
+	UpdateStatus(ctx context.Context, order *models.Order, from string, points []*models.LoyaltyTransaction, giftCards []*models.GiftCardTransaction) error
+	MarkPaid(ctx context.Context, order *models.Order, giftCards []*models.GiftCardTransaction) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Order, error)
+	GetAllByUser(ctx context.Context, userID uuid.UUID) ([]models.Order, error)
+	UsePickupAttempt(ctx context.Context, orderID uuid.UUID, max int) (bool, error)
+}
+
+//go:generate mockgen -destination=../../mocks/mock_order_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces OrderServiceInterface
+type OrderServiceInterface interface {
+	Checkout(ctx context.Context, userID uuid.UUID, input dto.CheckoutInput) (*models.Order, error)
+	GetMine(ctx context.Context, userID uuid.UUID) ([]models.Order, error)
+	GetByID(ctx context.Context, userID, id uuid.UUID) (*models.Order, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, input dto.OrderStatusInput) (*models.Order, error)
+	HandOver(ctx context.Context, id uuid.UUID, input dto.PickupInput) (*models.Order, error)
+	MarkPaid(ctx context.Context, id uuid.UUID) (*models.Order, error)
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_outbox_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces OutboxRepositoryInterface
+type OutboxRepositoryInterface interface {
+	Enqueue(ctx context.Context, message *models.OutboxMessage) error
+	Process(ctx context.Context, limit int, handle func(message *models.OutboxMessage)) (int, error)
+}
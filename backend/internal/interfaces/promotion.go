@@ -0,0 +1,31 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_promotion_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces PromotionRepositoryInterface
+type PromotionRepositoryInterface interface {
+	Create(ctx context.Context, promotion *models.Promotion) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Promotion, error)
+	GetByCouponCode(ctx context.Context, code string) (*models.Promotion, error)
+	GetAll(ctx context.Context, filter dto.PromotionFilter) ([]models.Promotion, error)
+	GetRunning(ctx context.Context) ([]models.Promotion, error)
+	Update(ctx context.Context, promotion *models.Promotion) error
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
+	CountRedemptions(ctx context.Context, promotionID, userID uuid.UUID) (total int, byUser int, err error)
+	GetCategoryAncestors(ctx context.Context, categoryIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+}
+
+//go:generate mockgen -destination=../../mocks/mock_promotion_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces PromotionServiceInterface
+type PromotionServiceInterface interface {
+	Create(ctx context.Context, input dto.PromotionInput) (*models.Promotion, error)
+	GetAll(ctx context.Context, filter dto.PromotionFilter) ([]models.Promotion, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Promotion, error)
+	Update(ctx context.Context, id uuid.UUID, version int64, input dto.PromotionInput) (*models.Promotion, error)
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
+}
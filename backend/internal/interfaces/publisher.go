@@ -14,7 +14,7 @@ type PublisherRepositoryInterface interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Publisher, error)
 	GetAll(ctx context.Context) ([]models.Publisher, error)
 	Update(ctx context.Context, author *models.Publisher) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
 }
 
 //go:generate mockgen -destination=../../mocks/mock_publisher_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces PublisherServiceInterface
@@ -22,6 +22,7 @@ type PublisherServiceInterface interface {
 	Create(ctx context.Context, input dto.PublisherInput) (*models.Publisher, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Publisher, error)
 	GetAll(ctx context.Context) ([]models.Publisher, error)
-	Update(ctx context.Context, id uuid.UUID, input dto.PublisherInput) (*models.Publisher, error)
-	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, version int64, input dto.PublisherInput) (*models.Publisher, error)
+	Patch(ctx context.Context, id uuid.UUID, version int64, patch []byte) (*models.Publisher, error)
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
 }
\ No newline at end of file
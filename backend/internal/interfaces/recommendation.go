@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_recommendation_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces RecommendationRepositoryInterface
+type RecommendationRepositoryInterface interface {
+	Rebuild(ctx context.Context, perBook int) error
+	GetRelated(ctx context.Context, bookID uuid.UUID, limit int) ([]models.Book, error)
+	GetForUser(ctx context.Context, userID uuid.UUID, limit int) ([]models.Book, error)
+	GetBestsellers(ctx context.Context, userID uuid.UUID, limit int) ([]models.Book, error)
+}
+
+//go:generate mockgen -destination=../../mocks/mock_recommendation_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces RecommendationServiceInterface
+type RecommendationServiceInterface interface {
+	GetRelated(ctx context.Context, bookID uuid.UUID, query dto.RecommendationQuery) ([]models.Book, error)
+	GetForUser(ctx context.Context, userID uuid.UUID, query dto.RecommendationQuery) ([]models.Book, error)
+}
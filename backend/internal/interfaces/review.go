@@ -0,0 +1,31 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_review_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces ReviewRepositoryInterface
+type ReviewRepositoryInterface interface {
+	Create(ctx context.Context, review *models.Review) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Review, error)
+	ExistsForUser(ctx context.Context, bookID, userID uuid.UUID) (bool, error)
+	HasDeliveredPurchase(ctx context.Context, userID, bookID uuid.UUID) (bool, error)
+	List(ctx context.Context, bookID, userID *uuid.UUID, status string, query dto.ReviewQuery) ([]models.Review, int, error)
+	Update(ctx context.Context, review *models.Review) error
+	Delete(ctx context.Context, review *models.Review, version int64) error
+}
+
+//go:generate mockgen -destination=../../mocks/mock_review_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces ReviewServiceInterface
+type ReviewServiceInterface interface {
+	Create(ctx context.Context, userID, bookID uuid.UUID, input dto.ReviewInput) (*models.Review, error)
+	GetForBook(ctx context.Context, bookID uuid.UUID, query dto.ReviewQuery) (*dto.ReviewPage, error)
+	GetMine(ctx context.Context, userID uuid.UUID, query dto.ReviewQuery) (*dto.ReviewPage, error)
+	GetModerationQueue(ctx context.Context, query dto.ReviewQuery) (*dto.ReviewPage, error)
+	Update(ctx context.Context, userID, id uuid.UUID, version int64, input dto.ReviewInput) (*models.Review, error)
+	Delete(ctx context.Context, userID, id uuid.UUID, version int64) error
+	Moderate(ctx context.Context, id uuid.UUID, version int64, input dto.ModerationInput) (*models.Review, error)
+}
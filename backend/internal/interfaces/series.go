@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_series_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces SeriesRepositoryInterface
+type SeriesRepositoryInterface interface {
+	Create(ctx context.Context, series *models.Series) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Series, error)
+	GetAll(ctx context.Context) ([]models.Series, error)
+	Update(ctx context.Context, series *models.Series) error
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
+}
+
+//go:generate mockgen -destination=../../mocks/mock_series_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces SeriesServiceInterface
+type SeriesServiceInterface interface {
+	Create(ctx context.Context, input dto.SeriesInput) (*models.Series, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Series, error)
+	GetAll(ctx context.Context) ([]models.Series, error)
+	Update(ctx context.Context, id uuid.UUID, version int64, input dto.SeriesInput) (*models.Series, error)
+	Patch(ctx context.Context, id uuid.UUID, version int64, patch []byte) (*models.Series, error)
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
+}
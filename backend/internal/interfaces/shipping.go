@@ -0,0 +1,49 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_shipping_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces ShippingRepositoryInterface
+type ShippingRepositoryInterface interface {
+	CreateZone(ctx context.Context, zone *models.ShippingZone) error
+	GetZoneByID(ctx context.Context, id uuid.UUID) (*models.ShippingZone, error)
+	GetZones(ctx context.Context) ([]models.ShippingZone, error)
+	UpdateZone(ctx context.Context, zone *models.ShippingZone) error
+	DeleteZone(ctx context.Context, id uuid.UUID, version int64) error
+	CreateMethod(ctx context.Context, method *models.ShippingMethod) error
+	GetMethodByID(ctx context.Context, id uuid.UUID) (*models.ShippingMethod, error)
+	GetMethods(ctx context.Context, filter dto.ShippingMethodFilter) ([]models.ShippingMethod, error)
+	UpdateMethod(ctx context.Context, method *models.ShippingMethod) error
+	DeleteMethod(ctx context.Context, id uuid.UUID, version int64) error
+	CreatePickupPoint(ctx context.Context, point *models.PickupPoint) error
+	GetPickupPointByID(ctx context.Context, id uuid.UUID) (*models.PickupPoint, error)
+	GetPickupPoints(ctx context.Context, activeOnly bool) ([]models.PickupPoint, error)
+	UpdatePickupPoint(ctx context.Context, point *models.PickupPoint) error
+	DeletePickupPoint(ctx context.Context, id uuid.UUID, version int64) error
+}
+
+//go:generate mockgen -destination=../../mocks/mock_shipping_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces ShippingServiceInterface
+type ShippingServiceInterface interface {
+	CreateZone(ctx context.Context, input dto.ShippingZoneInput) (*models.ShippingZone, error)
+	GetZones(ctx context.Context) ([]models.ShippingZone, error)
+	UpdateZone(ctx context.Context, id uuid.UUID, version int64, input dto.ShippingZoneInput) (*models.ShippingZone, error)
+	DeleteZone(ctx context.Context, id uuid.UUID, version int64) error
+	CreateMethod(ctx context.Context, input dto.ShippingMethodInput) (*models.ShippingMethod, error)
+	GetMethods(ctx context.Context, filter dto.ShippingMethodFilter) ([]models.ShippingMethod, error)
+	GetMethodByID(ctx context.Context, id uuid.UUID) (*models.ShippingMethod, error)
+	UpdateMethod(ctx context.Context, id uuid.UUID, version int64, input dto.ShippingMethodInput) (*models.ShippingMethod, error)
+	DeleteMethod(ctx context.Context, id uuid.UUID, version int64) error
+	Quote(ctx context.Context, userID uuid.UUID, query dto.ShippingQuoteQuery) ([]dto.ShippingQuote, error)
+	QuoteCart(ctx context.Context, cart *dto.Cart, postalCode string) ([]dto.ShippingQuote, error)
+	CreatePickupPoint(ctx context.Context, input dto.PickupPointInput) (*models.PickupPoint, error)
+	GetPickupPoints(ctx context.Context, query dto.PickupPointQuery) ([]models.PickupPoint, error)
+	GetAllPickupPoints(ctx context.Context) ([]models.PickupPoint, error)
+	GetPickupPointByID(ctx context.Context, id uuid.UUID) (*models.PickupPoint, error)
+	UpdatePickupPoint(ctx context.Context, id uuid.UUID, version int64, input dto.PickupPointInput) (*models.PickupPoint, error)
+	DeletePickupPoint(ctx context.Context, id uuid.UUID, version int64) error
+}
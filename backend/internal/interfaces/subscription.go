@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_subscription_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces SubscriptionRepositoryInterface
+type SubscriptionRepositoryInterface interface {
+	Create(ctx context.Context, subscription *models.StockSubscription) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.StockSubscription, error)
+	GetByToken(ctx context.Context, token string) (*models.StockSubscription, error)
+	GetByUser(ctx context.Context, userID uuid.UUID) ([]models.StockSubscription, error)
+	GetByBook(ctx context.Context, bookID uuid.UUID) ([]models.StockSubscription, error)
+	Exists(ctx context.Context, userID, bookID uuid.UUID, kind string) (bool, error)
+	CountByUser(ctx context.Context, userID uuid.UUID) (int, error)
+	GetBookStock(ctx context.Context, bookID uuid.UUID) (*dto.BookStock, error)
+	Fire(ctx context.Context, subscription *models.StockSubscription, message *models.OutboxMessage) error
+	Rearm(ctx context.Context, ids []uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+//go:generate mockgen -destination=../../mocks/mock_subscription_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces SubscriptionServiceInterface
+type SubscriptionServiceInterface interface {
+	Subscribe(ctx context.Context, userID, bookID uuid.UUID, input dto.SubscriptionInput) (*models.StockSubscription, error)
+	GetMine(ctx context.Context, userID uuid.UUID) ([]models.StockSubscription, error)
+	Unsubscribe(ctx context.Context, userID, id uuid.UUID) error
+	UnsubscribeByToken(ctx context.Context, token string) error
+}
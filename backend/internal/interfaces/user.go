@@ -27,5 +27,6 @@ type UserServiceInterface interface {
     GetAllEmployees(ctx context.Context) ([]models.User, error)
     GetByID(ctx context.Context, id uuid.UUID) (*models.User, error)
     Update(ctx context.Context, id uuid.UUID, req dto.UpdateUserRequest) (*models.User, error)
+    Patch(ctx context.Context, id uuid.UUID, patch []byte) (*models.User, error)
     Delete(ctx context.Context, id uuid.UUID) error
 }
\ No newline at end of file
@@ -0,0 +1,36 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_warehouse_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces WarehouseRepositoryInterface
+type WarehouseRepositoryInterface interface {
+	Create(ctx context.Context, warehouse *models.Warehouse) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Warehouse, error)
+	GetAll(ctx context.Context, activeOnly bool) ([]models.Warehouse, error)
+	Update(ctx context.Context, warehouse *models.Warehouse) error
+	GetStock(ctx context.Context, warehouseID uuid.UUID) ([]models.EditionStock, error)
+	GetEditionStock(ctx context.Context, editionID uuid.UUID) ([]models.EditionStock, error)
+	SetStock(ctx context.Context, stock *models.EditionStock) error
+	Transfer(ctx context.Context, transfer *models.StockTransfer) error
+	GetTransfers(ctx context.Context, filter dto.StockTransferFilter) ([]models.StockTransfer, error)
+}
+
+//go:generate mockgen -destination=../../mocks/mock_warehouse_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces WarehouseServiceInterface
+type WarehouseServiceInterface interface {
+	Create(ctx context.Context, input dto.WarehouseInput) (*models.Warehouse, error)
+	GetAll(ctx context.Context) ([]models.Warehouse, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Warehouse, error)
+	Update(ctx context.Context, id uuid.UUID, version int64, input dto.WarehouseInput) (*models.Warehouse, error)
+	GetStock(ctx context.Context, warehouseID uuid.UUID) ([]models.EditionStock, error)
+	GetEditionStock(ctx context.Context, editionID uuid.UUID) ([]models.EditionStock, error)
+	SetStock(ctx context.Context, warehouseID, editionID uuid.UUID, input dto.StockInput) (*models.Edition, error)
+	Transfer(ctx context.Context, input dto.StockTransferInput) (*models.StockTransfer, error)
+	GetTransfers(ctx context.Context, filter dto.StockTransferFilter) ([]models.StockTransfer, error)
+	ShipFrom(ctx context.Context, pickupPointID *uuid.UUID) ([]uuid.UUID, error)
+}
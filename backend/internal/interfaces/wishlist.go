@@ -0,0 +1,40 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=../../mocks/mock_wishlist_repo.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces WishlistRepositoryInterface
+type WishlistRepositoryInterface interface {
+	Create(ctx context.Context, wishlist *models.Wishlist) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Wishlist, error)
+	GetByShareToken(ctx context.Context, token string) (*models.Wishlist, error)
+	GetByName(ctx context.Context, userID uuid.UUID, name string) (*models.Wishlist, error)
+	GetAllByUser(ctx context.Context, userID uuid.UUID) ([]models.Wishlist, error)
+	CountByUser(ctx context.Context, userID uuid.UUID) (int, error)
+	Update(ctx context.Context, wishlist *models.Wishlist) error
+	Delete(ctx context.Context, id uuid.UUID, version int64) error
+	AddItem(ctx context.Context, item *models.WishlistItem) error
+	RemoveItem(ctx context.Context, id uuid.UUID) error
+	MoveToCart(ctx context.Context, item *models.WishlistItem, userID uuid.UUID, quantity int) error
+	GetWatchers(ctx context.Context, editionID uuid.UUID) ([]uuid.UUID, error)
+}
+
+//go:generate mockgen -destination=../../mocks/mock_wishlist_service.go -package=mocks github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces WishlistServiceInterface
+type WishlistServiceInterface interface {
+	Create(ctx context.Context, userID uuid.UUID, input dto.WishlistInput) (*models.Wishlist, error)
+	GetAll(ctx context.Context, userID uuid.UUID) ([]models.Wishlist, error)
+	GetByID(ctx context.Context, userID, id uuid.UUID) (*models.Wishlist, error)
+	GetShared(ctx context.Context, token string) (*dto.SharedWishlist, error)
+	Update(ctx context.Context, userID, id uuid.UUID, version int64, input dto.WishlistInput) (*models.Wishlist, error)
+	Delete(ctx context.Context, userID, id uuid.UUID, version int64) error
+	AddItem(ctx context.Context, userID, id uuid.UUID, input dto.WishlistItemInput) (*models.Wishlist, error)
+	RemoveItem(ctx context.Context, userID, id, editionID uuid.UUID) (*models.Wishlist, error)
+	MoveToCart(ctx context.Context, userID, id, editionID uuid.UUID, input dto.MoveToCartInput) (*models.Wishlist, error)
+	Share(ctx context.Context, userID, id uuid.UUID, version int64) (*models.Wishlist, error)
+	Unshare(ctx context.Context, userID, id uuid.UUID, version int64) (*models.Wishlist, error)
+}
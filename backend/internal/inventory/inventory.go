@@ -0,0 +1,83 @@
+// Package inventory decides which warehouses an order is shipped from.
+package inventory
+
+import "github.com/google/uuid"
+
+// Line is a number of copies of an edition to ship.
+type Line struct {
+	EditionID uuid.UUID
+	Quantity  int
+}
+
+// Stock is how many copies of each edition each warehouse has, by
+// warehouse and then edition.
+type Stock map[uuid.UUID]map[uuid.UUID]int
+
+// Allocation is the part of a line, given by its index, shipped from a
+// warehouse.
+type Allocation struct {
+	Line        int
+	WarehouseID uuid.UUID
+	Quantity    int
+}
+
+// Allocate decides which of the warehouses, in order of preference, ship
+// the lines. The first warehouse that has all of them ships the whole
+// order, so that it goes out in one parcel; otherwise each line is taken
+// from the first warehouses that still have copies of it, split between
+// them if need be. It reports false if the warehouses do not have enough
+// copies between them.
+func Allocate(lines []Line, stock Stock, warehouses []uuid.UUID) ([]Allocation, bool) {
+	for _, warehouse := range warehouses {
+		if hasAll(lines, stock[warehouse]) {
+			allocations := make([]Allocation, 0, len(lines))
+			for i, line := range lines {
+				allocations = append(allocations, Allocation{Line: i, WarehouseID: warehouse, Quantity: line.Quantity})
+			}
+			return allocations, true
+		}
+	}
+
+	left := make(Stock, len(stock))
+	for warehouse, editions := range stock {
+		left[warehouse] = make(map[uuid.UUID]int, len(editions))
+		for edition, quantity := range editions {
+			left[warehouse][edition] = quantity
+		}
+	}
+	var allocations []Allocation
+	for i, line := range lines {
+		needed := line.Quantity
+		for _, warehouse := range warehouses {
+			if needed == 0 {
+				break
+			}
+			taken := min(needed, left[warehouse][line.EditionID])
+			if taken <= 0 {
+				continue
+			}
+			left[warehouse][line.EditionID] -= taken
+			needed -= taken
+			allocations = append(allocations, Allocation{Line: i, WarehouseID: warehouse, Quantity: taken})
+		}
+		if needed > 0 {
+			return nil, false
+		}
+	}
+	return allocations, true
+}
+
+// hasAll reports whether a warehouse with the stock has every line in full.
+// Lines of the same edition are counted together.
+func hasAll(lines []Line, stock map[uuid.UUID]int) bool {
+	needed := make(map[uuid.UUID]int, len(lines))
+	for _, line := range lines {
+		needed[line.EditionID] += line.Quantity
+	}
+	for edition, quantity := range needed {
+		if stock[edition] < quantity {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+package inventory_test
+
+import (
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/inventory"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocate_OneWarehouseShipsEverything(t *testing.T) {
+	central, shop := uuid.New(), uuid.New()
+	a, b := uuid.New(), uuid.New()
+	stock := inventory.Stock{
+		central: {a: 5},
+		shop:    {a: 1, b: 2},
+	}
+
+	allocations, ok := inventory.Allocate([]inventory.Line{{EditionID: a, Quantity: 1}, {EditionID: b, Quantity: 2}}, stock, []uuid.UUID{central, shop})
+
+	assert.True(t, ok)
+	assert.Equal(t, []inventory.Allocation{
+		{Line: 0, WarehouseID: shop, Quantity: 1},
+		{Line: 1, WarehouseID: shop, Quantity: 2},
+	}, allocations)
+}
+
+func TestAllocate_PrefersEarlierWarehouse(t *testing.T) {
+	central, shop := uuid.New(), uuid.New()
+	a := uuid.New()
+	stock := inventory.Stock{
+		central: {a: 5},
+		shop:    {a: 5},
+	}
+
+	allocations, ok := inventory.Allocate([]inventory.Line{{EditionID: a, Quantity: 2}}, stock, []uuid.UUID{shop, central})
+
+	assert.True(t, ok)
+	assert.Equal(t, []inventory.Allocation{{Line: 0, WarehouseID: shop, Quantity: 2}}, allocations)
+}
+
+func TestAllocate_SplitsBetweenWarehouses(t *testing.T) {
+	central, shop := uuid.New(), uuid.New()
+	a, b := uuid.New(), uuid.New()
+	stock := inventory.Stock{
+		central: {a: 1, b: 4},
+		shop:    {a: 2},
+	}
+
+	allocations, ok := inventory.Allocate([]inventory.Line{{EditionID: a, Quantity: 3}, {EditionID: b, Quantity: 1}}, stock, []uuid.UUID{central, shop})
+
+	assert.True(t, ok)
+	assert.Equal(t, []inventory.Allocation{
+		{Line: 0, WarehouseID: central, Quantity: 1},
+		{Line: 0, WarehouseID: shop, Quantity: 2},
+		{Line: 1, WarehouseID: central, Quantity: 1},
+	}, allocations)
+	assert.Equal(t, 1, stock[central][a], "the stock given is left as it was")
+}
+
+func TestAllocate_NotEnoughCopies(t *testing.T) {
+	central, shop := uuid.New(), uuid.New()
+	a := uuid.New()
+	stock := inventory.Stock{
+		central: {a: 1},
+		shop:    {a: 1},
+	}
+
+	_, ok := inventory.Allocate([]inventory.Line{{EditionID: a, Quantity: 3}}, stock, []uuid.UUID{central, shop})
+
+	assert.False(t, ok)
+}
+
+func TestAllocate_IgnoresWarehousesNotGiven(t *testing.T) {
+	central, closed := uuid.New(), uuid.New()
+	a := uuid.New()
+	stock := inventory.Stock{
+		central: {a: 1},
+		closed:  {a: 10},
+	}
+
+	_, ok := inventory.Allocate([]inventory.Line{{EditionID: a, Quantity: 2}}, stock, []uuid.UUID{central})
+
+	assert.False(t, ok)
+}
@@ -0,0 +1,300 @@
+// Package invoice lays out the invoice of an order as a PDF document.
+package invoice
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/pdf"
+)
+
+// Company holds the seller's requisites printed on every invoice. Empty
+// fields are left out.
+type Company struct {
+	Name                 string
+	Address              string
+	INN                  string
+	KPP                  string
+	OGRN                 string
+	Bank                 string
+	BIK                  string
+	Account              string
+	CorrespondentAccount string
+	Phone                string
+	Email                string
+}
+
+// Renderer draws invoices in TrueType fonts, so that Cyrillic titles and
+// names print as they are.
+type Renderer struct {
+	company Company
+	regular *pdf.TrueType
+	bold    *pdf.TrueType
+}
+
+// NewRenderer creates a renderer; regular and bold may be nil. Without
+// fonts it uses the bundled DejaVu Sans, and bold defaults to regular.
+func NewRenderer(company Company, regular, bold *pdf.TrueType) *Renderer {
+	if regular == nil {
+		regular, bold = pdf.DejaVuSans(), pdf.DejaVuSansBold()
+	}
+	if bold == nil {
+		bold = regular
+	}
+	return &Renderer{company: company, regular: regular, bold: bold}
+}
+
+const (
+	margin   = 40.0
+	right    = pdf.PageWidth - margin
+	bottom   = 50.0
+	bodySize = 9.0
+	leading  = 13.0
+)
+
+// columns of the items table; amounts are aligned to the right edge given.
+var (
+	colNumber   = margin
+	colItem     = margin + 18
+	colQuantity = 318.0
+	colPrice    = 376.0
+	colDiscount = 428.0
+	colRate     = 460.0
+	colTax      = 508.0
+	colTotal    = right
+)
+
+// Render draws the invoice of the order. The order needs its items with
+// editions and books, its payment and, for the buyer's details, its user.
+func (r *Renderer) Render(invoice *models.Invoice, order *models.Order) ([]byte, error) {
+	l := &layout{doc: pdf.New("Invoice "+invoice.Code, invoice.IssuedAt)}
+	l.regular, l.bold = r.regular.Font(), r.bold.Font()
+	l.newPage()
+
+	l.page.Text(l.bold, 16, margin, l.y, "Invoice No. "+invoice.Code)
+	l.y -= 22
+	l.line("Date: " + invoice.IssuedAt.Format("02.01.2006"))
+	l.line("Order: " + order.ID.String())
+	l.line("Currency: " + order.Currency)
+	l.y -= 6
+
+	l.heading("Seller")
+	c := r.company
+	l.line(c.Name)
+	l.line(joinNonEmpty(", ", label("INN", c.INN), label("KPP", c.KPP), label("OGRN", c.OGRN)))
+	l.line(c.Address)
+	l.line(joinNonEmpty(", ", label("Bank", c.Bank), label("BIK", c.BIK)))
+	l.line(joinNonEmpty(", ", label("Account", c.Account), label("Corr. account", c.CorrespondentAccount)))
+	l.line(joinNonEmpty(", ", c.Phone, c.Email))
+	l.y -= 6
+
+	if user := order.User; user != nil {
+		l.heading("Buyer")
+		phone := ""
+		if user.Phone != nil {
+			phone = *user.Phone
+		}
+		l.line(joinNonEmpty(", ", user.Username, user.Email, phone))
+	}
+	if d := order.Delivery; d != nil && d.PickupPointID != nil {
+		l.line("Pickup point: " + joinNonEmpty(", ", d.Method, d.PostalCode, d.Address))
+	} else if d != nil {
+		l.line("Delivery address: " + joinNonEmpty(", ", d.PostalCode, d.Address))
+	}
+	l.y -= 10
+
+	l.tableHeader()
+	var subtotal money.Amount
+	for i, item := range order.Items {
+		l.need(leading, true)
+		lineSubtotal := item.Price.Mul(item.Quantity)
+		subtotal += lineSubtotal
+		p := l.page
+		p.Text(l.regular, bodySize, colNumber, l.y, strconv.Itoa(i+1))
+		p.Text(l.regular, bodySize, colItem, l.y, fit(l.regular, itemName(item), colQuantity-30-colItem))
+		p.TextRight(l.regular, bodySize, colQuantity, l.y, strconv.Itoa(item.Quantity))
+		p.TextRight(l.regular, bodySize, colPrice, l.y, item.Price.String())
+		p.TextRight(l.regular, bodySize, colDiscount, l.y, item.Discount.String())
+		p.TextRight(l.regular, bodySize, colRate, l.y, strconv.Itoa(item.TaxRate)+"%")
+		p.TextRight(l.regular, bodySize, colTax, l.y, item.Tax.String())
+		p.TextRight(l.regular, bodySize, colTotal, l.y, (lineSubtotal - item.Discount).String())
+		l.y -= leading
+	}
+	l.page.Line(margin, l.y+leading-3, right, l.y+leading-3, 0.5)
+	l.y -= 4
+
+	l.total("Subtotal", subtotal.String(), false)
+	if order.Discount != 0 {
+		name := "Discount"
+		if order.CouponCode != nil {
+			name += " (coupon " + *order.CouponCode + ")"
+		}
+		l.total(name, "-"+order.Discount.String(), false)
+	}
+	if order.Delivery != nil && order.Delivery.Method != "" && order.Delivery.PickupPointID == nil {
+		l.total("Shipping ("+order.Delivery.Method+")", order.ShippingCost.String(), false)
+	}
+	if order.GiftCardsBought != 0 {
+		l.total("Gift cards ("+strconv.Itoa(len(order.GiftCards))+")", order.GiftCardsBought.String(), false)
+	}
+	l.total("Total, "+order.Currency, order.TotalPrice.String(), true)
+	l.total("including VAT", order.Tax.String(), false)
+	if order.PointsValue != 0 {
+		l.total("Paid with "+strconv.Itoa(order.PointsRedeemed)+" points", "-"+order.PointsValue.String(), false)
+	}
+	if order.GiftCardValue != 0 {
+		l.total("Paid with gift cards", "-"+order.GiftCardValue.String(), false)
+	}
+	if order.PointsValue != 0 || order.GiftCardValue != 0 {
+		l.total("To pay, "+order.Currency, (order.TotalPrice - order.PointsValue - order.GiftCardValue).String(), true)
+	}
+	l.y -= 10
+
+	if len(order.Taxes) > 0 {
+		l.need(leading*float64(len(order.Taxes)+2), false)
+		l.heading("VAT")
+		l.page.Text(l.bold, bodySize, margin, l.y, "Rate")
+		l.page.TextRight(l.bold, bodySize, margin+140, l.y, "Net")
+		l.page.TextRight(l.bold, bodySize, margin+220, l.y, "VAT")
+		l.page.TextRight(l.bold, bodySize, margin+300, l.y, "Total")
+		l.y -= leading
+		for _, line := range order.Taxes {
+			l.page.Text(l.regular, bodySize, margin, l.y, strconv.Itoa(line.Rate)+"%")
+			l.page.TextRight(l.regular, bodySize, margin+140, l.y, line.Net.String())
+			l.page.TextRight(l.regular, bodySize, margin+220, l.y, line.Tax.String())
+			l.page.TextRight(l.regular, bodySize, margin+300, l.y, line.Total.String())
+			l.y -= leading
+		}
+		l.y -= 10
+	}
+
+	l.need(leading*2, false)
+	if order.Payment != nil {
+		l.page.Text(l.bold, bodySize+1, margin, l.y, "Payment: "+order.Payment.Method+", "+strings.ToLower(order.Payment.Status))
+		l.y -= leading
+	}
+	l.line("All prices include VAT.")
+
+	for i, page := range l.pages {
+		page.TextRight(l.regular, 8, right, bottom-20, fmt.Sprintf("Invoice %s, page %d of %d", invoice.Code, i+1, len(l.pages)))
+	}
+	return l.doc.Bytes()
+}
+
+// layout keeps track of where the next line goes.
+type layout struct {
+	doc     *pdf.Document
+	regular pdf.Font
+	bold    pdf.Font
+	pages   []*pdf.Page
+	page    *pdf.Page
+	y       float64
+}
+
+func (l *layout) newPage() {
+	l.page = l.doc.AddPage()
+	l.pages = append(l.pages, l.page)
+	l.y = pdf.PageHeight - margin - 16
+}
+
+// need starts a new page unless height fits on this one; inTable repeats
+// the table header on the new page.
+func (l *layout) need(height float64, inTable bool) {
+	if l.y-height >= bottom {
+		return
+	}
+	l.newPage()
+	if inTable {
+		l.tableHeader()
+	}
+}
+
+func (l *layout) line(text string) {
+	if text == "" {
+		return
+	}
+	l.need(leading, false)
+	l.page.Text(l.regular, bodySize, margin, l.y, fit(l.regular, text, right-margin))
+	l.y -= leading
+}
+
+func (l *layout) heading(text string) {
+	l.need(leading*2, false)
+	l.page.Text(l.bold, bodySize+1, margin, l.y, text)
+	l.y -= leading
+}
+
+func (l *layout) tableHeader() {
+	p := l.page
+	p.Text(l.bold, bodySize, colNumber, l.y, "#")
+	p.Text(l.bold, bodySize, colItem, l.y, "Item")
+	p.TextRight(l.bold, bodySize, colQuantity, l.y, "Qty")
+	p.TextRight(l.bold, bodySize, colPrice, l.y, "Price")
+	p.TextRight(l.bold, bodySize, colDiscount, l.y, "Discount")
+	p.TextRight(l.bold, bodySize, colRate, l.y, "VAT %")
+	p.TextRight(l.bold, bodySize, colTax, l.y, "VAT")
+	p.TextRight(l.bold, bodySize, colTotal, l.y, "Total")
+	p.Line(margin, l.y-4, right, l.y-4, 0.5)
+	l.y -= leading + 2
+}
+
+func (l *layout) total(name, value string, strong bool) {
+	l.need(leading, false)
+	font := l.regular
+	if strong {
+		font = l.bold
+	}
+	l.page.TextRight(font, bodySize, colTax, l.y, name+":")
+	l.page.TextRight(font, bodySize, colTotal, l.y, value)
+	l.y -= leading
+}
+
+// itemName describes the edition sold: the book's title, its format and
+// ISBN.
+func itemName(item *models.OrderItem) string {
+	edition := item.Edition
+	if edition == nil {
+		return item.EditionID.String()
+	}
+	title := edition.ID.String()
+	if edition.Book != nil {
+		title = edition.Book.Title
+	}
+	isbn := ""
+	if edition.ISBN13 != nil {
+		isbn = "ISBN " + *edition.ISBN13
+	}
+	return joinNonEmpty(", ", title, edition.Format, isbn)
+}
+
+// fit shortens text with an ellipsis so it is at most width wide.
+func fit(font pdf.Font, text string, width float64) string {
+	if font.Width(text, bodySize) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && font.Width(string(runes)+"...", bodySize) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return strings.TrimSpace(string(runes)) + "..."
+}
+
+func label(name, value string) string {
+	if value == "" {
+		return ""
+	}
+	return name + " " + value
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	kept := parts[:0]
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			kept = append(kept, part)
+		}
+	}
+	return strings.Join(kept, sep)
+}
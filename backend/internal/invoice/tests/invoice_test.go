@@ -0,0 +1,101 @@
+package invoice_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/invoice"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testOrder(items int) *models.Order {
+	order := &models.Order{
+		ID: uuid.New(), Currency: "RUB", TotalPrice: 90000, Discount: 10000, CouponCode: strPtr("SALE"), Tax: 8182,
+		Taxes:    []models.TaxLine{{Rate: 10, Net: 81818, Tax: 8182, Total: 90000}},
+		User:     &models.User{Username: "reader", Email: "reader@example.com"},
+		Delivery: &models.Delivery{Address: "Moscow, Tverskaya 1"},
+		Payment:  &models.Payment{Method: models.PaymentMethodCard, Status: "Paid"},
+	}
+	for i := 0; i < items; i++ {
+		order.Items = append(order.Items, &models.OrderItem{
+			Quantity: 1, Price: 100000, Discount: 10000, TaxRate: 10, Tax: 8182,
+			Edition: &models.Edition{ID: uuid.New(), Format: "hardcover", Book: &models.Book{Title: fmt.Sprintf("Book %d", i+1)}},
+		})
+	}
+	return order
+}
+
+func strPtr(s string) *string { return &s }
+
+func testInvoice() *models.Invoice {
+	return &models.Invoice{Year: 2026, Number: 7, Code: "2026-000007", PaymentStatus: "Paid", IssuedAt: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)}
+}
+
+func TestRender_IsDeterministic(t *testing.T) {
+	renderer := invoice.NewRenderer(invoice.Company{Name: "Bookstore LLC", INN: "7700000000"}, nil, nil)
+	order := testOrder(2)
+
+	first, err := renderer.Render(testInvoice(), order)
+	require.NoError(t, err)
+	second, err := renderer.Render(testInvoice(), order)
+	require.NoError(t, err)
+
+	assert.True(t, bytes.HasPrefix(first, []byte("%PDF-")))
+	assert.Equal(t, first, second)
+	assert.Contains(t, string(first), "/CreationDate (D:20260301090000Z)")
+	assert.Contains(t, string(first), "/Count 1")
+}
+
+func TestRender_LongOrderSpansPages(t *testing.T) {
+	renderer := invoice.NewRenderer(invoice.Company{Name: "Bookstore LLC"}, nil, nil)
+
+	out, err := renderer.Render(testInvoice(), testOrder(80))
+
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "/Count 2")
+}
+
+func TestRender_PrintsCyrillic(t *testing.T) {
+	renderer := invoice.NewRenderer(invoice.Company{Name: "ООО «Книжный»"}, nil, nil)
+	order := testOrder(1)
+	order.User.Username = "Иванов"
+	order.Items[0].Edition.Book.Title = "Мастер и Маргарита"
+
+	out, err := renderer.Render(testInvoice(), order)
+
+	require.NoError(t, err)
+	text := toUnicode(t, out)
+	for _, r := range "ООО Книжный Иванов Мастер и Маргарита" {
+		if r != ' ' {
+			assert.Contains(t, text, fmt.Sprintf("<%04X>", r), string(r))
+		}
+	}
+}
+
+// toUnicode joins the decompressed ToUnicode maps of the document's fonts.
+func toUnicode(t *testing.T, out []byte) string {
+	t.Helper()
+	var maps strings.Builder
+	streams := regexp.MustCompile(`<< /Length (\d+) /Filter /FlateDecode >>\nstream\n`).FindAllSubmatchIndex(out, -1)
+	for _, s := range streams {
+		length, _ := strconv.Atoi(string(out[s[2]:s[3]]))
+		z, err := zlib.NewReader(bytes.NewReader(out[s[1] : s[1]+length]))
+		require.NoError(t, err)
+		data, err := io.ReadAll(z)
+		require.NoError(t, err)
+		if bytes.Contains(data, []byte("/CMapName")) {
+			maps.Write(data)
+		}
+	}
+	return maps.String()
+}
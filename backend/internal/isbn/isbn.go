@@ -0,0 +1,91 @@
+// Package isbn validates and normalizes International Standard Book Numbers.
+package isbn
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid ISBN")
+
+// Normalize strips hyphens and spaces, validates the checksum of an ISBN-10
+// or ISBN-13 and returns the ISBN-13 form, which is the canonical one.
+func Normalize(raw string) (string, error) {
+	value := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(raw)))
+	switch len(value) {
+	case 10:
+		if !Valid10(value) {
+			return "", ErrInvalid
+		}
+		body := "978" + value[:9]
+		return body + string(checkDigit13(body)), nil
+	case 13:
+		if !Valid13(value) {
+			return "", ErrInvalid
+		}
+		return value, nil
+	default:
+		return "", ErrInvalid
+	}
+}
+
+// To10 converts an ISBN-13 to ISBN-10. Only numbers with the 978 prefix
+// have an ISBN-10 form.
+func To10(isbn13 string) (string, bool) {
+	if len(isbn13) != 13 || !strings.HasPrefix(isbn13, "978") || !Valid13(isbn13) {
+		return "", false
+	}
+	body := isbn13[3:12]
+	return body + string(checkDigit10(body)), true
+}
+
+func Valid10(value string) bool {
+	if len(value) != 10 || !allDigits(value[:9]) {
+		return false
+	}
+	last := value[9]
+	if last != 'X' && (last < '0' || last > '9') {
+		return false
+	}
+	return checkDigit10(value[:9]) == last
+}
+
+func Valid13(value string) bool {
+	if len(value) != 13 || !allDigits(value) {
+		return false
+	}
+	return checkDigit13(value[:12]) == value[12]
+}
+
+func checkDigit10(body string) byte {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(body[i]-'0') * (10 - i)
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return 'X'
+	}
+	return byte('0' + check)
+}
+
+func checkDigit13(body string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		digit := int(body[i] - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+func allDigits(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package isbn_test
+
+import (
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/isbn"
+	"github.com/stretchr/testify/assert"
+)
+
+// --- Normalize ---
+
+func TestNormalize_ISBN13WithHyphens(t *testing.T) {
+	result, err := isbn.Normalize("978-0-306-40615-7")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "9780306406157", result)
+}
+
+func TestNormalize_ISBN10ConvertedTo13(t *testing.T) {
+	result, err := isbn.Normalize("0-306-40615-2")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "9780306406157", result)
+}
+
+func TestNormalize_ISBN10WithXCheckDigit(t *testing.T) {
+	result, err := isbn.Normalize("0-8044-2957-x")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "9780804429573", result)
+}
+
+func TestNormalize_InvalidChecksum(t *testing.T) {
+	_, err := isbn.Normalize("978-0-306-40615-8")
+
+	assert.ErrorIs(t, err, isbn.ErrInvalid)
+}
+
+func TestNormalize_InvalidLength(t *testing.T) {
+	for _, raw := range []string{"", "12345", "97803064061570"} {
+		_, err := isbn.Normalize(raw)
+		assert.ErrorIs(t, err, isbn.ErrInvalid, raw)
+	}
+}
+
+func TestNormalize_LettersRejected(t *testing.T) {
+	_, err := isbn.Normalize("97803064O6157")
+
+	assert.ErrorIs(t, err, isbn.ErrInvalid)
+}
+
+// --- To10 ---
+
+func TestTo10(t *testing.T) {
+	result, ok := isbn.To10("9780804429573")
+
+	assert.True(t, ok)
+	assert.Equal(t, "080442957X", result)
+}
+
+func TestTo10_979PrefixHasNoISBN10(t *testing.T) {
+	_, ok := isbn.To10("9791034303434")
+
+	assert.False(t, ok)
+}
@@ -0,0 +1,44 @@
+// Package loyalty works out the points customers earn and what they are
+// worth when spent.
+package loyalty
+
+import (
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+)
+
+// PointValue is what one point is worth in the base currency: a ruble.
+const PointValue = money.Amount(money.MinorUnits)
+
+// Program sets how many points an order earns, in percent of its total,
+// and for how many months they can be spent.
+type Program struct {
+	EarnPercent int
+	ValidMonths int
+}
+
+var DefaultProgram = Program{EarnPercent: 5, ValidMonths: 12}
+
+// Earned returns the whole points earned by an order total in the currency
+// of rate.
+func (p Program) Earned(total money.Amount, rate money.Rate) (int, error) {
+	base, err := rate.ConvertBack(total)
+	if err != nil {
+		return 0, err
+	}
+	if base <= 0 || p.EarnPercent <= 0 {
+		return 0, nil
+	}
+	return int(base * money.Amount(p.EarnPercent) / 100 / PointValue), nil
+}
+
+// ExpiresAt returns when points credited at from expire.
+func (p Program) ExpiresAt(from time.Time) time.Time {
+	return from.AddDate(0, p.ValidMonths, 0)
+}
+
+// Value returns what points are worth in the currency of rate.
+func Value(points int, rate money.Rate) (money.Amount, error) {
+	return rate.Convert(PointValue.Mul(points))
+}
@@ -0,0 +1,57 @@
+package loyalty_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/loyalty"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEarned(t *testing.T) {
+	program := loyalty.Program{EarnPercent: 5, ValidMonths: 12}
+
+	tests := []struct {
+		name  string
+		total money.Amount
+		rate  money.Rate
+		want  int
+	}{
+		{"whole points are kept", 45050, money.One, 22},
+		{"less than a point", 1999, money.One, 0},
+		{"converted to rubles first", 1000, money.Rate(35_400), 14},
+		{"nothing for free orders", 0, money.One, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			earned, err := program.Earned(tt.total, tt.rate)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, earned)
+		})
+	}
+}
+
+func TestEarned_ProgramOff(t *testing.T) {
+	earned, err := loyalty.Program{}.Earned(100000, money.One)
+
+	assert.NoError(t, err)
+	assert.Zero(t, earned)
+}
+
+func TestValue(t *testing.T) {
+	value, err := loyalty.Value(100, money.One)
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(10000), value)
+
+	value, err = loyalty.Value(100, money.Rate(35_400))
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(354), value)
+}
+
+func TestExpiresAt(t *testing.T) {
+	from := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2027, 1, 15, 10, 0, 0, 0, time.UTC), loyalty.DefaultProgram.ExpiresAt(from))
+}
@@ -0,0 +1,81 @@
+// Package mergepatch implements JSON Merge Patch as described in RFC 7396.
+package mergepatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var ErrNotObject = errors.New("merge patch must be a JSON object")
+
+// Apply returns doc with patch merged into it. Members set to null in the
+// patch are removed, objects are merged recursively and any other value
+// replaces the original one.
+func Apply(doc []byte, patch []byte) ([]byte, error) {
+	var original any
+	if len(bytes.TrimSpace(doc)) > 0 {
+		if err := json.Unmarshal(doc, &original); err != nil {
+			return nil, fmt.Errorf("invalid document: %w", err)
+		}
+	}
+	var p any
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, fmt.Errorf("invalid merge patch: %w", err)
+	}
+	return json.Marshal(merge(original, p))
+}
+
+// ApplyTo applies the patch to the JSON representation of target and
+// decodes the result back into it. The patch has to be an object and may
+// only contain fields known to target.
+func ApplyTo(target any, patch []byte) error {
+	var p any
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return fmt.Errorf("invalid merge patch: %w", err)
+	}
+	if _, ok := p.(map[string]any); !ok {
+		return ErrNotObject
+	}
+
+	doc, err := json.Marshal(target)
+	if err != nil {
+		return err
+	}
+	merged, err := Apply(doc, patch)
+	if err != nil {
+		return err
+	}
+
+	// fields removed by the patch must end up as zero values
+	v := reflect.ValueOf(target).Elem()
+	v.Set(reflect.Zero(v.Type()))
+
+	decoder := json.NewDecoder(bytes.NewReader(merged))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		return fmt.Errorf("invalid merge patch: %w", err)
+	}
+	return nil
+}
+
+func merge(target any, patch any) any {
+	patchObj, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	targetObj, ok := target.(map[string]any)
+	if !ok {
+		targetObj = make(map[string]any)
+	}
+	for name, value := range patchObj {
+		if value == nil {
+			delete(targetObj, name)
+			continue
+		}
+		targetObj[name] = merge(targetObj[name], value)
+	}
+	return targetObj
+}
@@ -0,0 +1,80 @@
+package mergepatch_test
+
+import (
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/mergepatch"
+	"github.com/stretchr/testify/assert"
+)
+
+// --- Apply ---
+
+func TestApply_RFC7396Example(t *testing.T) {
+	doc := `{"title":"Goodbye!","author":{"givenName":"John","familyName":"Doe"},"tags":["example","sample"],"content":"This will be unchanged"}`
+	patch := `{"title":"Hello!","phoneNumber":"+01-123-456-7890","author":{"familyName":null},"tags":["example"]}`
+
+	result, err := mergepatch.Apply([]byte(doc), []byte(patch))
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"title":"Hello!","author":{"givenName":"John"},"tags":["example"],"content":"This will be unchanged","phoneNumber":"+01-123-456-7890"}`, string(result))
+}
+
+func TestApply_NonObjectPatchReplacesDocument(t *testing.T) {
+	result, err := mergepatch.Apply([]byte(`{"a":"b"}`), []byte(`["c"]`))
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `["c"]`, string(result))
+}
+
+func TestApply_InvalidPatch(t *testing.T) {
+	_, err := mergepatch.Apply([]byte(`{}`), []byte(`{invalid}`))
+
+	assert.Error(t, err)
+}
+
+// --- ApplyTo ---
+
+type sample struct {
+	Name  string  `json:"name"`
+	Note  *string `json:"note"`
+	Count int     `json:"count"`
+}
+
+func TestApplyTo_KeepsAbsentFields(t *testing.T) {
+	note := "keep me"
+	target := sample{Name: "old", Note: &note, Count: 3}
+
+	err := mergepatch.ApplyTo(&target, []byte(`{"name":"new"}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new", target.Name)
+	assert.Equal(t, &note, target.Note)
+	assert.Equal(t, 3, target.Count)
+}
+
+func TestApplyTo_NullClearsField(t *testing.T) {
+	note := "remove me"
+	target := sample{Name: "old", Note: &note, Count: 3}
+
+	err := mergepatch.ApplyTo(&target, []byte(`{"note":null}`))
+
+	assert.NoError(t, err)
+	assert.Nil(t, target.Note)
+	assert.Equal(t, "old", target.Name)
+}
+
+func TestApplyTo_RejectsUnknownFields(t *testing.T) {
+	target := sample{Name: "old"}
+
+	err := mergepatch.ApplyTo(&target, []byte(`{"unknown":1}`))
+
+	assert.Error(t, err)
+}
+
+func TestApplyTo_RejectsNonObject(t *testing.T) {
+	target := sample{Name: "old"}
+
+	err := mergepatch.ApplyTo(&target, []byte(`"name"`))
+
+	assert.ErrorIs(t, err, mergepatch.ErrNotObject)
+}
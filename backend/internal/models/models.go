@@ -1,8 +1,12 @@
 package models
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 )
@@ -39,8 +43,10 @@ type Author struct {
 	Name       	string    	`bun:"name,notnull"`
 	Patronymic 	string    	`bun:"patronymic,notnull"`
 	Info	   	*string		`bun:"info"`
+	Photo		*Image		`bun:"photo,type:jsonb"`
+	Version    	int64     	`bun:"version,notnull,default:1"`
 
-	Books 		[]*Book 	`bun:"rel:has-many,join:id=author_id"`
+	Books 		[]*Book 	`bun:"m2m:book_contributors,join:Author=Book"`
 }
 
 type Publisher struct {
@@ -49,40 +55,219 @@ type Publisher struct {
 	ID      uuid.UUID 	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
 	Name    string    	`bun:"name,unique,notnull"`
 	Address	string    	`bun:"address,notnull"`
+	Version	int64     	`bun:"version,notnull,default:1"`
 
-	Books 	[]*Book 	`bun:"rel:has-many,join:id=publisher_id"`
+	Editions	[]*Edition	`bun:"rel:has-many,join:id=publisher_id"`
 }
 
 type Category struct {
 	bun.BaseModel `bun:"table:categories"`
 
-	ID   	uuid.UUID 	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
-	Name 	string    	`bun:"name,notnull"`
+	ID   		uuid.UUID 	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Name 		string    	`bun:"name,notnull"`
+	Slug		string		`bun:"slug,unique,notnull"`
+	ParentID	*uuid.UUID	`bun:"parent_id,type:uuid"`
+	SortOrder	int			`bun:"sort_order,notnull,default:0"`
+	Version		int64     	`bun:"version,notnull,default:1"`
 
-	Books 	[]*Book 	`bun:"m2m:book_to_category,join:Category=Book"`
+	Parent		*Category	`bun:"rel:belongs-to,join:parent_id=id"`
+	Children	[]*Category	`bun:"rel:has-many,join:id=parent_id"`
+	Books 		[]*Book 	`bun:"m2m:book_to_category,join:Category=Book"`
 }
 
+// Book is a work: the metadata shared by all of its editions.
 type Book struct {
 	bun.BaseModel `bun:"table:books"`
 
 	ID          	uuid.UUID 		`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
 	Title       	string    		`bun:"title,notnull"`
 	Description 	*string
-	Price       	float64   		`bun:"price,notnull,default:0"`
-	Stock       	int       		`bun:"stock,notnull,default:0"`
-
-	AuthorID    	uuid.UUID 		`bun:"author_id,type:uuid,notnull"`
-	PublisherID 	uuid.UUID 		`bun:"publisher_id,type:uuid,notnull"`
+	SeriesID		*uuid.UUID		`bun:"series_id,type:uuid"`
+	SeriesPosition	*float64		`bun:"series_position"`
+	// Language is an ISO 639-1 code; it decides the book's VAT rate.
+	Language		string			`bun:"language,nullzero,notnull,default:'ru'"`
+	Cover			*Image			`bun:"cover,type:jsonb"`
+	// RatingAverage and RatingCount summarize the approved reviews; they are
+	// maintained by the review repository.
+	RatingAverage	float64			`bun:"rating_average,notnull,default:0"`
+	RatingCount		int				`bun:"rating_count,notnull,default:0"`
+	Version     	int64     		`bun:"version,notnull,default:1"`
 
 	CreatedAt 		time.Time 		`bun:"created_at,nullzero,notnull,default:current_timestamp"`
 	UpdatedAt 		time.Time 		`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
 
-	Author    		*Author     	`bun:"rel:belongs-to,join:author_id=id"`
-	Publisher 		*Publisher 		`bun:"rel:belongs-to,join:publisher_id=id"`
+	Contributors	[]*BookContributor	`bun:"rel:has-many,join:id=book_id"`
 	Categories 		[]*Category 	`bun:"m2m:book_to_category,join:Book=Category"`
+	Editions		[]*Edition		`bun:"rel:has-many,join:id=book_id"`
+}
+
+// Image is an uploaded picture stored as blobs: the original and resized
+// variants. Keys and URLs are indexed by variant name ("original", "large",
+// "medium", "small"). Keys are the blob store keys; they are saved with the
+// image but never sent to clients.
+type Image struct {
+	Width	int
+	Height	int
+	Keys	map[string]string	`json:"-"`
+	URLs	map[string]string
+}
+
+// storedImage is an Image as it is saved in a jsonb column, keys included.
+type storedImage struct {
+	Width	int
+	Height	int
+	Keys	map[string]string
+	URLs	map[string]string
+}
+
+func (i Image) Value() (driver.Value, error) {
+	data, err := json.Marshal(storedImage(i))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (i *Image) Scan(src any) error {
+	var stored storedImage
+	switch src := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		if err := json.Unmarshal(src, &stored); err != nil {
+			return err
+		}
+	case string:
+		if err := json.Unmarshal([]byte(src), &stored); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("cannot scan %T into Image", src)
+	}
+	*i = Image(stored)
+	return nil
+}
+
+// Series groups books that are meant to be read in order. A book's place in
+// the series is its SeriesPosition, which may be fractional (2.5 for a
+// novella between the second and third books).
+type Series struct {
+	bun.BaseModel `bun:"table:series"`
+
+	ID          	uuid.UUID 	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Name        	string    	`bun:"name,notnull"`
+	Description 	*string		`bun:"description"`
+	Version     	int64     	`bun:"version,notnull,default:1"`
+
+	CreatedAt 		time.Time 	`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt 		time.Time 	`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+
+	Books			[]*Book		`bun:"rel:has-many,join:id=series_id"`
+}
+
+const (
+	EditionFormatHardcover	= "hardcover"
+	EditionFormatPaperback	= "paperback"
+	EditionFormatEbook		= "ebook"
+	EditionFormatAudiobook	= "audiobook"
+)
+
+// Edition is a sellable form of a book with its own ISBN, price and stock.
+// Like all amounts of money, the price is stored in kopecks. Currency is
+// only set in catalog responses, where Price has been converted to the
+// customer's currency.
+type Edition struct {
+	bun.BaseModel `bun:"table:editions"`
+
+	ID				uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	BookID			uuid.UUID	`bun:"book_id,type:uuid,notnull"`
+	Format			string		`bun:"format,notnull"`
+	ISBN13			*string		`bun:"isbn13,unique"`
+	ISBN10			*string		`bun:"isbn10,unique"`
+	PublisherID		uuid.UUID	`bun:"publisher_id,type:uuid,notnull"`
+	PublicationYear	*int		`bun:"publication_year"`
+	PageCount		*int		`bun:"page_count"`
+	// Weight is the weight of one copy in grams, for shipping costs.
+	Weight			*int		`bun:"weight"`
+	Price			money.Amount	`bun:"price,notnull,default:0"`
+	// Stock is the number of copies in the active warehouses, kept up to
+	// date with their EditionStock.
+	Stock			int			`bun:"stock,notnull,default:0"`
+	Currency		string		`bun:"-" json:",omitempty"`
+	Version			int64		`bun:"version,notnull,default:1"`
+
+	CreatedAt		time.Time	`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt		time.Time	`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+
+	Book			*Book		`bun:"rel:belongs-to,join:book_id=id"`
+	Publisher		*Publisher	`bun:"rel:belongs-to,join:publisher_id=id"`
+
+	CartItems		[]*CartItem		`bun:"rel:has-many,join:id=edition_id"`
+	OrderItems		[]*OrderItem	`bun:"rel:has-many,join:id=edition_id"`
+}
+
+// Currency is a currency the store sells in. Amounts in every currency have
+// two decimal places.
+type Currency struct {
+	bun.BaseModel `bun:"table:currencies"`
+
+	ID			uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Code		string		`bun:"code,notnull,unique"`
+	Name		string		`bun:"name,notnull"`
 
-	CartItems  		[]*CartItem  	`bun:"rel:has-many,join:id=book_id"`
-	OrderItems 		[]*OrderItem 	`bun:"rel:has-many,join:id=book_id"`
+	CreatedAt	time.Time	`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+const (
+	ExchangeRateSourceManual	= "manual"
+	ExchangeRateSourceImport	= "import"
+)
+
+// ExchangeRate is how many units of Currency one unit of the base currency
+// buys from ValidFrom until the next rate of the currency takes over.
+type ExchangeRate struct {
+	bun.BaseModel `bun:"table:exchange_rates"`
+
+	ID			uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Currency	string		`bun:"currency,notnull,unique:exchange_rates_currency_valid_from_key"`
+	Rate		money.Rate	`bun:"rate,type:numeric(18,6),notnull"`
+	ValidFrom	time.Time	`bun:"valid_from,notnull,unique:exchange_rates_currency_valid_from_key"`
+	Source		string		`bun:"source,notnull,default:'manual'"`
+
+	CreatedAt	time.Time	`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// EditionPrice sets the price of an edition in a currency by hand instead of
+// converting the base price.
+type EditionPrice struct {
+	bun.BaseModel `bun:"table:edition_prices"`
+
+	EditionID	uuid.UUID		`bun:"edition_id,pk,type:uuid"`
+	Currency	string			`bun:"currency,pk"`
+	Price		money.Amount	`bun:"price,notnull"`
+
+	UpdatedAt	time.Time		`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+const (
+	ContributorRoleAuthor		= "author"
+	ContributorRoleTranslator	= "translator"
+	ContributorRoleEditor		= "editor"
+	ContributorRoleIllustrator	= "illustrator"
+)
+
+// BookContributor links a book to a person in some role. Position orders
+// the contributors of a book, e.g. the co-authors on the cover.
+type BookContributor struct {
+	bun.BaseModel `bun:"table:book_contributors"`
+
+	BookID		uuid.UUID	`bun:"book_id,pk,type:uuid"`
+	AuthorID	uuid.UUID	`bun:"author_id,pk,type:uuid"`
+	Role		string		`bun:"role,pk"`
+	Position	int			`bun:"position,notnull,default:0"`
+
+	Book		*Book		`bun:"rel:belongs-to,join:book_id=id"`
+	Author		*Author		`bun:"rel:belongs-to,join:author_id=id"`
 }
 
 type BookToCategory struct {
@@ -95,6 +280,205 @@ type BookToCategory struct {
 	Category 	*Category 	`bun:"rel:belongs-to,join:category_id=id"`
 }
 
+// RelatedBook is a precomputed "customers also bought" pair: CoPurchases
+// counts the orders that contain both books. Books with few co-purchases are
+// topped up with pairs scored by Affinity, the authors (2 points each) and
+// categories (1 point each) they share.
+type RelatedBook struct {
+	bun.BaseModel `bun:"table:related_books"`
+
+	BookID			uuid.UUID	`bun:"book_id,pk,type:uuid"`
+	RelatedBookID	uuid.UUID	`bun:"related_book_id,pk,type:uuid"`
+	CoPurchases		int			`bun:"co_purchases,notnull,default:0"`
+	Affinity		int			`bun:"affinity,notnull,default:0"`
+
+	Book			*Book		`bun:"rel:belongs-to,join:book_id=id"`
+	RelatedBook		*Book		`bun:"rel:belongs-to,join:related_book_id=id"`
+}
+
+const (
+	ReviewStatusPending		= "pending"
+	ReviewStatusApproved	= "approved"
+	ReviewStatusRejected	= "rejected"
+	ReviewStatusHidden		= "hidden"
+)
+
+// Review is a customer's rating of a book. Only approved reviews are public
+// and count towards the book's rating.
+type Review struct {
+	bun.BaseModel `bun:"table:reviews"`
+
+	ID					uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	BookID				uuid.UUID	`bun:"book_id,type:uuid,notnull,unique:reviews_book_id_user_id_key"`
+	UserID				uuid.UUID	`bun:"user_id,type:uuid,notnull,unique:reviews_book_id_user_id_key"`
+	Rating				int			`bun:"rating,notnull"`
+	Text				string		`bun:"text,notnull,default:''"`
+	Status				string		`bun:"status,notnull,default:'pending'"`
+	ModerationReason	*string		`bun:"moderation_reason"`
+	ModeratedBy			*uuid.UUID	`bun:"moderated_by,type:uuid"`
+	ModeratedAt			*time.Time	`bun:"moderated_at"`
+	Version				int64		`bun:"version,notnull,default:1"`
+
+	CreatedAt			time.Time	`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt			time.Time	`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+
+	// Username is the reviewer's public name, filled in by list queries.
+	Username			string		`bun:"username,scanonly"`
+
+	Book				*Book		`bun:"rel:belongs-to,join:book_id=id"`
+}
+
+// Wishlist is a named list of editions a customer wants to buy later. A
+// list with a share token can be viewed by anyone who knows the token.
+type Wishlist struct {
+	bun.BaseModel `bun:"table:wishlists"`
+
+	ID			uuid.UUID		`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	UserID		uuid.UUID		`bun:"user_id,type:uuid,notnull,unique:wishlists_user_id_name_key"`
+	Name		string			`bun:"name,notnull,unique:wishlists_user_id_name_key"`
+	ShareToken	*string			`bun:"share_token,unique"`
+	Version		int64			`bun:"version,notnull,default:1"`
+
+	CreatedAt	time.Time		`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt	time.Time		`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+
+	Items		[]*WishlistItem	`bun:"rel:has-many,join:id=wishlist_id"`
+}
+
+type WishlistItem struct {
+	bun.BaseModel `bun:"table:wishlist_items"`
+
+	ID			uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	WishlistID	uuid.UUID	`bun:"wishlist_id,type:uuid,notnull,unique:wishlist_items_wishlist_id_edition_id_key"`
+	EditionID	uuid.UUID	`bun:"edition_id,type:uuid,notnull,unique:wishlist_items_wishlist_id_edition_id_key"`
+	AddedAt		time.Time	`bun:"added_at,nullzero,notnull,default:current_timestamp"`
+
+	Wishlist	*Wishlist	`bun:"rel:belongs-to,join:wishlist_id=id"`
+	Edition		*Edition	`bun:"rel:belongs-to,join:edition_id=id"`
+}
+
+const (
+	SubscriptionBackInStock	= "back_in_stock"
+	SubscriptionPriceBelow	= "price_below"
+)
+
+// StockSubscription asks for an alert when a book comes back in stock or
+// its price falls to the threshold. A subscription fires once and is armed
+// again only after the condition stops holding, so a flapping stock level
+// sends one alert rather than many.
+type StockSubscription struct {
+	bun.BaseModel `bun:"table:stock_subscriptions"`
+
+	ID					uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	UserID				uuid.UUID	`bun:"user_id,type:uuid,notnull,unique:stock_subscriptions_user_id_book_id_kind_key"`
+	BookID				uuid.UUID	`bun:"book_id,type:uuid,notnull,unique:stock_subscriptions_user_id_book_id_kind_key"`
+	Kind				string		`bun:"kind,notnull,unique:stock_subscriptions_user_id_book_id_kind_key"`
+	Threshold			*money.Amount	`bun:"threshold"`
+	UnsubscribeToken	string		`bun:"unsubscribe_token,notnull,unique"`
+	Armed				bool		`bun:"armed,notnull,default:true"`
+	NotifiedAt			*time.Time	`bun:"notified_at"`
+
+	CreatedAt			time.Time	`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+
+	Book				*Book		`bun:"rel:belongs-to,join:book_id=id"`
+}
+
+const (
+	OutboxStatusPending	= "pending"
+	OutboxStatusSent	= "sent"
+	OutboxStatusFailed	= "failed"
+)
+
+// OutboxMessage is a notification waiting to be delivered. Messages are
+// written after the change that caused them has been committed, so a crash
+// in between loses the notification, and are sent later by the outbox
+// dispatcher. DedupKey is unique, so a notification enqueued twice is
+// stored once.
+type OutboxMessage struct {
+	bun.BaseModel `bun:"table:notification_outbox"`
+
+	ID			uuid.UUID		`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	UserID		uuid.UUID		`bun:"user_id,type:uuid,notnull"`
+	Kind		string			`bun:"kind,notnull"`
+	Payload		json.RawMessage	`bun:"payload,type:jsonb,notnull"`
+	DedupKey	string			`bun:"dedup_key,notnull,unique"`
+	Status		string			`bun:"status,notnull,default:'pending'"`
+	Attempts	int				`bun:"attempts,notnull,default:0"`
+	LastError	*string			`bun:"last_error"`
+	AvailableAt	time.Time		`bun:"available_at,nullzero,notnull,default:current_timestamp"`
+	SentAt		*time.Time		`bun:"sent_at"`
+
+	CreatedAt	time.Time		`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+const (
+	PromotionKindPercent	= "percent"
+	PromotionKindFixed		= "fixed"
+)
+
+const (
+	PromotionScopeAll		= "all"
+	PromotionScopeBook		= "book"
+	PromotionScopeCategory	= "category"
+	PromotionScopeAuthor	= "author"
+	PromotionScopePublisher	= "publisher"
+)
+
+// Promotion is a discount on the editions in its scope while it runs: a
+// percent promotion takes Percent off their price, a fixed one Amount off
+// every copy. TargetIDs are the books, categories (with their
+// subcategories), authors or publishers of the scope. A promotion applies
+// only to carts worth at least MinOrderTotal, and one with a CouponCode only
+// when the customer enters the code. An exclusive promotion is never
+// combined with others on the same line; the customer gets whichever is
+// better.
+type Promotion struct {
+	bun.BaseModel `bun:"table:promotions"`
+
+	ID				uuid.UUID		`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Name			string			`bun:"name,notnull"`
+	Kind			string			`bun:"kind,notnull"`
+	Percent			int				`bun:"percent,notnull,default:0"`
+	Amount			money.Amount	`bun:"amount,notnull,default:0"`
+	Scope			string			`bun:"scope,notnull,default:'all'"`
+	TargetIDs		[]uuid.UUID		`bun:"target_ids,type:jsonb,notnull,default:'[]'"`
+	StartsAt		time.Time		`bun:"starts_at,notnull"`
+	EndsAt			*time.Time		`bun:"ends_at"`
+	MinOrderTotal	money.Amount	`bun:"min_order_total,notnull,default:0"`
+	CouponCode		*string			`bun:"coupon_code,unique"`
+	UsageLimit		*int			`bun:"usage_limit"`
+	PerUserLimit	*int			`bun:"per_user_limit"`
+	Exclusive		bool			`bun:"exclusive,notnull,default:false"`
+	Active			bool			`bun:"active,notnull,default:true"`
+	Version			int64			`bun:"version,notnull,default:1"`
+
+	CreatedAt		time.Time		`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt		time.Time		`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// CouponRedemption records an order placed with a coupon; the usage limits
+// of the coupon count them.
+type CouponRedemption struct {
+	bun.BaseModel `bun:"table:coupon_redemptions"`
+
+	ID			uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	PromotionID	uuid.UUID	`bun:"promotion_id,type:uuid,notnull"`
+	UserID		uuid.UUID	`bun:"user_id,type:uuid,notnull"`
+	OrderID		uuid.UUID	`bun:"order_id,type:uuid,notnull,unique"`
+
+	CreatedAt	time.Time	`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// AppliedPromotion is what a promotion took off a cart line. Order items
+// keep them as they were at checkout, so later changes to the promotion do
+// not rewrite past orders.
+type AppliedPromotion struct {
+	PromotionID	uuid.UUID
+	Name		string
+	CouponCode	*string		`json:",omitempty"`
+	Discount	money.Amount
+}
+
 type Cart struct {
 	bun.BaseModel `bun:"table:carts"`
 
@@ -110,19 +494,60 @@ type CartItem struct {
 
 	ID       	uuid.UUID 	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
 	CartID   	uuid.UUID 	`bun:"cart_id,type:uuid,notnull"`
-	BookID   	uuid.UUID 	`bun:"book_id,type:uuid,notnull"`
+	EditionID	uuid.UUID 	`bun:"edition_id,type:uuid,notnull"`
 	Quantity 	int       	`bun:"quantity,notnull,default:1"`
 
 	Cart 		*Cart 		`bun:"rel:belongs-to,join:cart_id=id"`
-	Book 		*Book 		`bun:"rel:belongs-to,join:book_id=id"`
+	Edition		*Edition	`bun:"rel:belongs-to,join:edition_id=id"`
 }
 
+const (
+	OrderStatusNew			= "New"
+	OrderStatusReadyForPickup	= "ReadyForPickup"
+	OrderStatusDelivered	= "Delivered"
+	OrderStatusReturned		= "Returned"
+	DeliveryStatusReadyForPickup	= "ReadyForPickup"
+	DeliveryStatusDelivered	= "Delivered"
+)
+
+const (
+	PaymentMethodCard	= "Card"
+	PaymentMethodCash	= "Cash"
+	PaymentStatusNotPaid	= "Not paid"
+	PaymentStatusPaid	= "Paid"
+)
+
+// Order is a placed cart. Its amounts are in Currency; Discount is the sum
+// of the promotions on its items and TotalPrice what is left to pay,
+// shipping included.
 type Order struct {
 	bun.BaseModel `bun:"table:orders"`
 
 	ID         	uuid.UUID 		`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
 	UserID     	uuid.UUID 		`bun:"user_id,type:uuid,notnull"`
-	TotalPrice 	float64   		`bun:"total_price,notnull,default:0"`
+	TotalPrice 	money.Amount	`bun:"total_price,notnull,default:0"`
+	Discount	money.Amount	`bun:"discount,notnull,default:0"`
+	CouponCode	*string			`bun:"coupon_code"`
+	// Tax is the VAT included in TotalPrice, broken down by rate in Taxes.
+	Tax			money.Amount	`bun:"tax,notnull,default:0"`
+	Taxes		[]TaxLine		`bun:"taxes,type:jsonb,notnull,default:'[]'"`
+	// Currency and ExchangeRate are fixed at checkout, so totals stay
+	// correct when rates change later.
+	Currency	string			`bun:"currency,notnull,default:'RUB'"`
+	ExchangeRate	money.Rate	`bun:"exchange_rate,type:numeric(18,6),notnull,default:1"`
+	// PointsValue is the part of TotalPrice paid with PointsRedeemed
+	// loyalty points; the payment covers the rest.
+	PointsRedeemed	int			`bun:"points_redeemed,notnull,default:0"`
+	PointsValue		money.Amount	`bun:"points_value,notnull,default:0"`
+	// GiftCardValue is the part paid with gift cards and store credit.
+	GiftCardValue	money.Amount	`bun:"gift_card_value,notnull,default:0"`
+	// GiftCardsBought is the part of TotalPrice for the GiftCards bought
+	// with the order.
+	GiftCardsBought	money.Amount	`bun:"gift_cards_bought,notnull,default:0"`
+	// ShippingCost is the part of TotalPrice charged for delivery by the
+	// ShippingMethod.
+	ShippingMethodID	*uuid.UUID	`bun:"shipping_method_id,type:uuid"`
+	ShippingCost	money.Amount	`bun:"shipping_cost,notnull,default:0"`
 	Status     	string    		`bun:"status,notnull,default:'New'"`
 
 	CreatedAt 	time.Time 		`bun:"created_at,nullzero,notnull,default:current_timestamp"`
@@ -132,18 +557,38 @@ type Order struct {
 	Items     	[]*OrderItem 	`bun:"rel:has-many,join:id=order_id"`
 	Payment  	*Payment     	`bun:"rel:has-one,join:id=order_id"`
 	Delivery  	*Delivery    	`bun:"rel:has-one,join:id=order_id"`
+	GiftCardTransactions	[]*GiftCardTransaction	`bun:"rel:has-many,join:id=order_id"`
+	GiftCards	[]*GiftCard		`bun:"rel:has-many,join:id=order_id"`
 }
 
+// OrderItem is an edition as it was sold: Price is the price of one copy
+// and Discount what the Promotions took off the whole line at checkout.
+// Prices include VAT; Tax is the VAT of the discounted line at TaxRate.
 type OrderItem struct {
 	bun.BaseModel `bun:"table:order_items"`
 
 	ID       	uuid.UUID 	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
 	OrderID  	uuid.UUID 	`bun:"order_id,type:uuid,notnull"`
-	BookID   	uuid.UUID 	`bun:"book_id,type:uuid,notnull"`
+	EditionID	uuid.UUID 	`bun:"edition_id,type:uuid,notnull"`
 	Quantity 	int       	`bun:"quantity,notnull,default:1"`
+	Price		money.Amount	`bun:"price,notnull,default:0"`
+	Discount	money.Amount	`bun:"discount,notnull,default:0"`
+	Promotions	[]AppliedPromotion	`bun:"promotions,type:jsonb,notnull,default:'[]'"`
+	TaxRate		int				`bun:"tax_rate,notnull,default:0"`
+	Tax			money.Amount	`bun:"tax,notnull,default:0"`
 
 	Order 		*Order 		`bun:"rel:belongs-to,join:order_id=id"`
-	Book  		*Book  		`bun:"rel:belongs-to,join:book_id=id"`
+	Edition		*Edition	`bun:"rel:belongs-to,join:edition_id=id"`
+	Allocations	[]*StockAllocation	`bun:"rel:has-many,join:id=order_item_id"`
+}
+
+// TaxLine is the part of an order taxed at one VAT rate (in percent):
+// Total includes the Tax, Net is what is left without it.
+type TaxLine struct {
+	Rate	int
+	Net		money.Amount
+	Tax		money.Amount
+	Total	money.Amount
 }
 
 type Payment struct {
@@ -151,7 +596,7 @@ type Payment struct {
 
 	ID      	uuid.UUID 	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
 	OrderID 	uuid.UUID 	`bun:"order_id,type:uuid,notnull,unique"`
-	Amount  	float64   	`bun:"amount,notnull"`
+	Amount  	money.Amount	`bun:"amount,notnull"`
 	Method  	string    	`bun:"method,notnull"`
 	Status  	string    	`bun:"status,notnull,default:'Not paid'"`
 
@@ -161,11 +606,28 @@ type Payment struct {
 	Order 		*Order 		`bun:"rel:belongs-to,join:order_id=id"`
 }
 
+// Delivery is how an order reaches the customer: Method is the name of the
+// shipping method at checkout, Weight the parcel's in grams and Cost what
+// was charged for it, in the order's currency. Orders collected at one of
+// our shops have a PickupPointID instead of a shipping method, with the
+// shop's name and address. Once the order is ready for pickup, the customer
+// tells the shop its code, which is worked out from PickupCodeSalt, see
+// package pickup; PickupAttempts counts the codes tried. PickupCode is not
+// stored and only set in the customer's own view of the order.
 type Delivery struct {
 	bun.BaseModel `bun:"table:deliveries"`
 
 	OrderID 	uuid.UUID 	`bun:"order_id,pk,type:uuid"`
 	Address 	string    	`bun:"address,notnull"`
+	PostalCode	string		`bun:"postal_code,notnull,default:''"`
+	ShippingMethodID	*uuid.UUID	`bun:"shipping_method_id,type:uuid"`
+	PickupPointID	*uuid.UUID	`bun:"pickup_point_id,type:uuid"`
+	PickupCodeSalt	*string	`bun:"pickup_code_salt" json:"-"`
+	PickupAttempts	int		`bun:"pickup_attempts,notnull,default:0"`
+	PickupCode	*string		`bun:"-" json:",omitempty"`
+	Method		string		`bun:"method,notnull,default:''"`
+	Weight		int			`bun:"weight,notnull,default:0"`
+	Cost		money.Amount	`bun:"cost,notnull,default:0"`
 	Status  	string    	`bun:"status,notnull,default:'Waiting'"`
 
 	CreatedAt 	time.Time 	`bun:"created_at,nullzero,notnull,default:current_timestamp"`
@@ -173,3 +635,271 @@ type Delivery struct {
 
 	Order 		*Order 		`bun:"rel:belongs-to,join:order_id=id"`
 }
+
+const (
+	ShippingKindCourier		= "courier"
+	ShippingKindPickupPoint	= "pickup_point"
+	ShippingKindPost		= "post"
+)
+
+// ShippingZone is a part of the country, made of the postal codes starting
+// with one of its PostalPrefixes.
+type ShippingZone struct {
+	bun.BaseModel `bun:"table:shipping_zones"`
+
+	ID				uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Name			string		`bun:"name,notnull"`
+	PostalPrefixes	[]string	`bun:"postal_prefixes,type:jsonb,notnull,default:'[]'"`
+	Version			int64		`bun:"version,notnull,default:1"`
+
+	CreatedAt		time.Time	`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt		time.Time	`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// ShippingMethod is a way of delivering orders. Its Rates set the cost by
+// zone and weight in the base currency; orders worth at least FreeOver
+// ship for free. A method is offered where one of its rates applies.
+type ShippingMethod struct {
+	bun.BaseModel `bun:"table:shipping_methods"`
+
+	ID			uuid.UUID		`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Name		string			`bun:"name,notnull"`
+	Kind		string			`bun:"kind,notnull"`
+	Rates		[]ShippingRate	`bun:"rates,type:jsonb,notnull,default:'[]'"`
+	FreeOver	*money.Amount	`bun:"free_over"`
+	Active		bool			`bun:"active,notnull,default:true"`
+	Version		int64			`bun:"version,notnull,default:1"`
+
+	CreatedAt	time.Time		`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt	time.Time		`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// ShippingRate is the Cost of parcels up to MaxWeight grams sent to the
+// zone. A rate without a ZoneID applies to zones the method has no rates
+// for, and one without a MaxWeight to parcels of any weight.
+type ShippingRate struct {
+	ZoneID		*uuid.UUID		`json:",omitempty"`
+	MaxWeight	*int			`json:",omitempty"`
+	Cost		money.Amount
+}
+
+// PickupPoint is one of our shops where customers collect their orders.
+// Hours is free text such as "Mon–Fri 10:00–21:00"; Latitude and Longitude
+// are in degrees.
+type PickupPoint struct {
+	bun.BaseModel `bun:"table:pickup_points"`
+
+	ID			uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Name		string		`bun:"name,notnull"`
+	Address		string		`bun:"address,notnull"`
+	PostalCode	string		`bun:"postal_code,notnull,default:''"`
+	Hours		string		`bun:"hours,notnull,default:''"`
+	Latitude	float64		`bun:"latitude,notnull"`
+	Longitude	float64		`bun:"longitude,notnull"`
+	Active		bool		`bun:"active,notnull,default:true"`
+	Version		int64		`bun:"version,notnull,default:1"`
+
+	CreatedAt	time.Time	`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt	time.Time	`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// Warehouse is a place stock is kept: the central warehouse or a shop,
+// whose pickup point is then PickupPointID. Orders are shipped from active
+// warehouses, those with the lowest Priority first.
+type Warehouse struct {
+	bun.BaseModel `bun:"table:warehouses"`
+
+	ID				uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Name			string		`bun:"name,notnull"`
+	Address			string		`bun:"address,notnull,default:''"`
+	PickupPointID	*uuid.UUID	`bun:"pickup_point_id,type:uuid"`
+	Priority		int			`bun:"priority,notnull,default:0"`
+	Active			bool		`bun:"active,notnull,default:true"`
+	Version			int64		`bun:"version,notnull,default:1"`
+
+	CreatedAt		time.Time	`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt		time.Time	`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// EditionStock is how many copies of an edition a warehouse has.
+type EditionStock struct {
+	bun.BaseModel `bun:"table:edition_stocks"`
+
+	EditionID	uuid.UUID	`bun:"edition_id,pk,type:uuid"`
+	WarehouseID	uuid.UUID	`bun:"warehouse_id,pk,type:uuid"`
+	Quantity	int			`bun:"quantity,notnull,default:0"`
+
+	UpdatedAt	time.Time	`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+
+	Edition		*Edition	`bun:"rel:belongs-to,join:edition_id=id"`
+	Warehouse	*Warehouse	`bun:"rel:belongs-to,join:warehouse_id=id"`
+}
+
+// StockAllocation is the part of an order item shipped from a warehouse,
+// decided at checkout.
+type StockAllocation struct {
+	bun.BaseModel `bun:"table:stock_allocations"`
+
+	ID			uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	OrderItemID	uuid.UUID	`bun:"order_item_id,type:uuid,notnull"`
+	WarehouseID	uuid.UUID	`bun:"warehouse_id,type:uuid,notnull"`
+	Quantity	int			`bun:"quantity,notnull"`
+}
+
+// StockTransfer moves copies of editions from one warehouse to another.
+type StockTransfer struct {
+	bun.BaseModel `bun:"table:stock_transfers"`
+
+	ID				uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	FromWarehouseID	uuid.UUID	`bun:"from_warehouse_id,type:uuid,notnull"`
+	ToWarehouseID	uuid.UUID	`bun:"to_warehouse_id,type:uuid,notnull"`
+	Items			[]StockTransferItem	`bun:"items,type:jsonb,notnull,default:'[]'"`
+	Note			*string		`bun:"note"`
+
+	CreatedAt		time.Time	`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// StockTransferItem is the number of copies of an edition transferred.
+type StockTransferItem struct {
+	EditionID	uuid.UUID
+	Quantity	int
+}
+
+// Invoice is the document issued for an order. Number runs from 1 within
+// Year without gaps; Code is the printed form, e.g. "2026-000042". Snapshot
+// is the order with its buyer and items as they were when the invoice was
+// issued, so later renders print the same data. The PDF is kept in the
+// document store under Key, which is empty until the first one is stored,
+// and only rendered again when the payment status it shows changes.
+type Invoice struct {
+	bun.BaseModel `bun:"table:invoices"`
+
+	ID				uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	OrderID			uuid.UUID	`bun:"order_id,type:uuid,notnull,unique"`
+	Year			int			`bun:"year,notnull,unique:invoices_year_number_key"`
+	Number			int			`bun:"number,notnull,unique:invoices_year_number_key"`
+	Code			string		`bun:"code,notnull,unique"`
+	PaymentStatus	string		`bun:"payment_status,notnull"`
+	Key				string		`bun:"key,notnull"`
+	Size			int			`bun:"size,notnull"`
+	Snapshot		*Order		`bun:"order_snapshot,type:jsonb"`
+
+	IssuedAt		time.Time	`bun:"issued_at,notnull"`
+	UpdatedAt		time.Time	`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+
+	Order			*Order		`bun:"rel:belongs-to,join:order_id=id"`
+}
+
+// InvoiceCounter holds the last invoice number given out in a year.
+type InvoiceCounter struct {
+	bun.BaseModel `bun:"table:invoice_counters"`
+
+	Year		int		`bun:"year,pk"`
+	LastNumber	int		`bun:"last_number,notnull"`
+}
+
+const (
+	LoyaltyKindEarn		= "earn"
+	LoyaltyKindReverse	= "reverse"
+	LoyaltyKindRedeem	= "redeem"
+	LoyaltyKindRefund	= "refund"
+	LoyaltyKindExpire	= "expire"
+	LoyaltyKindAdjust	= "adjust"
+)
+
+// LoyaltyTransaction is an entry of a customer's points ledger. Credits
+// have positive Points and expire at ExpiresAt; Remaining is what is left
+// of them after spending, which takes the credits expiring first. Debits
+// have negative Points. CreatedBy is the admin who made an adjustment.
+type LoyaltyTransaction struct {
+	bun.BaseModel `bun:"table:loyalty_transactions"`
+
+	ID			uuid.UUID	`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	UserID		uuid.UUID	`bun:"user_id,type:uuid,notnull"`
+	Kind		string		`bun:"kind,notnull"`
+	Points		int			`bun:"points,notnull"`
+	Remaining	int			`bun:"remaining,notnull,default:0" json:"-"`
+	ExpiresAt	*time.Time	`bun:"expires_at"`
+	OrderID		*uuid.UUID	`bun:"order_id,type:uuid"`
+	Reason		*string		`bun:"reason"`
+	CreatedBy	*uuid.UUID	`bun:"created_by,type:uuid"`
+
+	CreatedAt	time.Time	`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+const (
+	GiftCardKindGiftCard	= "gift_card"
+	GiftCardKindStoreCredit	= "store_credit"
+)
+
+const (
+	GiftCardTransactionIssue	= "issue"
+	GiftCardTransactionRedeem	= "redeem"
+	GiftCardTransactionRefund	= "refund"
+	GiftCardTransactionExpire	= "expire"
+	GiftCardTransactionCancel	= "cancel"
+)
+
+// GiftCard holds money in the base currency to pay for orders until
+// ExpiresAt. A gift card is spent by whoever has its code, of which only
+// the hash and the last digits are kept; store credit has no code and
+// belongs to its Owner. PurchasedBy is the customer who bought a gift
+// card with the Order, which funds it once it is paid; IssuedBy is the
+// employee who issued a card or credit. Code is not stored and only set
+// in the response that creates the card.
+type GiftCard struct {
+	bun.BaseModel `bun:"table:gift_cards"`
+
+	ID				uuid.UUID		`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Kind			string			`bun:"kind,notnull"`
+	CodeHash		*string			`bun:"code_hash,unique" json:"-"`
+	LastDigits		*string			`bun:"last_digits"`
+	InitialAmount	money.Amount	`bun:"initial_amount,notnull"`
+	Balance			money.Amount	`bun:"balance,notnull"`
+	ExpiresAt		time.Time		`bun:"expires_at,notnull"`
+	OwnerID			*uuid.UUID		`bun:"owner_id,type:uuid"`
+	PurchasedBy		*uuid.UUID		`bun:"purchased_by,type:uuid"`
+	OrderID			*uuid.UUID		`bun:"order_id,type:uuid"`
+	IssuedBy		*uuid.UUID		`bun:"issued_by,type:uuid"`
+	Reason			*string			`bun:"reason"`
+	Code			string			`bun:"-" json:",omitempty"`
+
+	CreatedAt		time.Time		`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt		time.Time		`bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+
+	Transactions	[]*GiftCardTransaction	`bun:"rel:has-many,join:id=gift_card_id" json:",omitempty"`
+}
+
+// GiftCardTransaction is an entry of a card's ledger: Amount is added to
+// the balance, so redemptions and expiry are negative.
+type GiftCardTransaction struct {
+	bun.BaseModel `bun:"table:gift_card_transactions"`
+
+	ID			uuid.UUID		`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	GiftCardID	uuid.UUID		`bun:"gift_card_id,type:uuid,notnull"`
+	Kind		string			`bun:"kind,notnull"`
+	Amount		money.Amount	`bun:"amount,notnull"`
+	OrderID		*uuid.UUID		`bun:"order_id,type:uuid"`
+
+	CreatedAt	time.Time		`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+
+	// GiftCard is set on the first entry of a card that is yet to be
+	// issued.
+	GiftCard	*GiftCard		`bun:"rel:belongs-to,join:gift_card_id=id" json:",omitempty"`
+}
+
+type AuditLog struct {
+	bun.BaseModel `bun:"table:audit_logs"`
+
+	ID         	uuid.UUID 			`bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	ActorID    	*uuid.UUID 			`bun:"actor_id,type:uuid"`
+	ActorRole  	string    			`bun:"actor_role,notnull,default:''"`
+	Action     	string    			`bun:"action,notnull"`
+	EntityType 	string    			`bun:"entity_type,notnull"`
+	EntityID   	uuid.UUID 			`bun:"entity_id,type:uuid,notnull"`
+	Changes    	json.RawMessage 	`bun:"changes,type:jsonb"`
+	IP         	string    			`bun:"ip,notnull,default:''"`
+	RequestID  	string    			`bun:"request_id,notnull,default:''"`
+
+	CreatedAt 	time.Time 			`bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
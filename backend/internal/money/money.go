@@ -0,0 +1,115 @@
+// Package money represents amounts of money exactly, as whole minor units
+// (kopecks), so sums and refunds never drift the way float64 prices do.
+package money
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MinorUnits is the number of minor units in a major unit.
+const MinorUnits = 100
+
+var (
+	ErrInvalid   = errors.New("invalid amount")
+	ErrPrecision = errors.New("amount has more than two decimal places")
+	ErrOverflow  = errors.New("amount is out of range")
+)
+
+// Amount is a sum of money in minor units. In JSON it is a decimal number
+// with two decimal places, e.g. 1200.50; in the database a bigint.
+type Amount int64
+
+// Parse reads a decimal amount such as "1200", "1200.5" or "-0.05". Amounts
+// with more than two decimal places are rejected rather than rounded.
+func Parse(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	if negative || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	whole, fraction, point := strings.Cut(s, ".")
+	if whole == "" || (point && fraction == "") || !allDigits(whole) || !allDigits(fraction) {
+		return 0, ErrInvalid
+	}
+	if len(fraction) > 2 {
+		return 0, ErrPrecision
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || major > (math.MaxInt64-99)/MinorUnits {
+		return 0, ErrOverflow
+	}
+	minor, _ := strconv.ParseInt(fraction, 10, 64)
+	amount := Amount(major*MinorUnits + minor)
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+// Mul returns the amount times n, e.g. a line total for n copies.
+func (a Amount) Mul(n int) Amount {
+	return a * Amount(n)
+}
+
+// String formats the amount with two decimal places.
+func (a Amount) String() string {
+	sign := ""
+	value := int64(a)
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+	minor := strconv.FormatInt(value%MinorUnits, 10)
+	if len(minor) < 2 {
+		minor = "0" + minor
+	}
+	return sign + strconv.FormatInt(value/MinorUnits, 10) + "." + minor
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON accepts a JSON number or a string holding one.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) >= 2 && data[0] == '"' {
+		unquoted, err := strconv.Unquote(string(data))
+		if err != nil {
+			return ErrInvalid
+		}
+		data = []byte(unquoted)
+	}
+	return a.UnmarshalText(data)
+}
+
+func (a *Amount) UnmarshalText(text []byte) error {
+	amount, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+// UnmarshalParam lets gin bind amounts from query parameters.
+func (a *Amount) UnmarshalParam(param string) error {
+	return a.UnmarshalText([]byte(param))
+}
+
+func allDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
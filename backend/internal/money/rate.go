@@ -0,0 +1,142 @@
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// RateScale is the number of steps in one unit of a Rate: rates are exact
+// to six decimal places.
+const RateScale = 1_000_000
+
+var ErrRate = errors.New("rate must be a positive number with at most six decimal places")
+
+// Rate is an exchange rate: how many units of a currency one unit of the
+// base currency buys, in millionths. In JSON it is a decimal number, in the
+// database a numeric.
+type Rate int64
+
+// One is the rate of the base currency to itself.
+const One Rate = RateScale
+
+// ParseRate reads a positive decimal rate such as "0.0354" or "5.62".
+func ParseRate(s string) (Rate, error) {
+	whole, fraction, point := strings.Cut(strings.TrimSpace(s), ".")
+	if whole == "" || (point && fraction == "") || !allDigits(whole) || !allDigits(fraction) || len(fraction) > 6 {
+		return 0, ErrRate
+	}
+	fraction += strings.Repeat("0", 6-len(fraction))
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || major > (math.MaxInt64-999_999)/RateScale {
+		return 0, ErrRate
+	}
+	minor, _ := strconv.ParseInt(fraction, 10, 64)
+	rate := Rate(major*RateScale + minor)
+	if rate <= 0 {
+		return 0, ErrRate
+	}
+	return rate, nil
+}
+
+// Convert turns an amount in the base currency into the rate's currency,
+// rounding half away from zero.
+func (r Rate) Convert(a Amount) (Amount, error) {
+	return mulDiv(a, uint64(r), RateScale)
+}
+
+// ConvertBack turns an amount in the rate's currency into the base
+// currency, rounding half away from zero.
+func (r Rate) ConvertBack(a Amount) (Amount, error) {
+	if r <= 0 {
+		return 0, ErrRate
+	}
+	return mulDiv(a, RateScale, uint64(r))
+}
+
+// String formats the rate without trailing zeros, e.g. "0.0354".
+func (r Rate) String() string {
+	whole := strconv.FormatInt(int64(r)/RateScale, 10)
+	fraction := strconv.FormatInt(int64(r)%RateScale, 10)
+	fraction = strings.TrimRight(strings.Repeat("0", 6-len(fraction))+fraction, "0")
+	if fraction == "" {
+		return whole
+	}
+	return whole + "." + fraction
+}
+
+func (r Rate) MarshalJSON() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalJSON accepts a JSON number or a string holding one.
+func (r *Rate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(string(data)); err == nil {
+		data = []byte(unquoted)
+	}
+	rate, err := ParseRate(string(data))
+	if err != nil {
+		return err
+	}
+	*r = rate
+	return nil
+}
+
+func (r Rate) Value() (driver.Value, error) {
+	return r.String(), nil
+}
+
+func (r *Rate) Scan(src any) error {
+	var text string
+	switch v := src.(type) {
+	case []byte:
+		text = string(v)
+	case string:
+		text = v
+	case int64:
+		text = strconv.FormatInt(v, 10)
+	default:
+		return fmt.Errorf("cannot scan %T into a rate", src)
+	}
+	// numeric columns come back with as many decimals as the column has
+	whole, fraction, _ := strings.Cut(text, ".")
+	if trimmed := strings.TrimRight(fraction, "0"); trimmed != "" {
+		whole += "." + trimmed
+	}
+	rate, err := ParseRate(whole)
+	if err != nil {
+		return fmt.Errorf("invalid rate %q: %w", text, err)
+	}
+	*r = rate
+	return nil
+}
+
+// mulDiv computes a * mul / div with 128-bit intermediates.
+func mulDiv(a Amount, mul, div uint64) (Amount, error) {
+	negative := a < 0
+	abs := uint64(a)
+	if negative {
+		abs = uint64(-a)
+	}
+	hi, lo := bits.Mul64(abs, mul)
+	lo, carry := bits.Add64(lo, div/2, 0)
+	hi += carry
+	if hi >= div {
+		return 0, ErrOverflow
+	}
+	quotient, _ := bits.Div64(hi, lo, div)
+	if quotient > math.MaxInt64 {
+		return 0, ErrOverflow
+	}
+	if negative {
+		return -Amount(quotient), nil
+	}
+	return Amount(quotient), nil
+}
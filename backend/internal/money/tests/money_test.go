@@ -0,0 +1,177 @@
+package money_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+// --- Parse ---
+
+func TestParse(t *testing.T) {
+	cases := map[string]money.Amount{
+		"1200":    120000,
+		"1200.5":  120050,
+		"1200.50": 120050,
+		"0.05":    5,
+		"-0.05":   -5,
+		" 7.1 ":   710,
+		"+3":      300,
+	}
+	for input, expected := range cases {
+		amount, err := money.Parse(input)
+
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, amount, input)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, input := range []string{"", "-", ".5", "1.", "1,50", "1e3", "abc", "--1", "1.2.3"} {
+		_, err := money.Parse(input)
+
+		assert.ErrorIs(t, err, money.ErrInvalid, input)
+	}
+}
+
+func TestParse_TooPrecise(t *testing.T) {
+	_, err := money.Parse("0.125")
+
+	assert.ErrorIs(t, err, money.ErrPrecision)
+}
+
+func TestParse_Overflow(t *testing.T) {
+	_, err := money.Parse("99999999999999999999")
+
+	assert.ErrorIs(t, err, money.ErrOverflow)
+}
+
+// --- Arithmetic ---
+
+func TestSumIsExact(t *testing.T) {
+	a, _ := money.Parse("0.1")
+	b, _ := money.Parse("0.2")
+
+	assert.Equal(t, "0.30", (a + b).String())
+}
+
+func TestMul(t *testing.T) {
+	assert.Equal(t, money.Amount(3597), money.Amount(1199).Mul(3))
+}
+
+// --- Formatting ---
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "1200.50", money.Amount(120050).String())
+	assert.Equal(t, "0.05", money.Amount(5).String())
+	assert.Equal(t, "-0.05", money.Amount(-5).String())
+	assert.Equal(t, "0.00", money.Amount(0).String())
+}
+
+// --- JSON ---
+
+func TestJSON_RoundTrip(t *testing.T) {
+	type item struct {
+		Price     money.Amount  `json:"price"`
+		Threshold *money.Amount `json:"threshold"`
+	}
+	threshold := money.Amount(50000)
+
+	data, err := json.Marshal(item{Price: 120050, Threshold: &threshold})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"price": 1200.50, "threshold": 500.00}`, string(data))
+
+	var decoded item
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, money.Amount(120050), decoded.Price)
+	assert.Equal(t, threshold, *decoded.Threshold)
+}
+
+func TestJSON_AcceptsStringsAndNull(t *testing.T) {
+	var decoded struct {
+		Price     money.Amount  `json:"price"`
+		Threshold *money.Amount `json:"threshold"`
+	}
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"price": "19.99", "threshold": null}`), &decoded))
+	assert.Equal(t, money.Amount(1999), decoded.Price)
+	assert.Nil(t, decoded.Threshold)
+}
+
+func TestJSON_RejectsFloatNoise(t *testing.T) {
+	var price money.Amount
+
+	assert.Error(t, json.Unmarshal([]byte(`0.30000000000000004`), &price))
+}
+
+// --- Rate ---
+
+func TestParseRate(t *testing.T) {
+	rate, err := money.ParseRate("0.0354")
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Rate(35400), rate)
+	assert.Equal(t, "0.0354", rate.String())
+	assert.Equal(t, "1", money.One.String())
+}
+
+func TestParseRate_Invalid(t *testing.T) {
+	for _, input := range []string{"", "0", "-1", "0.0000001", "abc", "1."} {
+		_, err := money.ParseRate(input)
+
+		assert.ErrorIs(t, err, money.ErrRate, input)
+	}
+}
+
+func TestRate_Convert(t *testing.T) {
+	rate, _ := money.ParseRate("0.0354")
+
+	converted, err := rate.Convert(money.Amount(120050))
+	assert.NoError(t, err)
+	// 1200.50 * 0.0354 = 42.4977
+	assert.Equal(t, money.Amount(4250), converted)
+
+	back, err := rate.ConvertBack(money.Amount(4250))
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(120056), back)
+}
+
+func TestRate_ConvertRoundsHalfAwayFromZero(t *testing.T) {
+	rate, _ := money.ParseRate("0.5")
+
+	up, _ := rate.Convert(money.Amount(5))
+	down, _ := rate.Convert(money.Amount(-5))
+
+	assert.Equal(t, money.Amount(3), up)
+	assert.Equal(t, money.Amount(-3), down)
+}
+
+func TestRate_ConvertOverflow(t *testing.T) {
+	rate, _ := money.ParseRate("1000")
+
+	_, err := rate.Convert(money.Amount(1 << 62))
+
+	assert.ErrorIs(t, err, money.ErrOverflow)
+}
+
+func TestRate_Scan(t *testing.T) {
+	var rate money.Rate
+
+	assert.NoError(t, rate.Scan([]byte("5.620000")))
+	assert.Equal(t, money.Rate(5620000), rate)
+
+	value, err := rate.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "5.62", value)
+}
+
+func TestRate_JSON(t *testing.T) {
+	var rate money.Rate
+
+	assert.NoError(t, json.Unmarshal([]byte(`"0.0354"`), &rate))
+	data, err := json.Marshal(rate)
+	assert.NoError(t, err)
+	assert.Equal(t, `0.0354`, string(data))
+}
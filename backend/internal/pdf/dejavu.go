@@ -0,0 +1,37 @@
+package pdf
+
+import (
+	_ "embed"
+	"sync"
+)
+
+// DejaVu Sans ships with the package, so documents can draw Cyrillic and
+// most other scripts without any fonts installed. See fonts/LICENSE.
+var (
+	//go:embed fonts/DejaVuSans.ttf
+	dejaVuSansData []byte
+	//go:embed fonts/DejaVuSans-Bold.ttf
+	dejaVuSansBoldData []byte
+)
+
+var (
+	dejaVuSans     = sync.OnceValue(func() *TrueType { return mustParse(dejaVuSansData) })
+	dejaVuSansBold = sync.OnceValue(func() *TrueType { return mustParse(dejaVuSansBoldData) })
+)
+
+// DejaVuSans and DejaVuSansBold return the bundled faces.
+func DejaVuSans() *TrueType {
+	return dejaVuSans()
+}
+
+func DejaVuSansBold() *TrueType {
+	return dejaVuSansBold()
+}
+
+func mustParse(data []byte) *TrueType {
+	face, err := ParseTrueType(data)
+	if err != nil {
+		panic("pdf: bundled font: " + err.Error())
+	}
+	return face
+}
@@ -0,0 +1,98 @@
+package pdf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Font draws text in a document. A font value belongs to one document, as
+// embedded fonts record the glyphs they are asked to draw.
+type Font interface {
+	// Width returns the width of s in points at the given size.
+	Width(s string, size float64) float64
+	encode(s string) string
+	write(w *writer) (int, error)
+}
+
+// Helvetica and HelveticaBold return the standard fonts every PDF reader
+// has. They only cover Latin-1; other characters are drawn as "?".
+func Helvetica() Font {
+	return &standardFont{name: "Helvetica", widths: &helveticaWidths}
+}
+
+func HelveticaBold() Font {
+	return &standardFont{name: "Helvetica-Bold", widths: &helveticaBoldWidths}
+}
+
+type standardFont struct {
+	name   string
+	widths *[95]int
+}
+
+func (f *standardFont) Width(s string, size float64) float64 {
+	total := 0
+	for _, b := range winAnsi(s) {
+		if b >= 32 && b < 127 {
+			total += f.widths[b-32]
+		} else {
+			total += 556
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+func (f *standardFont) encode(s string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, c := range winAnsi(s) {
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 32 || c > 126:
+			fmt.Fprintf(&b, "\\%03o", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+func (f *standardFont) write(w *writer) (int, error) {
+	return w.object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", f.name)), nil
+}
+
+// winAnsi encodes the Latin-1 characters of s, which WinAnsiEncoding
+// shares with it, replacing the rest with "?".
+func winAnsi(s string) []byte {
+	encoded := make([]byte, 0, len(s))
+	for _, r := range s {
+		if (r >= 32 && r < 127) || (r >= 160 && r <= 255) {
+			encoded = append(encoded, byte(r))
+		} else {
+			encoded = append(encoded, '?')
+		}
+	}
+	return encoded
+}
+
+// Advance widths of the printable ASCII characters, from the Adobe font
+// metrics of the standard fonts.
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
@@ -0,0 +1,196 @@
+// Package pdf writes simple text documents: A4 pages with lines of text
+// and rules, in the standard Helvetica fonts or an embedded TrueType font.
+// The output only depends on what was drawn, so drawing the same document
+// twice gives the same bytes.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A4 page size in points.
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Document is a PDF under construction.
+type Document struct {
+	title   string
+	created time.Time
+	fonts   []Font
+	pages   []*Page
+}
+
+// New starts a document. created is written as its creation date.
+func New(title string, created time.Time) *Document {
+	return &Document{title: title, created: created}
+}
+
+// AddPage appends an empty A4 page.
+func (d *Document) AddPage() *Page {
+	page := &Page{doc: d}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// Page is a page of a document. Coordinates are in points from the
+// bottom-left corner.
+type Page struct {
+	doc     *Document
+	content bytes.Buffer
+}
+
+// Text draws s with its baseline starting at (x, y).
+func (p *Page) Text(font Font, size, x, y float64, s string) {
+	p.use(font)
+	fmt.Fprintf(&p.content, "BT /F%d %s Tf %s %s Td %s Tj ET\n", p.fontIndex(font), num(size), num(x), num(y), font.encode(s))
+}
+
+// TextRight draws s so that it ends at x.
+func (p *Page) TextRight(font Font, size, x, y float64, s string) {
+	p.Text(font, size, x-font.Width(s, size), y, s)
+}
+
+// Line draws a straight line of the given width.
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n", num(width), num(x1), num(y1), num(x2), num(y2))
+}
+
+func (p *Page) use(font Font) {
+	for _, f := range p.doc.fonts {
+		if f == font {
+			return
+		}
+	}
+	p.doc.fonts = append(p.doc.fonts, font)
+}
+
+func (p *Page) fontIndex(font Font) int {
+	for i, f := range p.doc.fonts {
+		if f == font {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// Bytes renders the document.
+func (d *Document) Bytes() ([]byte, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	w := &writer{}
+	w.buf.WriteString("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n")
+
+	catalog := w.reserve()
+	pagesRef := w.reserve()
+
+	fontRefs := make([]int, len(d.fonts))
+	for i, font := range d.fonts {
+		ref, err := font.write(w)
+		if err != nil {
+			return nil, err
+		}
+		fontRefs[i] = ref
+	}
+	var resources strings.Builder
+	resources.WriteString("<< /Font <<")
+	for i, ref := range fontRefs {
+		fmt.Fprintf(&resources, " /F%d %d 0 R", i+1, ref)
+	}
+	resources.WriteString(" >> >>")
+
+	kids := make([]string, len(d.pages))
+	for i, page := range d.pages {
+		content, err := w.stream("", page.content.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		ref := w.object(fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %s %s] /Resources %s /Contents %d 0 R >>",
+			pagesRef, num(PageWidth), num(PageHeight), resources.String(), content))
+		kids[i] = strconv.Itoa(ref) + " 0 R"
+	}
+	w.define(pagesRef, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	w.define(catalog, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesRef))
+	info := w.object(fmt.Sprintf("<< /Title %s /Producer (Bookstore) /CreationDate (D:%s) >>",
+		textString(d.title), d.created.UTC().Format("20060102150405Z")))
+
+	w.finish(catalog, info)
+	return w.buf.Bytes(), nil
+}
+
+// writer lays out numbered objects and the cross-reference table.
+type writer struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+// reserve takes an object number to be defined later.
+func (w *writer) reserve() int {
+	w.offsets = append(w.offsets, -1)
+	return len(w.offsets)
+}
+
+func (w *writer) define(ref int, body string) {
+	w.offsets[ref-1] = w.buf.Len()
+	fmt.Fprintf(&w.buf, "%d 0 obj\n%s\nendobj\n", ref, body)
+}
+
+func (w *writer) object(body string) int {
+	ref := w.reserve()
+	w.define(ref, body)
+	return ref
+}
+
+// stream writes data compressed, with extra entries added to its
+// dictionary.
+func (w *writer) stream(extra string, data []byte) (int, error) {
+	var compressed bytes.Buffer
+	z := zlib.NewWriter(&compressed)
+	if _, err := z.Write(data); err != nil {
+		return 0, err
+	}
+	if err := z.Close(); err != nil {
+		return 0, err
+	}
+	ref := w.reserve()
+	w.offsets[ref-1] = w.buf.Len()
+	fmt.Fprintf(&w.buf, "%d 0 obj\n<< /Length %d /Filter /FlateDecode%s >>\nstream\n", ref, compressed.Len(), extra)
+	w.buf.Write(compressed.Bytes())
+	w.buf.WriteString("\nendstream\nendobj\n")
+	return ref, nil
+}
+
+func (w *writer) finish(catalog, info int) {
+	xref := w.buf.Len()
+	fmt.Fprintf(&w.buf, "xref\n0 %d\n0000000000 65535 f \n", len(w.offsets)+1)
+	for _, offset := range w.offsets {
+		fmt.Fprintf(&w.buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&w.buf, "trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(w.offsets)+1, catalog, info, xref)
+}
+
+// num formats a coordinate with at most two decimals.
+func num(f float64) string {
+	return strconv.FormatFloat(float64(int64(f*100+0.5))/100, 'f', -1, 64)
+}
+
+// textString encodes s as a UTF-16 text string for the document info.
+func textString(s string) string {
+	var b strings.Builder
+	b.WriteString("<FEFF")
+	for _, r := range s {
+		if r > 0xFFFF {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	b.WriteString(">")
+	return b.String()
+}
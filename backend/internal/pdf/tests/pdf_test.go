@@ -0,0 +1,247 @@
+package pdf_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/pdf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var created = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func draw(t *testing.T, font func() pdf.Font, text string) []byte {
+	t.Helper()
+	doc := pdf.New("Test", created)
+	page := doc.AddPage()
+	f := font()
+	page.Text(f, 12, 40, 800, text)
+	page.Line(40, 790, 300, 790, 0.5)
+	doc.AddPage().TextRight(f, 10, 500, 40, "page 2")
+	out, err := doc.Bytes()
+	require.NoError(t, err)
+	return out
+}
+
+// checkXref verifies that every cross-reference entry points at its object.
+func checkXref(t *testing.T, out []byte) {
+	t.Helper()
+	start := regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`).FindSubmatch(out)
+	require.NotNil(t, start)
+	xref, err := strconv.Atoi(string(start[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(out[xref:], []byte("xref\n0 ")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[xref:], -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		assert.True(t, bytes.HasPrefix(out[offset:], []byte(strconv.Itoa(i+1)+" 0 obj\n")), "object %d", i+1)
+	}
+	assert.Contains(t, string(out), "/Size "+strconv.Itoa(len(entries)+1)+" ")
+}
+
+func TestDocument_Helvetica(t *testing.T) {
+	out := draw(t, pdf.Helvetica, "Total (incl. VAT): 1 000,00")
+
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-1.7\n")))
+	checkXref(t, out)
+	assert.Contains(t, string(out), "/BaseFont /Helvetica ")
+	assert.Contains(t, string(out), "/Count 2")
+	assert.Contains(t, string(out), "/CreationDate (D:20260301120000Z)")
+}
+
+func TestDocument_IsDeterministic(t *testing.T) {
+	assert.Equal(t, draw(t, pdf.Helvetica, "Invoice"), draw(t, pdf.Helvetica, "Invoice"))
+
+	face := testFont(t)
+	assert.Equal(t, draw(t, face.Font, "AB"), draw(t, face.Font, "AB"))
+}
+
+func TestHelvetica_Width(t *testing.T) {
+	assert.InDelta(t, 6.67, pdf.Helvetica().Width("AB", 5), 1e-9)
+	assert.InDelta(t, 7.22, pdf.HelveticaBold().Width("AB", 5), 1e-9)
+	// characters outside Latin-1 are drawn and measured as "?"
+	assert.Equal(t, pdf.Helvetica().Width("??", 10), pdf.Helvetica().Width("Яя", 10))
+}
+
+func TestParseTrueType_Invalid(t *testing.T) {
+	_, err := pdf.ParseTrueType([]byte("not a font at all"))
+	assert.ErrorIs(t, err, pdf.ErrInvalidFont)
+
+	_, err = pdf.ParseTrueType(buildFont(map[string][]byte{"head": make([]byte, 54)}))
+	assert.ErrorIs(t, err, pdf.ErrInvalidFont)
+}
+
+func TestTrueType_Width(t *testing.T) {
+	font := testFont(t).Font()
+
+	assert.InDelta(t, 12.0, font.Width("AB", 10), 1e-9)
+	// unmapped characters fall back to "?"
+	assert.InDelta(t, font.Width("?", 10), font.Width("Я", 10), 1e-9)
+}
+
+func TestTrueType_EmbedsSubset(t *testing.T) {
+	out := draw(t, testFont(t).Font, "B")
+
+	checkXref(t, out)
+	text := string(out)
+	assert.Regexp(t, `/BaseFont /[A-Z]{6}\+TestSans `, text)
+	assert.Contains(t, text, "/Encoding /Identity-H")
+	assert.Contains(t, text, "<0002> Tj")
+	assert.Contains(t, text, "/W [ 2 [700] ")
+
+	toUnicode := string(streamAfter(t, out, "/CMapName", false))
+	assert.Contains(t, toUnicode, "<0002> <0042>")
+
+	subset := streamAfter(t, out, "/Length1", true)
+	glyphs := glyphLengths(t, subset)
+	require.Len(t, glyphs, 6)
+	assert.Zero(t, glyphs[1], "unused glyph is dropped")
+	assert.NotZero(t, glyphs[2], "drawn glyph is kept")
+	assert.NotZero(t, glyphs[3], "component of a drawn glyph is kept")
+	assert.Zero(t, glyphs[4])
+}
+
+func TestDejaVuSans_DrawsCyrillic(t *testing.T) {
+	face := pdf.DejaVuSans()
+	assert.NotEqual(t, face.Font().Width("?", 10), face.Font().Width("Я", 10))
+
+	out := draw(t, face.Font, "Счёт Я")
+
+	checkXref(t, out)
+	assert.Regexp(t, `/BaseFont /[A-Z]{6}\+DejaVuSans `, string(out))
+	toUnicode := string(streamAfter(t, out, "/CMapName", false))
+	for _, code := range []string{"<0421>", "<0447>", "<0451>", "<0442>", "<042F>"} {
+		assert.Contains(t, toUnicode, code)
+	}
+	assert.NotContains(t, toUnicode, "<003F>")
+}
+
+// streamAfter decompresses the first stream whose dictionary or content
+// contains marker.
+func streamAfter(t *testing.T, out []byte, marker string, inDictionary bool) []byte {
+	t.Helper()
+	streams := regexp.MustCompile(`(?s)<< /Length (\d+) /Filter /FlateDecode([^>]*)>>\nstream\n`).FindAllSubmatchIndex(out, -1)
+	for _, s := range streams {
+		length, _ := strconv.Atoi(string(out[s[2]:s[3]]))
+		z, err := zlib.NewReader(bytes.NewReader(out[s[1] : s[1]+length]))
+		require.NoError(t, err)
+		data, err := io.ReadAll(z)
+		require.NoError(t, err)
+		if inDictionary && bytes.Contains(out[s[4]:s[5]], []byte(marker)) ||
+			!inDictionary && bytes.Contains(data, []byte(marker)) {
+			return data
+		}
+	}
+	t.Fatalf("no stream with %s", marker)
+	return nil
+}
+
+// glyphLengths reads the long loca table of a font file.
+func glyphLengths(t *testing.T, font []byte) []int {
+	t.Helper()
+	tables := map[string][]byte{}
+	for i := 0; i < int(binary.BigEndian.Uint16(font[4:])); i++ {
+		record := font[12+16*i:]
+		offset := binary.BigEndian.Uint32(record[8:])
+		length := binary.BigEndian.Uint32(record[12:])
+		tables[string(record[:4])] = font[offset : offset+length]
+	}
+	require.Equal(t, uint16(1), binary.BigEndian.Uint16(tables["head"][50:]))
+	loca := tables["loca"]
+	lengths := make([]int, len(loca)/4-1)
+	for g := range lengths {
+		lengths[g] = int(binary.BigEndian.Uint32(loca[4*g+4:]) - binary.BigEndian.Uint32(loca[4*g:]))
+	}
+	return lengths
+}
+
+// testFont builds a font with six glyphs: .notdef, "A", "B" made of glyph
+// 3, glyph 3, an unused glyph 4 and "?".
+func testFont(t *testing.T) *pdf.TrueType {
+	t.Helper()
+	face, err := pdf.ParseTrueType(buildFont(testTables()))
+	require.NoError(t, err)
+	return face
+}
+
+func testTables() map[string][]byte {
+	be16 := func(values ...int) []byte {
+		b := make([]byte, 2*len(values))
+		for i, v := range values {
+			binary.BigEndian.PutUint16(b[2*i:], uint16(v))
+		}
+		return b
+	}
+	concat := func(parts ...[]byte) []byte { return bytes.Join(parts, nil) }
+
+	head := make([]byte, 54)
+	binary.BigEndian.PutUint32(head, 0x00010000)
+	copy(head[18:], be16(1000))
+	copy(head[36:], be16(0, -200, 1000, 800))
+
+	hhea := make([]byte, 36)
+	copy(hhea[4:], be16(800, -200))
+	copy(hhea[34:], be16(6))
+
+	maxp := concat(be16(0, 0x5000), be16(6))
+	hmtx := concat(be16(500, 0), be16(500, 0), be16(700, 0), be16(600, 0), be16(400, 0), be16(550, 0))
+
+	simple := concat(be16(1, 0, 0, 100, 100), be16(0), be16(0), []byte{1, 0, 0})
+	simple = append(simple, 0) // pad to an even length
+	composite := concat(be16(0xFFFF, 0, 0, 100, 100), be16(0x0002, 3), []byte{0, 0})
+	outlines := [][]byte{nil, simple, composite, simple, simple, simple}
+	var glyf, loca []byte
+	for _, outline := range outlines {
+		loca = append(loca, be16(len(glyf)/2)...)
+		glyf = append(glyf, outline...)
+	}
+	loca = append(loca, be16(len(glyf)/2)...)
+
+	// format 4: "?" -> 5, "A".."B" -> 1..2, and the closing segment
+	format4 := concat(be16(4, 0, 0, 6, 0, 0, 0),
+		be16('?', 'B', 0xFFFF), be16(0), be16('?', 'A', 0xFFFF),
+		be16(5-'?', 1-'A', 1), be16(0, 0, 0))
+	binary.BigEndian.PutUint16(format4[2:], uint16(len(format4)))
+	cmap := concat(be16(0, 1), be16(3, 1), []byte{0, 0, 0, 12}, format4)
+
+	name := "TestSans"
+	nameTable := concat(be16(0, 1, 18), be16(1, 0, 0, 6, len(name), 0), []byte(name))
+
+	return map[string][]byte{
+		"head": head, "hhea": hhea, "maxp": maxp, "hmtx": hmtx,
+		"loca": loca, "glyf": glyf, "cmap": cmap, "name": nameTable,
+	}
+}
+
+func buildFont(tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	header := make([]byte, 12+16*len(tags))
+	binary.BigEndian.PutUint32(header, 0x00010000)
+	binary.BigEndian.PutUint16(header[4:], uint16(len(tags)))
+	var body []byte
+	for i, tag := range tags {
+		record := header[12+16*i:]
+		copy(record, tag)
+		binary.BigEndian.PutUint32(record[8:], uint32(len(header)+len(body)))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(tables[tag])))
+		body = append(body, tables[tag]...)
+		for len(body)%4 != 0 {
+			body = append(body, 0)
+		}
+	}
+	return append(header, body...)
+}
@@ -0,0 +1,535 @@
+package pdf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf16"
+)
+
+var ErrInvalidFont = errors.New("invalid TrueType font")
+
+// TrueType is a parsed TrueType font file, such as DejaVu Sans, for text
+// the standard fonts cannot draw. Documents embed only the glyphs they use.
+type TrueType struct {
+	tables     map[string][]byte
+	name       string
+	unitsPerEm int
+	bbox       [4]int
+	ascent     int
+	descent    int
+	advances   []int
+	glyphs     map[rune]uint16
+}
+
+// ParseTrueType reads the tables a PDF needs from a .ttf file.
+func ParseTrueType(data []byte) (*TrueType, error) {
+	if len(data) < 12 {
+		return nil, ErrInvalidFont
+	}
+	if version := binary.BigEndian.Uint32(data); version != 0x00010000 && version != 0x74727565 {
+		return nil, fmt.Errorf("%w: not a TrueType outline font", ErrInvalidFont)
+	}
+	t := &TrueType{tables: map[string][]byte{}}
+	count := int(binary.BigEndian.Uint16(data[4:]))
+	for i := 0; i < count; i++ {
+		record := 12 + 16*i
+		if record+16 > len(data) {
+			return nil, ErrInvalidFont
+		}
+		offset := int(binary.BigEndian.Uint32(data[record+8:]))
+		length := int(binary.BigEndian.Uint32(data[record+12:]))
+		if offset < 0 || length < 0 || offset+length > len(data) {
+			return nil, ErrInvalidFont
+		}
+		t.tables[string(data[record:record+4])] = data[offset : offset+length]
+	}
+	for _, tag := range []string{"head", "hhea", "maxp", "hmtx", "loca", "glyf", "cmap"} {
+		if t.tables[tag] == nil {
+			return nil, fmt.Errorf("%w: missing %s table", ErrInvalidFont, tag)
+		}
+	}
+
+	head, hhea := t.tables["head"], t.tables["hhea"]
+	if len(head) < 54 || len(hhea) < 36 || len(t.tables["maxp"]) < 6 {
+		return nil, ErrInvalidFont
+	}
+	t.unitsPerEm = int(binary.BigEndian.Uint16(head[18:]))
+	if t.unitsPerEm == 0 {
+		return nil, ErrInvalidFont
+	}
+	for i := range t.bbox {
+		t.bbox[i] = t.scale(int(int16(binary.BigEndian.Uint16(head[36+2*i:]))))
+	}
+	t.ascent = t.scale(int(int16(binary.BigEndian.Uint16(hhea[4:]))))
+	t.descent = t.scale(int(int16(binary.BigEndian.Uint16(hhea[6:]))))
+
+	numGlyphs := int(binary.BigEndian.Uint16(t.tables["maxp"][4:]))
+	metrics := int(binary.BigEndian.Uint16(hhea[34:]))
+	hmtx := t.tables["hmtx"]
+	if metrics == 0 || len(hmtx) < 4*metrics {
+		return nil, ErrInvalidFont
+	}
+	t.advances = make([]int, numGlyphs)
+	for g := range t.advances {
+		t.advances[g] = int(binary.BigEndian.Uint16(hmtx[4*min(g, metrics-1):]))
+	}
+
+	var err error
+	if t.glyphs, err = parseCmap(t.tables["cmap"]); err != nil {
+		return nil, err
+	}
+	t.name = postScriptName(t.tables["name"])
+	return t, nil
+}
+
+// Font returns a font drawing with the TrueType face in one document.
+func (t *TrueType) Font() Font {
+	return &trueTypeFont{face: t, used: map[uint16]rune{}}
+}
+
+func (t *TrueType) scale(units int) int {
+	return units * 1000 / t.unitsPerEm
+}
+
+func (t *TrueType) glyph(r rune) uint16 {
+	if g, ok := t.glyphs[r]; ok && int(g) < len(t.advances) {
+		return g
+	}
+	if g, ok := t.glyphs['?']; ok && int(g) < len(t.advances) {
+		return g
+	}
+	return 0
+}
+
+type trueTypeFont struct {
+	face *TrueType
+	used map[uint16]rune
+}
+
+func (f *trueTypeFont) Width(s string, size float64) float64 {
+	total := 0
+	for _, r := range s {
+		total += f.face.advances[f.face.glyph(r)]
+	}
+	return float64(total) * size / float64(f.face.unitsPerEm)
+}
+
+// encode writes the glyph IDs, which the Identity-H encoding uses as
+// character codes.
+func (f *trueTypeFont) encode(s string) string {
+	var b strings.Builder
+	b.WriteByte('<')
+	for _, r := range s {
+		g := f.face.glyph(r)
+		if _, ok := f.used[g]; !ok {
+			f.used[g] = r
+		}
+		fmt.Fprintf(&b, "%04X", g)
+	}
+	b.WriteByte('>')
+	return b.String()
+}
+
+func (f *trueTypeFont) write(w *writer) (int, error) {
+	used := make([]uint16, 0, len(f.used))
+	for g := range f.used {
+		used = append(used, g)
+	}
+	slices.Sort(used)
+
+	subset, err := f.face.subset(used)
+	if err != nil {
+		return 0, err
+	}
+	name := subsetTag(used) + "+" + f.face.name
+
+	file, err := w.stream(fmt.Sprintf(" /Length1 %d", len(subset)), subset)
+	if err != nil {
+		return 0, err
+	}
+	bbox := f.face.bbox
+	descriptor := w.object(fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 32 /FontBBox [%d %d %d %d] /ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>",
+		name, bbox[0], bbox[1], bbox[2], bbox[3], f.face.ascent, f.face.descent, f.face.ascent, file))
+
+	var widths strings.Builder
+	for _, g := range used {
+		fmt.Fprintf(&widths, " %d [%d]", g, f.face.scale(f.face.advances[g]))
+	}
+	cid := w.object(fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> /FontDescriptor %d 0 R /CIDToGIDMap /Identity /DW 1000 /W [%s ] >>",
+		name, descriptor, widths.String()))
+
+	toUnicode, err := w.stream("", f.toUnicode(used))
+	if err != nil {
+		return 0, err
+	}
+	return w.object(fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>",
+		name, cid, toUnicode)), nil
+}
+
+// toUnicode maps the glyphs back to text, so it can be copied and searched.
+func (f *trueTypeFont) toUnicode(used []uint16) []byte {
+	var b strings.Builder
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n")
+	b.WriteString("/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n")
+	b.WriteString("/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n")
+	b.WriteString("1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	for start := 0; start < len(used); start += 100 {
+		chunk := used[start:min(start+100, len(used))]
+		fmt.Fprintf(&b, "%d beginbfchar\n", len(chunk))
+		for _, g := range chunk {
+			fmt.Fprintf(&b, "<%04X> <", g)
+			for _, unit := range utf16.Encode([]rune{f.used[g]}) {
+				fmt.Fprintf(&b, "%04X", unit)
+			}
+			b.WriteString(">\n")
+		}
+		b.WriteString("endbfchar\n")
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	return []byte(b.String())
+}
+
+// subset rebuilds the font with the outlines of the used glyphs and the
+// glyphs they are composed of; the other glyphs are left empty, so glyph
+// IDs stay the same.
+func (t *TrueType) subset(used []uint16) ([]byte, error) {
+	numGlyphs := len(t.advances)
+	loca := t.tables["loca"]
+	longLoca := binary.BigEndian.Uint16(t.tables["head"][50:]) == 1
+	offset := func(g int) (int, error) {
+		var o int
+		if longLoca {
+			if 4*g+4 > len(loca) {
+				return 0, ErrInvalidFont
+			}
+			o = int(binary.BigEndian.Uint32(loca[4*g:]))
+		} else {
+			if 2*g+2 > len(loca) {
+				return 0, ErrInvalidFont
+			}
+			o = 2 * int(binary.BigEndian.Uint16(loca[2*g:]))
+		}
+		if o > len(t.tables["glyf"]) {
+			return 0, ErrInvalidFont
+		}
+		return o, nil
+	}
+	outline := func(g int) ([]byte, error) {
+		start, err := offset(g)
+		if err != nil {
+			return nil, err
+		}
+		end, err := offset(g + 1)
+		if err != nil || end < start {
+			return nil, ErrInvalidFont
+		}
+		return t.tables["glyf"][start:end], nil
+	}
+
+	keep := map[int]bool{0: true}
+	queue := []int{0}
+	for _, g := range used {
+		if !keep[int(g)] {
+			keep[int(g)] = true
+			queue = append(queue, int(g))
+		}
+	}
+	for len(queue) > 0 {
+		g := queue[0]
+		queue = queue[1:]
+		data, err := outline(g)
+		if err != nil {
+			return nil, err
+		}
+		for _, component := range components(data) {
+			if component < numGlyphs && !keep[component] {
+				keep[component] = true
+				queue = append(queue, component)
+			}
+		}
+	}
+
+	var glyf []byte
+	newLoca := make([]byte, 4*(numGlyphs+1))
+	for g := 0; g < numGlyphs; g++ {
+		binary.BigEndian.PutUint32(newLoca[4*g:], uint32(len(glyf)))
+		if !keep[g] {
+			continue
+		}
+		data, err := outline(g)
+		if err != nil {
+			return nil, err
+		}
+		glyf = append(glyf, data...)
+		for len(glyf)%4 != 0 {
+			glyf = append(glyf, 0)
+		}
+	}
+	binary.BigEndian.PutUint32(newLoca[4*numGlyphs:], uint32(len(glyf)))
+
+	head := slices.Clone(t.tables["head"])
+	binary.BigEndian.PutUint32(head[8:], 0)
+	binary.BigEndian.PutUint16(head[50:], 1)
+
+	tables := map[string][]byte{
+		"head": head,
+		"hhea": t.tables["hhea"],
+		"maxp": t.tables["maxp"],
+		"hmtx": t.tables["hmtx"],
+		"loca": newLoca,
+		"glyf": glyf,
+	}
+	for _, tag := range []string{"cvt ", "fpgm", "prep"} {
+		if t.tables[tag] != nil {
+			tables[tag] = t.tables[tag]
+		}
+	}
+	font := assembleFont(tables)
+	binary.BigEndian.PutUint32(font[headOffset(font)+8:], 0xB1B0AFBA-checksum(font))
+	return font, nil
+}
+
+// components lists the glyphs a composite glyph is built from.
+func components(data []byte) []int {
+	if len(data) < 10 || int16(binary.BigEndian.Uint16(data)) >= 0 {
+		return nil
+	}
+	const (
+		argsAreWords   = 0x0001
+		haveScale      = 0x0008
+		moreComponents = 0x0020
+		haveXYScale    = 0x0040
+		haveTwoByTwo   = 0x0080
+	)
+	var glyphs []int
+	for p := 10; p+4 <= len(data); {
+		flags := binary.BigEndian.Uint16(data[p:])
+		glyphs = append(glyphs, int(binary.BigEndian.Uint16(data[p+2:])))
+		p += 4
+		if flags&argsAreWords != 0 {
+			p += 4
+		} else {
+			p += 2
+		}
+		switch {
+		case flags&haveScale != 0:
+			p += 2
+		case flags&haveXYScale != 0:
+			p += 4
+		case flags&haveTwoByTwo != 0:
+			p += 8
+		}
+		if flags&moreComponents == 0 {
+			break
+		}
+	}
+	return glyphs
+}
+
+// assembleFont writes a font file with the tables in tag order.
+func assembleFont(tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+
+	count := len(tags)
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= count {
+		entrySelector++
+	}
+	searchRange := 16 << entrySelector
+
+	header := make([]byte, 12+16*count)
+	binary.BigEndian.PutUint32(header, 0x00010000)
+	binary.BigEndian.PutUint16(header[4:], uint16(count))
+	binary.BigEndian.PutUint16(header[6:], uint16(searchRange))
+	binary.BigEndian.PutUint16(header[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(header[10:], uint16(16*count-searchRange))
+
+	body := []byte{}
+	for i, tag := range tags {
+		data := tables[tag]
+		record := header[12+16*i:]
+		copy(record, tag)
+		binary.BigEndian.PutUint32(record[4:], checksum(data))
+		binary.BigEndian.PutUint32(record[8:], uint32(len(header)+len(body)))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(data)))
+		body = append(body, data...)
+		for len(body)%4 != 0 {
+			body = append(body, 0)
+		}
+	}
+	return append(header, body...)
+}
+
+func headOffset(font []byte) int {
+	count := int(binary.BigEndian.Uint16(font[4:]))
+	for i := 0; i < count; i++ {
+		record := font[12+16*i:]
+		if string(record[:4]) == "head" {
+			return int(binary.BigEndian.Uint32(record[8:]))
+		}
+	}
+	return 0
+}
+
+func checksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+// parseCmap reads the Unicode character map, preferring the full-range
+// format 12 subtable over the BMP-only format 4 one.
+func parseCmap(cmap []byte) (map[rune]uint16, error) {
+	if len(cmap) < 4 {
+		return nil, ErrInvalidFont
+	}
+	var format4, format12 []byte
+	count := int(binary.BigEndian.Uint16(cmap[2:]))
+	for i := 0; i < count; i++ {
+		record := 4 + 8*i
+		if record+8 > len(cmap) {
+			return nil, ErrInvalidFont
+		}
+		platform := binary.BigEndian.Uint16(cmap[record:])
+		encoding := binary.BigEndian.Uint16(cmap[record+2:])
+		offset := int(binary.BigEndian.Uint32(cmap[record+4:]))
+		if offset+4 > len(cmap) || (platform != 0 && !(platform == 3 && (encoding == 1 || encoding == 10))) {
+			continue
+		}
+		switch binary.BigEndian.Uint16(cmap[offset:]) {
+		case 4:
+			format4 = cmap[offset:]
+		case 12:
+			format12 = cmap[offset:]
+		}
+	}
+
+	glyphs := map[rune]uint16{}
+	switch {
+	case format12 != nil:
+		if len(format12) < 16 {
+			return nil, ErrInvalidFont
+		}
+		groups := int(binary.BigEndian.Uint32(format12[12:]))
+		if len(format12) < 16+12*groups {
+			return nil, ErrInvalidFont
+		}
+		for i := 0; i < groups; i++ {
+			group := format12[16+12*i:]
+			start := binary.BigEndian.Uint32(group)
+			end := binary.BigEndian.Uint32(group[4:])
+			glyph := binary.BigEndian.Uint32(group[8:])
+			for c := start; c <= end && c <= 0x10FFFF; c++ {
+				glyphs[rune(c)] = uint16(glyph + c - start)
+			}
+		}
+	case format4 != nil:
+		if len(format4) < 14 {
+			return nil, ErrInvalidFont
+		}
+		segments := int(binary.BigEndian.Uint16(format4[6:])) / 2
+		ends := 14
+		starts := ends + 2*segments + 2
+		deltas := starts + 2*segments
+		rangeOffsets := deltas + 2*segments
+		if len(format4) < rangeOffsets+2*segments {
+			return nil, ErrInvalidFont
+		}
+		for s := 0; s < segments; s++ {
+			start := int(binary.BigEndian.Uint16(format4[starts+2*s:]))
+			end := int(binary.BigEndian.Uint16(format4[ends+2*s:]))
+			delta := int(binary.BigEndian.Uint16(format4[deltas+2*s:]))
+			rangeOffset := int(binary.BigEndian.Uint16(format4[rangeOffsets+2*s:]))
+			for c := start; c <= end && c != 0xFFFF; c++ {
+				glyph := 0
+				if rangeOffset == 0 {
+					glyph = (c + delta) & 0xFFFF
+				} else {
+					p := rangeOffsets + 2*s + rangeOffset + 2*(c-start)
+					if p+2 > len(format4) {
+						continue
+					}
+					if glyph = int(binary.BigEndian.Uint16(format4[p:])); glyph != 0 {
+						glyph = (glyph + delta) & 0xFFFF
+					}
+				}
+				if glyph != 0 {
+					glyphs[rune(c)] = uint16(glyph)
+				}
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w: no Unicode character map", ErrInvalidFont)
+	}
+	return glyphs, nil
+}
+
+// postScriptName returns the font's PostScript name from its name table,
+// or a generic one.
+func postScriptName(table []byte) string {
+	if len(table) >= 6 {
+		count := int(binary.BigEndian.Uint16(table[2:]))
+		storage := int(binary.BigEndian.Uint16(table[4:]))
+		for i := 0; i < count && 6+12*i+12 <= len(table); i++ {
+			record := table[6+12*i:]
+			platform := binary.BigEndian.Uint16(record)
+			nameID := binary.BigEndian.Uint16(record[6:])
+			length := int(binary.BigEndian.Uint16(record[8:]))
+			offset := storage + int(binary.BigEndian.Uint16(record[10:]))
+			if nameID != 6 || offset+length > len(table) {
+				continue
+			}
+			raw := table[offset : offset+length]
+			var name string
+			if platform == 3 || platform == 0 {
+				units := make([]uint16, len(raw)/2)
+				for j := range units {
+					units[j] = binary.BigEndian.Uint16(raw[2*j:])
+				}
+				name = string(utf16.Decode(units))
+			} else {
+				name = string(raw)
+			}
+			if name = sanitizeName(name); name != "" {
+				return name
+			}
+		}
+	}
+	return "EmbeddedFont"
+}
+
+// sanitizeName keeps the characters allowed in a PDF name without escapes.
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r > 32 && r < 127 && !strings.ContainsRune("()<>[]{}/%#", r) {
+			return r
+		}
+		return -1
+	}, name)
+}
+
+// subsetTag derives the six-letter prefix of a subset font's name from its
+// glyphs, so the same text always gives the same name.
+func subsetTag(used []uint16) string {
+	var h uint32 = 2166136261
+	for _, g := range used {
+		h = (h ^ uint32(g)) * 16777619
+	}
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = byte('A' + h%26)
+		h /= 26
+	}
+	return string(tag)
+}
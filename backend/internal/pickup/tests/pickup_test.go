@@ -0,0 +1,33 @@
+package pickup_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/pickup"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodes_Code(t *testing.T) {
+	codes := pickup.NewCodes("secret")
+	orderID := uuid.New()
+	salt, err := pickup.NewSalt()
+
+	assert.NoError(t, err)
+	code := codes.Code(orderID, salt)
+	assert.Regexp(t, regexp.MustCompile(`^[0-9]{6}$`), code)
+	assert.Equal(t, code, codes.Code(orderID, salt))
+	assert.True(t, codes.Check(orderID, salt, code))
+	assert.False(t, codes.Check(orderID, salt, "not it"))
+}
+
+func TestCodes_DependOnKeyOrderAndSalt(t *testing.T) {
+	codes := pickup.NewCodes("secret")
+	orderID := uuid.MustParse("1b4e28ba-2fa1-11d2-883f-0016d3cca427")
+	code := codes.Code(orderID, "salt")
+
+	assert.NotEqual(t, code, pickup.NewCodes("other").Code(orderID, "salt"))
+	assert.NotEqual(t, code, codes.Code(uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), "salt"))
+	assert.NotEqual(t, code, codes.Code(orderID, "pepper"))
+}
@@ -0,0 +1,131 @@
+// Package promotion works out which promotions apply to the lines of a cart
+// and how much they take off.
+package promotion
+
+import (
+	"slices"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/google/uuid"
+)
+
+// Line is a cart line as promotions see it. CategoryIDs include the
+// ancestors of the book's categories, so a promotion for a category covers
+// its subcategories.
+type Line struct {
+	BookID      uuid.UUID
+	PublisherID uuid.UUID
+	AuthorIDs   []uuid.UUID
+	CategoryIDs []uuid.UUID
+	Quantity    int
+	Price       money.Amount
+}
+
+func (l Line) subtotal() money.Amount {
+	return l.Price.Mul(l.Quantity)
+}
+
+// Apply returns the promotions applied to each line. The promotions must
+// already be running and their amounts in the currency of the lines.
+//
+// On each line the stackable promotions add up, while an exclusive one is
+// weighed alone against them; the line gets whichever option saves more. A
+// line never gets more off than it costs.
+func Apply(lines []Line, promotions []models.Promotion) [][]models.AppliedPromotion {
+	var subtotal money.Amount
+	for _, line := range lines {
+		subtotal += line.subtotal()
+	}
+
+	applied := make([][]models.AppliedPromotion, len(lines))
+	for i, line := range lines {
+		var stacked []models.AppliedPromotion
+		var best []models.AppliedPromotion
+		for _, p := range promotions {
+			if subtotal < p.MinOrderTotal || !Covers(p, line) {
+				continue
+			}
+			discount := lineDiscount(p, line)
+			if discount <= 0 {
+				continue
+			}
+			a := models.AppliedPromotion{PromotionID: p.ID, Name: p.Name, CouponCode: p.CouponCode, Discount: discount}
+			if !p.Exclusive {
+				stacked = append(stacked, a)
+			} else if Total(best) < discount {
+				best = []models.AppliedPromotion{a}
+			}
+		}
+		stacked = capAt(stacked, line.subtotal())
+		if Total(best) > Total(stacked) {
+			stacked = best
+		}
+		applied[i] = stacked
+	}
+	return applied
+}
+
+// Covers reports whether the line is in the scope of the promotion.
+func Covers(p models.Promotion, line Line) bool {
+	switch p.Scope {
+	case models.PromotionScopeAll:
+		return true
+	case models.PromotionScopeBook:
+		return slices.Contains(p.TargetIDs, line.BookID)
+	case models.PromotionScopePublisher:
+		return slices.Contains(p.TargetIDs, line.PublisherID)
+	case models.PromotionScopeAuthor:
+		return intersects(p.TargetIDs, line.AuthorIDs)
+	case models.PromotionScopeCategory:
+		return intersects(p.TargetIDs, line.CategoryIDs)
+	}
+	return false
+}
+
+// Total sums the discounts of applied promotions.
+func Total(applied []models.AppliedPromotion) money.Amount {
+	var total money.Amount
+	for _, a := range applied {
+		total += a.Discount
+	}
+	return total
+}
+
+func lineDiscount(p models.Promotion, line Line) money.Amount {
+	subtotal := line.subtotal()
+	var discount money.Amount
+	switch p.Kind {
+	case models.PromotionKindPercent:
+		// rounded half up to the minor unit
+		discount = (subtotal*money.Amount(p.Percent) + 50) / 100
+	case models.PromotionKindFixed:
+		discount = p.Amount.Mul(line.Quantity)
+	}
+	return min(discount, subtotal)
+}
+
+// capAt trims the discounts, last ones first, so they add up to at most limit.
+func capAt(applied []models.AppliedPromotion, limit money.Amount) []models.AppliedPromotion {
+	var total money.Amount
+	for i := range applied {
+		if total+applied[i].Discount > limit {
+			applied[i].Discount = limit - total
+			if applied[i].Discount == 0 {
+				return applied[:i]
+			}
+			return applied[:i+1]
+		}
+		total += applied[i].Discount
+	}
+	return applied
+}
+
+func intersects(a, b []uuid.UUID) bool {
+	for _, id := range a {
+		if slices.Contains(b, id) {
+			return true
+		}
+	}
+	return false
+}
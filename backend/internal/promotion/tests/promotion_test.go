@@ -0,0 +1,69 @@
+package promotion_test
+
+import (
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/promotion"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func percent(n int, exclusive bool) models.Promotion {
+	return models.Promotion{ID: uuid.New(), Kind: models.PromotionKindPercent, Percent: n, Scope: models.PromotionScopeAll, Exclusive: exclusive}
+}
+
+func TestApply_StacksAndRoundsHalfUp(t *testing.T) {
+	lines := []promotion.Line{{Quantity: 1, Price: 1005}}
+
+	applied := promotion.Apply(lines, []models.Promotion{percent(10, false), percent(5, false)})
+
+	if assert.Len(t, applied[0], 2) {
+		assert.Equal(t, money.Amount(101), applied[0][0].Discount)
+		assert.Equal(t, money.Amount(50), applied[0][1].Discount)
+	}
+}
+
+func TestApply_ExclusiveWinsOnlyIfBetter(t *testing.T) {
+	lines := []promotion.Line{{Quantity: 2, Price: 1000}}
+	exclusive := percent(20, true)
+
+	applied := promotion.Apply(lines, []models.Promotion{percent(10, false), percent(5, false), exclusive})
+	assert.Equal(t, []models.AppliedPromotion{{PromotionID: exclusive.ID, Discount: 400}}, applied[0])
+
+	applied = promotion.Apply(lines, []models.Promotion{percent(15, false), percent(10, false), exclusive})
+	assert.Equal(t, money.Amount(500), promotion.Total(applied[0]))
+}
+
+func TestApply_CappedAtLineSubtotal(t *testing.T) {
+	lines := []promotion.Line{{Quantity: 3, Price: 500}}
+	fixed := models.Promotion{ID: uuid.New(), Kind: models.PromotionKindFixed, Amount: 400, Scope: models.PromotionScopeAll}
+
+	applied := promotion.Apply(lines, []models.Promotion{fixed, percent(50, false)})
+
+	assert.Equal(t, money.Amount(1500), promotion.Total(applied[0]))
+	assert.Equal(t, money.Amount(1200), applied[0][0].Discount)
+	assert.Equal(t, money.Amount(300), applied[0][1].Discount)
+}
+
+func TestApply_MinOrderTotalCountsWholeCart(t *testing.T) {
+	lines := []promotion.Line{{Quantity: 1, Price: 600}, {Quantity: 1, Price: 500}}
+	p := percent(10, false)
+	p.MinOrderTotal = 1000
+
+	assert.Len(t, promotion.Apply(lines, []models.Promotion{p})[1], 1)
+	assert.Empty(t, promotion.Apply(lines[:1], []models.Promotion{p})[0])
+}
+
+func TestCovers(t *testing.T) {
+	author, category := uuid.New(), uuid.New()
+	line := promotion.Line{BookID: uuid.New(), PublisherID: uuid.New(), AuthorIDs: []uuid.UUID{author}, CategoryIDs: []uuid.UUID{uuid.New(), category}}
+
+	assert.True(t, promotion.Covers(models.Promotion{Scope: models.PromotionScopeAll}, line))
+	assert.True(t, promotion.Covers(models.Promotion{Scope: models.PromotionScopeBook, TargetIDs: []uuid.UUID{line.BookID}}, line))
+	assert.True(t, promotion.Covers(models.Promotion{Scope: models.PromotionScopePublisher, TargetIDs: []uuid.UUID{line.PublisherID}}, line))
+	assert.True(t, promotion.Covers(models.Promotion{Scope: models.PromotionScopeAuthor, TargetIDs: []uuid.UUID{author}}, line))
+	assert.True(t, promotion.Covers(models.Promotion{Scope: models.PromotionScopeCategory, TargetIDs: []uuid.UUID{category}}, line))
+	assert.False(t, promotion.Covers(models.Promotion{Scope: models.PromotionScopeBook, TargetIDs: []uuid.UUID{uuid.New()}}, line))
+}
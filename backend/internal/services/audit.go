@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/actor"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+)
+
+// fields that must never end up in the audit log
+var auditRedactedFields = map[string]bool{
+	"PasswordHash":     true,
+	"PickupCode":       true,
+	"PickupCodeSalt":   true,
+	"ShareToken":       true,
+	"UnsubscribeToken": true,
+}
+
+type AuditService struct {
+	repo interfaces.AuditRepositoryInterface
+}
+
+func NewAuditService(repo interfaces.AuditRepositoryInterface) *AuditService {
+	return &AuditService{repo: repo}
+}
+
+// Record stores a write operation together with the actor taken from ctx.
+// A failure to write the audit entry is logged and does not fail the operation.
+func (s *AuditService) Record(ctx context.Context, action string, entityType string, entityID uuid.UUID, before any, after any) {
+	changes, err := auditDiff(before, after)
+	if err != nil {
+		log.Printf("failed to build audit diff for %s %s: %v", entityType, entityID, err)
+		return
+	}
+
+	entry := &models.AuditLog{
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Changes:    changes,
+	}
+	if a, ok := actor.FromContext(ctx); ok {
+		actorID := a.UserID
+		entry.ActorID = &actorID
+		entry.ActorRole = a.Role
+		entry.IP = a.IP
+		entry.RequestID = a.RequestID
+	}
+
+	if err := s.repo.Create(ctx, entry); err != nil {
+		log.Printf("failed to record audit log for %s %s: %v", entityType, entityID, err)
+	}
+}
+
+func (s *AuditService) GetAll(ctx context.Context, filter dto.AuditFilter) ([]models.AuditLog, error) {
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return nil, apperrors.ErrBadRequest("'to' must not be before 'from'")
+	}
+	entries, err := s.repo.GetAll(ctx, filter)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return entries, nil
+}
+
+// auditDiff returns the changed top-level fields of two snapshots as
+// {"Field": {"old": ..., "new": ...}}. Belongs-to relations are skipped and
+// to-many relations are reduced to the list of their IDs.
+func auditDiff(before any, after any) (json.RawMessage, error) {
+	oldFields, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := make(map[string]dto.FieldChange)
+	for name, oldValue := range oldFields {
+		newValue, ok := newFields[name]
+		if !ok {
+			diff[name] = dto.FieldChange{Old: oldValue}
+			continue
+		}
+		if !reflect.DeepEqual(oldValue, newValue) {
+			diff[name] = dto.FieldChange{Old: oldValue, New: newValue}
+		}
+	}
+	for name, newValue := range newFields {
+		if _, ok := oldFields[name]; !ok {
+			diff[name] = dto.FieldChange{New: newValue}
+		}
+	}
+	return json.Marshal(diff)
+}
+
+func auditFields(snapshot any) (map[string]any, error) {
+	fields := make(map[string]any)
+	if snapshot == nil {
+		return fields, nil
+	}
+	if v := reflect.ValueOf(snapshot); v.Kind() == reflect.Pointer && v.IsNil() {
+		return fields, nil
+	}
+
+	raw, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+
+	for name, value := range decoded {
+		if auditRedactedFields[name] {
+			continue
+		}
+		switch v := value.(type) {
+		case map[string]any:
+			continue
+		case []any:
+			fields[name] = auditRelationIDs(v)
+		default:
+			fields[name] = value
+		}
+	}
+	return fields, nil
+}
+
+// auditRelationIDs reduces related records to their keys: the ID, or for
+// book contributors, which have none, the author and role.
+func auditRelationIDs(items []any) []any {
+	ids := make([]any, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			ids = append(ids, item)
+			continue
+		}
+		if id, ok := obj["ID"]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		if authorID, ok := obj["AuthorID"]; ok {
+			ids = append(ids, fmt.Sprintf("%v:%v", authorID, obj["Role"]))
+			continue
+		}
+		ids = append(ids, obj)
+	}
+	return ids
+}
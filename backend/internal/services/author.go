@@ -6,16 +6,20 @@ import (
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/mergepatch"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
 	"github.com/google/uuid"
 )
 
 type AuthorService struct {
-	repo interfaces.AuthorRepositoryInterface
+	repo  interfaces.AuthorRepositoryInterface
+	store interfaces.BlobStore
+	audit interfaces.AuditRecorderInterface
 }
 
-func NewAuthorService(repo interfaces.AuthorRepositoryInterface) *AuthorService {
-	return &AuthorService{repo: repo}
+// NewAuthorService creates the service; store holds the authors' photos.
+func NewAuthorService(repo interfaces.AuthorRepositoryInterface, store interfaces.BlobStore, audit interfaces.AuditRecorderInterface) *AuthorService {
+	return &AuthorService{repo: repo, store: store, audit: audit}
 }
 
 func (s *AuthorService) Create(ctx context.Context, input dto.AuthorInput) (*models.Author, error) {
@@ -28,6 +32,7 @@ func (s *AuthorService) Create(ctx context.Context, input dto.AuthorInput) (*mod
 	if err := s.repo.Create(ctx, author); err != nil {
 		return nil, apperrors.ErrInternal(err)
 	}
+	s.audit.Record(ctx, AuditActionCreate, "author", author.ID, nil, author)
 	return author, nil
 }
 
@@ -47,11 +52,15 @@ func (s *AuthorService) GetAll(ctx context.Context) ([]models.Author, error) {
 	return authors, nil
 }
 
-func (s *AuthorService) Update(ctx context.Context, id uuid.UUID, input dto.AuthorInput) (*models.Author, error) {
+func (s *AuthorService) Update(ctx context.Context, id uuid.UUID, version int64, input dto.AuthorInput) (*models.Author, error) {
 	author, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, apperrors.ErrNotFound("author not found")
 	}
+	if err := checkVersion("author", author.Version, version); err != nil {
+		return nil, err
+	}
+	before := *author
 
 	author.Surname = input.Surname
 	author.Name = input.Name
@@ -59,14 +68,44 @@ func (s *AuthorService) Update(ctx context.Context, id uuid.UUID, input dto.Auth
 	author.Info = input.Info
 
 	if err := s.repo.Update(ctx, author); err != nil {
-		return nil, apperrors.ErrInternal(err)
+		return nil, versionedWriteError("author", err)
 	}
+	s.audit.Record(ctx, AuditActionUpdate, "author", author.ID, &before, author)
 	return author, nil
 }
 
-func (s *AuthorService) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := s.repo.Delete(ctx, id); err != nil {
-		return apperrors.ErrInternal(err)
+// Patch applies a JSON merge patch to the author and saves the result.
+func (s *AuthorService) Patch(ctx context.Context, id uuid.UUID, version int64, patch []byte) (*models.Author, error) {
+	author, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("author not found")
+	}
+
+	input := dto.AuthorInput{
+		Surname:    author.Surname,
+		Name:       author.Name,
+		Patronymic: author.Patronymic,
+		Info:       author.Info,
+	}
+	if err := mergepatch.ApplyTo(&input, patch); err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+	return s.Update(ctx, id, version, input)
+}
+
+func (s *AuthorService) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	author, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return apperrors.ErrNotFound("author not found")
+	}
+	if err := checkVersion("author", author.Version, version); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id, version); err != nil {
+		return versionedWriteError("author", err)
 	}
+	removeImage(ctx, s.store, author.Photo)
+	s.audit.Record(ctx, AuditActionDelete, "author", id, author, nil)
 	return nil
 }
\ No newline at end of file
@@ -2,34 +2,54 @@ package services
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/currency"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/isbn"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/mergepatch"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
 	"github.com/google/uuid"
 )
 
 type BookService struct {
-	repo interfaces.BookRepositoryInterface
+	repo   interfaces.BookRepositoryInterface
+	series interfaces.SeriesRepositoryInterface
+	prices interfaces.PriceLocalizerInterface
+	store  interfaces.BlobStore
+	audit  interfaces.AuditRecorderInterface
 }
 
-func NewBookService(repo interfaces.BookRepositoryInterface) *BookService {
-	return &BookService{repo: repo}
+// NewBookService creates the service; prices converts the editions of the
+// books it returns to the customer's currency, and store holds their covers.
+func NewBookService(repo interfaces.BookRepositoryInterface, series interfaces.SeriesRepositoryInterface, prices interfaces.PriceLocalizerInterface, store interfaces.BlobStore, audit interfaces.AuditRecorderInterface) *BookService {
+	return &BookService{repo: repo, series: series, prices: prices, store: store, audit: audit}
 }
 
 func (s *BookService) Create(ctx context.Context, input dto.BookInput) (*models.Book, error) {
+	contributors, err := contributorsFromInput(input.Contributors)
+	if err != nil {
+		return nil, err
+	}
+	language, err := normalizeLanguage(input.Language)
+	if err != nil {
+		return nil, err
+	}
 	book := &models.Book{
-		Title: input.Title,
+		Title:       input.Title,
 		Description: input.Description,
-		Price: input.Price,
-		Stock: input.Stock,
-		AuthorID: input.AuthorID,
-		PublisherID: input.PublisherID,
+		Language:    language,
+	}
+	if err := s.setSeries(ctx, book, input.SeriesID, input.SeriesPosition); err != nil {
+		return nil, err
 	}
-	if err := s.repo.Create(ctx, book, input.CategoryIDs); err != nil {
+	if err := s.repo.Create(ctx, book, contributors, input.CategoryIDs); err != nil {
 		return nil, apperrors.ErrInternal(err)
 	}
+	s.audit.Record(ctx, AuditActionCreate, "book", book.ID, nil, book)
 	return book, nil
 }
 
@@ -41,39 +61,261 @@ func (s *BookService) GetByID(ctx context.Context, id uuid.UUID) (*models.Book,
 	return book, nil
 }
 
+// GetDetails returns the book with its series and the previous and next
+// books in reading order.
+func (s *BookService) GetDetails(ctx context.Context, id uuid.UUID) (*dto.BookDetails, error) {
+	book, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.prices.Localize(ctx, bookEditions(book)); err != nil {
+		return nil, err
+	}
+	details := &dto.BookDetails{Book: book}
+	if book.SeriesID == nil {
+		return details, nil
+	}
+	series, err := s.series.GetByID(ctx, *book.SeriesID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	details.Series = seriesInfo(series, book.ID)
+	return details, nil
+}
+
+// GetByISBN returns the book one of whose editions has the ISBN. It accepts
+// an ISBN-10 or ISBN-13 with or without hyphens.
+func (s *BookService) GetByISBN(ctx context.Context, raw string) (*models.Book, error) {
+	isbn13, err := isbn.Normalize(raw)
+	if err != nil {
+		return nil, apperrors.ErrBadRequest("invalid ISBN")
+	}
+	book, err := s.repo.GetByISBN(ctx, isbn13)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("book not found")
+	}
+	if err := s.prices.Localize(ctx, bookEditions(book)); err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
 func (s *BookService) GetAll(ctx context.Context, filter dto.BookFilter) ([]models.Book, error) {
+	if err := normalizeBookFilter(ctx, s.prices, &filter); err != nil {
+		return nil, err
+	}
 	books, err := s.repo.GetAll(ctx, filter)
 	if err != nil {
 		return nil, apperrors.ErrInternal(err)
 	}
+	refs := make([]*models.Book, len(books))
+	for i := range books {
+		refs[i] = &books[i]
+	}
+	if err := s.prices.Localize(ctx, bookEditions(refs...)); err != nil {
+		return nil, err
+	}
 	return books, nil
 }
 
-func (s *BookService) Update(ctx context.Context, id uuid.UUID, input dto.BookInput) (*models.Book, error) {
+// normalizeBookFilter checks the filter and brings it to the form the
+// repositories match, so the catalog and its export find the same books:
+// ISBNs become ISBN-13, and prices, given in the customer's currency, are
+// matched against what the customer is shown, fixed prices in that
+// currency included.
+func normalizeBookFilter(ctx context.Context, prices interfaces.PriceLocalizerInterface, filter *dto.BookFilter) error {
+	if filter.ISBN != nil {
+		isbn13, err := isbn.Normalize(*filter.ISBN)
+		if err != nil {
+			return apperrors.ErrBadRequest("invalid ISBN")
+		}
+		filter.ISBN = &isbn13
+	}
+	if filter.Format != nil && !editionFormats[*filter.Format] {
+		return apperrors.ErrBadRequest("unknown edition format: " + *filter.Format)
+	}
+	if filter.MinPrice != nil || filter.MaxPrice != nil {
+		code, rate, err := prices.PreferredRate(ctx)
+		if err != nil {
+			return err
+		}
+		if code != currency.Base {
+			filter.PriceCurrency, filter.PriceRate = code, rate
+		}
+	}
+	return nil
+}
+
+func (s *BookService) Update(ctx context.Context, id uuid.UUID, version int64, input dto.BookInput) (*models.Book, error) {
 	book, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, apperrors.ErrNotFound("book not found")
 	}
+	if err := checkVersion("book", book.Version, version); err != nil {
+		return nil, err
+	}
+	contributors, err := contributorsFromInput(input.Contributors)
+	if err != nil {
+		return nil, err
+	}
+	language, err := normalizeLanguage(input.Language)
+	if err != nil {
+		return nil, err
+	}
+	before := *book
+
+	if err := s.setSeries(ctx, book, input.SeriesID, input.SeriesPosition); err != nil {
+		return nil, err
+	}
 	book.Title = input.Title
 	book.Description = input.Description
-	book.Price = input.Price
-	book.Stock = input.Stock
-	book.AuthorID = input.AuthorID
-	book.PublisherID = input.PublisherID
+	book.Language = language
+
+	if err := s.repo.Update(ctx, book, contributors, input.CategoryIDs); err != nil {
+		return nil, versionedWriteError("book", err)
+	}
 
-	if err := s.repo.Update(ctx, book, input.CategoryIDs); err != nil {
+	// reload to get the new relations
+	updated, err := s.repo.GetByID(ctx, id)
+	if err != nil {
 		return nil, apperrors.ErrInternal(err)
 	}
-	return book, nil
+	s.audit.Record(ctx, AuditActionUpdate, "book", id, &before, updated)
+	return updated, nil
+}
+
+// Patch applies a JSON merge patch to the book and saves the result.
+// Omitted fields, including category_ids, keep their current values.
+func (s *BookService) Patch(ctx context.Context, id uuid.UUID, version int64, patch []byte) (*models.Book, error) {
+	book, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("book not found")
+	}
+
+	categoryIDs := make([]uuid.UUID, len(book.Categories))
+	for i, category := range book.Categories {
+		categoryIDs[i] = category.ID
+	}
+	contributors := make([]dto.ContributorInput, len(book.Contributors))
+	for i, contributor := range book.Contributors {
+		contributors[i] = dto.ContributorInput{AuthorID: contributor.AuthorID, Role: contributor.Role}
+	}
+	input := dto.BookInput{
+		Title:          book.Title,
+		Description:    book.Description,
+		Language:       book.Language,
+		Contributors:   contributors,
+		CategoryIDs:    categoryIDs,
+		SeriesID:       book.SeriesID,
+		SeriesPosition: book.SeriesPosition,
+	}
+	if err := mergepatch.ApplyTo(&input, patch); err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+	return s.Update(ctx, id, version, input)
 }
 
-func (s *BookService) Delete(ctx context.Context, id uuid.UUID) error {
-	if _, err := s.repo.GetByID(ctx, id); err != nil {
+func (s *BookService) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	book, err := s.repo.GetByID(ctx, id)
+	if err != nil {
 		return apperrors.ErrNotFound("book not found")
 	}
-	
-	if err := s.repo.Delete(ctx, id); err != nil {
-		return apperrors.ErrInternal(err)
+	if err := checkVersion("book", book.Version, version); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id, version); err != nil {
+		if errors.Is(err, apperrors.ErrForeignKey) {
+			return apperrors.ErrConflict("the book has editions that have been ordered or are in carts")
+		}
+		return versionedWriteError("book", err)
+	}
+	removeImage(ctx, s.store, book.Cover)
+	s.audit.Record(ctx, AuditActionDelete, "book", id, book, nil)
+	return nil
+}
+
+// setSeries places the book in a series. A position is only meaningful
+// within a series and must be positive.
+func (s *BookService) setSeries(ctx context.Context, book *models.Book, seriesID *uuid.UUID, position *float64) error {
+	if seriesID == nil {
+		if position != nil {
+			return apperrors.ErrBadRequest("series_position requires series_id")
+		}
+		book.SeriesID, book.SeriesPosition = nil, nil
+		return nil
+	}
+	if position != nil && *position <= 0 {
+		return apperrors.ErrBadRequest("series_position must be positive")
 	}
+	if book.SeriesID == nil || *book.SeriesID != *seriesID {
+		if _, err := s.series.GetByID(ctx, *seriesID); err != nil {
+			return apperrors.ErrBadRequest("series not found")
+		}
+	}
+	book.SeriesID, book.SeriesPosition = seriesID, position
 	return nil
-}
\ No newline at end of file
+}
+
+const defaultBookLanguage = "ru"
+
+// normalizeLanguage checks that a book's language is a two-letter ISO
+// 639-1 code; books without one are in Russian.
+func normalizeLanguage(raw string) (string, error) {
+	language := strings.ToLower(strings.TrimSpace(raw))
+	if language == "" {
+		return defaultBookLanguage, nil
+	}
+	if len(language) != 2 || language[0] < 'a' || language[0] > 'z' || language[1] < 'a' || language[1] > 'z' {
+		return "", apperrors.ErrBadRequest("language must be a two-letter ISO 639-1 code")
+	}
+	return language, nil
+}
+
+var contributorRoles = map[string]bool{
+	models.ContributorRoleAuthor:      true,
+	models.ContributorRoleTranslator:  true,
+	models.ContributorRoleEditor:      true,
+	models.ContributorRoleIllustrator: true,
+}
+
+// contributorsFromInput validates the contributor list of a book. Every book
+// needs at least one contributor and a person may hold each role only once.
+func contributorsFromInput(input []dto.ContributorInput) ([]models.BookContributor, error) {
+	if len(input) == 0 {
+		return nil, apperrors.ErrBadRequest("book must have at least one contributor")
+	}
+	contributors := make([]models.BookContributor, 0, len(input))
+	type contributorKey struct {
+		authorID uuid.UUID
+		role     string
+	}
+	seen := make(map[contributorKey]bool, len(input))
+	for _, item := range input {
+		role := strings.ToLower(strings.TrimSpace(item.Role))
+		if role == "" {
+			role = models.ContributorRoleAuthor
+		}
+		if !contributorRoles[role] {
+			return nil, apperrors.ErrBadRequest("unknown contributor role: " + item.Role)
+		}
+		if item.AuthorID == uuid.Nil {
+			return nil, apperrors.ErrBadRequest("contributor author_id is required")
+		}
+		key := contributorKey{authorID: item.AuthorID, role: role}
+		if seen[key] {
+			return nil, apperrors.ErrBadRequest("duplicate contributor " + item.AuthorID.String() + " as " + role)
+		}
+		seen[key] = true
+		contributors = append(contributors, models.BookContributor{AuthorID: item.AuthorID, Role: role, Position: len(contributors)})
+	}
+	return contributors, nil
+}
+
+func bookEditions(books ...*models.Book) []*models.Edition {
+	var editions []*models.Edition
+	for _, book := range books {
+		editions = append(editions, book.Editions...)
+	}
+	return editions
+}
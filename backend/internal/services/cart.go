@@ -0,0 +1,273 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/promotion"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/tax"
+	"github.com/google/uuid"
+)
+
+const maxCartItems = 100
+
+// CartService keeps customers' carts and prices them: in the customer's
+// currency, with the running promotions and the entered coupon applied,
+// and with the VAT included in each line worked out.
+type CartService struct {
+	repo       interfaces.CartRepositoryInterface
+	editions   interfaces.EditionRepositoryInterface
+	promotions interfaces.PromotionRepositoryInterface
+	prices     interfaces.PriceLocalizerInterface
+	rates      tax.Rates
+}
+
+func NewCartService(repo interfaces.CartRepositoryInterface, editions interfaces.EditionRepositoryInterface, promotions interfaces.PromotionRepositoryInterface, prices interfaces.PriceLocalizerInterface, rates tax.Rates) *CartService {
+	return &CartService{repo: repo, editions: editions, promotions: promotions, prices: prices, rates: rates}
+}
+
+// Get returns the priced cart. An empty couponCode means no coupon.
+func (s *CartService) Get(ctx context.Context, userID uuid.UUID, couponCode string) (*dto.Cart, error) {
+	cart, err := s.repo.GetByUser(ctx, userID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return s.price(ctx, userID, cart, couponCode)
+}
+
+// SetItem sets how many copies of the edition are in the cart.
+func (s *CartService) SetItem(ctx context.Context, userID, editionID uuid.UUID, input dto.CartItemInput) (*dto.Cart, error) {
+	if input.Quantity < 1 || input.Quantity > maxMoveToCartQuantity {
+		return nil, apperrors.ErrBadRequest("quantity must be between 1 and 99")
+	}
+	edition, err := s.editions.GetByID(ctx, editionID)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("edition not found")
+	}
+	if edition.Stock < input.Quantity {
+		return nil, apperrors.ErrConflict("not enough copies in stock")
+	}
+	cart, err := s.repo.GetByUser(ctx, userID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	if len(cart.Items) >= maxCartItems && findCartItem(cart, editionID) == nil {
+		return nil, apperrors.ErrConflict("cart is full")
+	}
+
+	if err := s.repo.SetItem(ctx, userID, editionID, input.Quantity); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return s.Get(ctx, userID, "")
+}
+
+func (s *CartService) RemoveItem(ctx context.Context, userID, editionID uuid.UUID) (*dto.Cart, error) {
+	if err := s.repo.RemoveItem(ctx, userID, editionID); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return s.Get(ctx, userID, "")
+}
+
+func (s *CartService) price(ctx context.Context, userID uuid.UUID, cart *models.Cart, couponCode string) (*dto.Cart, error) {
+	code, rate, err := s.prices.PreferredRate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &dto.Cart{Items: make([]dto.CartLine, 0, len(cart.Items)), Currency: code, Rate: rate}
+
+	// price copies, so the editions of the cart keep their base prices
+	editions := make([]*models.Edition, len(cart.Items))
+	for i, item := range cart.Items {
+		edition := *item.Edition
+		editions[i] = &edition
+	}
+	if err := s.prices.Localize(ctx, editions); err != nil {
+		return nil, err
+	}
+	lines, err := s.promotionLines(ctx, cart, editions)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range lines {
+		result.Subtotal += line.Price.Mul(line.Quantity)
+	}
+
+	running, err := s.promotions.GetRunning(ctx)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	var coupon *models.Promotion
+	if couponCode != "" {
+		coupon, result.CouponError, err = s.coupon(ctx, userID, couponCode)
+		if err != nil {
+			return nil, err
+		}
+		if coupon != nil {
+			result.CouponCode = coupon.CouponCode
+			running = append(running, *coupon)
+		} else {
+			result.CouponCode = &couponCode
+		}
+	}
+	for i := range running {
+		if err := convertPromotion(&running[i], rate); err != nil {
+			return nil, apperrors.ErrInternal(err)
+		}
+	}
+
+	applied := promotion.Apply(lines, running)
+	taxed := make([]tax.Line, 0, len(cart.Items))
+	for i, item := range cart.Items {
+		line := dto.CartLine{
+			Edition:    editions[i],
+			Quantity:   item.Quantity,
+			Price:      lines[i].Price,
+			Subtotal:   lines[i].Price.Mul(item.Quantity),
+			Discount:   promotion.Total(applied[i]),
+			Promotions: applied[i],
+		}
+		if line.Promotions == nil {
+			line.Promotions = []models.AppliedPromotion{}
+		}
+		line.Total = line.Subtotal - line.Discount
+		line.TaxRate = s.rates.For(item.Edition.Book)
+		line.Tax = tax.Included(line.Total, line.TaxRate)
+		taxed = append(taxed, tax.Line{Rate: line.TaxRate, Total: line.Total, Tax: line.Tax})
+		result.Discount += line.Discount
+		result.Tax += line.Tax
+		result.Items = append(result.Items, line)
+		for _, a := range applied[i] {
+			if coupon != nil && a.PromotionID == coupon.ID {
+				result.Coupon = coupon
+			}
+		}
+	}
+	result.Total = result.Subtotal - result.Discount
+	result.Taxes = tax.Breakdown(taxed)
+
+	if coupon != nil && result.Coupon == nil {
+		if minimum, err := rate.Convert(coupon.MinOrderTotal); err == nil && result.Subtotal < minimum {
+			result.CouponError = "coupon needs an order of at least " + minimum.String() + " " + code
+		} else if outranked(lines, running[len(running)-1]) { // the converted coupon, appended last
+			// the coupon would apply, but exclusive promotions save more;
+			// the order goes through without it
+			result.CouponNotice = "a better promotion applies instead of the coupon"
+		} else {
+			result.CouponError = "coupon does not apply to the items in your cart"
+		}
+	}
+	return result, nil
+}
+
+// coupon finds the promotion of a coupon code and checks that the user may
+// use it now. If not, it returns why.
+func (s *CartService) coupon(ctx context.Context, userID uuid.UUID, raw string) (*models.Promotion, string, error) {
+	code, err := normalizeCouponCode(raw)
+	if err != nil {
+		return nil, "unknown coupon code", nil
+	}
+	coupon, err := s.promotions.GetByCouponCode(ctx, code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "unknown coupon code", nil
+	}
+	if err != nil {
+		return nil, "", apperrors.ErrInternal(err)
+	}
+	if !coupon.Active || coupon.StartsAt.After(time.Now()) {
+		return nil, "unknown coupon code", nil
+	}
+	if !promotionRunning(coupon, time.Now()) {
+		return nil, "coupon has expired", nil
+	}
+	if coupon.UsageLimit != nil || coupon.PerUserLimit != nil {
+		total, byUser, err := s.promotions.CountRedemptions(ctx, coupon.ID, userID)
+		if err != nil {
+			return nil, "", apperrors.ErrInternal(err)
+		}
+		if coupon.UsageLimit != nil && total >= *coupon.UsageLimit {
+			return nil, "coupon has been used up", nil
+		}
+		if coupon.PerUserLimit != nil && byUser >= *coupon.PerUserLimit {
+			return nil, "you have already used this coupon", nil
+		}
+	}
+	return coupon, "", nil
+}
+
+// outranked reports whether the coupon would give a discount on its own,
+// so that it only lost to promotions that save more.
+func outranked(lines []promotion.Line, coupon models.Promotion) bool {
+	for _, applied := range promotion.Apply(lines, []models.Promotion{coupon}) {
+		if promotion.Total(applied) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// promotionLines describes the cart items to the promotion engine, with
+// the prices already in the customer's currency.
+func (s *CartService) promotionLines(ctx context.Context, cart *models.Cart, editions []*models.Edition) ([]promotion.Line, error) {
+	var categoryIDs []uuid.UUID
+	for _, item := range cart.Items {
+		if item.Edition.Book == nil {
+			continue
+		}
+		for _, category := range item.Edition.Book.Categories {
+			categoryIDs = append(categoryIDs, category.ID)
+		}
+	}
+	ancestors, err := s.promotions.GetCategoryAncestors(ctx, uniqueIDs(categoryIDs))
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+
+	lines := make([]promotion.Line, len(cart.Items))
+	for i, item := range cart.Items {
+		line := promotion.Line{
+			BookID:      item.Edition.BookID,
+			PublisherID: item.Edition.PublisherID,
+			Quantity:    item.Quantity,
+			Price:       editions[i].Price,
+		}
+		if book := item.Edition.Book; book != nil {
+			for _, contributor := range book.Contributors {
+				if contributor.Role == models.ContributorRoleAuthor {
+					line.AuthorIDs = append(line.AuthorIDs, contributor.AuthorID)
+				}
+			}
+			for _, category := range book.Categories {
+				line.CategoryIDs = append(line.CategoryIDs, ancestors[category.ID]...)
+			}
+		}
+		lines[i] = line
+	}
+	return lines, nil
+}
+
+// convertPromotion turns the amounts of a promotion, kept in the base
+// currency, into the cart's currency.
+func convertPromotion(p *models.Promotion, rate money.Rate) error {
+	var err error
+	if p.Amount, err = rate.Convert(p.Amount); err != nil {
+		return err
+	}
+	p.MinOrderTotal, err = rate.Convert(p.MinOrderTotal)
+	return err
+}
+
+func findCartItem(cart *models.Cart, editionID uuid.UUID) *models.CartItem {
+	for _, item := range cart.Items {
+		if item.EditionID == editionID {
+			return item
+		}
+	}
+	return nil
+}
@@ -2,26 +2,41 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/mergepatch"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/slug"
 	"github.com/google/uuid"
 )
 
+// fallbackCategorySlug is used when nothing of the name survives slugging.
+const fallbackCategorySlug = "category"
+
 type CategoryService struct {
-	repo interfaces.CategoryRepositoryInterface
+	repo  interfaces.CategoryRepositoryInterface
+	audit interfaces.AuditRecorderInterface
 }
 
-func NewCategoryService(repo interfaces.CategoryRepositoryInterface) *CategoryService {
-	return &CategoryService{repo: repo}
+func NewCategoryService(repo interfaces.CategoryRepositoryInterface, audit interfaces.AuditRecorderInterface) *CategoryService {
+	return &CategoryService{repo: repo, audit: audit}
 }
 
-func (s *CategoryService) Create(ctx context.Context, name string) (*models.Category, error) {
-	category := &models.Category{Name: name}
+func (s *CategoryService) Create(ctx context.Context, input dto.CategoryInput) (*models.Category, error) {
+	category := &models.Category{}
+	if err := s.apply(ctx, category, input); err != nil {
+		return nil, err
+	}
 	if err := s.repo.Create(ctx, category); err != nil {
 		return nil, err
 	}
+	s.audit.Record(ctx, AuditActionCreate, "category", category.ID, nil, category)
 	return category, nil
 }
 
@@ -33,25 +48,222 @@ func (s *CategoryService) GetByID(ctx context.Context, id uuid.UUID) (*models.Ca
 	return category, nil
 }
 
+func (s *CategoryService) GetBySlug(ctx context.Context, slug string) (*models.Category, error) {
+	category, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("category not found")
+	}
+	return category, nil
+}
+
 func (s *CategoryService) GetAll(ctx context.Context) ([]models.Category, error) {
 	return s.repo.GetAll(ctx)
 }
 
-func (s *CategoryService) Update(ctx context.Context, id uuid.UUID, name string) (*models.Category, error) {
+// GetTree returns the root categories with their descendants nested in
+// Children, siblings ordered by sort order and then name.
+func (s *CategoryService) GetTree(ctx context.Context) ([]*models.Category, error) {
+	categories, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+
+	nodes := make(map[uuid.UUID]*models.Category, len(categories))
+	for i := range categories {
+		categories[i].Children = []*models.Category{}
+		nodes[categories[i].ID] = &categories[i]
+	}
+	roots := []*models.Category{}
+	for i := range categories {
+		node := &categories[i]
+		if node.ParentID == nil {
+			roots = append(roots, node)
+			continue
+		}
+		parent, ok := nodes[*node.ParentID]
+		if !ok {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+	return roots, nil
+}
+
+// GetBreadcrumbs returns the path from the root category down to id.
+func (s *CategoryService) GetBreadcrumbs(ctx context.Context, id uuid.UUID) ([]models.Category, error) {
+	path, err := s.repo.GetAncestors(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	if len(path) == 0 {
+		return nil, apperrors.ErrNotFound("category not found")
+	}
+	return path, nil
+}
+
+func (s *CategoryService) Update(ctx context.Context, id uuid.UUID, version int64, input dto.CategoryInput) (*models.Category, error) {
 	category, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("category not found")
 	}
-	category.Name = name
+	if err := checkVersion("category", category.Version, version); err != nil {
+		return nil, err
+	}
+	before := *category
+
+	if err := s.apply(ctx, category, input); err != nil {
+		return nil, err
+	}
 	if err := s.repo.Update(ctx, category); err != nil {
+		if errors.Is(err, apperrors.ErrVersionConflict) {
+			return nil, errModified("category")
+		}
+		if errors.Is(err, apperrors.ErrCategoryCycle) {
+			return nil, apperrors.ErrBadRequest("category cannot be moved under its own subcategory")
+		}
 		return nil, fmt.Errorf("failed to update category")
 	}
+	s.audit.Record(ctx, AuditActionUpdate, "category", category.ID, &before, category)
 	return category, nil
 }
 
-func (s *CategoryService) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := s.repo.Delete(ctx, id); err != nil {
+// Patch applies a JSON merge patch to the category and saves the result.
+func (s *CategoryService) Patch(ctx context.Context, id uuid.UUID, version int64, patch []byte) (*models.Category, error) {
+	category, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("category not found")
+	}
+
+	input := dto.CategoryInput{
+		Name:      category.Name,
+		Slug:      category.Slug,
+		ParentID:  category.ParentID,
+		SortOrder: category.SortOrder,
+	}
+	if err := mergepatch.ApplyTo(&input, patch); err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+	return s.Update(ctx, id, version, input)
+}
+
+func (s *CategoryService) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	category, err := s.repo.GetByID(ctx, id)
+	if err != nil {
 		return fmt.Errorf("category not found")
 	}
+	if err := checkVersion("category", category.Version, version); err != nil {
+		return err
+	}
+	hasChildren, err := s.repo.HasChildren(ctx, id)
+	if err != nil {
+		return apperrors.ErrInternal(err)
+	}
+	if hasChildren {
+		return apperrors.ErrConflict("category has subcategories")
+	}
+
+	if err := s.repo.Delete(ctx, id, version); err != nil {
+		if errors.Is(err, apperrors.ErrVersionConflict) {
+			return errModified("category")
+		}
+		return fmt.Errorf("category not found")
+	}
+	s.audit.Record(ctx, AuditActionDelete, "category", id, category, nil)
+	return nil
+}
+
+// apply validates the input and copies it to the category.
+func (s *CategoryService) apply(ctx context.Context, category *models.Category, input dto.CategoryInput) error {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return apperrors.ErrBadRequest("category name is required")
+	}
+	if err := s.setParent(ctx, category, input.ParentID); err != nil {
+		return err
+	}
+	if err := s.setSlug(ctx, category, name, strings.TrimSpace(input.Slug)); err != nil {
+		return err
+	}
+	category.Name = name
+	category.SortOrder = input.SortOrder
 	return nil
-}
\ No newline at end of file
+}
+
+// setParent moves the category under parentID, refusing moves that would
+// make the category its own ancestor.
+func (s *CategoryService) setParent(ctx context.Context, category *models.Category, parentID *uuid.UUID) error {
+	if parentID == nil {
+		category.ParentID = nil
+		return nil
+	}
+	if category.ParentID != nil && *category.ParentID == *parentID {
+		return nil
+	}
+	if category.ID != uuid.Nil && *parentID == category.ID {
+		return apperrors.ErrBadRequest("category cannot be its own parent")
+	}
+
+	path, err := s.repo.GetAncestors(ctx, *parentID)
+	if err != nil {
+		return apperrors.ErrInternal(err)
+	}
+	if len(path) == 0 {
+		return apperrors.ErrBadRequest("parent category not found")
+	}
+	for _, ancestor := range path {
+		if ancestor.ID == category.ID {
+			return apperrors.ErrBadRequest("category cannot be moved under its own subcategory")
+		}
+	}
+	id := *parentID
+	category.ParentID = &id
+	return nil
+}
+
+// setSlug keeps an explicitly requested slug if it is valid and free. With
+// none requested a category keeps its slug, so renaming it does not break
+// links to it, and a new one gets a unique slug generated from the name.
+func (s *CategoryService) setSlug(ctx context.Context, category *models.Category, name, requested string) error {
+	if requested != "" {
+		if requested == category.Slug {
+			return nil
+		}
+		if !slug.Valid(requested) {
+			return apperrors.ErrBadRequest("invalid slug: " + requested)
+		}
+		existing, err := s.repo.GetBySlug(ctx, requested)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrInternal(err)
+		}
+		if err == nil && existing.ID != category.ID {
+			return apperrors.ErrConflict("slug already in use: " + requested)
+		}
+		category.Slug = requested
+		return nil
+	}
+	if category.Slug != "" {
+		return nil
+	}
+
+	base := slug.Make(name)
+	if base == "" {
+		base = fallbackCategorySlug
+	}
+	candidate := base
+	for n := 2; ; n++ {
+		existing, err := s.repo.GetBySlug(ctx, candidate)
+		if errors.Is(err, sql.ErrNoRows) {
+			break
+		}
+		if err != nil {
+			return apperrors.ErrInternal(err)
+		}
+		if existing.ID == category.ID {
+			break
+		}
+		candidate = fmt.Sprintf("%s-%d", base, n)
+	}
+	category.Slug = candidate
+	return nil
+}
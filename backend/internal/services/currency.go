@@ -0,0 +1,396 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/currency"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/google/uuid"
+)
+
+const (
+	maxCurrencyNameLength = 100
+	maxRateImportRows     = 10000
+)
+
+// CurrencyService manages the currencies the store sells in, their
+// exchange rates and per-edition price overrides, and converts catalog
+// prices into the customer's currency.
+type CurrencyService struct {
+	repo     interfaces.CurrencyRepositoryInterface
+	editions interfaces.EditionRepositoryInterface
+	audit    interfaces.AuditRecorderInterface
+}
+
+func NewCurrencyService(repo interfaces.CurrencyRepositoryInterface, editions interfaces.EditionRepositoryInterface, audit interfaces.AuditRecorderInterface) *CurrencyService {
+	return &CurrencyService{repo: repo, editions: editions, audit: audit}
+}
+
+func (s *CurrencyService) GetAll(ctx context.Context) ([]dto.CurrencyInfo, error) {
+	currencies, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	now := time.Now()
+	infos := make([]dto.CurrencyInfo, 0, len(currencies))
+	for _, c := range currencies {
+		info := dto.CurrencyInfo{Code: c.Code, Name: c.Name, Base: c.Code == currency.Base}
+		if info.Base {
+			one := money.One
+			info.Rate = &one
+		} else if rate, err := s.repo.GetRate(ctx, c.Code, now); err == nil {
+			info.Rate = &rate.Rate
+			info.ValidFrom = &rate.ValidFrom
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (s *CurrencyService) Create(ctx context.Context, input dto.CurrencyInput) (*models.Currency, error) {
+	code, err := currency.Normalize(input.Code)
+	if err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, apperrors.ErrBadRequest("name is required")
+	}
+	if utf8.RuneCountInString(name) > maxCurrencyNameLength {
+		return nil, apperrors.ErrBadRequest("name is too long")
+	}
+	if _, err := s.repo.GetByCode(ctx, code); err == nil {
+		return nil, apperrors.ErrConflict("currency already exists")
+	}
+
+	c := &models.Currency{Code: code, Name: name}
+	if err := s.repo.Create(ctx, c); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "currency", c.ID, nil, c)
+	return c, nil
+}
+
+// GetRates returns the rate history of the currency, newest first.
+func (s *CurrencyService) GetRates(ctx context.Context, code string) ([]models.ExchangeRate, error) {
+	code, err := s.known(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := s.repo.GetRates(ctx, code)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return rates, nil
+}
+
+func (s *CurrencyService) AddRate(ctx context.Context, code string, input dto.ExchangeRateInput) (*models.ExchangeRate, error) {
+	code, err := s.known(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := newExchangeRate(code, input.Rate, input.ValidFrom, models.ExchangeRateSourceManual)
+	if err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+	if err := s.repo.AddRates(ctx, []*models.ExchangeRate{rate}); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "exchange_rate", rate.ID, nil, rate)
+	return rate, nil
+}
+
+// ImportRates reads a CSV file with the columns currency, rate and,
+// optionally, valid_from (a date or an RFC 3339 time; now if empty). The
+// file is imported only if every line is valid.
+func (s *CurrencyService) ImportRates(ctx context.Context, r io.Reader) (*dto.RateImportReport, error) {
+	rows, err := parseRatesCSV(r)
+	if err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+	currencies, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	known := make(map[string]bool, len(currencies))
+	for _, c := range currencies {
+		known[c.Code] = true
+	}
+
+	var errs []string
+	rates := make([]*models.ExchangeRate, 0, len(rows))
+	for _, row := range rows {
+		rate, err := row.rate(known)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("line %d: %v", row.line, err))
+			continue
+		}
+		rates = append(rates, rate)
+	}
+	if len(errs) > 0 {
+		return nil, apperrors.ErrBadRequest(strings.Join(errs, "; "))
+	}
+	if len(rates) == 0 {
+		return nil, apperrors.ErrBadRequest("file has no rates")
+	}
+
+	if err := s.repo.AddRates(ctx, rates); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	for _, rate := range rates {
+		s.audit.Record(ctx, AuditActionCreate, "exchange_rate", rate.ID, nil, rate)
+	}
+	return &dto.RateImportReport{Imported: len(rates)}, nil
+}
+
+func (s *CurrencyService) GetEditionPrices(ctx context.Context, editionID uuid.UUID) ([]models.EditionPrice, error) {
+	if _, err := s.editions.GetByID(ctx, editionID); err != nil {
+		return nil, apperrors.ErrNotFound("edition not found")
+	}
+	prices, err := s.repo.GetPricesForEdition(ctx, editionID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return prices, nil
+}
+
+// SetEditionPrice fixes the edition's price in a currency other than the
+// base one, where the edition's own price applies.
+func (s *CurrencyService) SetEditionPrice(ctx context.Context, editionID uuid.UUID, code string, input dto.EditionPriceInput) (*models.EditionPrice, error) {
+	code, err := s.known(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if code == currency.Base {
+		return nil, apperrors.ErrBadRequest("the price in the base currency is the edition's own price")
+	}
+	if input.Price < 0 {
+		return nil, apperrors.ErrBadRequest("price must not be negative")
+	}
+	if _, err := s.editions.GetByID(ctx, editionID); err != nil {
+		return nil, apperrors.ErrNotFound("edition not found")
+	}
+
+	price := &models.EditionPrice{EditionID: editionID, Currency: code, Price: input.Price}
+	if err := s.repo.SetEditionPrice(ctx, price); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "edition_price", editionID, nil, price)
+	return price, nil
+}
+
+func (s *CurrencyService) DeleteEditionPrice(ctx context.Context, editionID uuid.UUID, code string) error {
+	code, err := currency.Normalize(code)
+	if err != nil {
+		return apperrors.ErrBadRequest(err.Error())
+	}
+	if err := s.repo.DeleteEditionPrice(ctx, editionID, code); err != nil {
+		return apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionDelete, "edition_price", editionID, &models.EditionPrice{EditionID: editionID, Currency: code}, nil)
+	return nil
+}
+
+// Localize converts the editions' prices into the request's preferred
+// currency, using an edition's fixed price in that currency where it has
+// one, and sets their Currency.
+func (s *CurrencyService) Localize(ctx context.Context, editions []*models.Edition) error {
+	code := currency.Preferred(ctx)
+	if code == currency.Base {
+		for _, edition := range editions {
+			edition.Currency = code
+		}
+		return nil
+	}
+	rate, err := s.rate(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]uuid.UUID, len(editions))
+	for i, edition := range editions {
+		ids[i] = edition.ID
+	}
+	overrides, err := s.repo.GetEditionPrices(ctx, ids, code)
+	if err != nil {
+		return apperrors.ErrInternal(err)
+	}
+	fixed := make(map[uuid.UUID]money.Amount, len(overrides))
+	for _, override := range overrides {
+		fixed[override.EditionID] = override.Price
+	}
+
+	for _, edition := range editions {
+		price, ok := fixed[edition.ID]
+		if !ok {
+			if price, err = rate.Convert(edition.Price); err != nil {
+				return apperrors.ErrInternal(err)
+			}
+		}
+		edition.Price = price
+		edition.Currency = code
+	}
+	return nil
+}
+
+// ToBase converts an amount in the request's preferred currency into the
+// base currency at the current rate.
+func (s *CurrencyService) ToBase(ctx context.Context, amount money.Amount) (money.Amount, error) {
+	code := currency.Preferred(ctx)
+	if code == currency.Base {
+		return amount, nil
+	}
+	rate, err := s.rate(ctx, code)
+	if err != nil {
+		return 0, err
+	}
+	converted, err := rate.ConvertBack(amount)
+	if err != nil {
+		return 0, apperrors.ErrBadRequest(err.Error())
+	}
+	return converted, nil
+}
+
+// PreferredRate returns the request's preferred currency and its current
+// rate, which is one for the base currency.
+func (s *CurrencyService) PreferredRate(ctx context.Context) (string, money.Rate, error) {
+	code := currency.Preferred(ctx)
+	if code == currency.Base {
+		return code, money.One, nil
+	}
+	rate, err := s.rate(ctx, code)
+	if err != nil {
+		return "", 0, err
+	}
+	return code, rate, nil
+}
+
+func (s *CurrencyService) rate(ctx context.Context, code string) (money.Rate, error) {
+	if _, err := s.repo.GetByCode(ctx, code); err != nil {
+		return 0, apperrors.ErrBadRequest("currency " + code + " is not supported")
+	}
+	rate, err := s.repo.GetRate(ctx, code, time.Now())
+	if err != nil {
+		return 0, apperrors.ErrBadRequest("no exchange rate for " + code + " yet")
+	}
+	return rate.Rate, nil
+}
+
+func (s *CurrencyService) known(ctx context.Context, code string) (string, error) {
+	code, err := currency.Normalize(code)
+	if err != nil {
+		return "", apperrors.ErrBadRequest(err.Error())
+	}
+	if _, err := s.repo.GetByCode(ctx, code); err != nil {
+		return "", apperrors.ErrNotFound("currency not found")
+	}
+	return code, nil
+}
+
+func newExchangeRate(code string, rate money.Rate, validFrom *time.Time, source string) (*models.ExchangeRate, error) {
+	if code == currency.Base {
+		return nil, errors.New("the base currency always has rate 1")
+	}
+	if rate <= 0 {
+		return nil, money.ErrRate
+	}
+	from := time.Now()
+	if validFrom != nil {
+		from = *validFrom
+	}
+	return &models.ExchangeRate{Currency: code, Rate: rate, ValidFrom: from, Source: source}, nil
+}
+
+type rateRow struct {
+	line                      int
+	code, rateText, validFrom string
+}
+
+func (r rateRow) rate(known map[string]bool) (*models.ExchangeRate, error) {
+	code, err := currency.Normalize(r.code)
+	if err != nil {
+		return nil, err
+	}
+	if !known[code] {
+		return nil, fmt.Errorf("unknown currency %s", code)
+	}
+	rate, err := money.ParseRate(r.rateText)
+	if err != nil {
+		return nil, err
+	}
+	var validFrom *time.Time
+	if r.validFrom != "" {
+		parsed, err := parseValidFrom(r.validFrom)
+		if err != nil {
+			return nil, err
+		}
+		validFrom = &parsed
+	}
+	return newExchangeRate(code, rate, validFrom, models.ExchangeRateSourceImport)
+}
+
+func parseRatesCSV(r io.Reader) ([]rateRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, required := range []string{"currency", "rate"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing column %q", required)
+		}
+	}
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var rows []rateRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if len(rows) == maxRateImportRows {
+			return nil, fmt.Errorf("file has more than %d rates", maxRateImportRows)
+		}
+		rows = append(rows, rateRow{
+			line:      line,
+			code:      field(record, "currency"),
+			rateText:  field(record, "rate"),
+			validFrom: field(record, "valid_from"),
+		})
+	}
+}
+
+func parseValidFrom(raw string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid valid_from %q", raw)
+	}
+	return t, nil
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/isbn"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/mergepatch"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+var editionFormats = map[string]bool{
+	models.EditionFormatHardcover: true,
+	models.EditionFormatPaperback: true,
+	models.EditionFormatEbook:     true,
+	models.EditionFormatAudiobook: true,
+}
+
+type EditionService struct {
+	repo     interfaces.EditionRepositoryInterface
+	books    interfaces.BookRepositoryInterface
+	listener interfaces.EditionListenerInterface
+	prices   interfaces.PriceLocalizerInterface
+	audit    interfaces.AuditRecorderInterface
+}
+
+// EditionListeners passes edition changes on to each listener in turn.
+type EditionListeners []interfaces.EditionListenerInterface
+
+func (l EditionListeners) EditionChanged(ctx context.Context, before, after *models.Edition) {
+	for _, listener := range l {
+		listener.EditionChanged(ctx, before, after)
+	}
+}
+
+// NewEditionService creates the service; listener is told about every
+// edition after it is saved or deleted. before is nil for new editions and
+// after is nil for deleted ones. prices converts editions returned by
+// GetByID to the customer's currency.
+func NewEditionService(repo interfaces.EditionRepositoryInterface, books interfaces.BookRepositoryInterface, listener interfaces.EditionListenerInterface, prices interfaces.PriceLocalizerInterface, audit interfaces.AuditRecorderInterface) *EditionService {
+	return &EditionService{repo: repo, books: books, listener: listener, prices: prices, audit: audit}
+}
+
+func (s *EditionService) Create(ctx context.Context, bookID uuid.UUID, input dto.EditionInput) (*models.Edition, error) {
+	if _, err := s.books.GetByID(ctx, bookID); err != nil {
+		return nil, apperrors.ErrNotFound("book not found")
+	}
+	edition := &models.Edition{BookID: bookID}
+	if err := s.apply(ctx, edition, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, edition); err != nil {
+		return nil, editionWriteError(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "edition", edition.ID, nil, edition)
+	s.listener.EditionChanged(ctx, nil, edition)
+	return edition, nil
+}
+
+func (s *EditionService) GetByID(ctx context.Context, id uuid.UUID) (*models.Edition, error) {
+	edition, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("edition not found")
+	}
+	if err := s.prices.Localize(ctx, []*models.Edition{edition}); err != nil {
+		return nil, err
+	}
+	return edition, nil
+}
+
+func (s *EditionService) Update(ctx context.Context, id uuid.UUID, version int64, input dto.EditionInput) (*models.Edition, error) {
+	edition, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("edition not found")
+	}
+	if err := checkVersion("edition", edition.Version, version); err != nil {
+		return nil, err
+	}
+	before := *edition
+
+	if err := s.apply(ctx, edition, input); err != nil {
+		return nil, err
+	}
+	edition.Publisher = nil
+	if err := s.repo.Update(ctx, edition); err != nil {
+		return nil, editionWriteError(err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "edition", id, &before, edition)
+	s.listener.EditionChanged(ctx, &before, edition)
+	return edition, nil
+}
+
+// Patch applies a JSON merge patch to the edition and saves the result.
+func (s *EditionService) Patch(ctx context.Context, id uuid.UUID, version int64, patch []byte) (*models.Edition, error) {
+	edition, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("edition not found")
+	}
+
+	input := dto.EditionInput{
+		Format:          edition.Format,
+		ISBN:            edition.ISBN13,
+		PublisherID:     edition.PublisherID,
+		PublicationYear: edition.PublicationYear,
+		PageCount:       edition.PageCount,
+		Weight:          edition.Weight,
+		Price:           edition.Price,
+	}
+	if err := mergepatch.ApplyTo(&input, patch); err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+	return s.Update(ctx, id, version, input)
+}
+
+func (s *EditionService) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	edition, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return apperrors.ErrNotFound("edition not found")
+	}
+	if err := checkVersion("edition", edition.Version, version); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id, version); err != nil {
+		if errors.Is(err, apperrors.ErrForeignKey) {
+			return apperrors.ErrConflict("the edition has been ordered or is in a cart")
+		}
+		return versionedWriteError("edition", err)
+	}
+	s.audit.Record(ctx, AuditActionDelete, "edition", id, edition, nil)
+	s.listener.EditionChanged(ctx, edition, nil)
+	return nil
+}
+
+// editionWriteError maps a repository error of saving an edition to an
+// application error: the only reference the service does not check itself
+// is the publisher.
+func editionWriteError(err error) error {
+	if errors.Is(err, apperrors.ErrForeignKey) {
+		return apperrors.ErrBadRequest("publisher not found")
+	}
+	return versionedWriteError("edition", err)
+}
+
+// apply validates the input and copies it to the edition.
+func (s *EditionService) apply(ctx context.Context, edition *models.Edition, input dto.EditionInput) error {
+	format := strings.ToLower(strings.TrimSpace(input.Format))
+	if !editionFormats[format] {
+		return apperrors.ErrBadRequest("unknown edition format: " + input.Format)
+	}
+	if input.Stock != nil {
+		return apperrors.ErrBadRequest("stock is kept per warehouse: set it with PUT /api/v1/warehouses/:id/stock/:edition_id")
+	}
+	if input.Price < 0 {
+		return apperrors.ErrBadRequest("price must not be negative")
+	}
+	if input.PageCount != nil && *input.PageCount <= 0 {
+		return apperrors.ErrBadRequest("page_count must be positive")
+	}
+	if input.Weight != nil && *input.Weight <= 0 {
+		return apperrors.ErrBadRequest("weight must be positive")
+	}
+	if input.PublicationYear != nil && *input.PublicationYear <= 0 {
+		return apperrors.ErrBadRequest("publication_year must be positive")
+	}
+	if err := s.setISBN(ctx, edition, input.ISBN); err != nil {
+		return err
+	}
+
+	edition.Format = format
+	edition.PublisherID = input.PublisherID
+	edition.PublicationYear = input.PublicationYear
+	edition.PageCount = input.PageCount
+	edition.Weight = input.Weight
+	edition.Price = input.Price
+	return nil
+}
+
+// setISBN normalizes the given ISBN and stores its ISBN-13 and, when one
+// exists, ISBN-10 form on the edition. An empty value clears both.
+func (s *EditionService) setISBN(ctx context.Context, edition *models.Edition, raw *string) error {
+	edition.ISBN13, edition.ISBN10 = nil, nil
+	if raw == nil || strings.TrimSpace(*raw) == "" {
+		return nil
+	}
+	isbn13, err := isbn.Normalize(*raw)
+	if err != nil {
+		return apperrors.ErrBadRequest("invalid ISBN: " + *raw)
+	}
+	if existing, err := s.repo.GetByISBN(ctx, isbn13); err == nil && existing.ID != edition.ID {
+		return apperrors.ErrConflict("edition with this ISBN already exists")
+	}
+	edition.ISBN13 = &isbn13
+	if isbn10, ok := isbn.To10(isbn13); ok {
+		edition.ISBN10 = &isbn10
+	}
+	return nil
+}
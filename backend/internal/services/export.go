@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"io"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/export"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+)
+
+var (
+	bookExportHeader      = []string{"id", "book_id", "isbn", "title", "description", "format", "publication_year", "page_count", "price", "stock", "authors", "contributors", "publisher", "categories", "created_at"}
+	authorExportHeader    = []string{"id", "surname", "name", "patronymic", "info"}
+	publisherExportHeader = []string{"id", "name", "address"}
+	customerExportHeader  = []string{"id", "username", "email", "phone"}
+)
+
+type ExportService struct {
+	repo   interfaces.ExportRepositoryInterface
+	prices interfaces.PriceLocalizerInterface
+}
+
+// NewExportService creates the service; prices reads the customer's
+// currency, which the book filter's prices are in.
+func NewExportService(repo interfaces.ExportRepositoryInterface, prices interfaces.PriceLocalizerInterface) *ExportService {
+	return &ExportService{repo: repo, prices: prices}
+}
+
+// ExportBooks writes the editions of the books the catalog shows for the
+// filter.
+func (s *ExportService) ExportBooks(ctx context.Context, format string, filter dto.BookFilter, w io.Writer) error {
+	if err := normalizeBookFilter(ctx, s.prices, &filter); err != nil {
+		return err
+	}
+	return runExport(format, w, bookExportHeader, func(writer export.Writer) error {
+		return s.repo.ExportBooks(ctx, filter, func(row dto.BookExportRow) error {
+			return writer.Write([]any{
+				row.ID, row.BookID, row.ISBN13, row.Title, row.Description,
+				row.Format, row.PublicationYear, row.PageCount, row.Price, row.Stock,
+				row.Authors, row.Contributors, row.Publisher, row.Categories, row.CreatedAt,
+			})
+		})
+	})
+}
+
+func (s *ExportService) ExportAuthors(ctx context.Context, format string, w io.Writer) error {
+	return runExport(format, w, authorExportHeader, func(writer export.Writer) error {
+		return s.repo.ExportAuthors(ctx, func(author models.Author) error {
+			return writer.Write([]any{author.ID, author.Surname, author.Name, author.Patronymic, author.Info})
+		})
+	})
+}
+
+func (s *ExportService) ExportPublishers(ctx context.Context, format string, w io.Writer) error {
+	return runExport(format, w, publisherExportHeader, func(writer export.Writer) error {
+		return s.repo.ExportPublishers(ctx, func(publisher models.Publisher) error {
+			return writer.Write([]any{publisher.ID, publisher.Name, publisher.Address})
+		})
+	})
+}
+
+// ExportCustomers writes contact data of users with the customer role.
+// Password hashes and other internal fields are never selected.
+func (s *ExportService) ExportCustomers(ctx context.Context, format string, w io.Writer) error {
+	return runExport(format, w, customerExportHeader, func(writer export.Writer) error {
+		return s.repo.ExportCustomers(ctx, func(customer dto.CustomerExportRow) error {
+			return writer.Write([]any{customer.ID, customer.Username, customer.Email, customer.Phone})
+		})
+	})
+}
+
+func runExport(format string, w io.Writer, header []string, fill func(export.Writer) error) error {
+	if _, ok := export.ContentType(format); !ok {
+		return apperrors.ErrBadRequest("unsupported export format: " + format)
+	}
+	writer, err := export.NewWriter(format, w, header)
+	if err != nil {
+		return apperrors.ErrInternal(err)
+	}
+	if err := fill(writer); err != nil {
+		return apperrors.ErrInternal(err)
+	}
+	if err := writer.Close(); err != nil {
+		return apperrors.ErrInternal(err)
+	}
+	return nil
+}
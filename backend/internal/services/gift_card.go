@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/giftcard"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/google/uuid"
+)
+
+// GiftCardService issues gift cards and store credit. Customers buy cards
+// and spend them at checkout, see OrderService.
+type GiftCardService struct {
+	repo  interfaces.GiftCardRepositoryInterface
+	users interfaces.UserRepositoryInterface
+	terms giftcard.Terms
+	audit interfaces.AuditRecorderInterface
+}
+
+func NewGiftCardService(repo interfaces.GiftCardRepositoryInterface, users interfaces.UserRepositoryInterface, terms giftcard.Terms, audit interfaces.AuditRecorderInterface) *GiftCardService {
+	return &GiftCardService{repo: repo, users: users, terms: terms, audit: audit}
+}
+
+// Run empties expired cards every interval until ctx is done.
+func (s *GiftCardService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.repo.Expire(ctx, time.Now()); err != nil {
+			log.Printf("failed to expire gift cards: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Issue lets employees give a gift card or store credit, e.g. one paid for
+// at the till or instead of a refund; the reason is kept on the card. The
+// code of a gift card is returned only here.
+func (s *GiftCardService) Issue(ctx context.Context, employeeID uuid.UUID, input dto.GiftCardIssueInput) (*dto.IssuedGiftCard, error) {
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" {
+		return nil, apperrors.ErrBadRequest("reason is required")
+	}
+	if input.Amount <= 0 || input.Amount > s.terms.MaxAmount {
+		return nil, apperrors.ErrBadRequest("amount must be positive and at most " + s.terms.MaxAmount.String())
+	}
+	card := &models.GiftCard{Kind: input.Kind, InitialAmount: input.Amount, IssuedBy: &employeeID, Reason: &reason}
+	switch input.Kind {
+	case models.GiftCardKindGiftCard:
+		if input.UserID != nil {
+			return nil, apperrors.ErrBadRequest("gift cards belong to whoever has the code, leave user_id out")
+		}
+	case models.GiftCardKindStoreCredit:
+		if input.UserID == nil {
+			return nil, apperrors.ErrBadRequest("user_id is required for store credit")
+		}
+		if _, err := s.users.GetByID(ctx, *input.UserID); err != nil {
+			return nil, apperrors.ErrNotFound("user not found")
+		}
+		card.OwnerID = input.UserID
+	default:
+		return nil, apperrors.ErrBadRequest("kind must be gift_card or store_credit")
+	}
+	return s.create(ctx, card)
+}
+
+func (s *GiftCardService) create(ctx context.Context, card *models.GiftCard) (*dto.IssuedGiftCard, error) {
+	card.ExpiresAt = s.terms.ExpiresAt(time.Now())
+	issued := &dto.IssuedGiftCard{GiftCard: card}
+	if card.Kind == models.GiftCardKindGiftCard {
+		code, err := newGiftCardCode(card)
+		if err != nil {
+			return nil, apperrors.ErrInternal(err)
+		}
+		issued.Code = code
+	}
+	if err := s.repo.Create(ctx, card); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "gift_card", card.ID, nil, card)
+	return issued, nil
+}
+
+// newGiftCardCode makes a code for the card, which keeps only its hash and
+// last digits, and returns it.
+func newGiftCardCode(card *models.GiftCard) (string, error) {
+	code, err := giftcard.NewCode()
+	if err != nil {
+		return "", err
+	}
+	hash, last := giftcard.Hash(code), giftcard.LastDigits(code)
+	card.CodeHash, card.LastDigits = &hash, &last
+	return code, nil
+}
+
+// Check shows the balance and expiry of a gift card to whoever has its
+// code, and nothing else about it.
+func (s *GiftCardService) Check(ctx context.Context, code string) (*dto.GiftCardBalance, error) {
+	if giftcard.Normalize(code) == "" {
+		return nil, apperrors.ErrNotFound("gift card not found")
+	}
+	card, err := s.repo.GetByCode(ctx, giftcard.Hash(code))
+	if err != nil {
+		return nil, apperrors.ErrNotFound("gift card not found")
+	}
+	return &dto.GiftCardBalance{Balance: card.Balance, ExpiresAt: card.ExpiresAt}, nil
+}
+
+// GetMine lists the gift cards the customer bought, which have no balance
+// until their order is paid, and their store credit, with the total of the
+// credit they can spend.
+func (s *GiftCardService) GetMine(ctx context.Context, userID uuid.UUID) (*dto.GiftCards, error) {
+	cards, err := s.repo.GetAllByUser(ctx, userID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	mine := &dto.GiftCards{Cards: cards}
+	now := time.Now()
+	for _, card := range cards {
+		if card.Kind == models.GiftCardKindStoreCredit && card.OwnerID != nil && *card.OwnerID == userID && card.ExpiresAt.After(now) {
+			mine.StoreCredit += card.Balance
+		}
+	}
+	return mine, nil
+}
+
+// GetByID returns a card with its ledger to the customer who bought or
+// owns it; other customers' cards are not found.
+func (s *GiftCardService) GetByID(ctx context.Context, userID, id uuid.UUID) (*models.GiftCard, error) {
+	card, err := s.repo.GetByID(ctx, id)
+	if err != nil || !giftCardOf(card, userID) {
+		return nil, apperrors.ErrNotFound("gift card not found")
+	}
+	return card, nil
+}
+
+func giftCardOf(card *models.GiftCard, userID uuid.UUID) bool {
+	return (card.OwnerID != nil && *card.OwnerID == userID) || (card.PurchasedBy != nil && *card.PurchasedBy == userID)
+}
+
+// giftCardPayments works out how the customer's store credit, if asked
+// for, and then the gift cards with the codes pay for due, in the order's
+// currency at rate. Cards are used in turn until due is covered; cards not
+// needed are left alone. It returns the ledger entries and the amount paid.
+func giftCardPayments(ctx context.Context, repo interfaces.GiftCardRepositoryInterface, userID uuid.UUID, storeCredit bool, codes []string, due money.Amount, rate money.Rate) ([]*models.GiftCardTransaction, money.Amount, error) {
+	now := time.Now()
+	var cards []models.GiftCard
+	if storeCredit {
+		credit, err := repo.GetStoreCredit(ctx, userID, now)
+		if err != nil {
+			return nil, 0, apperrors.ErrInternal(err)
+		}
+		cards = append(cards, credit...)
+	}
+	seen := map[string]bool{}
+	for _, code := range codes {
+		if giftcard.Normalize(code) == "" {
+			return nil, 0, apperrors.ErrBadRequest("gift card " + code + " not found")
+		}
+		hash := giftcard.Hash(code)
+		if seen[hash] {
+			continue
+		}
+		seen[hash] = true
+		card, err := repo.GetByCode(ctx, hash)
+		if err != nil {
+			return nil, 0, apperrors.ErrBadRequest("gift card " + code + " not found")
+		}
+		if !card.ExpiresAt.After(now) {
+			return nil, 0, apperrors.ErrBadRequest("gift card " + code + " has expired")
+		}
+		if card.Balance == 0 {
+			return nil, 0, apperrors.ErrBadRequest("gift card " + code + " has been used up")
+		}
+		cards = append(cards, *card)
+	}
+
+	var entries []*models.GiftCardTransaction
+	var paid money.Amount
+	for _, card := range cards {
+		if paid >= due {
+			break
+		}
+		amount, taken, err := giftcard.Apply(card.Balance, due-paid, rate)
+		if err != nil {
+			return nil, 0, apperrors.ErrInternal(err)
+		}
+		if taken == 0 {
+			continue
+		}
+		paid += amount
+		entries = append(entries, &models.GiftCardTransaction{GiftCardID: card.ID, Kind: models.GiftCardTransactionRedeem, Amount: -taken})
+	}
+	return entries, paid, nil
+}
@@ -0,0 +1,347 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/isbn"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+)
+
+var importRequiredColumns = []string{"title", "price", "author", "publisher"}
+var importKnownColumns = map[string]bool{
+	"title": true, "description": true, "isbn": true, "format": true,
+	"publication_year": true, "page_count": true, "price": true, "stock": true,
+	"author": true, "publisher": true, "categories": true,
+}
+
+type ImportService struct {
+	repo     interfaces.ImportRepositoryInterface
+	listener interfaces.EditionListenerInterface
+	audit    interfaces.AuditRecorderInterface
+}
+
+// NewImportService creates the service; listener is told about every
+// imported edition, as with EditionService.
+func NewImportService(repo interfaces.ImportRepositoryInterface, listener interfaces.EditionListenerInterface, audit interfaces.AuditRecorderInterface) *ImportService {
+	return &ImportService{repo: repo, listener: listener, audit: audit}
+}
+
+// ImportBooks reads a CSV file with one edition per line and the columns
+// title, description, isbn, format, publication_year, page_count, price,
+// stock, author, publisher and categories. An edition whose ISBN is already
+// in the catalog is updated instead of created. Nothing is written
+// if any row is invalid or dryRun is set; the report then shows what would
+// happen.
+func (s *ImportService) ImportBooks(ctx context.Context, r io.Reader, dryRun bool) (*dto.ImportReport, error) {
+	rows, results, err := parseImportCSV(r)
+	if err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+
+	report := &dto.ImportReport{DryRun: dryRun, Total: len(results), Rows: results}
+	for _, result := range results {
+		if result.Status == dto.ImportRowInvalid {
+			report.Invalid++
+		}
+	}
+	report.Valid = report.Total - report.Invalid
+	if report.Invalid > 0 || len(rows) == 0 {
+		return report, nil
+	}
+
+	imported, err := s.repo.ImportBooks(ctx, rows, dryRun)
+	if errors.Is(err, apperrors.ErrNoWarehouse) {
+		return nil, apperrors.ErrConflict("no active warehouse to put the imported stock in")
+	}
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+
+	for i, item := range imported {
+		result := &report.Rows[i]
+		if item.Edition != nil && !dryRun {
+			bookID, editionID := item.Edition.BookID, item.Edition.ID
+			result.BookID = &bookID
+			result.EditionID = &editionID
+			result.Status = dto.ImportRowImported
+			report.Imported++
+		}
+		if item.CreatedBook != nil {
+			result.BookCreated = true
+			report.BooksCreated++
+		}
+		if item.Previous != nil {
+			result.Updated = true
+			report.Updated++
+		}
+		if item.CreatedAuthor != nil {
+			result.AuthorCreated = true
+			report.AuthorsCreated++
+		}
+		if item.CreatedPublisher != nil {
+			result.PublisherCreated = true
+			report.PublishersCreated++
+		}
+		for _, category := range item.CreatedCategories {
+			result.CategoriesCreated = append(result.CategoriesCreated, category.Name)
+			report.CategoriesCreated++
+		}
+
+		if dryRun {
+			continue
+		}
+		if item.CreatedAuthor != nil {
+			s.audit.Record(ctx, AuditActionCreate, "author", item.CreatedAuthor.ID, nil, item.CreatedAuthor)
+		}
+		if item.CreatedPublisher != nil {
+			s.audit.Record(ctx, AuditActionCreate, "publisher", item.CreatedPublisher.ID, nil, item.CreatedPublisher)
+		}
+		for _, category := range item.CreatedCategories {
+			s.audit.Record(ctx, AuditActionCreate, "category", category.ID, nil, category)
+		}
+		if item.CreatedBook != nil {
+			s.audit.Record(ctx, AuditActionCreate, "book", item.CreatedBook.ID, nil, item.CreatedBook)
+		}
+		if item.UpdatedBook != nil {
+			s.audit.Record(ctx, AuditActionUpdate, "book", item.UpdatedBook.ID, item.PreviousBook, item.UpdatedBook)
+		}
+		switch {
+		case item.Edition != nil && item.Previous != nil:
+			s.audit.Record(ctx, AuditActionUpdate, "edition", item.Edition.ID, item.Previous, item.Edition)
+			s.listener.EditionChanged(ctx, item.Previous, item.Edition)
+		case item.Edition != nil:
+			s.audit.Record(ctx, AuditActionCreate, "edition", item.Edition.ID, nil, item.Edition)
+			s.listener.EditionChanged(ctx, nil, item.Edition)
+		}
+	}
+	return report, nil
+}
+
+func parseImportCSV(r io.Reader) ([]dto.ImportBookRow, []dto.ImportRowResult, error) {
+	buffered := bufio.NewReader(r)
+	firstLine, err := buffered.Peek(buffered.Size())
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if i := strings.IndexByte(string(firstLine), '\n'); i >= 0 {
+		firstLine = firstLine[:i]
+	}
+
+	reader := csv.NewReader(buffered)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	// spreadsheets with a comma as decimal separator export with semicolons
+	if strings.Count(string(firstLine), ";") > strings.Count(string(firstLine), ",") {
+		reader.Comma = ';'
+	}
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, errors.New("file is empty")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if !importKnownColumns[name] {
+			return nil, nil, fmt.Errorf("unknown column %q", name)
+		}
+		columns[name] = i
+	}
+	for _, name := range importRequiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, fmt.Errorf("missing required column %q", name)
+		}
+	}
+
+	var rows []dto.ImportBookRow
+	var results []dto.ImportRowResult
+	isbnLines := make(map[string]int)
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		row, errs := parseImportRow(line, field, reader.Comma)
+		if row.ISBN13 != nil {
+			if first, ok := isbnLines[*row.ISBN13]; ok {
+				errs = append(errs, fmt.Sprintf("duplicate ISBN, already used on line %d", first))
+			} else {
+				isbnLines[*row.ISBN13] = line
+			}
+		}
+		result := dto.ImportRowResult{Row: line, Title: row.Title, Status: dto.ImportRowValid}
+		if len(errs) > 0 {
+			result.Status = dto.ImportRowInvalid
+			result.Errors = errs
+		} else {
+			rows = append(rows, row)
+		}
+		results = append(results, result)
+	}
+	return rows, results, nil
+}
+
+func parseImportRow(line int, field func(string) string, comma rune) (dto.ImportBookRow, []string) {
+	var errs []string
+	row := dto.ImportBookRow{Row: line, Title: field("title"), PublisherName: field("publisher")}
+
+	if row.Title == "" {
+		errs = append(errs, "title is required")
+	}
+	if description := field("description"); description != "" {
+		row.Description = &description
+	}
+	if raw := field("isbn"); raw != "" {
+		if isbn13, err := isbn.Normalize(raw); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid ISBN %q", raw))
+		} else {
+			row.ISBN13 = &isbn13
+			if isbn10, ok := isbn.To10(isbn13); ok {
+				row.ISBN10 = &isbn10
+			}
+		}
+	}
+
+	row.Format = strings.ToLower(field("format"))
+	if row.Format == "" {
+		row.Format = models.EditionFormatPaperback
+	} else if !editionFormats[row.Format] {
+		errs = append(errs, fmt.Sprintf("unknown format %q", field("format")))
+	}
+	for _, column := range []struct {
+		name   string
+		target **int
+	}{
+		{"publication_year", &row.PublicationYear},
+		{"page_count", &row.PageCount},
+	} {
+		raw := field(column.name)
+		if raw == "" {
+			continue
+		}
+		value, err := strconv.Atoi(raw)
+		if err != nil || value <= 0 {
+			errs = append(errs, fmt.Sprintf("invalid %s %q", column.name, raw))
+			continue
+		}
+		*column.target = &value
+	}
+
+	price, err := importPrice(field("price"), comma)
+	switch {
+	case err != nil:
+		errs = append(errs, fmt.Sprintf("invalid price %q: %v", field("price"), err))
+	case price < 0:
+		errs = append(errs, "price must not be negative")
+	default:
+		row.Price = price
+	}
+
+	if raw := field("stock"); raw != "" {
+		stock, err := strconv.Atoi(raw)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Sprintf("invalid stock %q", raw))
+		case stock < 0:
+			errs = append(errs, "stock must not be negative")
+		default:
+			row.Stock = &stock
+		}
+	}
+
+	nameParts := strings.Fields(field("author"))
+	switch {
+	case len(nameParts) < 2:
+		errs = append(errs, "author must contain at least surname and name")
+	case len(nameParts) > 3:
+		errs = append(errs, "author must be 'Surname Name [Patronymic]'")
+	default:
+		row.AuthorSurname = nameParts[0]
+		row.AuthorName = nameParts[1]
+		if len(nameParts) == 3 {
+			row.AuthorPatronymic = nameParts[2]
+		}
+	}
+
+	if row.PublisherName == "" {
+		errs = append(errs, "publisher is required")
+	}
+
+	// categories are separated by '|', or by ';' in comma separated files
+	categories := strings.FieldsFunc(field("categories"), func(r rune) bool {
+		return r == '|' || (r == ';' && comma != ';')
+	})
+	seen := make(map[string]bool)
+	for _, name := range categories {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+		row.Categories = append(row.Categories, name)
+	}
+
+	return row, errs
+}
+
+func isBlankRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	// importCommaThousands and importDotThousands match prices with
+	// thousands separators in comma and semicolon separated files.
+	importCommaThousands = regexp.MustCompile(`^\d{1,3}(,\d{3})+(\.\d+)?$`)
+	importDotThousands   = regexp.MustCompile(`^\d{1,3}([. \x{00a0}]\d{3})+(,\d+)?$`)
+)
+
+// importPrice parses a price written the way the file's locale writes
+// numbers. Semicolon separated files use a decimal comma and may separate
+// thousands with dots or spaces, e.g. 1.234,56; comma separated files use
+// a decimal point and may separate thousands with commas, e.g. 1,234.56.
+// Any other comma makes the price invalid.
+func importPrice(raw string, comma rune) (money.Amount, error) {
+	if comma == ';' {
+		if importDotThousands.MatchString(raw) {
+			raw = strings.NewReplacer(".", "", " ", "", "\u00a0", "").Replace(raw)
+		}
+		raw = strings.Replace(raw, ",", ".", 1)
+	} else if importCommaThousands.MatchString(raw) {
+		raw = strings.ReplaceAll(raw, ",", "")
+	}
+	return money.Parse(raw)
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/blob"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+const contentTypePDF = "application/pdf"
+
+// InvoiceService issues invoices for orders. An order's invoice is numbered
+// the first time it is asked for and keeps a snapshot of the order; later
+// downloads get the stored document, so they are identical byte for byte
+// until the payment status printed on it changes.
+type InvoiceService struct {
+	repo      interfaces.InvoiceRepositoryInterface
+	orders    interfaces.OrderRepositoryInterface
+	users     interfaces.UserRepositoryInterface
+	documents interfaces.BlobStore
+	renderer  interfaces.InvoiceRendererInterface
+	audit     interfaces.AuditRecorderInterface
+}
+
+// NewInvoiceService creates the service; documents must be a private store,
+// not the one media is served from.
+func NewInvoiceService(repo interfaces.InvoiceRepositoryInterface, orders interfaces.OrderRepositoryInterface, users interfaces.UserRepositoryInterface, documents interfaces.BlobStore, renderer interfaces.InvoiceRendererInterface, audit interfaces.AuditRecorderInterface) *InvoiceService {
+	return &InvoiceService{repo: repo, orders: orders, users: users, documents: documents, renderer: renderer, audit: audit}
+}
+
+// Open returns the invoice of an order with its PDF. Customers only get
+// their own orders' invoices; anyOrder lets employees open any.
+func (s *InvoiceService) Open(ctx context.Context, userID, orderID uuid.UUID, anyOrder bool) (*models.Invoice, *blob.Object, error) {
+	order, err := s.orders.GetByID(ctx, orderID)
+	if err != nil || (!anyOrder && order.UserID != userID) {
+		return nil, nil, apperrors.ErrNotFound("order not found")
+	}
+	status := paymentStatus(order)
+
+	invoice, err := s.repo.GetByOrder(ctx, orderID)
+	if errors.Is(err, sql.ErrNoRows) {
+		invoice, err = s.issue(ctx, order, status)
+	}
+	if err != nil {
+		return nil, nil, apperrors.ErrInternal(err)
+	}
+	// an invoice without a key was numbered but its document never stored
+	if invoice.Key == "" || invoice.PaymentStatus != status {
+		if err := s.reissue(ctx, invoice, order, status); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	object, err := s.documents.Get(ctx, invoice.Key)
+	if errors.Is(err, blob.ErrNotFound) {
+		// the document was lost; it is rendered again from the snapshot
+		if err = s.store(ctx, invoice, order); err == nil {
+			object, err = s.documents.Get(ctx, invoice.Key)
+		}
+	}
+	if err != nil {
+		return nil, nil, apperrors.ErrInternal(err)
+	}
+	return invoice, object, nil
+}
+
+// issue numbers the first invoice of an order and saves it with a snapshot
+// of the order; its document is stored afterwards. If a concurrent request
+// issued it first, that invoice is returned instead.
+func (s *InvoiceService) issue(ctx context.Context, order *models.Order, status string) (*models.Invoice, error) {
+	snapshot := *order
+	snapshot.User = s.buyer(ctx, order)
+	snapshot.Payment = nil
+	snapshot.GiftCardTransactions = nil
+
+	invoice := &models.Invoice{OrderID: order.ID, PaymentStatus: status, Snapshot: &snapshot, IssuedAt: time.Now().UTC()}
+	if err := s.repo.Create(ctx, invoice); err != nil {
+		if errors.Is(err, apperrors.ErrDuplicate) {
+			return s.repo.GetByOrder(ctx, order.ID)
+		}
+		return nil, err
+	}
+	s.audit.Record(ctx, AuditActionCreate, "invoice", invoice.ID, nil, invoice)
+	return invoice, nil
+}
+
+// reissue renders the invoice under its number and saves the document, the
+// first time or after the payment status changed.
+func (s *InvoiceService) reissue(ctx context.Context, invoice *models.Invoice, order *models.Order, status string) error {
+	before := *invoice
+	invoice.PaymentStatus = status
+	if err := s.store(ctx, invoice, order); err != nil {
+		return apperrors.ErrInternal(err)
+	}
+	if err := s.repo.Update(ctx, invoice); err != nil {
+		return apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "invoice", invoice.ID, &before, invoice)
+	return nil
+}
+
+// store renders the invoice and saves the document under a key made of its
+// code and payment status. The snapshot is printed with the order's current
+// payment; invoices issued before snapshots were kept print the order.
+func (s *InvoiceService) store(ctx context.Context, invoice *models.Invoice, order *models.Order) error {
+	printed := order
+	if invoice.Snapshot != nil {
+		snapshot := *invoice.Snapshot
+		snapshot.Payment = order.Payment
+		printed = &snapshot
+	} else {
+		order.User = s.buyer(ctx, order)
+	}
+	document, err := s.renderer.Render(invoice, printed)
+	if err != nil {
+		return err
+	}
+	key := "invoices/" + strconv.Itoa(invoice.Year) + "/" + invoice.Code + "-" + strings.ReplaceAll(strings.ToLower(invoice.PaymentStatus), " ", "-") + ".pdf"
+	if err := s.documents.Put(ctx, key, contentTypePDF, document); err != nil {
+		return err
+	}
+	invoice.Key = key
+	invoice.Size = len(document)
+	return nil
+}
+
+// buyer returns the contact details of the order's buyer the invoice
+// prints, or nil if the user cannot be loaded.
+func (s *InvoiceService) buyer(ctx context.Context, order *models.Order) *models.User {
+	user := order.User
+	if user == nil {
+		var err error
+		if user, err = s.users.GetByID(ctx, order.UserID); err != nil {
+			log.Printf("failed to load buyer of order %s: %v", order.ID, err)
+			return nil
+		}
+	}
+	return &models.User{ID: user.ID, Username: user.Username, Email: user.Email, Phone: user.Phone}
+}
+
+func paymentStatus(order *models.Order) string {
+	if order.Payment == nil {
+		return ""
+	}
+	return order.Payment.Status
+}
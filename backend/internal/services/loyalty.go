@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/loyalty"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultLoyaltyPageSize = 20
+	maxLoyaltyPageSize     = 100
+)
+
+// LoyaltyService shows customers their points and lets admins adjust
+// them. Points are earned and spent by orders, see OrderService.
+type LoyaltyService struct {
+	repo    interfaces.LoyaltyRepositoryInterface
+	users   interfaces.UserRepositoryInterface
+	program loyalty.Program
+	audit   interfaces.AuditRecorderInterface
+}
+
+func NewLoyaltyService(repo interfaces.LoyaltyRepositoryInterface, users interfaces.UserRepositoryInterface, program loyalty.Program, audit interfaces.AuditRecorderInterface) *LoyaltyService {
+	return &LoyaltyService{repo: repo, users: users, program: program, audit: audit}
+}
+
+// Run writes off expired points every interval until ctx is done.
+func (s *LoyaltyService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.repo.Expire(ctx, time.Now()); err != nil {
+			log.Printf("failed to expire loyalty points: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetBalance counts the points that have not expired yet, even if the
+// expiry run has not written them off.
+func (s *LoyaltyService) GetBalance(ctx context.Context, userID uuid.UUID) (*dto.LoyaltyBalance, error) {
+	credits, err := s.repo.GetCredits(ctx, userID, time.Now())
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	balance := &dto.LoyaltyBalance{}
+	for _, credit := range credits {
+		balance.Points += credit.Remaining
+		switch {
+		case credit.ExpiresAt == nil:
+		case balance.ExpiresAt == nil || credit.ExpiresAt.Before(*balance.ExpiresAt):
+			balance.ExpiresAt, balance.ExpiringPoints = credit.ExpiresAt, credit.Remaining
+		case credit.ExpiresAt.Equal(*balance.ExpiresAt):
+			balance.ExpiringPoints += credit.Remaining
+		}
+	}
+	return balance, nil
+}
+
+// GetHistory pages through the user's ledger, newest entries first.
+func (s *LoyaltyService) GetHistory(ctx context.Context, userID uuid.UUID, query dto.LoyaltyHistoryQuery) (*dto.LoyaltyHistoryPage, error) {
+	if query.Limit < 0 || query.Offset < 0 {
+		return nil, apperrors.ErrBadRequest("limit and offset must not be negative")
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultLoyaltyPageSize
+	}
+	query.Limit = min(query.Limit, maxLoyaltyPageSize)
+
+	entries, total, err := s.repo.GetHistory(ctx, userID, query.Limit, query.Offset)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return &dto.LoyaltyHistoryPage{Items: entries, Total: total, Limit: query.Limit, Offset: query.Offset}, nil
+}
+
+// Adjust credits or debits a customer's points on an admin's behalf. The
+// reason is kept in the ledger; added points expire like earned ones.
+func (s *LoyaltyService) Adjust(ctx context.Context, adminID, userID uuid.UUID, input dto.LoyaltyAdjustmentInput) (*models.LoyaltyTransaction, error) {
+	reason := strings.TrimSpace(input.Reason)
+	if input.Points == 0 {
+		return nil, apperrors.ErrBadRequest("points must not be zero")
+	}
+	if reason == "" {
+		return nil, apperrors.ErrBadRequest("reason is required")
+	}
+	if _, err := s.users.GetByID(ctx, userID); err != nil {
+		return nil, apperrors.ErrNotFound("user not found")
+	}
+
+	entry := &models.LoyaltyTransaction{
+		UserID:    userID,
+		Kind:      models.LoyaltyKindAdjust,
+		Points:    input.Points,
+		Reason:    &reason,
+		CreatedBy: &adminID,
+	}
+	if input.Points > 0 {
+		expiresAt := s.program.ExpiresAt(time.Now())
+		entry.ExpiresAt = &expiresAt
+	}
+	if err := s.repo.Adjust(ctx, entry); err != nil {
+		if errors.Is(err, apperrors.ErrNotEnoughPoints) {
+			return nil, apperrors.ErrConflict("the customer does not have that many points")
+		}
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "loyalty_transaction", entry.ID, nil, entry)
+	return entry, nil
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/blob"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/imaging"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+const imageOriginal = "original"
+
+// maxImagePixels keeps a decoded upload under about 100 MB.
+const maxImagePixels = 25_000_000
+
+var (
+	coverRules = imaging.Rules{MaxBytes: imaging.MaxUploadSize, MinWidth: 200, MinHeight: 200, MaxWidth: 8000, MaxHeight: 8000, MaxPixels: maxImagePixels}
+	photoRules = imaging.Rules{MaxBytes: imaging.MaxUploadSize, MinWidth: 100, MinHeight: 100, MaxWidth: 8000, MaxHeight: 8000, MaxPixels: maxImagePixels}
+
+	coverVariants = []imaging.Variant{{Name: "large", MaxSide: 800}, {Name: "medium", MaxSide: 400}, {Name: "small", MaxSide: 160}}
+	photoVariants = []imaging.Variant{{Name: "large", MaxSide: 600}, {Name: "medium", MaxSide: 300}, {Name: "small", MaxSide: 96}}
+)
+
+// MediaService stores uploaded images in the blob store and links them to
+// books and authors. Every upload gets fresh keys, so a URL always refers to
+// the same bytes and can be cached forever.
+type MediaService struct {
+	store   interfaces.BlobStore
+	repo    interfaces.MediaRepositoryInterface
+	books   interfaces.BookRepositoryInterface
+	authors interfaces.AuthorRepositoryInterface
+	baseURL string
+	audit   interfaces.AuditRecorderInterface
+}
+
+// NewMediaService creates the service; baseURL is the address blobs are
+// served from, e.g. "/api/v1/media".
+func NewMediaService(store interfaces.BlobStore, repo interfaces.MediaRepositoryInterface, books interfaces.BookRepositoryInterface, authors interfaces.AuthorRepositoryInterface, baseURL string, audit interfaces.AuditRecorderInterface) *MediaService {
+	return &MediaService{store: store, repo: repo, books: books, authors: authors, baseURL: strings.TrimSuffix(baseURL, "/"), audit: audit}
+}
+
+func (s *MediaService) UploadBookCover(ctx context.Context, id uuid.UUID, version int64, data []byte) (*models.Book, error) {
+	book, err := s.books.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("book not found")
+	}
+	if err := checkVersion("book", book.Version, version); err != nil {
+		return nil, err
+	}
+
+	cover, err := s.save(ctx, "books/"+id.String()+"/cover", data, coverRules, coverVariants)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetBookCover(ctx, id, version, cover); err != nil {
+		s.remove(ctx, cover)
+		return nil, versionedWriteError("book", err)
+	}
+	s.remove(ctx, book.Cover)
+	return s.reloadBook(ctx, book)
+}
+
+func (s *MediaService) DeleteBookCover(ctx context.Context, id uuid.UUID, version int64) (*models.Book, error) {
+	book, err := s.books.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("book not found")
+	}
+	if err := checkVersion("book", book.Version, version); err != nil {
+		return nil, err
+	}
+	if book.Cover == nil {
+		return nil, apperrors.ErrNotFound("book has no cover")
+	}
+
+	if err := s.repo.SetBookCover(ctx, id, version, nil); err != nil {
+		return nil, versionedWriteError("book", err)
+	}
+	s.remove(ctx, book.Cover)
+	return s.reloadBook(ctx, book)
+}
+
+func (s *MediaService) UploadAuthorPhoto(ctx context.Context, id uuid.UUID, version int64, data []byte) (*models.Author, error) {
+	author, err := s.authors.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("author not found")
+	}
+	if err := checkVersion("author", author.Version, version); err != nil {
+		return nil, err
+	}
+
+	photo, err := s.save(ctx, "authors/"+id.String()+"/photo", data, photoRules, photoVariants)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetAuthorPhoto(ctx, id, version, photo); err != nil {
+		s.remove(ctx, photo)
+		return nil, versionedWriteError("author", err)
+	}
+	s.remove(ctx, author.Photo)
+	return s.reloadAuthor(ctx, author)
+}
+
+func (s *MediaService) DeleteAuthorPhoto(ctx context.Context, id uuid.UUID, version int64) (*models.Author, error) {
+	author, err := s.authors.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("author not found")
+	}
+	if err := checkVersion("author", author.Version, version); err != nil {
+		return nil, err
+	}
+	if author.Photo == nil {
+		return nil, apperrors.ErrNotFound("author has no photo")
+	}
+
+	if err := s.repo.SetAuthorPhoto(ctx, id, version, nil); err != nil {
+		return nil, versionedWriteError("author", err)
+	}
+	s.remove(ctx, author.Photo)
+	return s.reloadAuthor(ctx, author)
+}
+
+// Open returns a stored blob for serving.
+func (s *MediaService) Open(ctx context.Context, key string) (*blob.Object, error) {
+	object, err := s.store.Get(ctx, key)
+	if errors.Is(err, blob.ErrNotFound) || errors.Is(err, blob.ErrInvalidKey) {
+		return nil, apperrors.ErrNotFound("media not found")
+	}
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return object, nil
+}
+
+// save validates the upload and saves the original with its resized
+// variants under a new prefix.
+func (s *MediaService) save(ctx context.Context, prefix string, data []byte, rules imaging.Rules, variants []imaging.Variant) (*models.Image, error) {
+	upload, err := imaging.Decode(data, rules)
+	switch {
+	case errors.Is(err, imaging.ErrTooLarge):
+		return nil, apperrors.ErrPayloadTooLarge(err.Error())
+	case errors.Is(err, imaging.ErrUnsupportedType):
+		return nil, apperrors.ErrUnsupportedMediaType(err.Error())
+	case err != nil:
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+
+	prefix += "/" + uuid.NewString() + "/"
+	image := &models.Image{
+		Width:  upload.Width,
+		Height: upload.Height,
+		Keys:   map[string]string{},
+		URLs:   map[string]string{},
+	}
+	put := func(name, ext, contentType string, data []byte) error {
+		key := prefix + name + ext
+		if err := s.store.Put(ctx, key, contentType, data); err != nil {
+			return err
+		}
+		image.Keys[name] = key
+		image.URLs[name] = s.baseURL + "/" + key
+		return nil
+	}
+
+	if err := put(imageOriginal, imaging.Extension(upload.ContentType), upload.ContentType, data); err != nil {
+		s.remove(ctx, image)
+		return nil, apperrors.ErrInternal(err)
+	}
+	for i, resized := range imaging.ResizeAll(upload.Image, variants) {
+		encoded, err := imaging.EncodeJPEG(resized)
+		if err == nil {
+			err = put(variants[i].Name, ".jpg", imaging.ContentTypeJPEG, encoded)
+		}
+		if err != nil {
+			s.remove(ctx, image)
+			return nil, apperrors.ErrInternal(err)
+		}
+	}
+	return image, nil
+}
+
+// remove deletes the image's blobs.
+func (s *MediaService) remove(ctx context.Context, image *models.Image) {
+	removeImage(ctx, s.store, image)
+}
+
+// removeImage deletes the image's blobs. Failures only leave orphaned blobs
+// behind, so they are logged and not returned.
+func removeImage(ctx context.Context, store interfaces.BlobStore, image *models.Image) {
+	if image == nil {
+		return
+	}
+	for _, key := range image.Keys {
+		if err := store.Delete(ctx, key); err != nil {
+			log.Printf("failed to delete blob %s: %v", key, err)
+		}
+	}
+}
+
+func (s *MediaService) reloadBook(ctx context.Context, before *models.Book) (*models.Book, error) {
+	book, err := s.books.GetByID(ctx, before.ID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "book", book.ID, before, book)
+	return book, nil
+}
+
+func (s *MediaService) reloadAuthor(ctx context.Context, before *models.Author) (*models.Author, error) {
+	author, err := s.authors.GetByID(ctx, before.ID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "author", author.ID, before, author)
+	return author, nil
+}
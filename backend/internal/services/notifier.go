@@ -0,0 +1,23 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+)
+
+// LogNotifier writes notifications to the log. The outbox dispatcher uses it
+// as the sender until notifications are delivered to customers.
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, notification dto.Notification) error {
+	log.Printf("notification %s for user %s: book %s, edition %s, price %s (was %s), stock %d",
+		notification.Kind, notification.UserID, notification.BookID, notification.EditionID,
+		notification.Price, notification.PreviousPrice, notification.Stock)
+	return nil
+}
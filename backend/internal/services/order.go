@@ -0,0 +1,616 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/giftcard"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/loyalty"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/pickup"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/shipping"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/tax"
+	"github.com/google/uuid"
+)
+
+var paymentMethods = map[string]string{
+	strings.ToLower(models.PaymentMethodCard): models.PaymentMethodCard,
+	strings.ToLower(models.PaymentMethodCash): models.PaymentMethodCash,
+}
+
+// maxGiftCards is how many gift cards an order may buy.
+const maxGiftCards = 10
+
+// Refund methods of returned orders.
+const (
+	refundOriginal    = "original"
+	refundStoreCredit = "store_credit"
+)
+
+// orderTransitions maps each status employees can set to the statuses the
+// order may be in. Marking a pickup order ready again gives it a new code,
+// and one that is never collected is returned.
+var orderTransitions = map[string][]string{
+	models.OrderStatusReadyForPickup: {models.OrderStatusNew, models.OrderStatusReadyForPickup},
+	models.OrderStatusDelivered:      {models.OrderStatusNew},
+	models.OrderStatusReturned:       {models.OrderStatusDelivered, models.OrderStatusReadyForPickup},
+}
+
+// OrderService turns carts into orders. The order keeps the prices,
+// promotions and VAT the cart had at checkout, whatever happens to them
+// later. Delivered orders earn loyalty points, which returns take back.
+// Each item is shipped from the warehouses allocated to it at checkout.
+type OrderService struct {
+	repo       interfaces.OrderRepositoryInterface
+	carts      interfaces.CartServiceInterface
+	editions   interfaces.EditionRepositoryInterface
+	listener   interfaces.EditionListenerInterface
+	shipping   interfaces.ShippingServiceInterface
+	warehouses interfaces.WarehouseServiceInterface
+	points     interfaces.LoyaltyRepositoryInterface
+	program    loyalty.Program
+	giftCards  interfaces.GiftCardRepositoryInterface
+	terms      giftcard.Terms
+	codes      pickup.Codes
+	audit      interfaces.AuditRecorderInterface
+}
+
+// NewOrderService creates the service; listener is told about the stock
+// taken by every order, terms set how long refunded store credit lasts and
+// codes make the pickup codes.
+func NewOrderService(repo interfaces.OrderRepositoryInterface, carts interfaces.CartServiceInterface, editions interfaces.EditionRepositoryInterface, listener interfaces.EditionListenerInterface, shipping interfaces.ShippingServiceInterface, warehouses interfaces.WarehouseServiceInterface, points interfaces.LoyaltyRepositoryInterface, program loyalty.Program, giftCards interfaces.GiftCardRepositoryInterface, terms giftcard.Terms, codes pickup.Codes, audit interfaces.AuditRecorderInterface) *OrderService {
+	return &OrderService{repo: repo, carts: carts, editions: editions, listener: listener, shipping: shipping, warehouses: warehouses, points: points, program: program, giftCards: giftCards, terms: terms, codes: codes, audit: audit}
+}
+
+// Checkout places the user's cart as an order, shipped with the chosen
+// method or collected at a pickup point for free. Until shipping methods
+// are set up, orders are delivered to the address for free. Gift cards
+// bought with the order are paid for with money like the rest of it; their
+// codes are returned only here, and they are funded once the order is
+// paid, see MarkPaid.
+func (s *OrderService) Checkout(ctx context.Context, userID uuid.UUID, input dto.CheckoutInput) (*models.Order, error) {
+	method, ok := paymentMethods[strings.ToLower(strings.TrimSpace(input.PaymentMethod))]
+	if !ok {
+		return nil, apperrors.ErrBadRequest("payment_method must be Card or Cash")
+	}
+	switch {
+	case input.PickupPointID != nil && input.ShippingMethodID != nil:
+		return nil, apperrors.ErrBadRequest("choose either shipping_method_id or pickup_point_id")
+	case input.PickupPointID != nil:
+	case strings.TrimSpace(input.Address) == "":
+		return nil, apperrors.ErrBadRequest("address is required")
+	}
+	if input.Points < 0 {
+		return nil, apperrors.ErrBadRequest("points must not be negative")
+	}
+	if len(input.GiftCards) > 0 {
+		if err := s.checkGiftCardPurchase(input); err != nil {
+			return nil, err
+		}
+	}
+
+	couponCode := ""
+	if input.CouponCode != nil {
+		couponCode = strings.TrimSpace(*input.CouponCode)
+	}
+	cart, err := s.carts.Get(ctx, userID, couponCode)
+	if err != nil {
+		return nil, err
+	}
+	if len(cart.Items) == 0 && len(input.GiftCards) == 0 {
+		return nil, apperrors.ErrBadRequest("cart is empty")
+	}
+	if cart.CouponError != "" {
+		return nil, apperrors.ErrBadRequest(cart.CouponError)
+	}
+	quote, delivery, err := s.deliveryFor(ctx, cart, input)
+	if err != nil {
+		return nil, err
+	}
+
+	giftCards, codes, bought, err := s.buyGiftCards(userID, input.GiftCards, cart.Rate)
+	if err != nil {
+		return nil, err
+	}
+
+	total := cart.Total + quote.Cost + bought
+	order := &models.Order{
+		UserID:           userID,
+		TotalPrice:       total,
+		Discount:         cart.Discount,
+		Tax:              cart.Tax + quote.Tax,
+		Taxes:            withShippingTax(cart.Taxes, quote),
+		Currency:         cart.Currency,
+		ExchangeRate:     cart.Rate,
+		ShippingMethodID: delivery.ShippingMethodID,
+		ShippingCost:     quote.Cost,
+		Status:           models.OrderStatusNew,
+		Items:            make([]*models.OrderItem, 0, len(cart.Items)),
+		Payment:          &models.Payment{Amount: total, Method: method},
+		Delivery:         delivery,
+		GiftCards:        giftCards,
+		GiftCardsBought:  bought,
+	}
+	for _, line := range cart.Items {
+		if line.Quantity > line.Edition.Stock {
+			return nil, apperrors.ErrConflict("not enough copies in stock: " + line.Edition.ID.String())
+		}
+		order.Items = append(order.Items, &models.OrderItem{
+			EditionID:  line.Edition.ID,
+			Quantity:   line.Quantity,
+			Price:      line.Price,
+			Discount:   line.Discount,
+			Promotions: line.Promotions,
+			TaxRate:    line.TaxRate,
+			Tax:        line.Tax,
+			Edition:    line.Edition,
+		})
+	}
+	if input.Points > 0 {
+		value, err := loyalty.Value(input.Points, cart.Rate)
+		if err != nil {
+			return nil, apperrors.ErrInternal(err)
+		}
+		if value > total {
+			return nil, apperrors.ErrBadRequest("the points are worth more than the order")
+		}
+		order.PointsRedeemed = input.Points
+		order.PointsValue = value
+	}
+	if input.UseStoreCredit || len(input.GiftCardCodes) > 0 {
+		entries, paid, err := giftCardPayments(ctx, s.giftCards, userID, input.UseStoreCredit, input.GiftCardCodes, total-order.PointsValue, cart.Rate)
+		if err != nil {
+			return nil, err
+		}
+		order.GiftCardTransactions = entries
+		order.GiftCardValue = paid
+	}
+	order.Payment.Amount = total - order.PointsValue - order.GiftCardValue
+	var redemption *models.CouponRedemption
+	if cart.Coupon != nil {
+		order.CouponCode = cart.Coupon.CouponCode
+		redemption = &models.CouponRedemption{PromotionID: cart.Coupon.ID, UserID: userID}
+	}
+	warehouses, err := s.warehouses.ShipFrom(ctx, delivery.PickupPointID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, order, warehouses, redemption); err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrOutOfStock):
+			return nil, apperrors.ErrConflict("some editions ran out of stock, check your cart")
+		case errors.Is(err, apperrors.ErrCouponUsedUp):
+			return nil, apperrors.ErrConflict("coupon has been used up")
+		case errors.Is(err, apperrors.ErrNotEnoughPoints):
+			return nil, apperrors.ErrConflict("not enough loyalty points")
+		case errors.Is(err, apperrors.ErrGiftCardUsedUp):
+			return nil, apperrors.ErrConflict("a gift card has been used in the meantime, try again")
+		}
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.notifyStock(ctx, order)
+	s.audit.Record(ctx, AuditActionCreate, "order", order.ID, nil, order)
+	for i, card := range order.GiftCards {
+		card.Code = codes[i]
+	}
+	return order, nil
+}
+
+// checkGiftCardPurchase checks the amounts of the gift cards the input
+// buys. Cards are bought with money only, so that points and store credit,
+// which are the customer's own, do not turn into codes anyone can spend.
+func (s *OrderService) checkGiftCardPurchase(input dto.CheckoutInput) error {
+	if len(input.GiftCards) > maxGiftCards {
+		return apperrors.ErrBadRequest("an order can buy at most " + strconv.Itoa(maxGiftCards) + " gift cards")
+	}
+	if input.Points > 0 || input.UseStoreCredit || len(input.GiftCardCodes) > 0 {
+		return apperrors.ErrBadRequest("gift cards can only be bought with money, not with points, store credit or gift cards")
+	}
+	for _, amount := range input.GiftCards {
+		if amount < s.terms.MinAmount || amount > s.terms.MaxAmount {
+			return apperrors.ErrBadRequest("gift cards must be worth between " + s.terms.MinAmount.String() + " and " + s.terms.MaxAmount.String())
+		}
+	}
+	return nil
+}
+
+// buyGiftCards makes the gift cards the user buys, worth amounts in the
+// base currency, without a balance yet. It returns them with their codes
+// and what they cost in the order's currency at rate.
+func (s *OrderService) buyGiftCards(userID uuid.UUID, amounts []money.Amount, rate money.Rate) ([]*models.GiftCard, []string, money.Amount, error) {
+	expiresAt := s.terms.ExpiresAt(time.Now())
+	var cards []*models.GiftCard
+	var codes []string
+	var price money.Amount
+	for _, amount := range amounts {
+		converted, err := rate.Convert(amount)
+		if err != nil {
+			return nil, nil, 0, apperrors.ErrInternal(err)
+		}
+		card := &models.GiftCard{Kind: models.GiftCardKindGiftCard, InitialAmount: amount, ExpiresAt: expiresAt, PurchasedBy: &userID}
+		code, err := newGiftCardCode(card)
+		if err != nil {
+			return nil, nil, 0, apperrors.ErrInternal(err)
+		}
+		cards = append(cards, card)
+		codes = append(codes, code)
+		price += converted
+	}
+	return cards, codes, price, nil
+}
+
+// GetMine returns the user's orders with the pickup codes of those ready
+// for pickup.
+func (s *OrderService) GetMine(ctx context.Context, userID uuid.UUID) ([]models.Order, error) {
+	orders, err := s.repo.GetAllByUser(ctx, userID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	for i := range orders {
+		s.showPickupCode(&orders[i])
+	}
+	return orders, nil
+}
+
+// GetByID returns one of the user's orders, with its pickup code if it is
+// ready for pickup; other users' orders are not found.
+func (s *OrderService) GetByID(ctx context.Context, userID, id uuid.UUID) (*models.Order, error) {
+	order, err := s.repo.GetByID(ctx, id)
+	if err != nil || order.UserID != userID {
+		return nil, apperrors.ErrNotFound("order not found")
+	}
+	s.showPickupCode(order)
+	return order, nil
+}
+
+// showPickupCode sets the code the customer tells the shop on an order
+// that is ready for pickup. Only the customer's own views show it.
+func (s *OrderService) showPickupCode(order *models.Order) {
+	if order.Status != models.OrderStatusReadyForPickup || order.Delivery == nil || order.Delivery.PickupCodeSalt == nil {
+		return
+	}
+	code := s.codes.Code(order.ID, *order.Delivery.PickupCodeSalt)
+	order.Delivery.PickupCode = &code
+}
+
+// UpdateStatus lets employees mark a pickup order ready for pickup, which
+// gives it a new pickup code, an order delivered, which credits the
+// customer's loyalty points, or returned, which takes them back, puts the
+// copies back in stock, gives back the points and gift card balance spent
+// on it and cancels what is left on the gift cards it bought. What was
+// paid with money is refunded as store credit if the input asks for it. Pickup orders are delivered by handing them over
+// with HandOver, or returned if they are not collected.
+func (s *OrderService) UpdateStatus(ctx context.Context, id uuid.UUID, input dto.OrderStatusInput) (*models.Order, error) {
+	var status string
+	for candidate := range orderTransitions {
+		if strings.EqualFold(candidate, strings.TrimSpace(input.Status)) {
+			status = candidate
+		}
+	}
+	if status == "" {
+		return nil, apperrors.ErrBadRequest("status must be ReadyForPickup, Delivered or Returned")
+	}
+	refund := strings.ToLower(strings.TrimSpace(input.Refund))
+	switch {
+	case refund != "" && status != models.OrderStatusReturned:
+		return nil, apperrors.ErrBadRequest("refund only applies to returns")
+	case refund != "" && refund != refundOriginal && refund != refundStoreCredit:
+		return nil, apperrors.ErrBadRequest("refund must be original or store_credit")
+	}
+	order, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("order not found")
+	}
+	if !slices.Contains(orderTransitions[status], order.Status) {
+		return nil, apperrors.ErrConflict("a " + order.Status + " order cannot become " + status)
+	}
+	pickup := isPickup(order)
+	switch {
+	case status == models.OrderStatusReadyForPickup && !pickup:
+		return nil, apperrors.ErrConflict("only pickup orders can be ready for pickup")
+	case status == models.OrderStatusDelivered && pickup:
+		return nil, apperrors.ErrConflict("pickup orders are delivered by handing them over with their pickup code")
+	}
+	return s.transition(ctx, order, status, refund == refundStoreCredit)
+}
+
+// HandOver delivers a pickup order that is ready for pickup to the
+// customer, once an employee has checked the code the customer tells them.
+// After pickup.MaxAttempts wrong codes the order is locked until it is
+// marked ready for pickup again, which gives it a new code.
+func (s *OrderService) HandOver(ctx context.Context, id uuid.UUID, input dto.PickupInput) (*models.Order, error) {
+	code := strings.TrimSpace(input.Code)
+	if code == "" {
+		return nil, apperrors.ErrBadRequest("code is required")
+	}
+	order, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("order not found")
+	}
+	if !isPickup(order) {
+		return nil, apperrors.ErrConflict("the order is not for pickup")
+	}
+	if order.Status != models.OrderStatusReadyForPickup {
+		return nil, apperrors.ErrConflict("a " + order.Status + " order cannot be handed over")
+	}
+	allowed, err := s.repo.UsePickupAttempt(ctx, order.ID, pickup.MaxAttempts)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	if !allowed {
+		return nil, apperrors.ErrConflict("too many wrong pickup codes, mark the order ready for pickup again to give it a new code")
+	}
+	if order.Delivery.PickupCodeSalt == nil || !s.codes.Check(order.ID, *order.Delivery.PickupCodeSalt, code) {
+		return nil, apperrors.ErrBadRequest("wrong pickup code")
+	}
+	return s.transition(ctx, order, models.OrderStatusDelivered, false)
+}
+
+// MarkPaid lets employees record that the order's payment has been
+// received, which funds the gift cards bought with it.
+func (s *OrderService) MarkPaid(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	order, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("order not found")
+	}
+	if order.Status == models.OrderStatusReturned {
+		return nil, apperrors.ErrConflict("a returned order cannot be paid")
+	}
+	if order.Payment == nil || order.Payment.Status != models.PaymentStatusNotPaid {
+		return nil, apperrors.ErrConflict("the order has been paid already")
+	}
+	var giftCards []*models.GiftCardTransaction
+	for _, card := range order.GiftCards {
+		giftCards = append(giftCards, &models.GiftCardTransaction{GiftCardID: card.ID, Kind: models.GiftCardTransactionIssue, Amount: card.InitialAmount})
+	}
+	before := *order
+	payment := *order.Payment
+	before.Payment = &payment
+	if err := s.repo.MarkPaid(ctx, order, giftCards); err != nil {
+		if errors.Is(err, apperrors.ErrVersionConflict) {
+			return nil, apperrors.ErrConflict("the order has been paid already")
+		}
+		return nil, apperrors.ErrInternal(err)
+	}
+	for _, card := range order.GiftCards {
+		card.Balance += card.InitialAmount
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "order", order.ID, &before, order)
+	return order, nil
+}
+
+// transition moves the order on to status with what goes with it: the
+// pickup code, loyalty points and, for returns, gift card refunds.
+func (s *OrderService) transition(ctx context.Context, order *models.Order, status string, storeCredit bool) (*models.Order, error) {
+	points, err := s.pointsFor(ctx, order, status)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	var giftCards []*models.GiftCardTransaction
+	if status == models.OrderStatusReturned {
+		if giftCards, err = s.giftCardRefunds(ctx, order, storeCredit); err != nil {
+			return nil, apperrors.ErrInternal(err)
+		}
+	}
+	before := *order
+	if order.Delivery != nil {
+		delivery := *order.Delivery
+		before.Delivery = &delivery
+	}
+	if status == models.OrderStatusReadyForPickup {
+		salt, err := pickup.NewSalt()
+		if err != nil {
+			return nil, apperrors.ErrInternal(err)
+		}
+		order.Delivery.PickupCodeSalt = &salt
+		order.Delivery.PickupAttempts = 0
+	}
+	from := order.Status
+	order.Status = status
+	if err := s.repo.UpdateStatus(ctx, order, from, points, giftCards); err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrVersionConflict):
+			return nil, apperrors.ErrConflict("the order has been updated by someone else, reload it")
+		case errors.Is(err, apperrors.ErrGiftCardUsedUp):
+			return nil, apperrors.ErrConflict("a gift card the order bought has been used in the meantime, try again")
+		}
+		return nil, apperrors.ErrInternal(err)
+	}
+	if status == models.OrderStatusReturned {
+		s.notifyStock(ctx, order)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "order", order.ID, &before, order)
+	return order, nil
+}
+
+// deliveryFor returns the shipping quote and the delivery of an order
+// placed with the input: the chosen shipping method's, a free pickup at an
+// active pickup point, or free delivery if no shipping method is offered.
+func (s *OrderService) deliveryFor(ctx context.Context, cart *dto.Cart, input dto.CheckoutInput) (dto.ShippingQuote, *models.Delivery, error) {
+	if input.PickupPointID != nil {
+		point, err := s.shipping.GetPickupPointByID(ctx, *input.PickupPointID)
+		if err != nil || !point.Active {
+			return dto.ShippingQuote{}, nil, apperrors.ErrBadRequest("the pickup point is not available")
+		}
+		weight := cartWeight(cart)
+		return dto.ShippingQuote{Name: point.Name, Currency: cart.Currency, Weight: weight}, &models.Delivery{
+			Address:       point.Address,
+			PostalCode:    point.PostalCode,
+			PickupPointID: &point.ID,
+			Method:        point.Name,
+			Weight:        weight,
+		}, nil
+	}
+
+	if input.ShippingMethodID == nil {
+		methods, err := s.shipping.GetMethods(ctx, dto.ShippingMethodFilter{Active: true})
+		if err != nil {
+			return dto.ShippingQuote{}, nil, err
+		}
+		if len(methods) > 0 {
+			return dto.ShippingQuote{}, nil, apperrors.ErrBadRequest("shipping_method_id or pickup_point_id is required")
+		}
+		weight := cartWeight(cart)
+		return dto.ShippingQuote{Currency: cart.Currency, Weight: weight}, &models.Delivery{
+			Address:    strings.TrimSpace(input.Address),
+			PostalCode: shipping.NormalizePostalCode(input.PostalCode),
+			Weight:     weight,
+		}, nil
+	}
+
+	quotes, err := s.shipping.QuoteCart(ctx, cart, input.PostalCode)
+	if err != nil {
+		return dto.ShippingQuote{}, nil, err
+	}
+	i := slices.IndexFunc(quotes, func(q dto.ShippingQuote) bool { return q.MethodID == *input.ShippingMethodID })
+	if i < 0 {
+		return dto.ShippingQuote{}, nil, apperrors.ErrBadRequest("the shipping method is not available for this order")
+	}
+	quote := quotes[i]
+	return quote, &models.Delivery{
+		Address:          strings.TrimSpace(input.Address),
+		PostalCode:       shipping.NormalizePostalCode(input.PostalCode),
+		ShippingMethodID: &quote.MethodID,
+		Method:           quote.Name,
+		Weight:           quote.Weight,
+		Cost:             quote.Cost,
+	}, nil
+}
+
+// isPickup reports whether the order is collected at a pickup point.
+func isPickup(order *models.Order) bool {
+	return order.Delivery != nil && order.Delivery.PickupPointID != nil
+}
+
+// pointsFor returns the ledger entries of an order moving to status: the
+// points a delivery earns on the items, not on shipping or gift cards, or
+// on return the reversal of those and a refund of the points the order was
+// paid with.
+func (s *OrderService) pointsFor(ctx context.Context, order *models.Order, status string) ([]*models.LoyaltyTransaction, error) {
+	expiresAt := s.program.ExpiresAt(time.Now())
+	switch status {
+	case models.OrderStatusDelivered:
+		earned, err := s.program.Earned(order.TotalPrice-order.ShippingCost-order.GiftCardsBought, order.ExchangeRate)
+		if err != nil || earned == 0 {
+			return nil, err
+		}
+		return []*models.LoyaltyTransaction{{
+			UserID: order.UserID, Kind: models.LoyaltyKindEarn, Points: earned, ExpiresAt: &expiresAt, OrderID: &order.ID,
+		}}, nil
+	case models.OrderStatusReturned:
+		entries, err := s.points.GetByOrder(ctx, order.ID)
+		if err != nil {
+			return nil, err
+		}
+		var points []*models.LoyaltyTransaction
+		for _, entry := range entries {
+			switch entry.Kind {
+			case models.LoyaltyKindEarn:
+				points = append(points, &models.LoyaltyTransaction{
+					UserID: order.UserID, Kind: models.LoyaltyKindReverse, Points: -entry.Points, OrderID: &order.ID,
+				})
+			case models.LoyaltyKindRedeem:
+				points = append(points, &models.LoyaltyTransaction{
+					UserID: order.UserID, Kind: models.LoyaltyKindRefund, Points: -entry.Points, ExpiresAt: &expiresAt, OrderID: &order.ID,
+				})
+			}
+		}
+		return points, nil
+	}
+	return nil, nil
+}
+
+// withShippingTax adds the VAT included in the shipping cost to the cart's
+// breakdown.
+func withShippingTax(taxes []models.TaxLine, quote dto.ShippingQuote) []models.TaxLine {
+	if quote.Cost == 0 {
+		return taxes
+	}
+	lines := make([]tax.Line, 0, len(taxes)+1)
+	for _, line := range taxes {
+		lines = append(lines, tax.Line{Rate: line.Rate, Total: line.Total, Tax: line.Tax})
+	}
+	lines = append(lines, tax.Line{Rate: quote.TaxRate, Total: quote.Cost, Tax: quote.Tax})
+	return tax.Breakdown(lines)
+}
+
+// giftCardRefunds puts back on each card what the order took from it and,
+// with storeCredit, issues the customer store credit for the part paid with
+// money. What was taken from cards that have expired since goes to store
+// credit too, as Expire would write it off again. What is left on the gift
+// cards the order bought is cancelled.
+func (s *OrderService) giftCardRefunds(ctx context.Context, order *models.Order, storeCredit bool) ([]*models.GiftCardTransaction, error) {
+	entries, err := s.giftCards.GetByOrder(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var refunds []*models.GiftCardTransaction
+	var amount money.Amount
+	for _, entry := range entries {
+		if entry.Kind != models.GiftCardTransactionRedeem {
+			continue
+		}
+		if entry.GiftCard != nil && !entry.GiftCard.ExpiresAt.After(now) {
+			amount -= entry.Amount
+			continue
+		}
+		refunds = append(refunds, &models.GiftCardTransaction{GiftCardID: entry.GiftCardID, Kind: models.GiftCardTransactionRefund, Amount: -entry.Amount})
+	}
+	for _, card := range order.GiftCards {
+		if card.Balance > 0 {
+			refunds = append(refunds, &models.GiftCardTransaction{GiftCardID: card.ID, Kind: models.GiftCardTransactionCancel, Amount: -card.Balance})
+		}
+	}
+	if storeCredit && order.Payment != nil && order.Payment.Amount > 0 {
+		paid, err := order.ExchangeRate.ConvertBack(order.Payment.Amount)
+		if err != nil {
+			return nil, err
+		}
+		amount += paid
+	}
+	if amount <= 0 {
+		return refunds, nil
+	}
+	reason := "Refund of order " + order.ID.String()
+	refunds = append(refunds, &models.GiftCardTransaction{
+		Kind:   models.GiftCardTransactionIssue,
+		Amount: amount,
+		GiftCard: &models.GiftCard{
+			Kind:          models.GiftCardKindStoreCredit,
+			InitialAmount: amount,
+			ExpiresAt:     s.terms.ExpiresAt(now),
+			OwnerID:       &order.UserID,
+			Reason:        &reason,
+		},
+	})
+	return refunds, nil
+}
+
+// notifyStock tells the listener about the copies the order took, or put
+// back if it was returned. The editions are reloaded, as the cart's ones
+// carry the customer's prices.
+func (s *OrderService) notifyStock(ctx context.Context, order *models.Order) {
+	for _, item := range order.Items {
+		after, err := s.editions.GetByID(ctx, item.EditionID)
+		if err != nil {
+			continue
+		}
+		before := *after
+		if order.Status == models.OrderStatusReturned {
+			before.Stock -= item.Quantity
+		} else {
+			before.Stock += item.Quantity
+		}
+		s.listener.EditionChanged(ctx, &before, after)
+	}
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+)
+
+const (
+	outboxBatchSize   = 50
+	maxOutboxAttempts = 5
+)
+
+// OutboxNotifier queues notifications in the outbox instead of sending them.
+// The same notification is queued at most once per day.
+type OutboxNotifier struct {
+	repo interfaces.OutboxRepositoryInterface
+}
+
+func NewOutboxNotifier(repo interfaces.OutboxRepositoryInterface) *OutboxNotifier {
+	return &OutboxNotifier{repo: repo}
+}
+
+func (n *OutboxNotifier) Notify(ctx context.Context, notification dto.Notification) error {
+	key := notification.Kind + ":" + notification.UserID.String() + ":" + notification.EditionID.String()
+	message, err := newOutboxMessage(notification, key)
+	if err != nil {
+		return err
+	}
+	return n.repo.Enqueue(ctx, message)
+}
+
+// OutboxDispatcher delivers queued notifications through the sender. Failed
+// deliveries are retried with a growing delay and given up after
+// maxOutboxAttempts.
+type OutboxDispatcher struct {
+	repo   interfaces.OutboxRepositoryInterface
+	sender interfaces.NotifierInterface
+}
+
+func NewOutboxDispatcher(repo interfaces.OutboxRepositoryInterface, sender interfaces.NotifierInterface) *OutboxDispatcher {
+	return &OutboxDispatcher{repo: repo, sender: sender}
+}
+
+// Run dispatches pending notifications every interval until ctx is done.
+func (d *OutboxDispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for {
+			processed, err := d.Dispatch(ctx)
+			if err != nil {
+				log.Printf("failed to dispatch notifications: %v", err)
+			}
+			if err != nil || processed < outboxBatchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch sends one batch of due notifications and returns its size.
+func (d *OutboxDispatcher) Dispatch(ctx context.Context) (int, error) {
+	return d.repo.Process(ctx, outboxBatchSize, func(message *models.OutboxMessage) {
+		message.Attempts++
+		err := d.send(ctx, message)
+		if err == nil {
+			now := time.Now()
+			message.Status = models.OutboxStatusSent
+			message.SentAt = &now
+			message.LastError = nil
+			return
+		}
+
+		reason := err.Error()
+		message.LastError = &reason
+		if message.Attempts >= maxOutboxAttempts {
+			message.Status = models.OutboxStatusFailed
+			return
+		}
+		message.AvailableAt = time.Now().Add(time.Duration(message.Attempts*message.Attempts) * time.Minute)
+	})
+}
+
+func (d *OutboxDispatcher) send(ctx context.Context, message *models.OutboxMessage) error {
+	var notification dto.Notification
+	if err := json.Unmarshal(message.Payload, &notification); err != nil {
+		return err
+	}
+	return d.sender.Notify(ctx, notification)
+}
+
+// newOutboxMessage queues the notification under the dedup key, scoped to
+// the current day.
+func newOutboxMessage(notification dto.Notification, key string) (*models.OutboxMessage, error) {
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return nil, err
+	}
+	return &models.OutboxMessage{
+		UserID:   notification.UserID,
+		Kind:     notification.Kind,
+		Payload:  payload,
+		DedupKey: key + ":" + time.Now().UTC().Format(time.DateOnly),
+		Status:   models.OutboxStatusPending,
+	}, nil
+}
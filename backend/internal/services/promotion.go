@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+const (
+	maxPromotionNameLength = 200
+	minCouponCodeLength    = 3
+	maxCouponCodeLength    = 32
+)
+
+var promotionScopes = map[string]bool{
+	models.PromotionScopeAll:       true,
+	models.PromotionScopeBook:      true,
+	models.PromotionScopeCategory:  true,
+	models.PromotionScopeAuthor:    true,
+	models.PromotionScopePublisher: true,
+}
+
+// PromotionService lets employees run sales and hand out coupons. Which
+// promotions a cart gets is worked out by the cart service.
+type PromotionService struct {
+	repo  interfaces.PromotionRepositoryInterface
+	audit interfaces.AuditRecorderInterface
+}
+
+func NewPromotionService(repo interfaces.PromotionRepositoryInterface, audit interfaces.AuditRecorderInterface) *PromotionService {
+	return &PromotionService{repo: repo, audit: audit}
+}
+
+func (s *PromotionService) Create(ctx context.Context, input dto.PromotionInput) (*models.Promotion, error) {
+	promotion := &models.Promotion{}
+	if err := s.apply(ctx, promotion, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, promotion); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "promotion", promotion.ID, nil, promotion)
+	return promotion, nil
+}
+
+func (s *PromotionService) GetAll(ctx context.Context, filter dto.PromotionFilter) ([]models.Promotion, error) {
+	promotions, err := s.repo.GetAll(ctx, filter)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return promotions, nil
+}
+
+func (s *PromotionService) GetByID(ctx context.Context, id uuid.UUID) (*models.Promotion, error) {
+	promotion, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("promotion not found")
+	}
+	return promotion, nil
+}
+
+func (s *PromotionService) Update(ctx context.Context, id uuid.UUID, version int64, input dto.PromotionInput) (*models.Promotion, error) {
+	promotion, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion("promotion", promotion.Version, version); err != nil {
+		return nil, err
+	}
+	before := *promotion
+
+	if err := s.apply(ctx, promotion, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, promotion); err != nil {
+		return nil, versionedWriteError("promotion", err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "promotion", id, &before, promotion)
+	return promotion, nil
+}
+
+// Delete removes a promotion nobody has used a coupon of yet; used ones
+// are kept for the orders and can only be switched off.
+func (s *PromotionService) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	promotion, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion("promotion", promotion.Version, version); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, id, version); err != nil {
+		if errors.Is(err, apperrors.ErrForeignKey) {
+			return apperrors.ErrConflict("promotion has been used, deactivate it instead")
+		}
+		return versionedWriteError("promotion", err)
+	}
+	s.audit.Record(ctx, AuditActionDelete, "promotion", id, promotion, nil)
+	return nil
+}
+
+// apply validates the input and copies it onto the promotion.
+func (s *PromotionService) apply(ctx context.Context, promotion *models.Promotion, input dto.PromotionInput) error {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return apperrors.ErrBadRequest("name is required")
+	}
+	if utf8.RuneCountInString(name) > maxPromotionNameLength {
+		return apperrors.ErrBadRequest("name is too long")
+	}
+
+	switch input.Kind {
+	case models.PromotionKindPercent:
+		if input.Percent < 1 || input.Percent > 100 {
+			return apperrors.ErrBadRequest("percent must be between 1 and 100")
+		}
+		input.Amount = 0
+	case models.PromotionKindFixed:
+		if input.Amount <= 0 {
+			return apperrors.ErrBadRequest("amount must be positive")
+		}
+		input.Percent = 0
+	default:
+		return apperrors.ErrBadRequest("kind must be percent or fixed")
+	}
+
+	if input.Scope == "" {
+		input.Scope = models.PromotionScopeAll
+	}
+	if !promotionScopes[input.Scope] {
+		return apperrors.ErrBadRequest("unknown scope: " + input.Scope)
+	}
+	targets := uniqueIDs(input.TargetIDs)
+	if input.Scope == models.PromotionScopeAll {
+		targets = []uuid.UUID{}
+	} else if len(targets) == 0 {
+		return apperrors.ErrBadRequest("target_ids are required for scope " + input.Scope)
+	}
+
+	startsAt := time.Now()
+	if input.StartsAt != nil {
+		startsAt = *input.StartsAt
+	}
+	if input.EndsAt != nil && !input.EndsAt.After(startsAt) {
+		return apperrors.ErrBadRequest("ends_at must be after starts_at")
+	}
+	if input.MinOrderTotal < 0 {
+		return apperrors.ErrBadRequest("min_order_total must not be negative")
+	}
+	if (input.UsageLimit != nil && *input.UsageLimit < 1) || (input.PerUserLimit != nil && *input.PerUserLimit < 1) {
+		return apperrors.ErrBadRequest("usage limits must be positive")
+	}
+
+	var code *string
+	if input.CouponCode != nil {
+		normalized, err := normalizeCouponCode(*input.CouponCode)
+		if err != nil {
+			return err
+		}
+		if existing, err := s.repo.GetByCouponCode(ctx, normalized); err == nil && existing.ID != promotion.ID {
+			return apperrors.ErrConflict("coupon code is already in use")
+		}
+		code = &normalized
+	} else if input.UsageLimit != nil || input.PerUserLimit != nil {
+		return apperrors.ErrBadRequest("usage limits need a coupon code")
+	}
+
+	promotion.Name = name
+	promotion.Kind = input.Kind
+	promotion.Percent = input.Percent
+	promotion.Amount = input.Amount
+	promotion.Scope = input.Scope
+	promotion.TargetIDs = targets
+	promotion.StartsAt = startsAt
+	promotion.EndsAt = input.EndsAt
+	promotion.MinOrderTotal = input.MinOrderTotal
+	promotion.CouponCode = code
+	promotion.UsageLimit = input.UsageLimit
+	promotion.PerUserLimit = input.PerUserLimit
+	promotion.Exclusive = input.Exclusive
+	promotion.Active = input.Active == nil || *input.Active
+	return nil
+}
+
+// normalizeCouponCode upper-cases a coupon code, so customers may type it
+// in any case, and checks that it only has letters, digits and dashes.
+func normalizeCouponCode(raw string) (string, error) {
+	code := strings.ToUpper(strings.TrimSpace(raw))
+	if len(code) < minCouponCodeLength || len(code) > maxCouponCodeLength {
+		return "", apperrors.ErrBadRequest("coupon code must have 3 to 32 characters")
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' {
+			return "", apperrors.ErrBadRequest("coupon code may only have latin letters, digits and dashes")
+		}
+	}
+	return code, nil
+}
+
+// promotionRunning reports whether the promotion is switched on and within
+// its dates at the given time.
+func promotionRunning(promotion *models.Promotion, at time.Time) bool {
+	return promotion.Active && !promotion.StartsAt.After(at) && (promotion.EndsAt == nil || promotion.EndsAt.After(at))
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if id != uuid.Nil && !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -6,16 +6,18 @@ import (
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/mergepatch"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
 	"github.com/google/uuid"
 )
 
 type PublisherService struct {
-	repo interfaces.PublisherRepositoryInterface
+	repo  interfaces.PublisherRepositoryInterface
+	audit interfaces.AuditRecorderInterface
 }
 
-func NewPublisherService(repo interfaces.PublisherRepositoryInterface) *PublisherService {
-	return &PublisherService{repo: repo}
+func NewPublisherService(repo interfaces.PublisherRepositoryInterface, audit interfaces.AuditRecorderInterface) *PublisherService {
+	return &PublisherService{repo: repo, audit: audit}
 }
 
 func (s *PublisherService) Create(ctx context.Context, input dto.PublisherInput) (*models.Publisher, error) {
@@ -26,6 +28,7 @@ func (s *PublisherService) Create(ctx context.Context, input dto.PublisherInput)
 	if err := s.repo.Create(ctx, publisher); err != nil {
 		return nil, apperrors.ErrInternal(err)
 	}
+	s.audit.Record(ctx, AuditActionCreate, "publisher", publisher.ID, nil, publisher)
 	return publisher, nil
 }
 
@@ -45,24 +48,55 @@ func (s *PublisherService) GetAll(ctx context.Context) ([]models.Publisher, erro
 	return publishers, nil
 }
 
-func (s *PublisherService) Update(ctx context.Context, id uuid.UUID, input dto.PublisherInput) (*models.Publisher, error) {
+func (s *PublisherService) Update(ctx context.Context, id uuid.UUID, version int64, input dto.PublisherInput) (*models.Publisher, error) {
 	publisher, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, apperrors.ErrNotFound("publisher not found")
 	}
+	if err := checkVersion("publisher", publisher.Version, version); err != nil {
+		return nil, err
+	}
+	before := *publisher
 
 	publisher.Name = input.Name
 	publisher.Address = input.Address
 
 	if err := s.repo.Update(ctx, publisher); err != nil {
-		return nil, apperrors.ErrInternal(err)
+		return nil, versionedWriteError("publisher", err)
 	}
+	s.audit.Record(ctx, AuditActionUpdate, "publisher", publisher.ID, &before, publisher)
 	return publisher, nil
 }
 
-func (s *PublisherService) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := s.repo.Delete(ctx, id); err != nil {
-		return apperrors.ErrInternal(err)
+// Patch applies a JSON merge patch to the publisher and saves the result.
+func (s *PublisherService) Patch(ctx context.Context, id uuid.UUID, version int64, patch []byte) (*models.Publisher, error) {
+	publisher, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("publisher not found")
+	}
+
+	input := dto.PublisherInput{
+		Name:    publisher.Name,
+		Address: publisher.Address,
+	}
+	if err := mergepatch.ApplyTo(&input, patch); err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+	return s.Update(ctx, id, version, input)
+}
+
+func (s *PublisherService) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	publisher, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return apperrors.ErrNotFound("publisher not found")
+	}
+	if err := checkVersion("publisher", publisher.Version, version); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id, version); err != nil {
+		return versionedWriteError("publisher", err)
 	}
+	s.audit.Record(ctx, AuditActionDelete, "publisher", id, publisher, nil)
 	return nil
 }
\ No newline at end of file
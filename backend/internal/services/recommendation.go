@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+const (
+	relatedBooksPerBook        = 20
+	defaultRecommendationLimit = 10
+	maxRecommendationLimit     = 50
+)
+
+// RecommendationService serves "customers also bought" recommendations.
+// They are read from a table the service rebuilds periodically from order
+// history, so requests never aggregate orders themselves.
+type RecommendationService struct {
+	repo   interfaces.RecommendationRepositoryInterface
+	books  interfaces.BookRepositoryInterface
+	prices interfaces.PriceLocalizerInterface
+}
+
+func NewRecommendationService(repo interfaces.RecommendationRepositoryInterface, books interfaces.BookRepositoryInterface, prices interfaces.PriceLocalizerInterface) *RecommendationService {
+	return &RecommendationService{repo: repo, books: books, prices: prices}
+}
+
+// Run rebuilds the recommendations now and then every interval until ctx is
+// done.
+func (s *RecommendationService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Refresh(ctx); err != nil {
+			log.Printf("failed to refresh recommendations: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *RecommendationService) Refresh(ctx context.Context) error {
+	return s.repo.Rebuild(ctx, relatedBooksPerBook)
+}
+
+// GetRelated returns the books bought together with the book, or for books
+// with few sales, books by the same authors or in the same categories.
+func (s *RecommendationService) GetRelated(ctx context.Context, bookID uuid.UUID, query dto.RecommendationQuery) ([]models.Book, error) {
+	limit, err := recommendationLimit(query)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.books.GetByID(ctx, bookID); err != nil {
+		return nil, apperrors.ErrNotFound("book not found")
+	}
+	books, err := s.repo.GetRelated(ctx, bookID, limit)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return s.localize(ctx, books)
+}
+
+// GetForUser recommends books related to the user's orders and wishlists.
+// Users with little or no history get bestsellers to fill the list.
+func (s *RecommendationService) GetForUser(ctx context.Context, userID uuid.UUID, query dto.RecommendationQuery) ([]models.Book, error) {
+	limit, err := recommendationLimit(query)
+	if err != nil {
+		return nil, err
+	}
+	books, err := s.repo.GetForUser(ctx, userID, limit)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	if len(books) >= limit {
+		return s.localize(ctx, books)
+	}
+
+	bestsellers, err := s.repo.GetBestsellers(ctx, userID, limit)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	seen := make(map[uuid.UUID]bool, len(books))
+	for _, book := range books {
+		seen[book.ID] = true
+	}
+	for _, book := range bestsellers {
+		if len(books) == limit {
+			break
+		}
+		if !seen[book.ID] {
+			books = append(books, book)
+		}
+	}
+	return s.localize(ctx, books)
+}
+
+func (s *RecommendationService) localize(ctx context.Context, books []models.Book) ([]models.Book, error) {
+	refs := make([]*models.Book, len(books))
+	for i := range books {
+		refs[i] = &books[i]
+	}
+	if err := s.prices.Localize(ctx, bookEditions(refs...)); err != nil {
+		return nil, err
+	}
+	return books, nil
+}
+
+func recommendationLimit(query dto.RecommendationQuery) (int, error) {
+	if query.Limit < 0 {
+		return 0, apperrors.ErrBadRequest("limit must not be negative")
+	}
+	if query.Limit == 0 {
+		return defaultRecommendationLimit, nil
+	}
+	return min(query.Limit, maxRecommendationLimit), nil
+}
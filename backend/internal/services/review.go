@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/actor"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+const (
+	maxReviewLength       = 5000
+	defaultReviewPageSize = 20
+	maxReviewPageSize     = 100
+)
+
+const (
+	ModerationApprove = "approve"
+	ModerationReject  = "reject"
+	ModerationHide    = "hide"
+)
+
+var reviewSorts = map[string]bool{"": true, "newest": true, "oldest": true, "rating_desc": true, "rating_asc": true}
+
+var reviewStatuses = map[string]bool{
+	models.ReviewStatusPending:  true,
+	models.ReviewStatusApproved: true,
+	models.ReviewStatusRejected: true,
+	models.ReviewStatusHidden:   true,
+}
+
+// moderationTransitions lists the statuses each moderation action may be
+// applied to and the status it leads to.
+var moderationTransitions = map[string]struct {
+	from map[string]bool
+	to   string
+}{
+	ModerationApprove: {from: map[string]bool{models.ReviewStatusPending: true, models.ReviewStatusRejected: true, models.ReviewStatusHidden: true}, to: models.ReviewStatusApproved},
+	ModerationReject:  {from: map[string]bool{models.ReviewStatusPending: true}, to: models.ReviewStatusRejected},
+	ModerationHide:    {from: map[string]bool{models.ReviewStatusApproved: true}, to: models.ReviewStatusHidden},
+}
+
+// ReviewService lets customers review books they have received. New and
+// edited reviews wait for a moderator before they become public.
+type ReviewService struct {
+	repo  interfaces.ReviewRepositoryInterface
+	books interfaces.BookRepositoryInterface
+	audit interfaces.AuditRecorderInterface
+}
+
+func NewReviewService(repo interfaces.ReviewRepositoryInterface, books interfaces.BookRepositoryInterface, audit interfaces.AuditRecorderInterface) *ReviewService {
+	return &ReviewService{repo: repo, books: books, audit: audit}
+}
+
+func (s *ReviewService) Create(ctx context.Context, userID, bookID uuid.UUID, input dto.ReviewInput) (*models.Review, error) {
+	if err := validateReview(input); err != nil {
+		return nil, err
+	}
+	if _, err := s.books.GetByID(ctx, bookID); err != nil {
+		return nil, apperrors.ErrNotFound("book not found")
+	}
+	purchased, err := s.repo.HasDeliveredPurchase(ctx, userID, bookID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	if !purchased {
+		return nil, apperrors.ErrForbidden("only customers who received the book can review it")
+	}
+	exists, err := s.repo.ExistsForUser(ctx, bookID, userID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	if exists {
+		return nil, apperrors.ErrConflict("you have already reviewed this book")
+	}
+
+	review := &models.Review{
+		BookID: bookID,
+		UserID: userID,
+		Rating: input.Rating,
+		Text:   strings.TrimSpace(input.Text),
+		Status: models.ReviewStatusPending,
+	}
+	if err := s.repo.Create(ctx, review); err != nil {
+		if errors.Is(err, apperrors.ErrDuplicate) {
+			return nil, apperrors.ErrConflict("you have already reviewed this book")
+		}
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "review", review.ID, nil, review)
+	return review, nil
+}
+
+// GetForBook returns a page of the book's approved reviews.
+func (s *ReviewService) GetForBook(ctx context.Context, bookID uuid.UUID, query dto.ReviewQuery) (*dto.ReviewPage, error) {
+	if _, err := s.books.GetByID(ctx, bookID); err != nil {
+		return nil, apperrors.ErrNotFound("book not found")
+	}
+	return s.list(ctx, &bookID, nil, models.ReviewStatusApproved, query)
+}
+
+// GetMine returns the user's own reviews in every status.
+func (s *ReviewService) GetMine(ctx context.Context, userID uuid.UUID, query dto.ReviewQuery) (*dto.ReviewPage, error) {
+	return s.list(ctx, nil, &userID, "", query)
+}
+
+// GetModerationQueue returns reviews in the requested status, pending ones
+// by default.
+func (s *ReviewService) GetModerationQueue(ctx context.Context, query dto.ReviewQuery) (*dto.ReviewPage, error) {
+	status := query.Status
+	if status == "" {
+		status = models.ReviewStatusPending
+	}
+	if !reviewStatuses[status] {
+		return nil, apperrors.ErrBadRequest("unknown review status: " + status)
+	}
+	if query.Sort == "" {
+		query.Sort = "oldest"
+	}
+	return s.list(ctx, nil, nil, status, query)
+}
+
+// Update changes the user's own review and sends it back to moderation.
+func (s *ReviewService) Update(ctx context.Context, userID, id uuid.UUID, version int64, input dto.ReviewInput) (*models.Review, error) {
+	review, err := s.ownReview(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion("review", review.Version, version); err != nil {
+		return nil, err
+	}
+	if err := validateReview(input); err != nil {
+		return nil, err
+	}
+	before := *review
+
+	review.Rating = input.Rating
+	review.Text = strings.TrimSpace(input.Text)
+	review.Status = models.ReviewStatusPending
+	review.ModerationReason = nil
+	review.ModeratedBy = nil
+	review.ModeratedAt = nil
+	if err := s.repo.Update(ctx, review); err != nil {
+		return nil, versionedWriteError("review", err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "review", id, &before, review)
+	return review, nil
+}
+
+func (s *ReviewService) Delete(ctx context.Context, userID, id uuid.UUID, version int64) error {
+	review, err := s.ownReview(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion("review", review.Version, version); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, review, version); err != nil {
+		return versionedWriteError("review", err)
+	}
+	s.audit.Record(ctx, AuditActionDelete, "review", id, review, nil)
+	return nil
+}
+
+// Moderate approves, rejects or hides a review. Rejections need a reason
+// that is shown to the author.
+func (s *ReviewService) Moderate(ctx context.Context, id uuid.UUID, version int64, input dto.ModerationInput) (*models.Review, error) {
+	transition, ok := moderationTransitions[input.Action]
+	if !ok {
+		return nil, apperrors.ErrBadRequest("unknown moderation action: " + input.Action)
+	}
+	var reason *string
+	if input.Reason != nil {
+		if trimmed := strings.TrimSpace(*input.Reason); trimmed != "" {
+			reason = &trimmed
+		}
+	}
+	if input.Action == ModerationReject && reason == nil {
+		return nil, apperrors.ErrBadRequest("a reason is required to reject a review")
+	}
+
+	review, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("review not found")
+	}
+	if err := checkVersion("review", review.Version, version); err != nil {
+		return nil, err
+	}
+	if !transition.from[review.Status] {
+		return nil, apperrors.ErrConflict("cannot " + input.Action + " a " + review.Status + " review")
+	}
+	before := *review
+
+	now := time.Now()
+	review.Status = transition.to
+	review.ModerationReason = reason
+	review.ModeratedAt = &now
+	review.ModeratedBy = nil
+	if a, ok := actor.FromContext(ctx); ok {
+		moderator := a.UserID
+		review.ModeratedBy = &moderator
+	}
+	if err := s.repo.Update(ctx, review); err != nil {
+		return nil, versionedWriteError("review", err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "review", id, &before, review)
+	return review, nil
+}
+
+func (s *ReviewService) ownReview(ctx context.Context, userID, id uuid.UUID) (*models.Review, error) {
+	review, err := s.repo.GetByID(ctx, id)
+	// other users' reviews are reported as missing rather than forbidden
+	if err != nil || review.UserID != userID {
+		return nil, apperrors.ErrNotFound("review not found")
+	}
+	return review, nil
+}
+
+func (s *ReviewService) list(ctx context.Context, bookID, userID *uuid.UUID, status string, query dto.ReviewQuery) (*dto.ReviewPage, error) {
+	if !reviewSorts[query.Sort] {
+		return nil, apperrors.ErrBadRequest("unknown sort: " + query.Sort)
+	}
+	if query.Limit < 0 || query.Offset < 0 {
+		return nil, apperrors.ErrBadRequest("limit and offset must not be negative")
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultReviewPageSize
+	}
+	query.Limit = min(query.Limit, maxReviewPageSize)
+	reviews, total, err := s.repo.List(ctx, bookID, userID, status, query)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return &dto.ReviewPage{Items: reviews, Total: total, Limit: query.Limit, Offset: query.Offset}, nil
+}
+
+func validateReview(input dto.ReviewInput) error {
+	if input.Rating < 1 || input.Rating > 5 {
+		return apperrors.ErrBadRequest("rating must be between 1 and 5")
+	}
+	if utf8.RuneCountInString(input.Text) > maxReviewLength {
+		return apperrors.ErrBadRequest("review text is too long")
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/mergepatch"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+type SeriesService struct {
+	repo  interfaces.SeriesRepositoryInterface
+	audit interfaces.AuditRecorderInterface
+}
+
+func NewSeriesService(repo interfaces.SeriesRepositoryInterface, audit interfaces.AuditRecorderInterface) *SeriesService {
+	return &SeriesService{repo: repo, audit: audit}
+}
+
+func (s *SeriesService) Create(ctx context.Context, input dto.SeriesInput) (*models.Series, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, apperrors.ErrBadRequest("series name is required")
+	}
+	series := &models.Series{Name: name, Description: input.Description}
+	if err := s.repo.Create(ctx, series); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "series", series.ID, nil, series)
+	return series, nil
+}
+
+// GetByID returns the series with its books in reading order.
+func (s *SeriesService) GetByID(ctx context.Context, id uuid.UUID) (*models.Series, error) {
+	series, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("series not found")
+	}
+	return series, nil
+}
+
+func (s *SeriesService) GetAll(ctx context.Context) ([]models.Series, error) {
+	series, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return series, nil
+}
+
+func (s *SeriesService) Update(ctx context.Context, id uuid.UUID, version int64, input dto.SeriesInput) (*models.Series, error) {
+	series, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("series not found")
+	}
+	if err := checkVersion("series", series.Version, version); err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, apperrors.ErrBadRequest("series name is required")
+	}
+	before := *series
+
+	series.Name = name
+	series.Description = input.Description
+	books := series.Books
+	series.Books = nil
+	if err := s.repo.Update(ctx, series); err != nil {
+		return nil, versionedWriteError("series", err)
+	}
+	series.Books = books
+	s.audit.Record(ctx, AuditActionUpdate, "series", id, &before, series)
+	return series, nil
+}
+
+// Patch applies a JSON merge patch to the series and saves the result.
+func (s *SeriesService) Patch(ctx context.Context, id uuid.UUID, version int64, patch []byte) (*models.Series, error) {
+	series, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("series not found")
+	}
+
+	input := dto.SeriesInput{Name: series.Name, Description: series.Description}
+	if err := mergepatch.ApplyTo(&input, patch); err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+	return s.Update(ctx, id, version, input)
+}
+
+// Delete removes an empty series. Books have to be moved out of it first.
+func (s *SeriesService) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	series, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return apperrors.ErrNotFound("series not found")
+	}
+	if err := checkVersion("series", series.Version, version); err != nil {
+		return err
+	}
+	if len(series.Books) > 0 {
+		return apperrors.ErrConflict("series still has books")
+	}
+
+	if err := s.repo.Delete(ctx, id, version); err != nil {
+		return versionedWriteError("series", err)
+	}
+	s.audit.Record(ctx, AuditActionDelete, "series", id, series, nil)
+	return nil
+}
+
+// seriesInfo finds the book in its series and links the neighbouring books
+// in reading order.
+func seriesInfo(series *models.Series, bookID uuid.UUID) *dto.SeriesInfo {
+	info := &dto.SeriesInfo{ID: series.ID, Name: series.Name}
+	for i, book := range series.Books {
+		if book.ID != bookID {
+			continue
+		}
+		info.Position = book.SeriesPosition
+		if i > 0 {
+			info.Previous = seriesLink(series.Books[i-1])
+		}
+		if i+1 < len(series.Books) {
+			info.Next = seriesLink(series.Books[i+1])
+		}
+		break
+	}
+	return info
+}
+
+func seriesLink(book *models.Book) *dto.SeriesLink {
+	return &dto.SeriesLink{ID: book.ID, Title: book.Title, Position: book.SeriesPosition}
+}
@@ -0,0 +1,470 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/shipping"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/tax"
+	"github.com/google/uuid"
+)
+
+const (
+	maxShippingNameLength  = 200
+	maxPickupAddressLength = 500
+	maxPickupHoursLength   = 500
+)
+
+var shippingKinds = map[string]bool{
+	models.ShippingKindCourier:     true,
+	models.ShippingKindPickupPoint: true,
+	models.ShippingKindPost:        true,
+}
+
+// ShippingService lets employees set up shipping methods, the zones their
+// rates depend on and the pickup points where customers collect orders,
+// and quotes customers' carts. Delivery is taxed at the standard VAT rate.
+type ShippingService struct {
+	repo  interfaces.ShippingRepositoryInterface
+	carts interfaces.CartServiceInterface
+	rates tax.Rates
+	audit interfaces.AuditRecorderInterface
+}
+
+func NewShippingService(repo interfaces.ShippingRepositoryInterface, carts interfaces.CartServiceInterface, rates tax.Rates, audit interfaces.AuditRecorderInterface) *ShippingService {
+	return &ShippingService{repo: repo, carts: carts, rates: rates, audit: audit}
+}
+
+func (s *ShippingService) CreateZone(ctx context.Context, input dto.ShippingZoneInput) (*models.ShippingZone, error) {
+	zone := &models.ShippingZone{}
+	if err := applyShippingZone(zone, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateZone(ctx, zone); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "shipping_zone", zone.ID, nil, zone)
+	return zone, nil
+}
+
+func (s *ShippingService) GetZones(ctx context.Context) ([]models.ShippingZone, error) {
+	zones, err := s.repo.GetZones(ctx)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return zones, nil
+}
+
+func (s *ShippingService) UpdateZone(ctx context.Context, id uuid.UUID, version int64, input dto.ShippingZoneInput) (*models.ShippingZone, error) {
+	zone, err := s.repo.GetZoneByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("shipping zone not found")
+	}
+	if err := checkVersion("shipping zone", zone.Version, version); err != nil {
+		return nil, err
+	}
+	before := *zone
+
+	if err := applyShippingZone(zone, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateZone(ctx, zone); err != nil {
+		return nil, versionedWriteError("shipping zone", err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "shipping_zone", id, &before, zone)
+	return zone, nil
+}
+
+// DeleteZone removes a zone no shipping method has rates for.
+func (s *ShippingService) DeleteZone(ctx context.Context, id uuid.UUID, version int64) error {
+	zone, err := s.repo.GetZoneByID(ctx, id)
+	if err != nil {
+		return apperrors.ErrNotFound("shipping zone not found")
+	}
+	if err := checkVersion("shipping zone", zone.Version, version); err != nil {
+		return err
+	}
+	methods, err := s.repo.GetMethods(ctx, dto.ShippingMethodFilter{})
+	if err != nil {
+		return apperrors.ErrInternal(err)
+	}
+	for _, method := range methods {
+		for _, rate := range method.Rates {
+			if rate.ZoneID != nil && *rate.ZoneID == id {
+				return apperrors.ErrConflict("shipping method " + method.Name + " has rates for the zone")
+			}
+		}
+	}
+
+	if err := s.repo.DeleteZone(ctx, id, version); err != nil {
+		return versionedWriteError("shipping zone", err)
+	}
+	s.audit.Record(ctx, AuditActionDelete, "shipping_zone", id, zone, nil)
+	return nil
+}
+
+func (s *ShippingService) CreateMethod(ctx context.Context, input dto.ShippingMethodInput) (*models.ShippingMethod, error) {
+	method := &models.ShippingMethod{}
+	if err := s.applyMethod(ctx, method, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateMethod(ctx, method); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "shipping_method", method.ID, nil, method)
+	return method, nil
+}
+
+func (s *ShippingService) GetMethods(ctx context.Context, filter dto.ShippingMethodFilter) ([]models.ShippingMethod, error) {
+	methods, err := s.repo.GetMethods(ctx, filter)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return methods, nil
+}
+
+func (s *ShippingService) GetMethodByID(ctx context.Context, id uuid.UUID) (*models.ShippingMethod, error) {
+	method, err := s.repo.GetMethodByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("shipping method not found")
+	}
+	return method, nil
+}
+
+func (s *ShippingService) UpdateMethod(ctx context.Context, id uuid.UUID, version int64, input dto.ShippingMethodInput) (*models.ShippingMethod, error) {
+	method, err := s.GetMethodByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion("shipping method", method.Version, version); err != nil {
+		return nil, err
+	}
+	before := *method
+
+	if err := s.applyMethod(ctx, method, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateMethod(ctx, method); err != nil {
+		return nil, versionedWriteError("shipping method", err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "shipping_method", id, &before, method)
+	return method, nil
+}
+
+func (s *ShippingService) DeleteMethod(ctx context.Context, id uuid.UUID, version int64) error {
+	method, err := s.GetMethodByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion("shipping method", method.Version, version); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteMethod(ctx, id, version); err != nil {
+		return versionedWriteError("shipping method", err)
+	}
+	s.audit.Record(ctx, AuditActionDelete, "shipping_method", id, method, nil)
+	return nil
+}
+
+// Quote prices the customer's cart and returns what each method offered
+// for it costs.
+func (s *ShippingService) Quote(ctx context.Context, userID uuid.UUID, query dto.ShippingQuoteQuery) ([]dto.ShippingQuote, error) {
+	cart, err := s.carts.Get(ctx, userID, strings.TrimSpace(query.Coupon))
+	if err != nil {
+		return nil, err
+	}
+	return s.QuoteCart(ctx, cart, query.PostalCode)
+}
+
+// QuoteCart returns what the active methods with a rate for the cart's
+// parcel and postal code cost, cheapest first, in the cart's currency.
+func (s *ShippingService) QuoteCart(ctx context.Context, cart *dto.Cart, postalCode string) ([]dto.ShippingQuote, error) {
+	postalCode = shipping.NormalizePostalCode(postalCode)
+	if postalCode == "" {
+		return nil, apperrors.ErrBadRequest("postal_code is required")
+	}
+	methods, err := s.repo.GetMethods(ctx, dto.ShippingMethodFilter{Active: true})
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	zones, err := s.repo.GetZones(ctx)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	var zoneID *uuid.UUID
+	if zone := shipping.ZoneFor(zones, postalCode); zone != nil {
+		zoneID = &zone.ID
+	}
+	weight := cartWeight(cart)
+
+	quotes := []dto.ShippingQuote{}
+	for i := range methods {
+		method := &methods[i]
+		rate, ok := shipping.RateFor(method, zoneID, weight)
+		if !ok {
+			continue
+		}
+		quote := dto.ShippingQuote{
+			MethodID: method.ID,
+			Name:     method.Name,
+			Kind:     method.Kind,
+			Currency: cart.Currency,
+			Weight:   weight,
+			TaxRate:  s.rates.Standard,
+		}
+		if quote.Cost, err = cart.Rate.Convert(rate.Cost); err != nil {
+			return nil, apperrors.ErrInternal(err)
+		}
+		if method.FreeOver != nil {
+			freeOver, err := cart.Rate.Convert(*method.FreeOver)
+			if err != nil {
+				return nil, apperrors.ErrInternal(err)
+			}
+			quote.FreeOver = &freeOver
+			if cart.Total >= freeOver {
+				quote.Cost = 0
+			}
+		}
+		quote.Tax = tax.Included(quote.Cost, quote.TaxRate)
+		quotes = append(quotes, quote)
+	}
+	slices.SortStableFunc(quotes, func(a, b dto.ShippingQuote) int { return cmp.Compare(a.Cost, b.Cost) })
+	return quotes, nil
+}
+
+func (s *ShippingService) CreatePickupPoint(ctx context.Context, input dto.PickupPointInput) (*models.PickupPoint, error) {
+	point := &models.PickupPoint{}
+	if err := applyPickupPoint(point, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreatePickupPoint(ctx, point); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "pickup_point", point.ID, nil, point)
+	return point, nil
+}
+
+// GetPickupPoints lists the active pickup points for customers, by name or,
+// given a location, nearest first.
+func (s *ShippingService) GetPickupPoints(ctx context.Context, query dto.PickupPointQuery) ([]models.PickupPoint, error) {
+	if (query.Latitude == nil) != (query.Longitude == nil) {
+		return nil, apperrors.ErrBadRequest("latitude and longitude go together")
+	}
+	points, err := s.repo.GetPickupPoints(ctx, true)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	if query.Latitude != nil {
+		lat, lon := *query.Latitude, *query.Longitude
+		slices.SortStableFunc(points, func(a, b models.PickupPoint) int {
+			return cmp.Compare(shipping.Distance(lat, lon, a.Latitude, a.Longitude), shipping.Distance(lat, lon, b.Latitude, b.Longitude))
+		})
+	}
+	return points, nil
+}
+
+// GetAllPickupPoints lists the pickup points for employees, the closed
+// ones included.
+func (s *ShippingService) GetAllPickupPoints(ctx context.Context) ([]models.PickupPoint, error) {
+	points, err := s.repo.GetPickupPoints(ctx, false)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return points, nil
+}
+
+func (s *ShippingService) GetPickupPointByID(ctx context.Context, id uuid.UUID) (*models.PickupPoint, error) {
+	point, err := s.repo.GetPickupPointByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("pickup point not found")
+	}
+	return point, nil
+}
+
+func (s *ShippingService) UpdatePickupPoint(ctx context.Context, id uuid.UUID, version int64, input dto.PickupPointInput) (*models.PickupPoint, error) {
+	point, err := s.GetPickupPointByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion("pickup point", point.Version, version); err != nil {
+		return nil, err
+	}
+	before := *point
+
+	if err := applyPickupPoint(point, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdatePickupPoint(ctx, point); err != nil {
+		return nil, versionedWriteError("pickup point", err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "pickup_point", id, &before, point)
+	return point, nil
+}
+
+// DeletePickupPoint removes a pickup point no order has been sent to.
+// Those that have orders are closed by making them inactive instead.
+func (s *ShippingService) DeletePickupPoint(ctx context.Context, id uuid.UUID, version int64) error {
+	point, err := s.GetPickupPointByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion("pickup point", point.Version, version); err != nil {
+		return err
+	}
+	if err := s.repo.DeletePickupPoint(ctx, id, version); err != nil {
+		if errors.Is(err, apperrors.ErrForeignKey) {
+			return apperrors.ErrConflict("the pickup point has orders, make it inactive instead")
+		}
+		return versionedWriteError("pickup point", err)
+	}
+	s.audit.Record(ctx, AuditActionDelete, "pickup_point", id, point, nil)
+	return nil
+}
+
+// cartWeight returns what the cart's parcel weighs in grams.
+func cartWeight(cart *dto.Cart) int {
+	weight := 0
+	for _, line := range cart.Items {
+		weight += shipping.Weight(line.Edition, line.Quantity)
+	}
+	return weight
+}
+
+// applyMethod validates the input and copies it onto the method.
+func (s *ShippingService) applyMethod(ctx context.Context, method *models.ShippingMethod, input dto.ShippingMethodInput) error {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return apperrors.ErrBadRequest("name is required")
+	}
+	if utf8.RuneCountInString(name) > maxShippingNameLength {
+		return apperrors.ErrBadRequest("name is too long")
+	}
+	kind := strings.ToLower(strings.TrimSpace(input.Kind))
+	if !shippingKinds[kind] {
+		return apperrors.ErrBadRequest("kind must be courier, pickup_point or post")
+	}
+	if len(input.Rates) == 0 {
+		return apperrors.ErrBadRequest("rates are required")
+	}
+	if input.FreeOver != nil && *input.FreeOver < 0 {
+		return apperrors.ErrBadRequest("free_over must not be negative")
+	}
+
+	type band struct {
+		zone      uuid.UUID
+		maxWeight int
+	}
+	seen := map[band]bool{}
+	checked := map[uuid.UUID]bool{}
+	rates := make([]models.ShippingRate, 0, len(input.Rates))
+	for _, rate := range input.Rates {
+		if rate.Cost < 0 {
+			return apperrors.ErrBadRequest("cost must not be negative")
+		}
+		key := band{maxWeight: -1}
+		if rate.MaxWeight != nil {
+			if *rate.MaxWeight <= 0 {
+				return apperrors.ErrBadRequest("max_weight must be positive")
+			}
+			key.maxWeight = *rate.MaxWeight
+		}
+		if rate.ZoneID != nil {
+			if !checked[*rate.ZoneID] {
+				if _, err := s.repo.GetZoneByID(ctx, *rate.ZoneID); err != nil {
+					return apperrors.ErrBadRequest("shipping zone not found: " + rate.ZoneID.String())
+				}
+				checked[*rate.ZoneID] = true
+			}
+			key.zone = *rate.ZoneID
+		}
+		if seen[key] {
+			return apperrors.ErrBadRequest("rates must not repeat the same zone and max_weight")
+		}
+		seen[key] = true
+		rates = append(rates, models.ShippingRate{ZoneID: rate.ZoneID, MaxWeight: rate.MaxWeight, Cost: rate.Cost})
+	}
+
+	method.Name = name
+	method.Kind = kind
+	method.Rates = rates
+	method.FreeOver = input.FreeOver
+	method.Active = input.Active == nil || *input.Active
+	return nil
+}
+
+// applyShippingZone validates the input and copies it onto the zone.
+// Prefixes are postal code beginnings, kept without spaces and uppercased.
+func applyShippingZone(zone *models.ShippingZone, input dto.ShippingZoneInput) error {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return apperrors.ErrBadRequest("name is required")
+	}
+	if utf8.RuneCountInString(name) > maxShippingNameLength {
+		return apperrors.ErrBadRequest("name is too long")
+	}
+	prefixes := make([]string, 0, len(input.PostalPrefixes))
+	for _, raw := range input.PostalPrefixes {
+		prefix := shipping.NormalizePostalCode(raw)
+		if prefix == "" {
+			return apperrors.ErrBadRequest("postal prefixes must not be empty")
+		}
+		for _, r := range prefix {
+			if (r < '0' || r > '9') && (r < 'A' || r > 'Z') {
+				return apperrors.ErrBadRequest("postal prefixes may only have digits and latin letters")
+			}
+		}
+		if !slices.Contains(prefixes, prefix) {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	if len(prefixes) == 0 {
+		return apperrors.ErrBadRequest("postal_prefixes are required")
+	}
+
+	zone.Name = name
+	zone.PostalPrefixes = prefixes
+	return nil
+}
+
+// applyPickupPoint validates the input and copies it onto the pickup point.
+func applyPickupPoint(point *models.PickupPoint, input dto.PickupPointInput) error {
+	name := strings.TrimSpace(input.Name)
+	address := strings.TrimSpace(input.Address)
+	hours := strings.TrimSpace(input.Hours)
+	switch {
+	case name == "":
+		return apperrors.ErrBadRequest("name is required")
+	case utf8.RuneCountInString(name) > maxShippingNameLength:
+		return apperrors.ErrBadRequest("name is too long")
+	case address == "":
+		return apperrors.ErrBadRequest("address is required")
+	case utf8.RuneCountInString(address) > maxPickupAddressLength:
+		return apperrors.ErrBadRequest("address is too long")
+	case utf8.RuneCountInString(hours) > maxPickupHoursLength:
+		return apperrors.ErrBadRequest("hours are too long")
+	case input.Latitude == nil || input.Longitude == nil:
+		return apperrors.ErrBadRequest("latitude and longitude are required")
+	case *input.Latitude < -90 || *input.Latitude > 90:
+		return apperrors.ErrBadRequest("latitude must be between -90 and 90")
+	case *input.Longitude < -180 || *input.Longitude > 180:
+		return apperrors.ErrBadRequest("longitude must be between -180 and 180")
+	}
+
+	point.Name = name
+	point.Address = address
+	point.PostalCode = shipping.NormalizePostalCode(input.PostalCode)
+	point.Hours = hours
+	point.Latitude = *input.Latitude
+	point.Longitude = *input.Longitude
+	point.Active = input.Active == nil || *input.Active
+	return nil
+}
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+const maxSubscriptionsPerUser = 100
+
+// SubscriptionService lets customers ask to be told when a book comes back
+// in stock or gets cheaper. A book is in stock if any of its editions is,
+// and its price is the lowest price of an edition in stock.
+//
+// Alerts fire when the condition starts to hold, not while it holds: a
+// subscription is disarmed when it fires and armed again once the condition
+// is false. On top of that each subscription sends at most one alert a day.
+type SubscriptionService struct {
+	repo           interfaces.SubscriptionRepositoryInterface
+	books          interfaces.BookRepositoryInterface
+	unsubscribeURL string
+	audit          interfaces.AuditRecorderInterface
+}
+
+// NewSubscriptionService creates the service; unsubscribeURL is the address
+// alerts link to, followed by the subscription's token.
+func NewSubscriptionService(repo interfaces.SubscriptionRepositoryInterface, books interfaces.BookRepositoryInterface, unsubscribeURL string, audit interfaces.AuditRecorderInterface) *SubscriptionService {
+	return &SubscriptionService{repo: repo, books: books, unsubscribeURL: strings.TrimSuffix(unsubscribeURL, "/"), audit: audit}
+}
+
+func (s *SubscriptionService) Subscribe(ctx context.Context, userID, bookID uuid.UUID, input dto.SubscriptionInput) (*models.StockSubscription, error) {
+	switch input.Kind {
+	case models.SubscriptionBackInStock:
+		if input.Threshold != nil {
+			return nil, apperrors.ErrBadRequest("threshold is only allowed for price_below subscriptions")
+		}
+	case models.SubscriptionPriceBelow:
+		if input.Threshold == nil || *input.Threshold <= 0 {
+			return nil, apperrors.ErrBadRequest("threshold must be a positive price")
+		}
+	default:
+		return nil, apperrors.ErrBadRequest("unknown subscription kind: " + input.Kind)
+	}
+	if _, err := s.books.GetByID(ctx, bookID); err != nil {
+		return nil, apperrors.ErrNotFound("book not found")
+	}
+	exists, err := s.repo.Exists(ctx, userID, bookID, input.Kind)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	if exists {
+		return nil, apperrors.ErrConflict("you are already subscribed to this book")
+	}
+	count, err := s.repo.CountByUser(ctx, userID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	if count >= maxSubscriptionsPerUser {
+		return nil, apperrors.ErrConflict("subscription limit reached")
+	}
+	stock, err := s.repo.GetBookStock(ctx, bookID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	token, err := newToken()
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+
+	subscription := &models.StockSubscription{
+		UserID:           userID,
+		BookID:           bookID,
+		Kind:             input.Kind,
+		Threshold:        input.Threshold,
+		UnsubscribeToken: token,
+	}
+	// a condition that already holds only fires after it has stopped holding
+	subscription.Armed = !conditionHolds(subscription, stock)
+	if err := s.repo.Create(ctx, subscription); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "subscription", subscription.ID, nil, subscription)
+	return subscription, nil
+}
+
+func (s *SubscriptionService) GetMine(ctx context.Context, userID uuid.UUID) ([]models.StockSubscription, error) {
+	subscriptions, err := s.repo.GetByUser(ctx, userID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return subscriptions, nil
+}
+
+func (s *SubscriptionService) Unsubscribe(ctx context.Context, userID, id uuid.UUID) error {
+	subscription, err := s.repo.GetByID(ctx, id)
+	if err != nil || subscription.UserID != userID {
+		return apperrors.ErrNotFound("subscription not found")
+	}
+	return s.delete(ctx, subscription)
+}
+
+// UnsubscribeByToken serves the unsubscribe links in alerts, which work
+// without signing in.
+func (s *SubscriptionService) UnsubscribeByToken(ctx context.Context, token string) error {
+	subscription, err := s.repo.GetByToken(ctx, token)
+	if err != nil {
+		return apperrors.ErrNotFound("subscription not found")
+	}
+	return s.delete(ctx, subscription)
+}
+
+// EditionChanged re-checks the subscriptions to the edition's book. Failures
+// are logged so they never undo the edition change.
+func (s *SubscriptionService) EditionChanged(ctx context.Context, before, after *models.Edition) {
+	edition := after
+	if edition == nil {
+		edition = before
+	}
+	if before != nil && after != nil && before.Stock == after.Stock && before.Price == after.Price {
+		return
+	}
+	if err := s.check(ctx, edition); err != nil {
+		log.Printf("failed to check subscriptions to book %s: %v", edition.BookID, err)
+	}
+}
+
+func (s *SubscriptionService) check(ctx context.Context, edition *models.Edition) error {
+	subscriptions, err := s.repo.GetByBook(ctx, edition.BookID)
+	if err != nil || len(subscriptions) == 0 {
+		return err
+	}
+	stock, err := s.repo.GetBookStock(ctx, edition.BookID)
+	if err != nil {
+		return err
+	}
+
+	var rearm []uuid.UUID
+	for i := range subscriptions {
+		subscription := &subscriptions[i]
+		holds := conditionHolds(subscription, stock)
+		switch {
+		case holds && subscription.Armed:
+			if err := s.fire(ctx, subscription, edition, stock); err != nil {
+				return err
+			}
+		case !holds && !subscription.Armed:
+			rearm = append(rearm, subscription.ID)
+		}
+	}
+	return s.repo.Rearm(ctx, rearm)
+}
+
+func (s *SubscriptionService) fire(ctx context.Context, subscription *models.StockSubscription, edition *models.Edition, stock *dto.BookStock) error {
+	notification := dto.Notification{
+		Kind:           subscription.Kind,
+		UserID:         subscription.UserID,
+		EditionID:      edition.ID,
+		BookID:         subscription.BookID,
+		Stock:          stock.InStock,
+		Threshold:      subscription.Threshold,
+		UnsubscribeURL: s.unsubscribeURL + "/" + subscription.UnsubscribeToken,
+	}
+	if stock.MinPrice != nil {
+		notification.Price = *stock.MinPrice
+	}
+	message, err := newOutboxMessage(notification, "subscription:"+subscription.ID.String())
+	if err != nil {
+		return err
+	}
+	return s.repo.Fire(ctx, subscription, message)
+}
+
+func (s *SubscriptionService) delete(ctx context.Context, subscription *models.StockSubscription) error {
+	if err := s.repo.Delete(ctx, subscription.ID); err != nil {
+		return apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionDelete, "subscription", subscription.ID, subscription, nil)
+	return nil
+}
+
+func conditionHolds(subscription *models.StockSubscription, stock *dto.BookStock) bool {
+	if subscription.Kind == models.SubscriptionPriceBelow {
+		return stock.MinPrice != nil && subscription.Threshold != nil && *stock.MinPrice <= *subscription.Threshold
+	}
+	return stock.InStock > 0
+}
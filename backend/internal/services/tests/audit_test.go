@@ -0,0 +1,205 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/actor"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupAuditService(t *testing.T) (*services.AuditService, *mocks.MockAuditRepositoryInterface) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockAuditRepositoryInterface(ctrl)
+	svc := services.NewAuditService(mockRepo)
+	return svc, mockRepo
+}
+
+func decodeChanges(t *testing.T, raw json.RawMessage) map[string]dto.FieldChange {
+	var changes map[string]dto.FieldChange
+	assert.NoError(t, json.Unmarshal(raw, &changes))
+	return changes
+}
+
+// --- Record ---
+
+func TestAuditService_Record_UpdateStoresDiffAndActor(t *testing.T) {
+	svc, mockRepo := setupAuditService(t)
+
+	actorID := uuid.New()
+	ctx := actor.WithActor(context.Background(), actor.Actor{
+		UserID:    actorID,
+		Role:      "manager",
+		IP:        "10.0.0.1",
+		RequestID: "req-1",
+	})
+	id := uuid.New()
+	before := &models.Publisher{ID: id, Name: "АСТ", Address: "Москва"}
+	after := &models.Publisher{ID: id, Name: "АСТ", Address: "Санкт-Петербург"}
+
+	var saved *models.AuditLog
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, entry *models.AuditLog) error {
+			saved = entry
+			return nil
+		})
+
+	svc.Record(ctx, services.AuditActionUpdate, "publisher", id, before, after)
+
+	assert.NotNil(t, saved)
+	assert.Equal(t, &actorID, saved.ActorID)
+	assert.Equal(t, "manager", saved.ActorRole)
+	assert.Equal(t, "10.0.0.1", saved.IP)
+	assert.Equal(t, "req-1", saved.RequestID)
+	assert.Equal(t, "update", saved.Action)
+	assert.Equal(t, id, saved.EntityID)
+
+	changes := decodeChanges(t, saved.Changes)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "Москва", changes["Address"].Old)
+	assert.Equal(t, "Санкт-Петербург", changes["Address"].New)
+}
+
+func TestAuditService_Record_ClearedFieldKeepsBothSides(t *testing.T) {
+	svc, mockRepo := setupAuditService(t)
+
+	id := uuid.New()
+	var saved *models.AuditLog
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, entry *models.AuditLog) error {
+			saved = entry
+			return nil
+		})
+
+	svc.Record(context.Background(), services.AuditActionUpdate, "publisher", id,
+		&models.Publisher{ID: id, Name: "АСТ", Address: "Москва"},
+		&models.Publisher{ID: id, Name: "АСТ"})
+
+	var changes map[string]map[string]any
+	assert.NoError(t, json.Unmarshal(saved.Changes, &changes))
+	assert.Equal(t, map[string]any{"old": "Москва", "new": ""}, changes["Address"])
+}
+
+func TestAuditService_Record_CreateWithoutActor(t *testing.T) {
+	svc, mockRepo := setupAuditService(t)
+
+	id := uuid.New()
+	var saved *models.AuditLog
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, entry *models.AuditLog) error {
+			saved = entry
+			return nil
+		})
+
+	svc.Record(context.Background(), services.AuditActionCreate, "author", id, nil, &models.Author{ID: id, Surname: "Толстой"})
+
+	assert.Nil(t, saved.ActorID)
+	changes := decodeChanges(t, saved.Changes)
+	assert.Nil(t, changes["Surname"].Old)
+	assert.Equal(t, "Толстой", changes["Surname"].New)
+}
+
+func TestAuditService_Record_RedactsPasswordHash(t *testing.T) {
+	svc, mockRepo := setupAuditService(t)
+
+	id := uuid.New()
+	var saved *models.AuditLog
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, entry *models.AuditLog) error {
+			saved = entry
+			return nil
+		})
+
+	svc.Record(context.Background(), services.AuditActionDelete, "user", id, &models.User{ID: id, PasswordHash: "secret"}, nil)
+
+	changes := decodeChanges(t, saved.Changes)
+	assert.NotContains(t, changes, "PasswordHash")
+	assert.Contains(t, changes, "ID")
+}
+
+func TestAuditService_Record_DiffsContributors(t *testing.T) {
+	svc, mockRepo := setupAuditService(t)
+
+	id, tolstoy, translator := uuid.New(), uuid.New(), uuid.New()
+	before := &models.Book{ID: id, Contributors: []*models.BookContributor{
+		{BookID: id, AuthorID: tolstoy, Role: "author"},
+	}}
+	after := &models.Book{ID: id, Contributors: []*models.BookContributor{
+		{BookID: id, AuthorID: tolstoy, Role: "author"},
+		{BookID: id, AuthorID: translator, Role: "translator"},
+	}}
+	var saved *models.AuditLog
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, entry *models.AuditLog) error {
+			saved = entry
+			return nil
+		})
+
+	svc.Record(context.Background(), services.AuditActionUpdate, "book", id, before, after)
+
+	changes := decodeChanges(t, saved.Changes)
+	assert.Equal(t, []any{tolstoy.String() + ":author"}, changes["Contributors"].Old)
+	assert.Equal(t, []any{tolstoy.String() + ":author", translator.String() + ":translator"}, changes["Contributors"].New)
+}
+
+func TestAuditService_Record_RepoErrorIsSwallowed(t *testing.T) {
+	svc, mockRepo := setupAuditService(t)
+
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+	assert.NotPanics(t, func() {
+		svc.Record(context.Background(), services.AuditActionDelete, "book", uuid.New(), &models.Book{}, nil)
+	})
+}
+
+// --- GetAll ---
+
+func TestAuditService_GetAll_Success(t *testing.T) {
+	svc, mockRepo := setupAuditService(t)
+
+	entityType := "book"
+	filter := dto.AuditFilter{EntityType: &entityType}
+	expected := []models.AuditLog{{ID: uuid.New(), EntityType: "book"}}
+	mockRepo.EXPECT().GetAll(gomock.Any(), filter).Return(expected, nil)
+
+	result, err := svc.GetAll(context.Background(), filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestAuditService_GetAll_InvalidRange(t *testing.T) {
+	svc, _ := setupAuditService(t)
+
+	from := time.Now()
+	to := from.Add(-time.Hour)
+
+	result, err := svc.GetAll(context.Background(), dto.AuditFilter{From: &from, To: &to})
+
+	assert.Nil(t, result)
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 400, appErr.Code)
+}
+
+func TestAuditService_GetAll_RepoError(t *testing.T) {
+	svc, mockRepo := setupAuditService(t)
+
+	mockRepo.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+
+	result, err := svc.GetAll(context.Background(), dto.AuditFilter{})
+
+	assert.Nil(t, result)
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 500, appErr.Code)
+}
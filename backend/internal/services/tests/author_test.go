@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
@@ -17,7 +18,9 @@ import (
 func setupAuthorService(t *testing.T) (*services.AuthorService, *mocks.MockAuthorRepositoryInterface) {
 	ctrl := gomock.NewController(t)
 	mockRepo := mocks.NewMockAuthorRepositoryInterface(ctrl)
-	svc := services.NewAuthorService(mockRepo)
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	svc := services.NewAuthorService(mockRepo, mocks.NewMockBlobStore(ctrl), mockAudit)
 	return svc, mockRepo
 }
 
@@ -152,7 +155,7 @@ func TestAuthorService_Update_Success(t *testing.T) {
 	svc, mockRepo := setupAuthorService(t)
 
 	id := uuid.New()
-	existing := &models.Author{ID: id, Surname: "Старый", Name: "Автор", Patronymic: "Отчество"}
+	existing := &models.Author{ID: id, Version: 1, Surname: "Старый", Name: "Автор", Patronymic: "Отчество"}
 	newInfo := "Updated bio"
 	input := dto.AuthorInput{
 		Surname:    "Новый",
@@ -164,7 +167,7 @@ func TestAuthorService_Update_Success(t *testing.T) {
 	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil)
 	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 
-	result, err := svc.Update(context.Background(), id, input)
+	result, err := svc.Update(context.Background(), id, 1, input)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Новый", result.Surname)
@@ -177,7 +180,7 @@ func TestAuthorService_Update_NotFound(t *testing.T) {
 	id := uuid.New()
 	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(nil, assert.AnError)
 
-	result, err := svc.Update(context.Background(), id, dto.AuthorInput{})
+	result, err := svc.Update(context.Background(), id, 1, dto.AuthorInput{})
 
 	assert.Nil(t, result)
 	var appErr *apperrors.AppError
@@ -189,13 +192,13 @@ func TestAuthorService_Update_RepoError(t *testing.T) {
 	svc, mockRepo := setupAuthorService(t)
 
 	id := uuid.New()
-	existing := &models.Author{ID: id, Surname: "Автор", Name: "Имя", Patronymic: "Отч"}
+	existing := &models.Author{ID: id, Version: 1, Surname: "Автор", Name: "Имя", Patronymic: "Отч"}
 	input := dto.AuthorInput{Surname: "Новый", Name: "Имя", Patronymic: "Отч"}
 
 	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil)
 	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
 
-	result, err := svc.Update(context.Background(), id, input)
+	result, err := svc.Update(context.Background(), id, 1, input)
 
 	assert.Nil(t, result)
 	var appErr *apperrors.AppError
@@ -203,28 +206,122 @@ func TestAuthorService_Update_RepoError(t *testing.T) {
 	assert.Equal(t, 500, appErr.Code)
 }
 
+func TestAuthorService_Update_VersionMismatch(t *testing.T) {
+	svc, mockRepo := setupAuthorService(t)
+
+	id := uuid.New()
+	existing := &models.Author{ID: id, Version: 3, Surname: "Автор", Name: "Имя", Patronymic: "Отч"}
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil)
+
+	result, err := svc.Update(context.Background(), id, 2, dto.AuthorInput{Surname: "Новый"})
+
+	assert.Nil(t, result)
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 412, appErr.Code)
+}
+
+func TestAuthorService_Update_ConcurrentWrite(t *testing.T) {
+	svc, mockRepo := setupAuthorService(t)
+
+	id := uuid.New()
+	existing := &models.Author{ID: id, Version: 1, Surname: "Автор", Name: "Имя", Patronymic: "Отч"}
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(fmt.Errorf("failed to update author: %w", apperrors.ErrVersionConflict))
+
+	result, err := svc.Update(context.Background(), id, 1, dto.AuthorInput{Surname: "Новый"})
+
+	assert.Nil(t, result)
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 412, appErr.Code)
+}
+
+// --- Patch ---
+
+func TestAuthorService_Patch_UpdatesOnlyGivenFields(t *testing.T) {
+	svc, mockRepo := setupAuthorService(t)
+
+	id := uuid.New()
+	info := "Russian classic writer"
+	existing := &models.Author{ID: id, Version: 1, Surname: "Толстой", Name: "Лев", Patronymic: "Николаевич", Info: &info}
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil).Times(2)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	result, err := svc.Patch(context.Background(), id, 1, []byte(`{"info":null,"name":"Алексей"}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Толстой", result.Surname)
+	assert.Equal(t, "Алексей", result.Name)
+	assert.Nil(t, result.Info)
+}
+
+func TestAuthorService_Patch_InvalidPatch(t *testing.T) {
+	svc, mockRepo := setupAuthorService(t)
+
+	id := uuid.New()
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Author{ID: id, Version: 1}, nil)
+
+	result, err := svc.Patch(context.Background(), id, 1, []byte(`[]`))
+
+	assert.Nil(t, result)
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 400, appErr.Code)
+}
+
 // --- Delete ---
 
 func TestAuthorService_Delete_Success(t *testing.T) {
 	svc, mockRepo := setupAuthorService(t)
 
 	id := uuid.New()
-	mockRepo.EXPECT().Delete(gomock.Any(), id).Return(nil)
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Author{ID: id, Version: 1}, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), id, int64(1)).Return(nil)
 
-	err := svc.Delete(context.Background(), id)
+	err := svc.Delete(context.Background(), id, 1)
 
 	assert.NoError(t, err)
 }
 
+func TestAuthorService_Delete_NotFound(t *testing.T) {
+	svc, mockRepo := setupAuthorService(t)
+
+	id := uuid.New()
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(nil, assert.AnError)
+
+	err := svc.Delete(context.Background(), id, 1)
+
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 404, appErr.Code)
+}
+
 func TestAuthorService_Delete_RepoError(t *testing.T) {
 	svc, mockRepo := setupAuthorService(t)
 
 	id := uuid.New()
-	mockRepo.EXPECT().Delete(gomock.Any(), id).Return(assert.AnError)
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Author{ID: id, Version: 1}, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), id, int64(1)).Return(assert.AnError)
 
-	err := svc.Delete(context.Background(), id)
+	err := svc.Delete(context.Background(), id, 1)
 
 	var appErr *apperrors.AppError
 	assert.ErrorAs(t, err, &appErr)
 	assert.Equal(t, 500, appErr.Code)
-}
\ No newline at end of file
+}
+func TestAuthorService_Delete_VersionMismatch(t *testing.T) {
+	svc, mockRepo := setupAuthorService(t)
+
+	id := uuid.New()
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Author{ID: id, Version: 2}, nil)
+
+	err := svc.Delete(context.Background(), id, 1)
+
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 412, appErr.Code)
+}
@@ -0,0 +1,241 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/currency"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupBookService(t *testing.T) (*services.BookService, *mocks.MockBookRepositoryInterface) {
+	svc, mockRepo, _ := setupBookServiceWithSeries(t)
+	return svc, mockRepo
+}
+
+func setupBookServiceWithSeries(t *testing.T) (*services.BookService, *mocks.MockBookRepositoryInterface, *mocks.MockSeriesRepositoryInterface) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockBookRepositoryInterface(ctrl)
+	mockSeries := mocks.NewMockSeriesRepositoryInterface(ctrl)
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	svc := services.NewBookService(mockRepo, mockSeries, basePrices(ctrl), mocks.NewMockBlobStore(ctrl), mockAudit)
+	return svc, mockRepo, mockSeries
+}
+
+// basePrices leaves prices in the base currency, as when a request has no
+// currency preference.
+func basePrices(ctrl *gomock.Controller) *mocks.MockPriceLocalizerInterface {
+	prices := mocks.NewMockPriceLocalizerInterface(ctrl)
+	prices.EXPECT().Localize(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	prices.EXPECT().ToBase(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, amount money.Amount) (money.Amount, error) {
+			return amount, nil
+		}).AnyTimes()
+	prices.EXPECT().PreferredRate(gomock.Any()).Return(currency.Base, money.One, nil).AnyTimes()
+	return prices
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+// --- Create ---
+
+func TestBookService_Create_ContributorsKeepOrderAndRoles(t *testing.T) {
+	svc, mockRepo := setupBookService(t)
+	first, second, translator := uuid.New(), uuid.New(), uuid.New()
+
+	input := dto.BookInput{
+		Title: "Книга",
+		Contributors: []dto.ContributorInput{
+			{AuthorID: first},
+			{AuthorID: second, Role: "Author"},
+			{AuthorID: translator, Role: models.ContributorRoleTranslator},
+		},
+	}
+
+	var received []models.BookContributor
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *models.Book, contributors []models.BookContributor, _ []uuid.UUID) error {
+			received = contributors
+			return nil
+		})
+
+	_, err := svc.Create(context.Background(), input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.BookContributor{
+		{AuthorID: first, Role: models.ContributorRoleAuthor, Position: 0},
+		{AuthorID: second, Role: models.ContributorRoleAuthor, Position: 1},
+		{AuthorID: translator, Role: models.ContributorRoleTranslator, Position: 2},
+	}, received)
+}
+
+func TestBookService_Create_Language(t *testing.T) {
+	svc, mockRepo := setupBookService(t)
+	contributors := []dto.ContributorInput{{AuthorID: uuid.New()}}
+
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	book, err := svc.Create(context.Background(), dto.BookInput{Title: "Книга", Contributors: contributors})
+	assert.NoError(t, err)
+	assert.Equal(t, "ru", book.Language)
+
+	book, err = svc.Create(context.Background(), dto.BookInput{Title: "Book", Language: " EN ", Contributors: contributors})
+	assert.NoError(t, err)
+	assert.Equal(t, "en", book.Language)
+
+	_, err = svc.Create(context.Background(), dto.BookInput{Title: "Book", Language: "english", Contributors: contributors})
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestBookService_Create_InvalidContributors(t *testing.T) {
+	svc, _ := setupBookService(t)
+	id := uuid.New()
+
+	cases := map[string][]dto.ContributorInput{
+		"empty":        nil,
+		"unknown role": {{AuthorID: id, Role: "ghostwriter"}},
+		"missing id":   {{Role: models.ContributorRoleEditor}},
+		"duplicate":    {{AuthorID: id}, {AuthorID: id, Role: models.ContributorRoleAuthor}},
+	}
+	for name, contributors := range cases {
+		_, err := svc.Create(context.Background(), dto.BookInput{Title: "Книга", Contributors: contributors})
+
+		var appErr *apperrors.AppError
+		assert.ErrorAs(t, err, &appErr, name)
+		assert.Equal(t, 400, appErr.Code, name)
+	}
+}
+
+func TestBookService_Patch_KeepsContributors(t *testing.T) {
+	svc, mockRepo := setupBookService(t)
+	id, authorID, editorID := uuid.New(), uuid.New(), uuid.New()
+	existing := &models.Book{
+		ID:      id,
+		Title:   "Книга",
+		Version: 1,
+		Contributors: []*models.BookContributor{
+			{BookID: id, AuthorID: authorID, Role: models.ContributorRoleAuthor, Position: 0},
+			{BookID: id, AuthorID: editorID, Role: models.ContributorRoleEditor, Position: 1},
+		},
+	}
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil).Times(3)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), []models.BookContributor{
+		{AuthorID: authorID, Role: models.ContributorRoleAuthor, Position: 0},
+		{AuthorID: editorID, Role: models.ContributorRoleEditor, Position: 1},
+	}, gomock.Any()).Return(nil)
+
+	_, err := svc.Patch(context.Background(), id, 1, []byte(`{"title":"Новое название"}`))
+
+	assert.NoError(t, err)
+}
+
+// --- GetByISBN ---
+
+func TestBookService_GetByISBN_Success(t *testing.T) {
+	svc, mockRepo := setupBookService(t)
+	expected := &models.Book{ID: uuid.New(), Title: "Книга"}
+
+	mockRepo.EXPECT().GetByISBN(gomock.Any(), "9780306406157").Return(expected, nil)
+
+	book, err := svc.GetByISBN(context.Background(), "978-0-306-40615-7")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, book)
+}
+
+func TestBookService_GetByISBN_NotFound(t *testing.T) {
+	svc, mockRepo := setupBookService(t)
+
+	mockRepo.EXPECT().GetByISBN(gomock.Any(), "9780306406157").Return(nil, errors.New("not found"))
+
+	_, err := svc.GetByISBN(context.Background(), "0306406152")
+
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 404, appErr.Code)
+}
+
+// --- GetAll ---
+
+func TestBookService_GetAll_NormalizesISBNFilter(t *testing.T) {
+	svc, mockRepo := setupBookService(t)
+
+	mockRepo.EXPECT().GetAll(gomock.Any(), dto.BookFilter{ISBN: strPtr("9780306406157")}).Return([]models.Book{}, nil)
+
+	_, err := svc.GetAll(context.Background(), dto.BookFilter{ISBN: strPtr("0-306-40615-2")})
+
+	assert.NoError(t, err)
+}
+
+func TestBookService_GetAll_InvalidISBNFilter(t *testing.T) {
+	svc, _ := setupBookService(t)
+
+	_, err := svc.GetAll(context.Background(), dto.BookFilter{ISBN: strPtr("123")})
+
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 400, appErr.Code)
+}
+
+func TestBookService_GetAll_PriceFilterInPreferredCurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockBookRepositoryInterface(ctrl)
+	prices := mocks.NewMockPriceLocalizerInterface(ctrl)
+	prices.EXPECT().PreferredRate(gomock.Any()).Return("KZT", money.Rate(5_620_000), nil)
+	prices.EXPECT().Localize(gomock.Any(), gomock.Any()).Return(nil)
+	svc := services.NewBookService(mockRepo, mocks.NewMockSeriesRepositoryInterface(ctrl), prices, mocks.NewMockBlobStore(ctrl), mocks.NewMockAuditRecorderInterface(ctrl))
+	minPrice := money.Amount(300000)
+
+	mockRepo.EXPECT().GetAll(gomock.Any(), dto.BookFilter{MinPrice: &minPrice, PriceCurrency: "KZT", PriceRate: money.Rate(5_620_000)}).Return([]models.Book{}, nil)
+
+	_, err := svc.GetAll(context.Background(), dto.BookFilter{MinPrice: &minPrice})
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(300000), minPrice)
+}
+
+func TestBookService_Delete_Ordered(t *testing.T) {
+	svc, mockRepo := setupBookService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Book{ID: id, Version: 1}, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), id, int64(1)).Return(fmt.Errorf("failed to delete book editions: %w", apperrors.ErrForeignKey))
+
+	err := svc.Delete(context.Background(), id, 1)
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestBookService_Delete_RemovesCoverBlobs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockBookRepositoryInterface(ctrl)
+	mockStore := mocks.NewMockBlobStore(ctrl)
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	svc := services.NewBookService(mockRepo, mocks.NewMockSeriesRepositoryInterface(ctrl), basePrices(ctrl), mockStore, mockAudit)
+	id := uuid.New()
+	cover := &models.Image{Keys: map[string]string{"original": "books/a/original.png", "small": "books/a/small.jpg"}}
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Book{ID: id, Version: 1, Cover: cover}, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), id, int64(1)).Return(nil)
+	mockStore.EXPECT().Delete(gomock.Any(), "books/a/original.png").Return(nil)
+	mockStore.EXPECT().Delete(gomock.Any(), "books/a/small.jpg").Return(nil)
+
+	err := svc.Delete(context.Background(), id, 1)
+
+	assert.NoError(t, err)
+}
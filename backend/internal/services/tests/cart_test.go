@@ -0,0 +1,187 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/tax"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type cartMocks struct {
+	repo       *mocks.MockCartRepositoryInterface
+	editions   *mocks.MockEditionRepositoryInterface
+	promotions *mocks.MockPromotionRepositoryInterface
+}
+
+func setupCartService(t *testing.T) (*services.CartService, cartMocks) {
+	ctrl := gomock.NewController(t)
+	m := cartMocks{
+		repo:       mocks.NewMockCartRepositoryInterface(ctrl),
+		editions:   mocks.NewMockEditionRepositoryInterface(ctrl),
+		promotions: mocks.NewMockPromotionRepositoryInterface(ctrl),
+	}
+	m.promotions.EXPECT().GetCategoryAncestors(gomock.Any(), gomock.Any()).Return(map[uuid.UUID][]uuid.UUID{}, nil).AnyTimes()
+	return services.NewCartService(m.repo, m.editions, m.promotions, basePrices(ctrl), tax.DefaultRates), m
+}
+
+func cartWith(userID uuid.UUID, price money.Amount, quantity int) *models.Cart {
+	edition := &models.Edition{ID: uuid.New(), BookID: uuid.New(), Price: price, Stock: 10, Book: &models.Book{}}
+	return &models.Cart{UserID: userID, Items: []*models.CartItem{{EditionID: edition.ID, Quantity: quantity, Edition: edition}}}
+}
+
+// --- Get ---
+
+func TestCartService_Get_AppliesRunningPromotions(t *testing.T) {
+	svc, m := setupCartService(t)
+	userID := uuid.New()
+	sale := models.Promotion{ID: uuid.New(), Name: "Sale", Kind: models.PromotionKindPercent, Percent: 10, Scope: models.PromotionScopeAll}
+
+	m.repo.EXPECT().GetByUser(gomock.Any(), userID).Return(cartWith(userID, 50000, 2), nil)
+	m.promotions.EXPECT().GetRunning(gomock.Any()).Return([]models.Promotion{sale}, nil)
+
+	cart, err := svc.Get(context.Background(), userID, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(100000), cart.Subtotal)
+	assert.Equal(t, money.Amount(10000), cart.Discount)
+	assert.Equal(t, money.Amount(90000), cart.Total)
+	if assert.Len(t, cart.Items, 1) && assert.Len(t, cart.Items[0].Promotions, 1) {
+		assert.Equal(t, "Sale", cart.Items[0].Promotions[0].Name)
+	}
+}
+
+func TestCartService_Get_CouponBelowMinimum(t *testing.T) {
+	svc, m := setupCartService(t)
+	userID := uuid.New()
+	coupon := &models.Promotion{
+		ID: uuid.New(), Kind: models.PromotionKindFixed, Amount: 5000, Scope: models.PromotionScopeAll,
+		MinOrderTotal: 200000, CouponCode: strPtr("BIG"), Active: true, StartsAt: time.Now().Add(-time.Hour),
+	}
+
+	m.repo.EXPECT().GetByUser(gomock.Any(), userID).Return(cartWith(userID, 50000, 1), nil)
+	m.promotions.EXPECT().GetRunning(gomock.Any()).Return(nil, nil)
+	m.promotions.EXPECT().GetByCouponCode(gomock.Any(), "BIG").Return(coupon, nil)
+
+	cart, err := svc.Get(context.Background(), userID, "big")
+
+	assert.NoError(t, err)
+	assert.Nil(t, cart.Coupon)
+	assert.Contains(t, cart.CouponError, "at least 2000.00")
+	assert.Equal(t, money.Amount(50000), cart.Total)
+}
+
+func TestCartService_Get_CouponOutrankedByExclusivePromotion(t *testing.T) {
+	svc, m := setupCartService(t)
+	userID := uuid.New()
+	sale := models.Promotion{ID: uuid.New(), Name: "Sale", Kind: models.PromotionKindPercent, Percent: 30, Scope: models.PromotionScopeAll, Exclusive: true}
+	coupon := &models.Promotion{
+		ID: uuid.New(), Kind: models.PromotionKindPercent, Percent: 5, Scope: models.PromotionScopeAll,
+		CouponCode: strPtr("SMALL"), Active: true, StartsAt: time.Now().Add(-time.Hour),
+	}
+
+	m.repo.EXPECT().GetByUser(gomock.Any(), userID).Return(cartWith(userID, 50000, 1), nil)
+	m.promotions.EXPECT().GetRunning(gomock.Any()).Return([]models.Promotion{sale}, nil)
+	m.promotions.EXPECT().GetByCouponCode(gomock.Any(), "SMALL").Return(coupon, nil)
+
+	cart, err := svc.Get(context.Background(), userID, "SMALL")
+
+	assert.NoError(t, err)
+	assert.Nil(t, cart.Coupon)
+	assert.Empty(t, cart.CouponError)
+	assert.NotEmpty(t, cart.CouponNotice)
+	assert.Equal(t, money.Amount(15000), cart.Discount)
+}
+
+func TestCartService_Get_CouponLookupFails(t *testing.T) {
+	svc, m := setupCartService(t)
+	userID := uuid.New()
+
+	m.repo.EXPECT().GetByUser(gomock.Any(), userID).Return(cartWith(userID, 50000, 1), nil)
+	m.promotions.EXPECT().GetRunning(gomock.Any()).Return(nil, nil)
+	m.promotions.EXPECT().GetByCouponCode(gomock.Any(), "ANY").Return(nil, assert.AnError)
+
+	cart, err := svc.Get(context.Background(), userID, "ANY")
+
+	assert.Nil(t, cart)
+	assertAppErrorCode(t, err, 500)
+}
+
+func TestCartService_Get_CouponUsedUpByUser(t *testing.T) {
+	svc, m := setupCartService(t)
+	userID := uuid.New()
+	coupon := &models.Promotion{
+		ID: uuid.New(), Kind: models.PromotionKindPercent, Percent: 5, Scope: models.PromotionScopeAll,
+		CouponCode: strPtr("ONCE"), PerUserLimit: intPtr(1), Active: true, StartsAt: time.Now().Add(-time.Hour),
+	}
+
+	m.repo.EXPECT().GetByUser(gomock.Any(), userID).Return(cartWith(userID, 50000, 1), nil)
+	m.promotions.EXPECT().GetRunning(gomock.Any()).Return(nil, nil)
+	m.promotions.EXPECT().GetByCouponCode(gomock.Any(), "ONCE").Return(coupon, nil)
+	m.promotions.EXPECT().CountRedemptions(gomock.Any(), coupon.ID, userID).Return(7, 1, nil)
+
+	cart, err := svc.Get(context.Background(), userID, "ONCE")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "you have already used this coupon", cart.CouponError)
+	assert.Equal(t, money.Amount(0), cart.Discount)
+}
+
+func TestCartService_Get_TaxPerLanguage(t *testing.T) {
+	svc, m := setupCartService(t)
+	userID := uuid.New()
+	cart := cartWith(userID, 11000, 1)
+	cart.Items[0].Edition.Book.Language = "ru"
+	foreign := cartWith(userID, 12200, 2)
+	foreign.Items[0].Edition.Book.Language = "en"
+	cart.Items = append(cart.Items, foreign.Items...)
+
+	m.repo.EXPECT().GetByUser(gomock.Any(), userID).Return(cart, nil)
+	m.promotions.EXPECT().GetRunning(gomock.Any()).Return(nil, nil)
+
+	priced, err := svc.Get(context.Background(), userID, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10, priced.Items[0].TaxRate)
+	assert.Equal(t, money.Amount(1000), priced.Items[0].Tax)
+	assert.Equal(t, 22, priced.Items[1].TaxRate)
+	assert.Equal(t, money.Amount(4400), priced.Items[1].Tax)
+	assert.Equal(t, money.Amount(5400), priced.Tax)
+	assert.Equal(t, []models.TaxLine{
+		{Rate: 10, Net: 10000, Tax: 1000, Total: 11000},
+		{Rate: 22, Net: 20000, Tax: 4400, Total: 24400},
+	}, priced.Taxes)
+}
+
+// --- SetItem ---
+
+func TestCartService_SetItem_NotEnoughStock(t *testing.T) {
+	svc, m := setupCartService(t)
+	editionID := uuid.New()
+
+	m.editions.EXPECT().GetByID(gomock.Any(), editionID).Return(&models.Edition{ID: editionID, Stock: 2}, nil)
+
+	_, err := svc.SetItem(context.Background(), uuid.New(), editionID, dto.CartItemInput{Quantity: 3})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestCartService_SetItem_UnknownEdition(t *testing.T) {
+	svc, m := setupCartService(t)
+	editionID := uuid.New()
+
+	m.editions.EXPECT().GetByID(gomock.Any(), editionID).Return(nil, errors.New("not found"))
+
+	_, err := svc.SetItem(context.Background(), uuid.New(), editionID, dto.CartItemInput{Quantity: 1})
+
+	assertAppErrorCode(t, err, 404)
+}
@@ -0,0 +1,229 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupCategoryService(t *testing.T) (*services.CategoryService, *mocks.MockCategoryRepositoryInterface) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockCategoryRepositoryInterface(ctrl)
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	svc := services.NewCategoryService(mockRepo, mockAudit)
+	return svc, mockRepo
+}
+
+func assertAppErrorCode(t *testing.T, err error, code int) {
+	t.Helper()
+	var appErr *apperrors.AppError
+	if assert.ErrorAs(t, err, &appErr) {
+		assert.Equal(t, code, appErr.Code)
+	}
+}
+
+// --- Create ---
+
+func TestCategoryService_Create_GeneratesSlug(t *testing.T) {
+	svc, mockRepo := setupCategoryService(t)
+
+	mockRepo.EXPECT().GetBySlug(gomock.Any(), "nauchnaya-fantastika").Return(nil, sql.ErrNoRows)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	result, err := svc.Create(context.Background(), dto.CategoryInput{Name: "Научная фантастика"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "nauchnaya-fantastika", result.Slug)
+	assert.Nil(t, result.ParentID)
+}
+
+func TestCategoryService_Create_SlugSuffixWhenTaken(t *testing.T) {
+	svc, mockRepo := setupCategoryService(t)
+
+	mockRepo.EXPECT().GetBySlug(gomock.Any(), "fantasy").Return(&models.Category{ID: uuid.New()}, nil)
+	mockRepo.EXPECT().GetBySlug(gomock.Any(), "fantasy-2").Return(&models.Category{ID: uuid.New()}, nil)
+	mockRepo.EXPECT().GetBySlug(gomock.Any(), "fantasy-3").Return(nil, sql.ErrNoRows)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	result, err := svc.Create(context.Background(), dto.CategoryInput{Name: "Fantasy"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "fantasy-3", result.Slug)
+}
+
+func TestCategoryService_Create_ExplicitSlugTaken(t *testing.T) {
+	svc, mockRepo := setupCategoryService(t)
+
+	mockRepo.EXPECT().GetBySlug(gomock.Any(), "sci-fi").Return(&models.Category{ID: uuid.New()}, nil)
+
+	result, err := svc.Create(context.Background(), dto.CategoryInput{Name: "Sci-Fi", Slug: "sci-fi"})
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestCategoryService_Create_SlugLookupFails(t *testing.T) {
+	svc, mockRepo := setupCategoryService(t)
+	mockRepo.EXPECT().GetBySlug(gomock.Any(), "fantasy").Return(nil, assert.AnError)
+
+	result, err := svc.Create(context.Background(), dto.CategoryInput{Name: "Fantasy"})
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 500)
+}
+
+func TestCategoryService_Create_InvalidSlug(t *testing.T) {
+	svc, _ := setupCategoryService(t)
+
+	result, err := svc.Create(context.Background(), dto.CategoryInput{Name: "Sci-Fi", Slug: "Sci Fi"})
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestCategoryService_Create_UnknownParent(t *testing.T) {
+	svc, mockRepo := setupCategoryService(t)
+	parentID := uuid.New()
+
+	mockRepo.EXPECT().GetAncestors(gomock.Any(), parentID).Return(nil, nil)
+
+	result, err := svc.Create(context.Background(), dto.CategoryInput{Name: "Space opera", ParentID: &parentID})
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 400)
+}
+
+// --- Update ---
+
+func TestCategoryService_Update_RejectsCycle(t *testing.T) {
+	svc, mockRepo := setupCategoryService(t)
+	rootID, childID := uuid.New(), uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), rootID).Return(&models.Category{ID: rootID, Name: "Fiction", Slug: "fiction", Version: 1}, nil)
+	mockRepo.EXPECT().GetAncestors(gomock.Any(), childID).Return([]models.Category{
+		{ID: rootID, Slug: "fiction"},
+		{ID: childID, Slug: "fantasy", ParentID: &rootID},
+	}, nil)
+
+	result, err := svc.Update(context.Background(), rootID, 1, dto.CategoryInput{Name: "Fiction", Slug: "fiction", ParentID: &childID})
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestCategoryService_Update_ConcurrentMoveMakesCycle(t *testing.T) {
+	svc, mockRepo := setupCategoryService(t)
+	id, parentID := uuid.New(), uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Category{ID: id, Name: "Fiction", Slug: "fiction", Version: 1}, nil)
+	mockRepo.EXPECT().GetAncestors(gomock.Any(), parentID).Return([]models.Category{{ID: parentID, Slug: "fantasy"}}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(apperrors.ErrCategoryCycle)
+
+	result, err := svc.Update(context.Background(), id, 1, dto.CategoryInput{Name: "Fiction", Slug: "fiction", ParentID: &parentID})
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestCategoryService_Update_RejectsSelfParent(t *testing.T) {
+	svc, mockRepo := setupCategoryService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Category{ID: id, Name: "Fiction", Slug: "fiction", Version: 1}, nil)
+
+	result, err := svc.Update(context.Background(), id, 1, dto.CategoryInput{Name: "Fiction", Slug: "fiction", ParentID: &id})
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestCategoryService_Update_RenameKeepsCustomSlug(t *testing.T) {
+	svc, mockRepo := setupCategoryService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Category{ID: id, Name: "Fantasy", Slug: "sword-and-sorcery", Version: 1}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	result, err := svc.Update(context.Background(), id, 1, dto.CategoryInput{Name: "Epic fantasy"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Epic fantasy", result.Name)
+	assert.Equal(t, "sword-and-sorcery", result.Slug)
+}
+
+func TestCategoryService_Patch_KeepsSlugAndMovesCategory(t *testing.T) {
+	svc, mockRepo := setupCategoryService(t)
+	id, parentID := uuid.New(), uuid.New()
+	existing := &models.Category{ID: id, Name: "Fantasy", Slug: "fantasy", Version: 1}
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil).Times(2)
+	mockRepo.EXPECT().GetAncestors(gomock.Any(), parentID).Return([]models.Category{{ID: parentID, Slug: "fiction"}}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	result, err := svc.Patch(context.Background(), id, 1, []byte(`{"parent_id":"`+parentID.String()+`"}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "fantasy", result.Slug)
+	assert.Equal(t, &parentID, result.ParentID)
+}
+
+// --- Delete ---
+
+func TestCategoryService_Delete_WithChildren(t *testing.T) {
+	svc, mockRepo := setupCategoryService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Category{ID: id, Version: 1}, nil)
+	mockRepo.EXPECT().HasChildren(gomock.Any(), id).Return(true, nil)
+
+	err := svc.Delete(context.Background(), id, 1)
+
+	assertAppErrorCode(t, err, 409)
+}
+
+// --- Tree and breadcrumbs ---
+
+func TestCategoryService_GetTree(t *testing.T) {
+	svc, mockRepo := setupCategoryService(t)
+	fictionID, fantasyID, scienceID := uuid.New(), uuid.New(), uuid.New()
+
+	mockRepo.EXPECT().GetAll(gomock.Any()).Return([]models.Category{
+		{ID: fictionID, Name: "Fiction"},
+		{ID: fantasyID, Name: "Fantasy", ParentID: &fictionID},
+		{ID: scienceID, Name: "Science"},
+	}, nil)
+
+	tree, err := svc.GetTree(context.Background())
+
+	assert.NoError(t, err)
+	if assert.Len(t, tree, 2) {
+		assert.Equal(t, fictionID, tree[0].ID)
+		assert.Equal(t, scienceID, tree[1].ID)
+		if assert.Len(t, tree[0].Children, 1) {
+			assert.Equal(t, fantasyID, tree[0].Children[0].ID)
+		}
+		assert.Empty(t, tree[1].Children)
+	}
+}
+
+func TestCategoryService_GetBreadcrumbs_NotFound(t *testing.T) {
+	svc, mockRepo := setupCategoryService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetAncestors(gomock.Any(), id).Return(nil, nil)
+
+	path, err := svc.GetBreadcrumbs(context.Background(), id)
+
+	assert.Nil(t, path)
+	assertAppErrorCode(t, err, 404)
+}
@@ -0,0 +1,186 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/currency"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupCurrencyService(t *testing.T) (*services.CurrencyService, *mocks.MockCurrencyRepositoryInterface, *mocks.MockEditionRepositoryInterface) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockCurrencyRepositoryInterface(ctrl)
+	mockEditions := mocks.NewMockEditionRepositoryInterface(ctrl)
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	svc := services.NewCurrencyService(mockRepo, mockEditions, mockAudit)
+	return svc, mockRepo, mockEditions
+}
+
+// --- Create ---
+
+func TestCurrencyService_Create_NormalizesCode(t *testing.T) {
+	svc, mockRepo, _ := setupCurrencyService(t)
+
+	mockRepo.EXPECT().GetByCode(gomock.Any(), "BYN").Return(nil, errors.New("not found"))
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	c, err := svc.Create(context.Background(), dto.CurrencyInput{Code: "byn", Name: "Belarusian ruble"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "BYN", c.Code)
+}
+
+func TestCurrencyService_Create_Duplicate(t *testing.T) {
+	svc, mockRepo, _ := setupCurrencyService(t)
+
+	mockRepo.EXPECT().GetByCode(gomock.Any(), "KZT").Return(&models.Currency{Code: "KZT"}, nil)
+
+	_, err := svc.Create(context.Background(), dto.CurrencyInput{Code: "KZT", Name: "Tenge"})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+// --- AddRate ---
+
+func TestCurrencyService_AddRate_BaseCurrency(t *testing.T) {
+	svc, mockRepo, _ := setupCurrencyService(t)
+
+	mockRepo.EXPECT().GetByCode(gomock.Any(), currency.Base).Return(&models.Currency{Code: currency.Base}, nil)
+
+	_, err := svc.AddRate(context.Background(), "rub", dto.ExchangeRateInput{Rate: money.One})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestCurrencyService_AddRate_UnknownCurrency(t *testing.T) {
+	svc, mockRepo, _ := setupCurrencyService(t)
+
+	mockRepo.EXPECT().GetByCode(gomock.Any(), "USD").Return(nil, errors.New("not found"))
+
+	_, err := svc.AddRate(context.Background(), "USD", dto.ExchangeRateInput{Rate: money.One})
+
+	assertAppErrorCode(t, err, 404)
+}
+
+// --- ImportRates ---
+
+func TestCurrencyService_ImportRates(t *testing.T) {
+	svc, mockRepo, _ := setupCurrencyService(t)
+	file := "currency,rate,valid_from\nBYN,0.0354,2026-10-01\nkzt,5.62,\n"
+
+	mockRepo.EXPECT().GetAll(gomock.Any()).Return([]models.Currency{{Code: "RUB"}, {Code: "BYN"}, {Code: "KZT"}}, nil)
+	var saved []*models.ExchangeRate
+	mockRepo.EXPECT().AddRates(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, rates []*models.ExchangeRate) error {
+			saved = rates
+			return nil
+		})
+
+	report, err := svc.ImportRates(context.Background(), strings.NewReader(file))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Imported)
+	if assert.Len(t, saved, 2) {
+		assert.Equal(t, "BYN", saved[0].Currency)
+		assert.Equal(t, money.Rate(35400), saved[0].Rate)
+		assert.Equal(t, 2026, saved[0].ValidFrom.Year())
+		assert.Equal(t, "KZT", saved[1].Currency)
+		assert.Equal(t, models.ExchangeRateSourceImport, saved[1].Source)
+	}
+}
+
+func TestCurrencyService_ImportRates_RejectsWholeFileOnBadLine(t *testing.T) {
+	svc, mockRepo, _ := setupCurrencyService(t)
+	file := "currency,rate\nBYN,0.0354\nUSD,0.012\nKZT,-1\n"
+
+	mockRepo.EXPECT().GetAll(gomock.Any()).Return([]models.Currency{{Code: "RUB"}, {Code: "BYN"}, {Code: "KZT"}}, nil)
+
+	_, err := svc.ImportRates(context.Background(), strings.NewReader(file))
+
+	assertAppErrorCode(t, err, 400)
+	assert.Contains(t, err.Error(), "line 3")
+	assert.Contains(t, err.Error(), "line 4")
+}
+
+// --- SetEditionPrice ---
+
+func TestCurrencyService_SetEditionPrice_BaseCurrency(t *testing.T) {
+	svc, mockRepo, _ := setupCurrencyService(t)
+
+	mockRepo.EXPECT().GetByCode(gomock.Any(), currency.Base).Return(&models.Currency{Code: currency.Base}, nil)
+
+	_, err := svc.SetEditionPrice(context.Background(), uuid.New(), currency.Base, dto.EditionPriceInput{Price: 100})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+// --- Localize ---
+
+func TestCurrencyService_Localize_BaseCurrency(t *testing.T) {
+	svc, _, _ := setupCurrencyService(t)
+	edition := &models.Edition{ID: uuid.New(), Price: 120050}
+
+	err := svc.Localize(context.Background(), []*models.Edition{edition})
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(120050), edition.Price)
+	assert.Equal(t, currency.Base, edition.Currency)
+}
+
+func TestCurrencyService_Localize_ConvertsAndUsesOverrides(t *testing.T) {
+	svc, mockRepo, _ := setupCurrencyService(t)
+	ctx := currency.WithPreferred(context.Background(), "BYN")
+	converted := &models.Edition{ID: uuid.New(), Price: 120050}
+	fixed := &models.Edition{ID: uuid.New(), Price: 120050}
+	rate, _ := money.ParseRate("0.0354")
+
+	mockRepo.EXPECT().GetByCode(gomock.Any(), "BYN").Return(&models.Currency{Code: "BYN"}, nil)
+	mockRepo.EXPECT().GetRate(gomock.Any(), "BYN", gomock.Any()).Return(&models.ExchangeRate{Currency: "BYN", Rate: rate}, nil)
+	mockRepo.EXPECT().GetEditionPrices(gomock.Any(), []uuid.UUID{converted.ID, fixed.ID}, "BYN").
+		Return([]models.EditionPrice{{EditionID: fixed.ID, Currency: "BYN", Price: 3990}}, nil)
+
+	err := svc.Localize(ctx, []*models.Edition{converted, fixed})
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(4250), converted.Price)
+	assert.Equal(t, money.Amount(3990), fixed.Price)
+	assert.Equal(t, "BYN", converted.Currency)
+	assert.Equal(t, "BYN", fixed.Currency)
+}
+
+func TestCurrencyService_Localize_NoRate(t *testing.T) {
+	svc, mockRepo, _ := setupCurrencyService(t)
+	ctx := currency.WithPreferred(context.Background(), "KZT")
+
+	mockRepo.EXPECT().GetByCode(gomock.Any(), "KZT").Return(&models.Currency{Code: "KZT"}, nil)
+	mockRepo.EXPECT().GetRate(gomock.Any(), "KZT", gomock.Any()).Return(nil, errors.New("not found"))
+
+	err := svc.Localize(ctx, []*models.Edition{{ID: uuid.New()}})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestCurrencyService_ToBase(t *testing.T) {
+	svc, mockRepo, _ := setupCurrencyService(t)
+	ctx := currency.WithPreferred(context.Background(), "KZT")
+	rate, _ := money.ParseRate("5")
+
+	mockRepo.EXPECT().GetByCode(gomock.Any(), "KZT").Return(&models.Currency{Code: "KZT"}, nil)
+	mockRepo.EXPECT().GetRate(gomock.Any(), "KZT", gomock.Any()).Return(&models.ExchangeRate{Currency: "KZT", Rate: rate}, nil)
+
+	amount, err := svc.ToBase(ctx, money.Amount(50000))
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(10000), amount)
+}
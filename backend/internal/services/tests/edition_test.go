@@ -0,0 +1,190 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupEditionService(t *testing.T) (*services.EditionService, *mocks.MockEditionRepositoryInterface, *mocks.MockBookRepositoryInterface) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockEditionRepositoryInterface(ctrl)
+	mockBooks := mocks.NewMockBookRepositoryInterface(ctrl)
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockListener := mocks.NewMockEditionListenerInterface(ctrl)
+	mockListener.EXPECT().EditionChanged(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	svc := services.NewEditionService(mockRepo, mockBooks, mockListener, basePrices(ctrl), mockAudit)
+	return svc, mockRepo, mockBooks
+}
+
+// --- Create ---
+
+func TestEditionService_Create_NormalizesISBN(t *testing.T) {
+	svc, mockRepo, mockBooks := setupEditionService(t)
+	bookID := uuid.New()
+
+	input := dto.EditionInput{Format: "Hardcover", ISBN: strPtr("0-306-40615-2"), PublisherID: uuid.New(), Price: 50000}
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(&models.Book{ID: bookID}, nil)
+	mockRepo.EXPECT().GetByISBN(gomock.Any(), "9780306406157").Return(nil, errors.New("not found"))
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	edition, err := svc.Create(context.Background(), bookID, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, bookID, edition.BookID)
+	assert.Equal(t, models.EditionFormatHardcover, edition.Format)
+	assert.Equal(t, "9780306406157", *edition.ISBN13)
+	assert.Equal(t, "0306406152", *edition.ISBN10)
+}
+
+func TestEditionService_Create_BookNotFound(t *testing.T) {
+	svc, _, mockBooks := setupEditionService(t)
+	bookID := uuid.New()
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(nil, errors.New("not found"))
+
+	_, err := svc.Create(context.Background(), bookID, dto.EditionInput{Format: models.EditionFormatEbook})
+
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 404, appErr.Code)
+}
+
+func TestEditionService_Create_InvalidInput(t *testing.T) {
+	svc, _, mockBooks := setupEditionService(t)
+	bookID := uuid.New()
+	zero := 0
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(&models.Book{ID: bookID}, nil).AnyTimes()
+
+	cases := map[string]dto.EditionInput{
+		"unknown format": {Format: "scroll"},
+		"negative price": {Format: models.EditionFormatPaperback, Price: -1},
+		"zero pages":     {Format: models.EditionFormatPaperback, PageCount: &zero},
+		"zero weight":    {Format: models.EditionFormatPaperback, Weight: &zero},
+		"invalid isbn":   {Format: models.EditionFormatPaperback, ISBN: strPtr("978-0-306-40615-8")},
+		"stock":          {Format: models.EditionFormatPaperback, Stock: intPtr(5)},
+	}
+	for name, input := range cases {
+		_, err := svc.Create(context.Background(), bookID, input)
+
+		var appErr *apperrors.AppError
+		assert.ErrorAs(t, err, &appErr, name)
+		assert.Equal(t, 400, appErr.Code, name)
+	}
+}
+
+func TestEditionService_Create_DuplicateISBN(t *testing.T) {
+	svc, mockRepo, mockBooks := setupEditionService(t)
+	bookID := uuid.New()
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(&models.Book{ID: bookID}, nil)
+	mockRepo.EXPECT().GetByISBN(gomock.Any(), "9780306406157").Return(&models.Edition{ID: uuid.New()}, nil)
+
+	_, err := svc.Create(context.Background(), bookID, dto.EditionInput{Format: models.EditionFormatPaperback, ISBN: strPtr("9780306406157")})
+
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 409, appErr.Code)
+}
+
+// --- Update ---
+
+func TestEditionService_Update_SameISBNIsNotAConflict(t *testing.T) {
+	svc, mockRepo, _ := setupEditionService(t)
+	id := uuid.New()
+	isbn13 := "9780306406157"
+	existing := &models.Edition{ID: id, Version: 2, Format: models.EditionFormatPaperback, ISBN13: &isbn13}
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil)
+	mockRepo.EXPECT().GetByISBN(gomock.Any(), isbn13).Return(&models.Edition{ID: id}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	edition, err := svc.Update(context.Background(), id, 2, dto.EditionInput{Format: models.EditionFormatPaperback, ISBN: &isbn13, Price: 70000})
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(70000), edition.Price)
+}
+
+func TestEditionService_Update_VersionMismatch(t *testing.T) {
+	svc, mockRepo, _ := setupEditionService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Edition{ID: id, Version: 3}, nil)
+
+	_, err := svc.Update(context.Background(), id, 2, dto.EditionInput{Format: models.EditionFormatEbook})
+
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 412, appErr.Code)
+}
+
+// --- Patch ---
+
+func TestEditionService_Patch_ChangesOnlyGivenFields(t *testing.T) {
+	svc, mockRepo, _ := setupEditionService(t)
+	id, publisherID := uuid.New(), uuid.New()
+	pages := 320
+	existing := &models.Edition{ID: id, Version: 1, Format: models.EditionFormatHardcover, PublisherID: publisherID, PageCount: &pages, Price: 90000, Stock: 4}
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil).Times(2)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	edition, err := svc.Patch(context.Background(), id, 1, []byte(`{"price":950}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(95000), edition.Price)
+	assert.Equal(t, 4, edition.Stock)
+	assert.Equal(t, publisherID, edition.PublisherID)
+	assert.Equal(t, 320, *edition.PageCount)
+}
+
+func TestEditionService_Patch_RejectsStock(t *testing.T) {
+	svc, mockRepo, _ := setupEditionService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Edition{ID: id, Version: 1, Format: models.EditionFormatPaperback}, nil).Times(2)
+
+	_, err := svc.Patch(context.Background(), id, 1, []byte(`{"stock":10}`))
+
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 400, appErr.Code)
+}
+
+func TestEditionService_Create_UnknownPublisher(t *testing.T) {
+	svc, mockRepo, mockBooks := setupEditionService(t)
+	bookID := uuid.New()
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(&models.Book{ID: bookID}, nil)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(fmt.Errorf("%w: editions_publisher_id_fkey", apperrors.ErrForeignKey))
+
+	_, err := svc.Create(context.Background(), bookID, dto.EditionInput{Format: models.EditionFormatPaperback, PublisherID: uuid.New()})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestEditionService_Delete_Ordered(t *testing.T) {
+	svc, mockRepo, _ := setupEditionService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Edition{ID: id, Version: 2}, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), id, int64(2)).Return(fmt.Errorf("failed to delete edition: %w", apperrors.ErrForeignKey))
+
+	err := svc.Delete(context.Background(), id, 2)
+
+	assertAppErrorCode(t, err, 409)
+}
@@ -0,0 +1,127 @@
+package services_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/export"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupExportService(t *testing.T) (*services.ExportService, *mocks.MockExportRepositoryInterface) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockExportRepositoryInterface(ctrl)
+	svc := services.NewExportService(mockRepo, basePrices(ctrl))
+	return svc, mockRepo
+}
+
+func TestExportService_ExportBooks_CSV(t *testing.T) {
+	svc, mockRepo := setupExportService(t)
+	id := uuid.MustParse("6f1c1b0e-8c1e-4a8e-9d2e-0d6f2f3a4b5c")
+	bookID := uuid.MustParse("0b7a8e5c-2d4f-4c1a-8f3e-9a6b5c4d3e2f")
+	year := 2019
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	filter := dto.BookFilter{}
+
+	mockRepo.EXPECT().ExportBooks(gomock.Any(), filter, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ dto.BookFilter, fn func(dto.BookExportRow) error) error {
+			return fn(dto.BookExportRow{
+				ID: id, BookID: bookID, Title: "Война и мир", Format: "hardcover", PublicationYear: &year,
+				Price: 120050, Stock: 10,
+				Authors: "Толстой Лев Николаевич", Publisher: "Эксмо", Categories: "Классика|Роман",
+				CreatedAt: createdAt,
+			})
+		})
+
+	var buf bytes.Buffer
+	err := svc.ExportBooks(context.Background(), export.FormatCSV, filter, &buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "id,book_id,isbn,title,description,format,publication_year,page_count,price,stock,authors,contributors,publisher,categories,created_at\n"+
+		id.String()+","+bookID.String()+",,Война и мир,,hardcover,2019,,1200.50,10,Толстой Лев Николаевич,,Эксмо,Классика|Роман,2026-01-02T03:04:05Z\n", buf.String())
+}
+
+func TestExportService_ExportBooks_NormalizesFilterLikeCatalog(t *testing.T) {
+	svc, mockRepo := setupExportService(t)
+	isbn13 := "9780306406157"
+
+	mockRepo.EXPECT().ExportBooks(gomock.Any(), dto.BookFilter{ISBN: &isbn13}, gomock.Any()).Return(nil)
+
+	err := svc.ExportBooks(context.Background(), export.FormatCSV, dto.BookFilter{ISBN: strPtr("0-306-40615-2")}, io.Discard)
+
+	assert.NoError(t, err)
+}
+
+func TestExportService_ExportBooks_InvalidISBN(t *testing.T) {
+	svc, _ := setupExportService(t)
+
+	err := svc.ExportBooks(context.Background(), export.FormatCSV, dto.BookFilter{ISBN: strPtr("123")}, io.Discard)
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestExportService_ExportAuthors_JSONL(t *testing.T) {
+	svc, mockRepo := setupExportService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().ExportAuthors(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(models.Author) error) error {
+			return fn(models.Author{ID: id, Surname: "Пушкин", Name: "Александр", Patronymic: "Сергеевич"})
+		})
+
+	var buf bytes.Buffer
+	err := svc.ExportAuthors(context.Background(), export.FormatJSONL, &buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"`+id.String()+`","surname":"Пушкин","name":"Александр","patronymic":"Сергеевич","info":null}`+"\n", buf.String())
+}
+
+func TestExportService_ExportCustomers_OmitsPasswordHash(t *testing.T) {
+	svc, mockRepo := setupExportService(t)
+
+	mockRepo.EXPECT().ExportCustomers(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(dto.CustomerExportRow) error) error {
+			return fn(dto.CustomerExportRow{ID: uuid.New(), Username: "reader", Email: "reader@example.com"})
+		})
+
+	var buf bytes.Buffer
+	err := svc.ExportCustomers(context.Background(), export.FormatCSV, &buf)
+
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "id,username,email,phone\n")
+	assert.NotContains(t, buf.String(), "password")
+}
+
+func TestExportService_UnsupportedFormat(t *testing.T) {
+	svc, _ := setupExportService(t)
+
+	err := svc.ExportPublishers(context.Background(), "pdf", &bytes.Buffer{})
+
+	var appErr *apperrors.AppError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, http.StatusBadRequest, appErr.Code)
+}
+
+func TestExportService_RepositoryError(t *testing.T) {
+	svc, mockRepo := setupExportService(t)
+
+	mockRepo.EXPECT().ExportPublishers(gomock.Any(), gomock.Any()).Return(errors.New("db down"))
+
+	err := svc.ExportPublishers(context.Background(), export.FormatCSV, &bytes.Buffer{})
+
+	var appErr *apperrors.AppError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, http.StatusInternalServerError, appErr.Code)
+}
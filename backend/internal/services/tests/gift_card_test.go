@@ -0,0 +1,169 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/giftcard"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type giftCardMocks struct {
+	repo  *mocks.MockGiftCardRepositoryInterface
+	users *mocks.MockUserRepositoryInterface
+}
+
+func setupGiftCardService(t *testing.T) (*services.GiftCardService, giftCardMocks) {
+	ctrl := gomock.NewController(t)
+	m := giftCardMocks{
+		repo:  mocks.NewMockGiftCardRepositoryInterface(ctrl),
+		users: mocks.NewMockUserRepositoryInterface(ctrl),
+	}
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	return services.NewGiftCardService(m.repo, m.users, giftcard.DefaultTerms, mockAudit), m
+}
+
+// --- Issue ---
+
+func TestGiftCardService_Issue_StoreCredit(t *testing.T) {
+	svc, m := setupGiftCardService(t)
+	employeeID, userID := uuid.New(), uuid.New()
+
+	m.users.EXPECT().GetByID(gomock.Any(), userID).Return(&models.User{ID: userID}, nil)
+	m.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	issued, err := svc.Issue(context.Background(), employeeID, dto.GiftCardIssueInput{
+		Kind: "store_credit", Amount: 150000, UserID: &userID, Reason: " Damaged parcel ",
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, issued.Code)
+	assert.Nil(t, issued.GiftCard.CodeHash)
+	assert.Equal(t, userID, *issued.GiftCard.OwnerID)
+	assert.Equal(t, employeeID, *issued.GiftCard.IssuedBy)
+	assert.Equal(t, "Damaged parcel", *issued.GiftCard.Reason)
+}
+
+func TestGiftCardService_Issue_GiftCard(t *testing.T) {
+	svc, m := setupGiftCardService(t)
+	employeeID := uuid.New()
+
+	var saved *models.GiftCard
+	m.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, card *models.GiftCard) error {
+		saved = card
+		return nil
+	})
+
+	issued, err := svc.Issue(context.Background(), employeeID, dto.GiftCardIssueInput{Kind: "gift_card", Amount: 300000, Reason: "Sold at the till"})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, issued.Code)
+	assert.Equal(t, giftcard.Hash(issued.Code), *saved.CodeHash)
+	assert.Equal(t, giftcard.LastDigits(issued.Code), *saved.LastDigits)
+	assert.Equal(t, money.Amount(300000), saved.InitialAmount)
+	assert.Equal(t, employeeID, *saved.IssuedBy)
+	assert.True(t, saved.ExpiresAt.After(time.Now().AddDate(0, 11, 0)))
+}
+
+func TestGiftCardService_Issue_Invalid(t *testing.T) {
+	userID := uuid.New()
+	tests := []struct {
+		name  string
+		input dto.GiftCardIssueInput
+	}{
+		{"no reason", dto.GiftCardIssueInput{Kind: "gift_card", Amount: 1000}},
+		{"no amount", dto.GiftCardIssueInput{Kind: "gift_card", Reason: "Goodwill"}},
+		{"amount too large", dto.GiftCardIssueInput{Kind: "gift_card", Amount: 100_000_01, Reason: "Goodwill"}},
+		{"unknown kind", dto.GiftCardIssueInput{Kind: "voucher", Amount: 1000, Reason: "Goodwill"}},
+		{"store credit without owner", dto.GiftCardIssueInput{Kind: "store_credit", Amount: 1000, Reason: "Goodwill"}},
+		{"gift card with owner", dto.GiftCardIssueInput{Kind: "gift_card", Amount: 1000, Reason: "Goodwill", UserID: &userID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := setupGiftCardService(t)
+
+			_, err := svc.Issue(context.Background(), uuid.New(), tt.input)
+
+			assertAppErrorCode(t, err, 400)
+		})
+	}
+}
+
+func TestGiftCardService_Issue_UnknownUser(t *testing.T) {
+	svc, m := setupGiftCardService(t)
+	userID := uuid.New()
+
+	m.users.EXPECT().GetByID(gomock.Any(), userID).Return(nil, errors.New("not found"))
+
+	_, err := svc.Issue(context.Background(), uuid.New(), dto.GiftCardIssueInput{Kind: "store_credit", Amount: 1000, UserID: &userID, Reason: "Goodwill"})
+
+	assertAppErrorCode(t, err, 404)
+}
+
+// --- Check ---
+
+func TestGiftCardService_Check(t *testing.T) {
+	svc, m := setupGiftCardService(t)
+	ownerID := uuid.New()
+	expiresAt := time.Now().AddDate(1, 0, 0)
+	card := &models.GiftCard{ID: uuid.New(), Balance: 5000, ExpiresAt: expiresAt, PurchasedBy: &ownerID}
+
+	m.repo.EXPECT().GetByCode(gomock.Any(), giftcard.Hash("K7QX-2MZP-9RTA-WC4H")).Return(card, nil)
+
+	found, err := svc.Check(context.Background(), "k7qx 2mzp 9rta wc4h")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &dto.GiftCardBalance{Balance: 5000, ExpiresAt: expiresAt}, found)
+}
+
+func TestGiftCardService_Check_MalformedCode(t *testing.T) {
+	svc, _ := setupGiftCardService(t)
+
+	_, err := svc.Check(context.Background(), "not-a-code")
+
+	assertAppErrorCode(t, err, 404)
+}
+
+// --- GetMine ---
+
+func TestGiftCardService_GetMine_SumsSpendableCredit(t *testing.T) {
+	svc, m := setupGiftCardService(t)
+	userID := uuid.New()
+	future, past := time.Now().Add(time.Hour), time.Now().Add(-time.Hour)
+
+	m.repo.EXPECT().GetAllByUser(gomock.Any(), userID).Return([]models.GiftCard{
+		{Kind: models.GiftCardKindStoreCredit, OwnerID: &userID, Balance: 1000, ExpiresAt: future},
+		{Kind: models.GiftCardKindStoreCredit, OwnerID: &userID, Balance: 500, ExpiresAt: past},
+		{Kind: models.GiftCardKindGiftCard, PurchasedBy: &userID, Balance: 3000, ExpiresAt: future},
+	}, nil)
+
+	mine, err := svc.GetMine(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(1000), mine.StoreCredit)
+	assert.Len(t, mine.Cards, 3)
+}
+
+// --- GetByID ---
+
+func TestGiftCardService_GetByID_OtherUser(t *testing.T) {
+	svc, m := setupGiftCardService(t)
+	owner := uuid.New()
+	card := &models.GiftCard{ID: uuid.New(), OwnerID: &owner}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), card.ID).Return(card, nil)
+
+	_, err := svc.GetByID(context.Background(), uuid.New(), card.ID)
+
+	assertAppErrorCode(t, err, 404)
+}
@@ -0,0 +1,282 @@
+package services_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupImportService(t *testing.T) (*services.ImportService, *mocks.MockImportRepositoryInterface, *mocks.MockAuditRecorderInterface) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockImportRepositoryInterface(ctrl)
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockListener := mocks.NewMockEditionListenerInterface(ctrl)
+	mockListener.EXPECT().EditionChanged(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	svc := services.NewImportService(mockRepo, mockListener, mockAudit)
+	return svc, mockRepo, mockAudit
+}
+
+const validImportCSV = `title,description,price,stock,author,publisher,categories
+Война и мир,Роман-эпопея,1200.50,10,Толстой Лев Николаевич,Эксмо,Роман|Классика
+Анна Каренина,,"1,899.90",3,Толстой Лев Николаевич,Эксмо,Роман
+`
+
+func TestImportService_ImportBooks_Success(t *testing.T) {
+	svc, mockRepo, mockAudit := setupImportService(t)
+
+	var received []dto.ImportBookRow
+	mockRepo.EXPECT().ImportBooks(gomock.Any(), gomock.Any(), false).DoAndReturn(
+		func(_ context.Context, rows []dto.ImportBookRow, _ bool) ([]dto.ImportedBook, error) {
+			received = rows
+			book := &models.Book{ID: uuid.New()}
+			return []dto.ImportedBook{
+				{
+					Edition:           &models.Edition{ID: uuid.New(), BookID: book.ID},
+					CreatedBook:       book,
+					CreatedAuthor:     &models.Author{ID: uuid.New()},
+					CreatedCategories: []*models.Category{{ID: uuid.New(), Name: "Роман"}},
+				},
+				{Edition: &models.Edition{ID: uuid.New(), BookID: book.ID}},
+			}, nil
+		})
+	mockAudit.EXPECT().Record(gomock.Any(), services.AuditActionCreate, gomock.Any(), gomock.Any(), nil, gomock.Any()).Times(5)
+
+	report, err := svc.ImportBooks(context.Background(), strings.NewReader(validImportCSV), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Total)
+	assert.Equal(t, 2, report.Imported)
+	assert.Equal(t, 1, report.AuthorsCreated)
+	assert.Equal(t, 1, report.CategoriesCreated)
+	assert.Equal(t, 1, report.BooksCreated)
+	assert.Equal(t, dto.ImportRowImported, report.Rows[0].Status)
+	assert.True(t, report.Rows[0].BookCreated)
+	assert.Equal(t, *report.Rows[0].BookID, *report.Rows[1].BookID)
+	assert.NotEqual(t, *report.Rows[0].EditionID, *report.Rows[1].EditionID)
+
+	assert.Len(t, received, 2)
+	assert.Equal(t, "Толстой", received[0].AuthorSurname)
+	assert.Equal(t, "Николаевич", received[0].AuthorPatronymic)
+	assert.Equal(t, []string{"Роман", "Классика"}, received[0].Categories)
+	assert.Equal(t, money.Amount(120050), received[0].Price)
+	assert.Equal(t, 10, *received[0].Stock)
+	assert.Equal(t, money.Amount(189990), received[1].Price)
+	assert.Nil(t, received[1].Description)
+	assert.Equal(t, models.EditionFormatPaperback, received[0].Format)
+}
+
+func TestImportService_ImportBooks_DryRun(t *testing.T) {
+	svc, mockRepo, _ := setupImportService(t)
+
+	mockRepo.EXPECT().ImportBooks(gomock.Any(), gomock.Any(), true).Return([]dto.ImportedBook{
+		{Edition: &models.Edition{}, CreatedPublisher: &models.Publisher{Name: "Эксмо"}},
+		{Edition: &models.Edition{}},
+	}, nil)
+
+	report, err := svc.ImportBooks(context.Background(), strings.NewReader(validImportCSV), true)
+
+	assert.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 0, report.Imported)
+	assert.Equal(t, 1, report.PublishersCreated)
+	assert.Equal(t, dto.ImportRowValid, report.Rows[0].Status)
+	assert.Nil(t, report.Rows[0].BookID)
+}
+
+func TestImportService_ImportBooks_InvalidRowsAreNotWritten(t *testing.T) {
+	svc, _, _ := setupImportService(t)
+
+	csv := "title;price;stock;author;publisher\n" +
+		"Война и мир;1200;10;Толстой Лев Николаевич;Эксмо\n" +
+		";abc;-1;Толстой;\n"
+
+	report, err := svc.ImportBooks(context.Background(), strings.NewReader(csv), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Total)
+	assert.Equal(t, 1, report.Valid)
+	assert.Equal(t, 1, report.Invalid)
+	assert.Equal(t, 0, report.Imported)
+	assert.Equal(t, dto.ImportRowInvalid, report.Rows[1].Status)
+	assert.Len(t, report.Rows[1].Errors, 5)
+}
+
+func TestImportService_ImportBooks_MissingColumn(t *testing.T) {
+	svc, _, _ := setupImportService(t)
+
+	report, err := svc.ImportBooks(context.Background(), strings.NewReader("title,price\nКнига,100\n"), false)
+
+	assert.Nil(t, report)
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 400, appErr.Code)
+}
+
+func TestImportService_ImportBooks_RepoError(t *testing.T) {
+	svc, mockRepo, _ := setupImportService(t)
+
+	mockRepo.EXPECT().ImportBooks(gomock.Any(), gomock.Any(), false).Return(nil, assert.AnError)
+
+	report, err := svc.ImportBooks(context.Background(), strings.NewReader(validImportCSV), false)
+
+	assert.Nil(t, report)
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 500, appErr.Code)
+}
+
+func TestImportService_ImportBooks_NoActiveWarehouse(t *testing.T) {
+	svc, mockRepo, _ := setupImportService(t)
+
+	mockRepo.EXPECT().ImportBooks(gomock.Any(), gomock.Any(), false).Return(nil, apperrors.ErrNoWarehouse)
+
+	report, err := svc.ImportBooks(context.Background(), strings.NewReader(validImportCSV), false)
+
+	assert.Nil(t, report)
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 409, appErr.Code)
+}
+
+func TestImportService_ImportBooks_ISBNNormalizedAndDuplicatesRejected(t *testing.T) {
+	svc, _, _ := setupImportService(t)
+
+	csv := "title,isbn,price,author,publisher\n" +
+		"Книга,0-306-40615-2,100,Толстой Лев,Эксмо\n" +
+		"Копия,978-0-306-40615-7,100,Толстой Лев,Эксмо\n" +
+		"Ошибка,978-0-306-40615-8,100,Толстой Лев,Эксмо\n"
+
+	report, err := svc.ImportBooks(context.Background(), strings.NewReader(csv), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Valid)
+	assert.Equal(t, 2, report.Invalid)
+	assert.Equal(t, []string{"duplicate ISBN, already used on line 2"}, report.Rows[1].Errors)
+	assert.Equal(t, []string{`invalid ISBN "978-0-306-40615-8"`}, report.Rows[2].Errors)
+}
+
+func TestImportService_ImportBooks_ExistingISBNUpdatesEdition(t *testing.T) {
+	svc, mockRepo, mockAudit := setupImportService(t)
+
+	csv := "title,isbn,price,author,publisher\n" +
+		"Книга,0-306-40615-2,150,Толстой Лев,Эксмо\n"
+	id := uuid.New()
+	previous := &models.Edition{ID: id, Format: models.EditionFormatPaperback, Price: 10000}
+	updated := &models.Edition{ID: id, Format: models.EditionFormatPaperback, Price: 15000}
+
+	var received []dto.ImportBookRow
+	mockRepo.EXPECT().ImportBooks(gomock.Any(), gomock.Any(), false).DoAndReturn(
+		func(_ context.Context, rows []dto.ImportBookRow, _ bool) ([]dto.ImportedBook, error) {
+			received = rows
+			return []dto.ImportedBook{{Edition: updated, Previous: previous}}, nil
+		})
+	mockAudit.EXPECT().Record(gomock.Any(), services.AuditActionUpdate, "edition", id, previous, updated)
+
+	report, err := svc.ImportBooks(context.Background(), strings.NewReader(csv), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Imported)
+	assert.Equal(t, 1, report.Updated)
+	assert.True(t, report.Rows[0].Updated)
+	assert.Equal(t, "9780306406157", *received[0].ISBN13)
+	assert.Equal(t, "0306406152", *received[0].ISBN10)
+}
+
+func TestImportService_ImportBooks_ExistingISBNWithoutStockColumnKeepsStock(t *testing.T) {
+	svc, mockRepo, mockAudit := setupImportService(t)
+
+	csv := "title,isbn,price,author,publisher\n" +
+		"Книга,9780306406157,150,Толстой Лев,Эксмо\n"
+	id := uuid.New()
+	previous := &models.Edition{ID: id, Format: models.EditionFormatPaperback, Price: 10000, Stock: 7}
+	updated := &models.Edition{ID: id, Format: models.EditionFormatPaperback, Price: 15000, Stock: 7}
+
+	var received []dto.ImportBookRow
+	mockRepo.EXPECT().ImportBooks(gomock.Any(), gomock.Any(), false).DoAndReturn(
+		func(_ context.Context, rows []dto.ImportBookRow, _ bool) ([]dto.ImportedBook, error) {
+			received = rows
+			return []dto.ImportedBook{{Edition: updated, Previous: previous}}, nil
+		})
+	mockAudit.EXPECT().Record(gomock.Any(), services.AuditActionUpdate, "edition", id, previous, updated)
+
+	report, err := svc.ImportBooks(context.Background(), strings.NewReader(csv), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Updated)
+	assert.Nil(t, received[0].Stock)
+}
+
+func TestImportService_ImportBooks_PriceSeparators(t *testing.T) {
+	svc, mockRepo, _ := setupImportService(t)
+
+	var received []dto.ImportBookRow
+	mockRepo.EXPECT().ImportBooks(gomock.Any(), gomock.Any(), true).DoAndReturn(
+		func(_ context.Context, rows []dto.ImportBookRow, _ bool) ([]dto.ImportedBook, error) {
+			received = append(received, rows...)
+			return make([]dto.ImportedBook, len(rows)), nil
+		}).Times(2)
+
+	semicolons := "title;price;author;publisher\n" +
+		"Книга;1.234,56;Толстой Лев;Эксмо\n" +
+		"Книга;1 234;Толстой Лев;Эксмо\n" +
+		"Книга;99,9;Толстой Лев;Эксмо\n"
+	report, err := svc.ImportBooks(context.Background(), strings.NewReader(semicolons), true)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, report.Valid)
+
+	commas := "title,price,author,publisher\n" +
+		"Книга,\"1,234.56\",Толстой Лев,Эксмо\n" +
+		"Книга,99.9,Толстой Лев,Эксмо\n"
+	report, err = svc.ImportBooks(context.Background(), strings.NewReader(commas), true)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Valid)
+
+	if assert.Len(t, received, 5) {
+		assert.Equal(t, money.Amount(123456), received[0].Price)
+		assert.Equal(t, money.Amount(123400), received[1].Price)
+		assert.Equal(t, money.Amount(9990), received[2].Price)
+		assert.Equal(t, money.Amount(123456), received[3].Price)
+		assert.Equal(t, money.Amount(9990), received[4].Price)
+	}
+}
+
+func TestImportService_ImportBooks_AmbiguousPricesRejected(t *testing.T) {
+	svc, _, _ := setupImportService(t)
+
+	csv := "title,price,author,publisher\n" +
+		"Книга,\"899,90\",Толстой Лев,Эксмо\n" +
+		"Книга,\"1.234,56\",Толстой Лев,Эксмо\n"
+
+	report, err := svc.ImportBooks(context.Background(), strings.NewReader(csv), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Invalid)
+}
+
+func TestImportService_ImportBooks_EditionColumns(t *testing.T) {
+	svc, _, _ := setupImportService(t)
+
+	csv := "title,format,publication_year,page_count,price,author,publisher\n" +
+		"Книга,Hardcover,2019,352,100,Толстой Лев,Эксмо\n" +
+		"Книга,scroll,-5,abc,100,Толстой Лев,Эксмо\n"
+
+	report, err := svc.ImportBooks(context.Background(), strings.NewReader(csv), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Valid)
+	assert.Equal(t, []string{
+		`unknown format "scroll"`,
+		`invalid publication_year "-5"`,
+		`invalid page_count "abc"`,
+	}, report.Rows[1].Errors)
+}
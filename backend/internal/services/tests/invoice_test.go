@@ -0,0 +1,231 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/blob"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type invoiceMocks struct {
+	repo     *mocks.MockInvoiceRepositoryInterface
+	orders   *mocks.MockOrderRepositoryInterface
+	users    *mocks.MockUserRepositoryInterface
+	store    *mocks.MockBlobStore
+	renderer *mocks.MockInvoiceRendererInterface
+}
+
+func setupInvoiceService(t *testing.T) (*services.InvoiceService, invoiceMocks) {
+	ctrl := gomock.NewController(t)
+	m := invoiceMocks{
+		repo:     mocks.NewMockInvoiceRepositoryInterface(ctrl),
+		orders:   mocks.NewMockOrderRepositoryInterface(ctrl),
+		users:    mocks.NewMockUserRepositoryInterface(ctrl),
+		store:    mocks.NewMockBlobStore(ctrl),
+		renderer: mocks.NewMockInvoiceRendererInterface(ctrl),
+	}
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	return services.NewInvoiceService(m.repo, m.orders, m.users, m.store, m.renderer, mockAudit), m
+}
+
+func paidOrder(userID uuid.UUID, status string) *models.Order {
+	return &models.Order{ID: uuid.New(), UserID: userID, Payment: &models.Payment{Status: status}}
+}
+
+func document(content string) *blob.Object {
+	return &blob.Object{Body: io.NopCloser(strings.NewReader(content)), ContentType: "application/pdf", Size: int64(len(content))}
+}
+
+func TestInvoiceService_Open_IssuesInvoice(t *testing.T) {
+	svc, m := setupInvoiceService(t)
+	userID := uuid.New()
+	order := paidOrder(userID, "Not paid")
+	user := &models.User{ID: userID, Username: "reader", PasswordHash: "hash"}
+
+	m.orders.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(nil, fmt.Errorf("invoice not found: %w", sql.ErrNoRows))
+	m.users.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+	gomock.InOrder(
+		m.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, invoice *models.Invoice) error {
+				assert.Equal(t, "reader", invoice.Snapshot.User.Username)
+				assert.Empty(t, invoice.Snapshot.User.PasswordHash)
+				invoice.Year, invoice.Number, invoice.Code = 2026, 12, "2026-000012"
+				return nil
+			}),
+		m.renderer.EXPECT().Render(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(invoice *models.Invoice, o *models.Order) ([]byte, error) {
+				assert.Equal(t, "2026-000012", invoice.Code)
+				assert.Equal(t, "reader", o.User.Username)
+				assert.Equal(t, order.Payment, o.Payment)
+				return []byte("%PDF-1.7"), nil
+			}),
+		m.store.EXPECT().Put(gomock.Any(), "invoices/2026/2026-000012-not-paid.pdf", "application/pdf", []byte("%PDF-1.7")).Return(nil),
+		m.repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil),
+		m.store.EXPECT().Get(gomock.Any(), "invoices/2026/2026-000012-not-paid.pdf").Return(document("%PDF-1.7"), nil),
+	)
+
+	invoice, object, err := svc.Open(context.Background(), userID, order.ID, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Not paid", invoice.PaymentStatus)
+	assert.Equal(t, 8, invoice.Size)
+	assert.Equal(t, int64(8), object.Size)
+}
+
+func TestInvoiceService_Open_LookupFails(t *testing.T) {
+	svc, m := setupInvoiceService(t)
+	userID := uuid.New()
+	order := paidOrder(userID, "Paid")
+
+	m.orders.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(nil, errors.New("connection refused"))
+
+	_, _, err := svc.Open(context.Background(), userID, order.ID, false)
+
+	assertAppErrorCode(t, err, 500)
+}
+
+func TestInvoiceService_Open_StoresDocumentOfNumberedInvoice(t *testing.T) {
+	svc, m := setupInvoiceService(t)
+	userID := uuid.New()
+	order := paidOrder(userID, "Paid")
+	snapshot := &models.Order{ID: order.ID, User: &models.User{Username: "reader"}}
+	stored := &models.Invoice{OrderID: order.ID, Year: 2026, Code: "2026-000005", PaymentStatus: "Paid", Snapshot: snapshot}
+
+	m.orders.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(stored, nil)
+	m.renderer.EXPECT().Render(stored, gomock.Any()).Return([]byte("first"), nil)
+	m.store.EXPECT().Put(gomock.Any(), "invoices/2026/2026-000005-paid.pdf", "application/pdf", []byte("first")).Return(nil)
+	m.repo.EXPECT().Update(gomock.Any(), stored).Return(nil)
+	m.store.EXPECT().Get(gomock.Any(), "invoices/2026/2026-000005-paid.pdf").Return(document("first"), nil)
+
+	invoice, _, err := svc.Open(context.Background(), userID, order.ID, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "invoices/2026/2026-000005-paid.pdf", invoice.Key)
+}
+
+func TestInvoiceService_Open_ServesStoredDocument(t *testing.T) {
+	svc, m := setupInvoiceService(t)
+	userID := uuid.New()
+	order := paidOrder(userID, "Paid")
+	stored := &models.Invoice{OrderID: order.ID, Code: "2026-000001", PaymentStatus: "Paid", Key: "invoices/2026/2026-000001-paid.pdf"}
+
+	m.orders.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(stored, nil)
+	m.store.EXPECT().Get(gomock.Any(), stored.Key).Return(document("stored"), nil)
+
+	invoice, _, err := svc.Open(context.Background(), userID, order.ID, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, stored, invoice)
+}
+
+func TestInvoiceService_Open_ReissuesOnPaymentChange(t *testing.T) {
+	svc, m := setupInvoiceService(t)
+	userID := uuid.New()
+	order := paidOrder(userID, "Paid")
+	order.User = &models.User{ID: userID}
+	stored := &models.Invoice{OrderID: order.ID, Year: 2026, Code: "2026-000001", PaymentStatus: "Not paid", Key: "invoices/2026/2026-000001-not-paid.pdf"}
+
+	m.orders.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(stored, nil)
+	m.renderer.EXPECT().Render(stored, order).Return([]byte("paid"), nil)
+	m.store.EXPECT().Put(gomock.Any(), "invoices/2026/2026-000001-paid.pdf", "application/pdf", []byte("paid")).Return(nil)
+	m.repo.EXPECT().Update(gomock.Any(), stored).Return(nil)
+	m.store.EXPECT().Get(gomock.Any(), "invoices/2026/2026-000001-paid.pdf").Return(document("paid"), nil)
+
+	invoice, _, err := svc.Open(context.Background(), userID, order.ID, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-000001", invoice.Code)
+	assert.Equal(t, "Paid", invoice.PaymentStatus)
+}
+
+func TestInvoiceService_Open_ConcurrentIssue(t *testing.T) {
+	svc, m := setupInvoiceService(t)
+	userID := uuid.New()
+	order := paidOrder(userID, "Paid")
+	order.User = &models.User{ID: userID}
+	existing := &models.Invoice{OrderID: order.ID, PaymentStatus: "Paid", Key: "invoices/2026/2026-000003-paid.pdf"}
+
+	m.orders.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	gomock.InOrder(
+		m.repo.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(nil, sql.ErrNoRows),
+		m.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(fmt.Errorf("failed to create invoice: %w", apperrors.ErrDuplicate)),
+		m.repo.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(existing, nil),
+	)
+	m.store.EXPECT().Get(gomock.Any(), existing.Key).Return(document("stored"), nil)
+
+	invoice, _, err := svc.Open(context.Background(), userID, order.ID, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, existing, invoice)
+}
+
+func TestInvoiceService_Open_RestoresLostDocument(t *testing.T) {
+	svc, m := setupInvoiceService(t)
+	userID := uuid.New()
+	order := paidOrder(userID, "Paid")
+	order.User = &models.User{ID: userID, Username: "renamed"}
+	snapshot := &models.Order{ID: order.ID, User: &models.User{ID: userID, Username: "reader"}}
+	stored := &models.Invoice{OrderID: order.ID, Year: 2026, Code: "2026-000004", PaymentStatus: "Paid", Key: "invoices/2026/2026-000004-paid.pdf", Snapshot: snapshot}
+
+	m.orders.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(stored, nil)
+	gomock.InOrder(
+		m.store.EXPECT().Get(gomock.Any(), stored.Key).Return(nil, blob.ErrNotFound),
+		m.renderer.EXPECT().Render(stored, gomock.Any()).DoAndReturn(
+			func(_ *models.Invoice, o *models.Order) ([]byte, error) {
+				assert.Equal(t, "reader", o.User.Username)
+				assert.Equal(t, "Paid", o.Payment.Status)
+				return []byte("again"), nil
+			}),
+		m.store.EXPECT().Put(gomock.Any(), stored.Key, "application/pdf", []byte("again")).Return(nil),
+		m.store.EXPECT().Get(gomock.Any(), stored.Key).Return(document("again"), nil),
+	)
+
+	_, object, err := svc.Open(context.Background(), userID, order.ID, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), object.Size)
+}
+
+func TestInvoiceService_Open_OtherUsersOrder(t *testing.T) {
+	svc, m := setupInvoiceService(t)
+	order := paidOrder(uuid.New(), "Paid")
+
+	m.orders.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+
+	_, _, err := svc.Open(context.Background(), uuid.New(), order.ID, false)
+
+	assertAppErrorCode(t, err, 404)
+}
+
+func TestInvoiceService_Open_EmployeeOpensAnyOrder(t *testing.T) {
+	svc, m := setupInvoiceService(t)
+	order := paidOrder(uuid.New(), "Paid")
+	stored := &models.Invoice{OrderID: order.ID, PaymentStatus: "Paid", Key: "invoices/2026/2026-000002-paid.pdf"}
+
+	m.orders.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(stored, nil)
+	m.store.EXPECT().Get(gomock.Any(), stored.Key).Return(document("stored"), nil)
+
+	_, _, err := svc.Open(context.Background(), uuid.New(), order.ID, true)
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,147 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/loyalty"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type loyaltyMocks struct {
+	repo  *mocks.MockLoyaltyRepositoryInterface
+	users *mocks.MockUserRepositoryInterface
+}
+
+func setupLoyaltyService(t *testing.T) (*services.LoyaltyService, loyaltyMocks) {
+	ctrl := gomock.NewController(t)
+	m := loyaltyMocks{
+		repo:  mocks.NewMockLoyaltyRepositoryInterface(ctrl),
+		users: mocks.NewMockUserRepositoryInterface(ctrl),
+	}
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	return services.NewLoyaltyService(m.repo, m.users, loyalty.DefaultProgram, mockAudit), m
+}
+
+func timePtr(t time.Time) *time.Time { return &t }
+
+// --- GetBalance ---
+
+func TestLoyaltyService_GetBalance(t *testing.T) {
+	svc, m := setupLoyaltyService(t)
+	userID := uuid.New()
+	soon := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	later := soon.AddDate(0, 6, 0)
+
+	m.repo.EXPECT().GetCredits(gomock.Any(), userID, gomock.Any()).Return([]models.LoyaltyTransaction{
+		{Remaining: 30, ExpiresAt: timePtr(soon)},
+		{Remaining: 20, ExpiresAt: timePtr(soon)},
+		{Remaining: 100, ExpiresAt: timePtr(later)},
+	}, nil)
+
+	balance, err := svc.GetBalance(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 150, balance.Points)
+	assert.Equal(t, 50, balance.ExpiringPoints)
+	assert.Equal(t, soon, *balance.ExpiresAt)
+}
+
+func TestLoyaltyService_GetBalance_Empty(t *testing.T) {
+	svc, m := setupLoyaltyService(t)
+	userID := uuid.New()
+
+	m.repo.EXPECT().GetCredits(gomock.Any(), userID, gomock.Any()).Return([]models.LoyaltyTransaction{}, nil)
+
+	balance, err := svc.GetBalance(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Zero(t, balance.Points)
+	assert.Nil(t, balance.ExpiresAt)
+}
+
+// --- GetHistory ---
+
+func TestLoyaltyService_GetHistory_DefaultsPage(t *testing.T) {
+	svc, m := setupLoyaltyService(t)
+	userID := uuid.New()
+
+	m.repo.EXPECT().GetHistory(gomock.Any(), userID, 20, 0).Return([]models.LoyaltyTransaction{{Points: 5}}, 1, nil)
+
+	page, err := svc.GetHistory(context.Background(), userID, dto.LoyaltyHistoryQuery{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, page.Total)
+	assert.Equal(t, 20, page.Limit)
+}
+
+func TestLoyaltyService_GetHistory_NegativeOffset(t *testing.T) {
+	svc, _ := setupLoyaltyService(t)
+
+	_, err := svc.GetHistory(context.Background(), uuid.New(), dto.LoyaltyHistoryQuery{Offset: -1})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+// --- Adjust ---
+
+func TestLoyaltyService_Adjust_Credit(t *testing.T) {
+	svc, m := setupLoyaltyService(t)
+	adminID, userID := uuid.New(), uuid.New()
+
+	m.users.EXPECT().GetByID(gomock.Any(), userID).Return(&models.User{ID: userID}, nil)
+	m.repo.EXPECT().Adjust(gomock.Any(), gomock.Any()).Return(nil)
+
+	entry, err := svc.Adjust(context.Background(), adminID, userID, dto.LoyaltyAdjustmentInput{Points: 50, Reason: " goodwill "})
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.LoyaltyKindAdjust, entry.Kind)
+	assert.Equal(t, "goodwill", *entry.Reason)
+	assert.Equal(t, adminID, *entry.CreatedBy)
+	assert.NotNil(t, entry.ExpiresAt)
+}
+
+func TestLoyaltyService_Adjust_DebitTooLarge(t *testing.T) {
+	svc, m := setupLoyaltyService(t)
+	userID := uuid.New()
+
+	m.users.EXPECT().GetByID(gomock.Any(), userID).Return(&models.User{ID: userID}, nil)
+	m.repo.EXPECT().Adjust(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, entry *models.LoyaltyTransaction) error {
+			assert.Nil(t, entry.ExpiresAt)
+			return apperrors.ErrNotEnoughPoints
+		})
+
+	_, err := svc.Adjust(context.Background(), uuid.New(), userID, dto.LoyaltyAdjustmentInput{Points: -500, Reason: "fraud"})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestLoyaltyService_Adjust_ReasonRequired(t *testing.T) {
+	svc, _ := setupLoyaltyService(t)
+
+	_, err := svc.Adjust(context.Background(), uuid.New(), uuid.New(), dto.LoyaltyAdjustmentInput{Points: 10, Reason: "  "})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestLoyaltyService_Adjust_UnknownUser(t *testing.T) {
+	svc, m := setupLoyaltyService(t)
+	userID := uuid.New()
+
+	m.users.EXPECT().GetByID(gomock.Any(), userID).Return(nil, errors.New("not found"))
+
+	_, err := svc.Adjust(context.Background(), uuid.New(), userID, dto.LoyaltyAdjustmentInput{Points: 10, Reason: "bonus"})
+
+	assertAppErrorCode(t, err, 404)
+}
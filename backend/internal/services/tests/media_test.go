@@ -0,0 +1,155 @@
+package services_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type mediaMocks struct {
+	store   *mocks.MockBlobStore
+	repo    *mocks.MockMediaRepositoryInterface
+	books   *mocks.MockBookRepositoryInterface
+	authors *mocks.MockAuthorRepositoryInterface
+}
+
+func setupMediaService(t *testing.T) (*services.MediaService, mediaMocks) {
+	ctrl := gomock.NewController(t)
+	m := mediaMocks{
+		store:   mocks.NewMockBlobStore(ctrl),
+		repo:    mocks.NewMockMediaRepositoryInterface(ctrl),
+		books:   mocks.NewMockBookRepositoryInterface(ctrl),
+		authors: mocks.NewMockAuthorRepositoryInterface(ctrl),
+	}
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	svc := services.NewMediaService(m.store, m.repo, m.books, m.authors, "/api/v1/media/", mockAudit)
+	return svc, m
+}
+
+func coverPNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h))))
+	return buf.Bytes()
+}
+
+func TestMediaService_UploadBookCover_StoresVariants(t *testing.T) {
+	svc, m := setupMediaService(t)
+	id := uuid.New()
+	old := &models.Image{Keys: map[string]string{"original": "books/old/original.jpg"}}
+	book := &models.Book{ID: id, Version: 3, Cover: old}
+
+	stored := map[string]string{}
+	m.books.EXPECT().GetByID(gomock.Any(), id).Return(book, nil)
+	m.store.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key, contentType string, _ []byte) error {
+			stored[key] = contentType
+			return nil
+		}).Times(4)
+	var saved *models.Image
+	m.repo.EXPECT().SetBookCover(gomock.Any(), id, int64(3), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ int64, cover *models.Image) error {
+			saved = cover
+			return nil
+		})
+	m.store.EXPECT().Delete(gomock.Any(), "books/old/original.jpg").Return(nil)
+	m.books.EXPECT().GetByID(gomock.Any(), id).Return(&models.Book{ID: id, Version: 4}, nil)
+
+	result, err := svc.UploadBookCover(context.Background(), id, 3, coverPNG(t, 600, 900))
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), result.Version)
+	if assert.NotNil(t, saved) {
+		assert.Equal(t, 600, saved.Width)
+		assert.Equal(t, 900, saved.Height)
+		assert.Len(t, saved.Keys, 4)
+		assert.True(t, strings.HasSuffix(saved.Keys["original"], "/original.png"))
+		assert.Equal(t, "image/png", stored[saved.Keys["original"]])
+		assert.Equal(t, "image/jpeg", stored[saved.Keys["small"]])
+		assert.Equal(t, "/api/v1/media/"+saved.Keys["medium"], saved.URLs["medium"])
+		assert.True(t, strings.HasPrefix(saved.Keys["large"], "books/"+id.String()+"/cover/"))
+	}
+}
+
+func TestMediaService_UploadBookCover_RejectsNonImage(t *testing.T) {
+	svc, m := setupMediaService(t)
+	id := uuid.New()
+
+	m.books.EXPECT().GetByID(gomock.Any(), id).Return(&models.Book{ID: id, Version: 1}, nil)
+
+	result, err := svc.UploadBookCover(context.Background(), id, 1, []byte("%PDF-1.7 not an image"))
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 415)
+}
+
+func TestMediaService_UploadBookCover_TooSmall(t *testing.T) {
+	svc, m := setupMediaService(t)
+	id := uuid.New()
+
+	m.books.EXPECT().GetByID(gomock.Any(), id).Return(&models.Book{ID: id, Version: 1}, nil)
+
+	result, err := svc.UploadBookCover(context.Background(), id, 1, coverPNG(t, 120, 120))
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestMediaService_UploadBookCover_VersionConflictCleansUp(t *testing.T) {
+	svc, m := setupMediaService(t)
+	id := uuid.New()
+
+	m.books.EXPECT().GetByID(gomock.Any(), id).Return(&models.Book{ID: id, Version: 1}, nil)
+	m.store.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(4)
+	m.repo.EXPECT().SetBookCover(gomock.Any(), id, int64(1), gomock.Any()).Return(apperrors.ErrVersionConflict)
+	m.store.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(4)
+
+	result, err := svc.UploadBookCover(context.Background(), id, 1, coverPNG(t, 300, 400))
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 412)
+}
+
+func TestMediaService_DeleteAuthorPhoto_NoPhoto(t *testing.T) {
+	svc, m := setupMediaService(t)
+	id := uuid.New()
+
+	m.authors.EXPECT().GetByID(gomock.Any(), id).Return(&models.Author{ID: id, Version: 1}, nil)
+
+	result, err := svc.DeleteAuthorPhoto(context.Background(), id, 1)
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 404)
+}
+
+func TestImage_KeysAreStoredButNotSerialized(t *testing.T) {
+	image := models.Image{
+		Width:  600,
+		Height: 900,
+		Keys:   map[string]string{"original": "books/a/original.png"},
+		URLs:   map[string]string{"original": "/api/v1/media/books/a/original.png"},
+	}
+
+	body, err := json.Marshal(image)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(body), "Keys")
+
+	value, err := image.Value()
+	assert.NoError(t, err)
+	var scanned models.Image
+	assert.NoError(t, scanned.Scan([]byte(value.(string))))
+	assert.Equal(t, image, scanned)
+}
@@ -0,0 +1,985 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/giftcard"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/loyalty"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/pickup"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/tax"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type orderMocks struct {
+	repo       *mocks.MockOrderRepositoryInterface
+	carts      *mocks.MockCartServiceInterface
+	editions   *mocks.MockEditionRepositoryInterface
+	listener   *mocks.MockEditionListenerInterface
+	shipping   *mocks.MockShippingServiceInterface
+	warehouses *mocks.MockWarehouseServiceInterface
+	points     *mocks.MockLoyaltyRepositoryInterface
+	giftCards  *mocks.MockGiftCardRepositoryInterface
+}
+
+func setupOrderService(t *testing.T) (*services.OrderService, orderMocks) {
+	ctrl := gomock.NewController(t)
+	m := orderMocks{
+		repo:       mocks.NewMockOrderRepositoryInterface(ctrl),
+		carts:      mocks.NewMockCartServiceInterface(ctrl),
+		editions:   mocks.NewMockEditionRepositoryInterface(ctrl),
+		listener:   mocks.NewMockEditionListenerInterface(ctrl),
+		shipping:   mocks.NewMockShippingServiceInterface(ctrl),
+		warehouses: mocks.NewMockWarehouseServiceInterface(ctrl),
+		points:     mocks.NewMockLoyaltyRepositoryInterface(ctrl),
+		giftCards:  mocks.NewMockGiftCardRepositoryInterface(ctrl),
+	}
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	return services.NewOrderService(m.repo, m.carts, m.editions, m.listener, m.shipping, m.warehouses, m.points, loyalty.DefaultProgram, m.giftCards, giftcard.DefaultTerms, pickupCodes, mockAudit), m
+}
+
+var pickupCodes = pickup.NewCodes("secret")
+
+var courierID = uuid.New()
+
+var warehouseID = uuid.New()
+
+// offerCourier quotes a courier costing cost for the cart.
+func offerCourier(m orderMocks, cost money.Amount) {
+	m.shipping.EXPECT().QuoteCart(gomock.Any(), gomock.Any(), "101000").Return([]dto.ShippingQuote{{
+		MethodID: courierID, Name: "Courier", Kind: models.ShippingKindCourier,
+		Cost: cost, Currency: "RUB", Weight: 500, TaxRate: 22, Tax: tax.Included(cost, 22),
+	}}, nil)
+}
+
+func pricedCart(coupon *models.Promotion) *dto.Cart {
+	edition := &models.Edition{ID: uuid.New(), Price: 50000, Stock: 5}
+	applied := []models.AppliedPromotion{{PromotionID: uuid.New(), Name: "Sale", Discount: 5000}}
+	return &dto.Cart{
+		Items:    []dto.CartLine{{Edition: edition, Quantity: 1, Price: 50000, Subtotal: 50000, Discount: 5000, Total: 45000, Promotions: applied}},
+		Currency: "RUB", Subtotal: 50000, Discount: 5000, Total: 45000, Rate: money.One, Coupon: coupon,
+	}
+}
+
+// --- Checkout ---
+
+func TestOrderService_Checkout_SnapshotsPromotions(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+	coupon := &models.Promotion{ID: uuid.New(), CouponCode: strPtr("SALE")}
+	cart := pricedCart(coupon)
+	edition := cart.Items[0].Edition
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "sale").Return(cart, nil)
+	offerCourier(m, 0)
+	var redemption *models.CouponRedemption
+	m.warehouses.EXPECT().ShipFrom(gomock.Any(), nil).Return([]uuid.UUID{warehouseID}, nil)
+	m.repo.EXPECT().Create(gomock.Any(), gomock.Any(), []uuid.UUID{warehouseID}, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *models.Order, _ []uuid.UUID, r *models.CouponRedemption) error {
+			redemption = r
+			return nil
+		})
+	m.editions.EXPECT().GetByID(gomock.Any(), edition.ID).Return(&models.Edition{ID: edition.ID, Stock: 4}, nil)
+	m.listener.EXPECT().EditionChanged(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, before, after *models.Edition) {
+			assert.Equal(t, 5, before.Stock)
+			assert.Equal(t, 4, after.Stock)
+		})
+
+	order, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{
+		CouponCode: strPtr(" sale "), ShippingMethodID: &courierID, Address: "Moscow", PostalCode: "101000", PaymentMethod: "card",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(45000), order.TotalPrice)
+	assert.Equal(t, money.Amount(5000), order.Discount)
+	assert.Equal(t, "SALE", *order.CouponCode)
+	assert.Equal(t, models.PaymentMethodCard, order.Payment.Method)
+	assert.Equal(t, "Sale", order.Items[0].Promotions[0].Name)
+	if assert.NotNil(t, redemption) {
+		assert.Equal(t, coupon.ID, redemption.PromotionID)
+		assert.Equal(t, userID, redemption.UserID)
+	}
+}
+
+func TestOrderService_Checkout_CouponError(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+	cart := pricedCart(nil)
+	cart.CouponError = "coupon has expired"
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "OLD").Return(cart, nil)
+
+	_, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{
+		CouponCode: strPtr("OLD"), ShippingMethodID: &courierID, Address: "Moscow", PostalCode: "101000", PaymentMethod: "Cash",
+	})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestOrderService_Checkout_EmptyCart(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(&dto.Cart{}, nil)
+
+	_, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{ShippingMethodID: &courierID, Address: "Moscow", PostalCode: "101000", PaymentMethod: "Cash"})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestOrderService_Checkout_OutOfStock(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(pricedCart(nil), nil)
+	offerCourier(m, 0)
+	m.warehouses.EXPECT().ShipFrom(gomock.Any(), nil).Return([]uuid.UUID{warehouseID}, nil)
+	m.repo.EXPECT().Create(gomock.Any(), gomock.Any(), []uuid.UUID{warehouseID}, nil).Return(apperrors.ErrOutOfStock)
+
+	_, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{ShippingMethodID: &courierID, Address: "Moscow", PostalCode: "101000", PaymentMethod: "Cash"})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestOrderService_Checkout_UnknownPaymentMethod(t *testing.T) {
+	svc, _ := setupOrderService(t)
+
+	_, err := svc.Checkout(context.Background(), uuid.New(), dto.CheckoutInput{ShippingMethodID: &courierID, Address: "Moscow", PostalCode: "101000", PaymentMethod: "Barter"})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestOrderService_Checkout_RedeemsPoints(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+	cart := pricedCart(nil)
+	edition := cart.Items[0].Edition
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(cart, nil)
+	offerCourier(m, 0)
+	m.warehouses.EXPECT().ShipFrom(gomock.Any(), nil).Return([]uuid.UUID{warehouseID}, nil)
+	m.repo.EXPECT().Create(gomock.Any(), gomock.Any(), []uuid.UUID{warehouseID}, nil).Return(nil)
+	m.editions.EXPECT().GetByID(gomock.Any(), edition.ID).Return(edition, nil)
+	m.listener.EXPECT().EditionChanged(gomock.Any(), gomock.Any(), gomock.Any())
+
+	order, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{ShippingMethodID: &courierID, Address: "Moscow", PostalCode: "101000", PaymentMethod: "Card", Points: 100})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 100, order.PointsRedeemed)
+	assert.Equal(t, money.Amount(10000), order.PointsValue)
+	assert.Equal(t, money.Amount(45000), order.TotalPrice)
+	assert.Equal(t, money.Amount(35000), order.Payment.Amount)
+}
+
+func TestOrderService_Checkout_PointsWorthMoreThanOrder(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(pricedCart(nil), nil)
+	offerCourier(m, 0)
+
+	_, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{ShippingMethodID: &courierID, Address: "Moscow", PostalCode: "101000", PaymentMethod: "Card", Points: 451})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestOrderService_Checkout_NotEnoughPoints(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(pricedCart(nil), nil)
+	offerCourier(m, 0)
+	m.warehouses.EXPECT().ShipFrom(gomock.Any(), nil).Return([]uuid.UUID{warehouseID}, nil)
+	m.repo.EXPECT().Create(gomock.Any(), gomock.Any(), []uuid.UUID{warehouseID}, nil).Return(apperrors.ErrNotEnoughPoints)
+
+	_, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{ShippingMethodID: &courierID, Address: "Moscow", PostalCode: "101000", PaymentMethod: "Card", Points: 10})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestOrderService_Checkout_PaysWithStoreCreditAndGiftCards(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+	cart := pricedCart(nil)
+	edition := cart.Items[0].Edition
+	credit := models.GiftCard{ID: uuid.New(), Kind: models.GiftCardKindStoreCredit, Balance: 5000, ExpiresAt: time.Now().Add(time.Hour)}
+	card := &models.GiftCard{ID: uuid.New(), Kind: models.GiftCardKindGiftCard, Balance: 100000, ExpiresAt: time.Now().Add(time.Hour)}
+	code := "K7QX-2MZP-9RTA-WC4H"
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(cart, nil)
+	offerCourier(m, 0)
+	m.giftCards.EXPECT().GetStoreCredit(gomock.Any(), userID, gomock.Any()).Return([]models.GiftCard{credit}, nil)
+	m.giftCards.EXPECT().GetByCode(gomock.Any(), giftcard.Hash(code)).Return(card, nil)
+	m.warehouses.EXPECT().ShipFrom(gomock.Any(), nil).Return([]uuid.UUID{warehouseID}, nil)
+	m.repo.EXPECT().Create(gomock.Any(), gomock.Any(), []uuid.UUID{warehouseID}, nil).Return(nil)
+	m.editions.EXPECT().GetByID(gomock.Any(), edition.ID).Return(edition, nil)
+	m.listener.EXPECT().EditionChanged(gomock.Any(), gomock.Any(), gomock.Any())
+
+	order, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{
+		ShippingMethodID: &courierID, Address: "Moscow", PostalCode: "101000", PaymentMethod: "Card", Points: 100, UseStoreCredit: true, GiftCardCodes: []string{code, "k7qx2mzp9rtawc4h"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(35000), order.GiftCardValue)
+	assert.Equal(t, money.Amount(0), order.Payment.Amount)
+	if assert.Len(t, order.GiftCardTransactions, 2) {
+		assert.Equal(t, credit.ID, order.GiftCardTransactions[0].GiftCardID)
+		assert.Equal(t, money.Amount(-5000), order.GiftCardTransactions[0].Amount)
+		assert.Equal(t, card.ID, order.GiftCardTransactions[1].GiftCardID)
+		assert.Equal(t, money.Amount(-30000), order.GiftCardTransactions[1].Amount)
+	}
+}
+
+func TestOrderService_Checkout_ExpiredGiftCard(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+	code := "K7QX-2MZP-9RTA-WC4H"
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(pricedCart(nil), nil)
+	offerCourier(m, 0)
+	m.giftCards.EXPECT().GetByCode(gomock.Any(), giftcard.Hash(code)).Return(&models.GiftCard{Balance: 5000, ExpiresAt: time.Now().Add(-time.Hour)}, nil)
+
+	_, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{ShippingMethodID: &courierID, Address: "Moscow", PostalCode: "101000", PaymentMethod: "Card", GiftCardCodes: []string{code}})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestOrderService_Checkout_GiftCardUsedMeanwhile(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+	code := "K7QX-2MZP-9RTA-WC4H"
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(pricedCart(nil), nil)
+	offerCourier(m, 0)
+	m.giftCards.EXPECT().GetByCode(gomock.Any(), giftcard.Hash(code)).Return(&models.GiftCard{ID: uuid.New(), Balance: 5000, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	m.warehouses.EXPECT().ShipFrom(gomock.Any(), nil).Return([]uuid.UUID{warehouseID}, nil)
+	m.repo.EXPECT().Create(gomock.Any(), gomock.Any(), []uuid.UUID{warehouseID}, nil).Return(apperrors.ErrGiftCardUsedUp)
+
+	_, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{ShippingMethodID: &courierID, Address: "Moscow", PostalCode: "101000", PaymentMethod: "Card", GiftCardCodes: []string{code}})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestOrderService_Checkout_BuysGiftCardsUnpaid(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+	pointID := uuid.New()
+	cart := &dto.Cart{Currency: "EUR", Rate: money.Rate(10_000)}
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(cart, nil)
+	m.shipping.EXPECT().GetPickupPointByID(gomock.Any(), pointID).Return(&models.PickupPoint{ID: pointID, Name: "Shop", Active: true}, nil)
+	m.warehouses.EXPECT().ShipFrom(gomock.Any(), &pointID).Return([]uuid.UUID{warehouseID}, nil)
+	var saved *models.Order
+	m.repo.EXPECT().Create(gomock.Any(), gomock.Any(), []uuid.UUID{warehouseID}, nil).DoAndReturn(
+		func(_ context.Context, order *models.Order, _ []uuid.UUID, _ *models.CouponRedemption) error {
+			saved = order
+			for _, card := range order.GiftCards {
+				assert.Empty(t, card.Code)
+			}
+			return nil
+		})
+
+	order, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{
+		PickupPointID: &pointID, PaymentMethod: "Card", GiftCards: []money.Amount{300000, 50000},
+	})
+
+	assert.NoError(t, err)
+	assert.Same(t, saved, order)
+	assert.Equal(t, money.Amount(3500), order.TotalPrice)
+	assert.Equal(t, money.Amount(3500), order.GiftCardsBought)
+	assert.Equal(t, money.Amount(3500), order.Payment.Amount)
+	if assert.Len(t, order.GiftCards, 2) {
+		card := order.GiftCards[0]
+		assert.NotEmpty(t, card.Code)
+		assert.Equal(t, giftcard.Hash(card.Code), *card.CodeHash)
+		assert.Equal(t, giftcard.LastDigits(card.Code), *card.LastDigits)
+		assert.Equal(t, models.GiftCardKindGiftCard, card.Kind)
+		assert.Equal(t, money.Amount(300000), card.InitialAmount)
+		assert.Equal(t, money.Amount(0), card.Balance)
+		assert.Equal(t, userID, *card.PurchasedBy)
+		assert.Nil(t, card.OwnerID)
+		assert.True(t, card.ExpiresAt.After(time.Now().AddDate(0, 11, 0)))
+		assert.NotEqual(t, card.Code, order.GiftCards[1].Code)
+	}
+}
+
+func TestOrderService_Checkout_GiftCardsOnlyForMoney(t *testing.T) {
+	tests := []struct {
+		name  string
+		input dto.CheckoutInput
+	}{
+		{"points", dto.CheckoutInput{Points: 100}},
+		{"store credit", dto.CheckoutInput{UseStoreCredit: true}},
+		{"gift card", dto.CheckoutInput{GiftCardCodes: []string{"K7QX-2MZP-9RTA-WC4H"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := setupOrderService(t)
+			tt.input.Address, tt.input.PaymentMethod, tt.input.GiftCards = "Moscow", "Card", []money.Amount{300000}
+
+			_, err := svc.Checkout(context.Background(), uuid.New(), tt.input)
+
+			assertAppErrorCode(t, err, 400)
+		})
+	}
+}
+
+func TestOrderService_Checkout_GiftCardAmountOutOfRange(t *testing.T) {
+	svc, _ := setupOrderService(t)
+
+	for _, amount := range []money.Amount{0, 10000, 100_000_01} {
+		_, err := svc.Checkout(context.Background(), uuid.New(), dto.CheckoutInput{Address: "Moscow", PaymentMethod: "Card", GiftCards: []money.Amount{amount}})
+
+		assertAppErrorCode(t, err, 400)
+	}
+}
+
+func TestOrderService_Checkout_ChargesShipping(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+	cart := pricedCart(nil)
+	cart.Tax = 4091
+	cart.Taxes = []models.TaxLine{{Rate: 10, Net: 40909, Tax: 4091, Total: 45000}}
+	edition := cart.Items[0].Edition
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(cart, nil)
+	offerCourier(m, 30500)
+	m.warehouses.EXPECT().ShipFrom(gomock.Any(), nil).Return([]uuid.UUID{warehouseID}, nil)
+	m.repo.EXPECT().Create(gomock.Any(), gomock.Any(), []uuid.UUID{warehouseID}, nil).Return(nil)
+	m.editions.EXPECT().GetByID(gomock.Any(), edition.ID).Return(edition, nil)
+	m.listener.EXPECT().EditionChanged(gomock.Any(), gomock.Any(), gomock.Any())
+
+	order, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{
+		ShippingMethodID: &courierID, Address: "Moscow", PostalCode: "101000", PaymentMethod: "Card",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(75500), order.TotalPrice)
+	assert.Equal(t, money.Amount(30500), order.ShippingCost)
+	assert.Equal(t, courierID, *order.ShippingMethodID)
+	assert.Equal(t, money.Amount(75500), order.Payment.Amount)
+	assert.Equal(t, money.Amount(4091+5500), order.Tax)
+	assert.Equal(t, []models.TaxLine{
+		{Rate: 10, Net: 40909, Tax: 4091, Total: 45000},
+		{Rate: 22, Net: 25000, Tax: 5500, Total: 30500},
+	}, order.Taxes)
+	assert.Equal(t, "Courier", order.Delivery.Method)
+	assert.Equal(t, "101000", order.Delivery.PostalCode)
+	assert.Equal(t, 500, order.Delivery.Weight)
+	assert.Equal(t, money.Amount(30500), order.Delivery.Cost)
+}
+
+func TestOrderService_Checkout_ShippingMethodRequired(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(pricedCart(nil), nil)
+	m.shipping.EXPECT().GetMethods(gomock.Any(), dto.ShippingMethodFilter{Active: true}).Return([]models.ShippingMethod{{ID: courierID}}, nil)
+
+	_, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{Address: "Moscow", PostalCode: "101000", PaymentMethod: "Card"})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestOrderService_Checkout_FreeDeliveryWithoutShippingMethods(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+	cart := pricedCart(nil)
+	edition := cart.Items[0].Edition
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(cart, nil)
+	m.shipping.EXPECT().GetMethods(gomock.Any(), dto.ShippingMethodFilter{Active: true}).Return([]models.ShippingMethod{}, nil)
+	m.warehouses.EXPECT().ShipFrom(gomock.Any(), nil).Return([]uuid.UUID{warehouseID}, nil)
+	m.repo.EXPECT().Create(gomock.Any(), gomock.Any(), []uuid.UUID{warehouseID}, nil).Return(nil)
+	m.editions.EXPECT().GetByID(gomock.Any(), edition.ID).Return(edition, nil)
+	m.listener.EXPECT().EditionChanged(gomock.Any(), gomock.Any(), gomock.Any())
+
+	order, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{Address: " Moscow ", PostalCode: "101000", PaymentMethod: "Card"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(45000), order.TotalPrice)
+	assert.Zero(t, order.ShippingCost)
+	assert.Nil(t, order.ShippingMethodID)
+	assert.Equal(t, "Moscow", order.Delivery.Address)
+	assert.Equal(t, "101000", order.Delivery.PostalCode)
+}
+
+func TestOrderService_Checkout_ShippingMethodUnavailable(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+	other := uuid.New()
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(pricedCart(nil), nil)
+	offerCourier(m, 0)
+
+	_, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{
+		ShippingMethodID: &other, Address: "Moscow", PostalCode: "101000", PaymentMethod: "Card",
+	})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestOrderService_Checkout_PickupPoint(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+	cart := pricedCart(nil)
+	edition := cart.Items[0].Edition
+	point := &models.PickupPoint{ID: uuid.New(), Name: "Tverskaya shop", Address: "Moscow, Tverskaya 1", PostalCode: "125009", Active: true}
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(cart, nil)
+	m.shipping.EXPECT().GetPickupPointByID(gomock.Any(), point.ID).Return(point, nil)
+	shop, central := uuid.New(), uuid.New()
+	m.warehouses.EXPECT().ShipFrom(gomock.Any(), &point.ID).Return([]uuid.UUID{shop, central}, nil)
+	m.repo.EXPECT().Create(gomock.Any(), gomock.Any(), []uuid.UUID{shop, central}, nil).Return(nil)
+	m.editions.EXPECT().GetByID(gomock.Any(), edition.ID).Return(edition, nil)
+	m.listener.EXPECT().EditionChanged(gomock.Any(), gomock.Any(), gomock.Any())
+
+	order, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{PickupPointID: &point.ID, PaymentMethod: "Card"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, money.Amount(45000), order.TotalPrice)
+	assert.Zero(t, order.ShippingCost)
+	assert.Nil(t, order.ShippingMethodID)
+	assert.Equal(t, point.ID, *order.Delivery.PickupPointID)
+	assert.Equal(t, "Tverskaya shop", order.Delivery.Method)
+	assert.Equal(t, "Moscow, Tverskaya 1", order.Delivery.Address)
+	assert.Equal(t, "125009", order.Delivery.PostalCode)
+	assert.Equal(t, 500, order.Delivery.Weight)
+	assert.Nil(t, order.Delivery.PickupCode)
+}
+
+func TestOrderService_Checkout_PickupPointInactive(t *testing.T) {
+	svc, m := setupOrderService(t)
+	userID := uuid.New()
+	point := &models.PickupPoint{ID: uuid.New(), Name: "Closed shop", Active: false}
+
+	m.carts.EXPECT().Get(gomock.Any(), userID, "").Return(pricedCart(nil), nil)
+	m.shipping.EXPECT().GetPickupPointByID(gomock.Any(), point.ID).Return(point, nil)
+
+	_, err := svc.Checkout(context.Background(), userID, dto.CheckoutInput{PickupPointID: &point.ID, PaymentMethod: "Card"})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestOrderService_Checkout_ShippingMethodAndPickupPoint(t *testing.T) {
+	svc, _ := setupOrderService(t)
+	pointID := uuid.New()
+
+	_, err := svc.Checkout(context.Background(), uuid.New(), dto.CheckoutInput{
+		ShippingMethodID: &courierID, PickupPointID: &pointID, Address: "Moscow", PostalCode: "101000", PaymentMethod: "Card",
+	})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+// --- UpdateStatus ---
+
+func TestOrderService_UpdateStatus_DeliveredEarnsPoints(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := &models.Order{ID: uuid.New(), UserID: uuid.New(), TotalPrice: 45050, ExchangeRate: money.One, Status: models.OrderStatusNew}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().UpdateStatus(gomock.Any(), order, models.OrderStatusNew, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, o *models.Order, _ string, points []*models.LoyaltyTransaction, _ []*models.GiftCardTransaction) error {
+			assert.Equal(t, models.OrderStatusDelivered, o.Status)
+			if assert.Len(t, points, 1) {
+				assert.Equal(t, models.LoyaltyKindEarn, points[0].Kind)
+				assert.Equal(t, 22, points[0].Points)
+				assert.Equal(t, order.ID, *points[0].OrderID)
+				assert.NotNil(t, points[0].ExpiresAt)
+			}
+			return nil
+		})
+
+	updated, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "delivered"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.OrderStatusDelivered, updated.Status)
+}
+
+func TestOrderService_UpdateStatus_ShippingEarnsNoPoints(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := &models.Order{ID: uuid.New(), UserID: uuid.New(), TotalPrice: 75550, ShippingCost: 30500, ExchangeRate: money.One, Status: models.OrderStatusNew}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().UpdateStatus(gomock.Any(), order, models.OrderStatusNew, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *models.Order, _ string, points []*models.LoyaltyTransaction, _ []*models.GiftCardTransaction) error {
+			if assert.Len(t, points, 1) {
+				assert.Equal(t, 22, points[0].Points)
+			}
+			return nil
+		})
+
+	_, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "delivered"})
+
+	assert.NoError(t, err)
+}
+
+func TestOrderService_UpdateStatus_ReturnedReversesPoints(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := &models.Order{ID: uuid.New(), UserID: uuid.New(), ExchangeRate: money.One, Status: models.OrderStatusDelivered}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.points.EXPECT().GetByOrder(gomock.Any(), order.ID).Return([]models.LoyaltyTransaction{
+		{Kind: models.LoyaltyKindRedeem, Points: -100},
+		{Kind: models.LoyaltyKindEarn, Points: 22},
+	}, nil)
+	m.giftCards.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(nil, nil)
+	m.repo.EXPECT().UpdateStatus(gomock.Any(), order, models.OrderStatusDelivered, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *models.Order, _ string, points []*models.LoyaltyTransaction, _ []*models.GiftCardTransaction) error {
+			if assert.Len(t, points, 2) {
+				assert.Equal(t, models.LoyaltyKindRefund, points[0].Kind)
+				assert.Equal(t, 100, points[0].Points)
+				assert.NotNil(t, points[0].ExpiresAt)
+				assert.Equal(t, models.LoyaltyKindReverse, points[1].Kind)
+				assert.Equal(t, -22, points[1].Points)
+			}
+			return nil
+		})
+
+	_, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "Returned"})
+
+	assert.NoError(t, err)
+}
+
+func TestOrderService_UpdateStatus_ReturnedTellsListenerAboutStock(t *testing.T) {
+	svc, m := setupOrderService(t)
+	editionID := uuid.New()
+	order := &models.Order{
+		ID: uuid.New(), UserID: uuid.New(), ExchangeRate: money.One, Status: models.OrderStatusDelivered,
+		Items: []*models.OrderItem{{EditionID: editionID, Quantity: 2, Allocations: []*models.StockAllocation{{WarehouseID: warehouseID, Quantity: 2}}}},
+	}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.points.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(nil, nil)
+	m.giftCards.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(nil, nil)
+	m.repo.EXPECT().UpdateStatus(gomock.Any(), order, models.OrderStatusDelivered, gomock.Any(), gomock.Any()).Return(nil)
+	m.editions.EXPECT().GetByID(gomock.Any(), editionID).Return(&models.Edition{ID: editionID, Stock: 2}, nil)
+	m.listener.EXPECT().EditionChanged(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, before, after *models.Edition) {
+			assert.Equal(t, 0, before.Stock)
+			assert.Equal(t, 2, after.Stock)
+		})
+
+	_, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "Returned"})
+
+	assert.NoError(t, err)
+}
+
+func TestOrderService_UpdateStatus_RefundsToStoreCredit(t *testing.T) {
+	svc, m := setupOrderService(t)
+	cardID := uuid.New()
+	order := &models.Order{
+		ID: uuid.New(), UserID: uuid.New(), ExchangeRate: money.Rate(35_400), Status: models.OrderStatusDelivered,
+		Payment: &models.Payment{Amount: 1000},
+	}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.points.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(nil, nil)
+	m.giftCards.EXPECT().GetByOrder(gomock.Any(), order.ID).Return([]models.GiftCardTransaction{
+		{GiftCardID: cardID, Kind: models.GiftCardTransactionRedeem, Amount: -20000},
+	}, nil)
+	m.repo.EXPECT().UpdateStatus(gomock.Any(), order, models.OrderStatusDelivered, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *models.Order, _ string, _ []*models.LoyaltyTransaction, giftCards []*models.GiftCardTransaction) error {
+			if assert.Len(t, giftCards, 2) {
+				assert.Equal(t, cardID, giftCards[0].GiftCardID)
+				assert.Equal(t, models.GiftCardTransactionRefund, giftCards[0].Kind)
+				assert.Equal(t, money.Amount(20000), giftCards[0].Amount)
+				credit := giftCards[1].GiftCard
+				if assert.NotNil(t, credit) {
+					assert.Equal(t, models.GiftCardKindStoreCredit, credit.Kind)
+					assert.Equal(t, order.UserID, *credit.OwnerID)
+					assert.Equal(t, money.Amount(28249), credit.InitialAmount)
+				}
+			}
+			return nil
+		})
+
+	_, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "Returned", Refund: "store_credit"})
+
+	assert.NoError(t, err)
+}
+
+func TestOrderService_UpdateStatus_RefundsExpiredCardToStoreCredit(t *testing.T) {
+	svc, m := setupOrderService(t)
+	liveID, expiredID := uuid.New(), uuid.New()
+	order := &models.Order{ID: uuid.New(), UserID: uuid.New(), ExchangeRate: money.One, Status: models.OrderStatusDelivered}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.points.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(nil, nil)
+	m.giftCards.EXPECT().GetByOrder(gomock.Any(), order.ID).Return([]models.GiftCardTransaction{
+		{GiftCardID: liveID, Kind: models.GiftCardTransactionRedeem, Amount: -3000, GiftCard: &models.GiftCard{ExpiresAt: time.Now().Add(time.Hour)}},
+		{GiftCardID: expiredID, Kind: models.GiftCardTransactionRedeem, Amount: -2000, GiftCard: &models.GiftCard{ExpiresAt: time.Now().Add(-time.Hour)}},
+	}, nil)
+	m.repo.EXPECT().UpdateStatus(gomock.Any(), order, models.OrderStatusDelivered, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *models.Order, _ string, _ []*models.LoyaltyTransaction, giftCards []*models.GiftCardTransaction) error {
+			if assert.Len(t, giftCards, 2) {
+				assert.Equal(t, liveID, giftCards[0].GiftCardID)
+				assert.Equal(t, money.Amount(3000), giftCards[0].Amount)
+				credit := giftCards[1].GiftCard
+				if assert.NotNil(t, credit) {
+					assert.Equal(t, models.GiftCardKindStoreCredit, credit.Kind)
+					assert.Equal(t, order.UserID, *credit.OwnerID)
+					assert.Equal(t, money.Amount(2000), credit.InitialAmount)
+				}
+			}
+			return nil
+		})
+
+	_, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "Returned"})
+
+	assert.NoError(t, err)
+}
+
+func TestOrderService_UpdateStatus_ReturnedCancelsBoughtGiftCards(t *testing.T) {
+	svc, m := setupOrderService(t)
+	spent, unused := uuid.New(), uuid.New()
+	order := &models.Order{
+		ID: uuid.New(), UserID: uuid.New(), ExchangeRate: money.One, Status: models.OrderStatusDelivered,
+		GiftCards: []*models.GiftCard{{ID: spent, Balance: 0}, {ID: unused, Balance: 300000}},
+	}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.points.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(nil, nil)
+	m.giftCards.EXPECT().GetByOrder(gomock.Any(), order.ID).Return(nil, nil)
+	m.repo.EXPECT().UpdateStatus(gomock.Any(), order, models.OrderStatusDelivered, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *models.Order, _ string, _ []*models.LoyaltyTransaction, giftCards []*models.GiftCardTransaction) error {
+			if assert.Len(t, giftCards, 1) {
+				assert.Equal(t, unused, giftCards[0].GiftCardID)
+				assert.Equal(t, models.GiftCardTransactionCancel, giftCards[0].Kind)
+				assert.Equal(t, money.Amount(-300000), giftCards[0].Amount)
+			}
+			return nil
+		})
+
+	_, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "Returned"})
+
+	assert.NoError(t, err)
+}
+
+func TestOrderService_UpdateStatus_RefundOnlyForReturns(t *testing.T) {
+	svc, _ := setupOrderService(t)
+
+	_, err := svc.UpdateStatus(context.Background(), uuid.New(), dto.OrderStatusInput{Status: "Delivered", Refund: "store_credit"})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestOrderService_UpdateStatus_InvalidTransition(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := &models.Order{ID: uuid.New(), Status: models.OrderStatusNew}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+
+	_, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "Returned"})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestOrderService_UpdateStatus_ChangedConcurrently(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := &models.Order{ID: uuid.New(), UserID: uuid.New(), ExchangeRate: money.One, Status: models.OrderStatusNew}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().UpdateStatus(gomock.Any(), order, models.OrderStatusNew, gomock.Any(), gomock.Any()).Return(apperrors.ErrVersionConflict)
+
+	_, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "Delivered"})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestOrderService_UpdateStatus_UnknownStatus(t *testing.T) {
+	svc, _ := setupOrderService(t)
+
+	_, err := svc.UpdateStatus(context.Background(), uuid.New(), dto.OrderStatusInput{Status: "Lost"})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+// pickupOrder returns a new order to be collected at a pickup point.
+func pickupOrder() *models.Order {
+	pointID := uuid.New()
+	return &models.Order{
+		ID: uuid.New(), UserID: uuid.New(), TotalPrice: 45050, ExchangeRate: money.One, Status: models.OrderStatusNew,
+		Delivery: &models.Delivery{PickupPointID: &pointID, Method: "Tverskaya shop"},
+	}
+}
+
+func TestOrderService_UpdateStatus_ReadyForPickupGivesCode(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := pickupOrder()
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().UpdateStatus(gomock.Any(), order, models.OrderStatusNew, nil, nil).Return(nil)
+
+	updated, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "readyforpickup"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.OrderStatusReadyForPickup, updated.Status)
+	assert.NotNil(t, updated.Delivery.PickupCodeSalt)
+	assert.Nil(t, updated.Delivery.PickupCode)
+}
+
+func TestOrderService_UpdateStatus_ReadyForPickupAgainGivesNewCode(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := pickupOrder()
+	order.Status = models.OrderStatusReadyForPickup
+	order.Delivery.PickupCodeSalt = strPtr("salt")
+	order.Delivery.PickupAttempts = 5
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().UpdateStatus(gomock.Any(), order, models.OrderStatusReadyForPickup, nil, nil).Return(nil)
+
+	updated, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "ReadyForPickup"})
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, "salt", *updated.Delivery.PickupCodeSalt)
+	assert.Zero(t, updated.Delivery.PickupAttempts)
+}
+
+func TestOrderService_UpdateStatus_UncollectedPickupOrderReturned(t *testing.T) {
+	svc, m := setupOrderService(t)
+	cardID := uuid.New()
+	order := pickupOrder()
+	order.Status = models.OrderStatusReadyForPickup
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.points.EXPECT().GetByOrder(gomock.Any(), order.ID).Return([]models.LoyaltyTransaction{{Kind: models.LoyaltyKindRedeem, Points: -100}}, nil)
+	m.giftCards.EXPECT().GetByOrder(gomock.Any(), order.ID).Return([]models.GiftCardTransaction{
+		{GiftCardID: cardID, Kind: models.GiftCardTransactionRedeem, Amount: -3000},
+	}, nil)
+	m.repo.EXPECT().UpdateStatus(gomock.Any(), order, models.OrderStatusReadyForPickup, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *models.Order, _ string, points []*models.LoyaltyTransaction, giftCards []*models.GiftCardTransaction) error {
+			if assert.Len(t, points, 1) {
+				assert.Equal(t, models.LoyaltyKindRefund, points[0].Kind)
+			}
+			if assert.Len(t, giftCards, 1) {
+				assert.Equal(t, models.GiftCardTransactionRefund, giftCards[0].Kind)
+			}
+			return nil
+		})
+
+	updated, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "Returned"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.OrderStatusReturned, updated.Status)
+}
+
+func TestOrderService_UpdateStatus_ReadyForPickupOnlyForPickupOrders(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := &models.Order{ID: uuid.New(), Status: models.OrderStatusNew, Delivery: &models.Delivery{Method: "Courier"}}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+
+	_, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "ReadyForPickup"})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestOrderService_UpdateStatus_PickupOrderNeedsHandOver(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := pickupOrder()
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+
+	_, err := svc.UpdateStatus(context.Background(), order.ID, dto.OrderStatusInput{Status: "Delivered"})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+// --- HandOver ---
+
+func TestOrderService_HandOver_Success(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := pickupOrder()
+	order.Status = models.OrderStatusReadyForPickup
+	order.Delivery.PickupCodeSalt = strPtr("salt")
+	code := pickupCodes.Code(order.ID, "salt")
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().UsePickupAttempt(gomock.Any(), order.ID, pickup.MaxAttempts).Return(true, nil)
+	m.repo.EXPECT().UpdateStatus(gomock.Any(), order, models.OrderStatusReadyForPickup, gomock.Any(), nil).DoAndReturn(
+		func(_ context.Context, o *models.Order, _ string, points []*models.LoyaltyTransaction, _ []*models.GiftCardTransaction) error {
+			assert.Equal(t, models.OrderStatusDelivered, o.Status)
+			if assert.Len(t, points, 1) {
+				assert.Equal(t, models.LoyaltyKindEarn, points[0].Kind)
+				assert.Equal(t, 22, points[0].Points)
+			}
+			return nil
+		})
+
+	updated, err := svc.HandOver(context.Background(), order.ID, dto.PickupInput{Code: " " + code + " "})
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.OrderStatusDelivered, updated.Status)
+}
+
+func TestOrderService_HandOver_WrongCode(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := pickupOrder()
+	order.Status = models.OrderStatusReadyForPickup
+	order.Delivery.PickupCodeSalt = strPtr("salt")
+	wrong := "000000"
+	if pickupCodes.Code(order.ID, "salt") == wrong {
+		wrong = "000001"
+	}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().UsePickupAttempt(gomock.Any(), order.ID, pickup.MaxAttempts).Return(true, nil)
+
+	_, err := svc.HandOver(context.Background(), order.ID, dto.PickupInput{Code: wrong})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestOrderService_HandOver_LockedAfterTooManyAttempts(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := pickupOrder()
+	order.Status = models.OrderStatusReadyForPickup
+	order.Delivery.PickupCodeSalt = strPtr("salt")
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().UsePickupAttempt(gomock.Any(), order.ID, pickup.MaxAttempts).Return(false, nil)
+
+	_, err := svc.HandOver(context.Background(), order.ID, dto.PickupInput{Code: pickupCodes.Code(order.ID, "salt")})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestOrderService_HandOver_NotReady(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := pickupOrder()
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+
+	_, err := svc.HandOver(context.Background(), order.ID, dto.PickupInput{Code: "042917"})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestOrderService_HandOver_NotPickupOrder(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := &models.Order{ID: uuid.New(), Status: models.OrderStatusReadyForPickup, Delivery: &models.Delivery{Method: "Courier"}}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+
+	_, err := svc.HandOver(context.Background(), order.ID, dto.PickupInput{Code: "042917"})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+// --- GetByID ---
+
+func TestOrderService_GetByID_ShowsPickupCodeToCustomer(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := pickupOrder()
+	order.Status = models.OrderStatusReadyForPickup
+	order.Delivery.PickupCodeSalt = strPtr("salt")
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+
+	found, err := svc.GetByID(context.Background(), order.UserID, order.ID)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, found.Delivery.PickupCode) {
+		assert.Equal(t, pickupCodes.Code(order.ID, "salt"), *found.Delivery.PickupCode)
+	}
+}
+
+func TestOrderService_GetByID_OtherUser(t *testing.T) {
+	svc, m := setupOrderService(t)
+	id := uuid.New()
+
+	m.repo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Order{ID: id, UserID: uuid.New()}, nil)
+
+	_, err := svc.GetByID(context.Background(), uuid.New(), id)
+
+	assertAppErrorCode(t, err, 404)
+}
+
+func TestOrderService_GetByID_NotFound(t *testing.T) {
+	svc, m := setupOrderService(t)
+	id := uuid.New()
+
+	m.repo.EXPECT().GetByID(gomock.Any(), id).Return(nil, errors.New("not found"))
+
+	_, err := svc.GetByID(context.Background(), uuid.New(), id)
+
+	assertAppErrorCode(t, err, 404)
+}
+
+// --- MarkPaid ---
+
+func TestOrderService_MarkPaid_FundsGiftCards(t *testing.T) {
+	svc, m := setupOrderService(t)
+	cardID := uuid.New()
+	order := &models.Order{
+		ID: uuid.New(), Status: models.OrderStatusNew,
+		Payment:   &models.Payment{Amount: 30000, Status: models.PaymentStatusNotPaid},
+		GiftCards: []*models.GiftCard{{ID: cardID, InitialAmount: 300000}},
+	}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().MarkPaid(gomock.Any(), order, gomock.Any()).DoAndReturn(
+		func(_ context.Context, order *models.Order, giftCards []*models.GiftCardTransaction) error {
+			if assert.Len(t, giftCards, 1) {
+				assert.Equal(t, cardID, giftCards[0].GiftCardID)
+				assert.Equal(t, models.GiftCardTransactionIssue, giftCards[0].Kind)
+				assert.Equal(t, money.Amount(300000), giftCards[0].Amount)
+			}
+			order.Payment.Status = models.PaymentStatusPaid
+			return nil
+		})
+
+	paid, err := svc.MarkPaid(context.Background(), order.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.PaymentStatusPaid, paid.Payment.Status)
+	assert.Equal(t, money.Amount(300000), paid.GiftCards[0].Balance)
+}
+
+func TestOrderService_MarkPaid_AlreadyPaid(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := &models.Order{ID: uuid.New(), Status: models.OrderStatusNew, Payment: &models.Payment{Status: models.PaymentStatusPaid}}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+
+	_, err := svc.MarkPaid(context.Background(), order.ID)
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestOrderService_MarkPaid_PaidConcurrently(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := &models.Order{ID: uuid.New(), Status: models.OrderStatusNew, Payment: &models.Payment{Status: models.PaymentStatusNotPaid}}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+	m.repo.EXPECT().MarkPaid(gomock.Any(), order, gomock.Any()).Return(apperrors.ErrVersionConflict)
+
+	_, err := svc.MarkPaid(context.Background(), order.ID)
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestOrderService_MarkPaid_Returned(t *testing.T) {
+	svc, m := setupOrderService(t)
+	order := &models.Order{ID: uuid.New(), Status: models.OrderStatusReturned, Payment: &models.Payment{Status: models.PaymentStatusNotPaid}}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), order.ID).Return(order, nil)
+
+	_, err := svc.MarkPaid(context.Background(), order.ID)
+
+	assertAppErrorCode(t, err, 409)
+}
@@ -0,0 +1,106 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupPromotionService(t *testing.T) (*services.PromotionService, *mocks.MockPromotionRepositoryInterface) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockPromotionRepositoryInterface(ctrl)
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	return services.NewPromotionService(mockRepo, mockAudit), mockRepo
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+// --- Create ---
+
+func TestPromotionService_Create_NormalizesCoupon(t *testing.T) {
+	svc, mockRepo := setupPromotionService(t)
+
+	mockRepo.EXPECT().GetByCouponCode(gomock.Any(), "AUTUMN-10").Return(nil, errors.New("not found"))
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	p, err := svc.Create(context.Background(), dto.PromotionInput{
+		Name: "Autumn", Kind: models.PromotionKindPercent, Percent: 10,
+		CouponCode: strPtr(" autumn-10 "), UsageLimit: intPtr(100),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "AUTUMN-10", *p.CouponCode)
+	assert.Equal(t, models.PromotionScopeAll, p.Scope)
+	assert.True(t, p.Active)
+}
+
+func TestPromotionService_Create_Invalid(t *testing.T) {
+	svc, _ := setupPromotionService(t)
+	now := time.Now()
+
+	for name, input := range map[string]dto.PromotionInput{
+		"percent over 100":     {Name: "Sale", Kind: models.PromotionKindPercent, Percent: 120},
+		"fixed without amount": {Name: "Sale", Kind: models.PromotionKindFixed},
+		"scope without target": {Name: "Sale", Kind: models.PromotionKindPercent, Percent: 5, Scope: models.PromotionScopeAuthor},
+		"ends before start":    {Name: "Sale", Kind: models.PromotionKindPercent, Percent: 5, StartsAt: &now, EndsAt: &now},
+		"limit without coupon": {Name: "Sale", Kind: models.PromotionKindPercent, Percent: 5, UsageLimit: intPtr(1)},
+		"bad coupon":           {Name: "Sale", Kind: models.PromotionKindPercent, Percent: 5, CouponCode: strPtr("скидка")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.Create(context.Background(), input)
+
+			assertAppErrorCode(t, err, 400)
+		})
+	}
+}
+
+func TestPromotionService_Create_DuplicateCoupon(t *testing.T) {
+	svc, mockRepo := setupPromotionService(t)
+
+	mockRepo.EXPECT().GetByCouponCode(gomock.Any(), "WELCOME").Return(&models.Promotion{ID: uuid.New()}, nil)
+
+	_, err := svc.Create(context.Background(), dto.PromotionInput{
+		Name: "Welcome", Kind: models.PromotionKindPercent, Percent: 5, CouponCode: strPtr("welcome"),
+	})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+// --- Delete ---
+
+func TestPromotionService_Delete_Used(t *testing.T) {
+	svc, mockRepo := setupPromotionService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Promotion{ID: id, Version: 2}, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), id, int64(2)).Return(fmt.Errorf("failed to delete promotion: %w", apperrors.ErrForeignKey))
+
+	err := svc.Delete(context.Background(), id, 2)
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestPromotionService_Delete_StaleVersion(t *testing.T) {
+	svc, mockRepo := setupPromotionService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Promotion{ID: id, Version: 2}, nil)
+
+	err := svc.Delete(context.Background(), id, 1)
+
+	assertAppErrorCode(t, err, 412)
+}
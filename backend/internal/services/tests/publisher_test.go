@@ -17,7 +17,9 @@ import (
 func setupPublisherService(t *testing.T) (*services.PublisherService, *mocks.MockPublisherRepositoryInterface) {
 	ctrl := gomock.NewController(t)
 	mockRepo := mocks.NewMockPublisherRepositoryInterface(ctrl)
-	svc := services.NewPublisherService(mockRepo)
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	svc := services.NewPublisherService(mockRepo, mockAudit)
 	return svc, mockRepo
 }
 
@@ -134,7 +136,7 @@ func TestPublisherService_Update_Success(t *testing.T) {
 	svc, mockRepo := setupPublisherService(t)
 
 	id := uuid.New()
-	existing := &models.Publisher{ID: id, Name: "Старое", Address: "Старый адрес, ул. Прежняя, 5"}
+	existing := &models.Publisher{ID: id, Version: 1, Name: "Старое", Address: "Старый адрес, ул. Прежняя, 5"}
 	input := dto.PublisherInput{
 		Name:    "Новое",
 		Address: "Новый адрес, ул. Свежая, 10",
@@ -143,7 +145,7 @@ func TestPublisherService_Update_Success(t *testing.T) {
 	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil)
 	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 
-	result, err := svc.Update(context.Background(), id, input)
+	result, err := svc.Update(context.Background(), id, 1, input)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Новое", result.Name)
@@ -156,7 +158,7 @@ func TestPublisherService_Update_NotFound(t *testing.T) {
 	id := uuid.New()
 	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(nil, assert.AnError)
 
-	result, err := svc.Update(context.Background(), id, dto.PublisherInput{})
+	result, err := svc.Update(context.Background(), id, 1, dto.PublisherInput{})
 
 	assert.Nil(t, result)
 	var appErr *apperrors.AppError
@@ -168,13 +170,13 @@ func TestPublisherService_Update_RepoError(t *testing.T) {
 	svc, mockRepo := setupPublisherService(t)
 
 	id := uuid.New()
-	existing := &models.Publisher{ID: id, Name: "Эксмо", Address: "Москва, ул. Правды, 1"}
+	existing := &models.Publisher{ID: id, Version: 1, Name: "Эксмо", Address: "Москва, ул. Правды, 1"}
 	input := dto.PublisherInput{Name: "Новое", Address: "Новый адрес, ул. Свежая, 10"}
 
 	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil)
 	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
 
-	result, err := svc.Update(context.Background(), id, input)
+	result, err := svc.Update(context.Background(), id, 1, input)
 
 	assert.Nil(t, result)
 	var appErr *apperrors.AppError
@@ -188,20 +190,35 @@ func TestPublisherService_Delete_Success(t *testing.T) {
 	svc, mockRepo := setupPublisherService(t)
 
 	id := uuid.New()
-	mockRepo.EXPECT().Delete(gomock.Any(), id).Return(nil)
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Publisher{ID: id, Version: 1}, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), id, int64(1)).Return(nil)
 
-	err := svc.Delete(context.Background(), id)
+	err := svc.Delete(context.Background(), id, 1)
 
 	assert.NoError(t, err)
 }
 
+func TestPublisherService_Delete_NotFound(t *testing.T) {
+	svc, mockRepo := setupPublisherService(t)
+
+	id := uuid.New()
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(nil, assert.AnError)
+
+	err := svc.Delete(context.Background(), id, 1)
+
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 404, appErr.Code)
+}
+
 func TestPublisherService_Delete_RepoError(t *testing.T) {
 	svc, mockRepo := setupPublisherService(t)
 
 	id := uuid.New()
-	mockRepo.EXPECT().Delete(gomock.Any(), id).Return(assert.AnError)
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Publisher{ID: id, Version: 1}, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), id, int64(1)).Return(assert.AnError)
 
-	err := svc.Delete(context.Background(), id)
+	err := svc.Delete(context.Background(), id, 1)
 
 	var appErr *apperrors.AppError
 	assert.ErrorAs(t, err, &appErr)
@@ -0,0 +1,103 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupRecommendationService(t *testing.T) (*services.RecommendationService, *mocks.MockRecommendationRepositoryInterface, *mocks.MockBookRepositoryInterface) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockRecommendationRepositoryInterface(ctrl)
+	mockBooks := mocks.NewMockBookRepositoryInterface(ctrl)
+	return services.NewRecommendationService(mockRepo, mockBooks, basePrices(ctrl)), mockRepo, mockBooks
+}
+
+func TestRecommendationService_GetRelated_DefaultLimit(t *testing.T) {
+	svc, mockRepo, mockBooks := setupRecommendationService(t)
+	bookID := uuid.New()
+	related := []models.Book{{ID: uuid.New()}, {ID: uuid.New()}}
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(&models.Book{ID: bookID}, nil)
+	mockRepo.EXPECT().GetRelated(gomock.Any(), bookID, 10).Return(related, nil)
+
+	books, err := svc.GetRelated(context.Background(), bookID, dto.RecommendationQuery{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, related, books)
+}
+
+func TestRecommendationService_GetRelated_BookNotFound(t *testing.T) {
+	svc, _, mockBooks := setupRecommendationService(t)
+	bookID := uuid.New()
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(nil, errors.New("not found"))
+
+	_, err := svc.GetRelated(context.Background(), bookID, dto.RecommendationQuery{Limit: 5})
+
+	assertAppErrorCode(t, err, 404)
+}
+
+func TestRecommendationService_GetRelated_NegativeLimit(t *testing.T) {
+	svc, _, _ := setupRecommendationService(t)
+
+	_, err := svc.GetRelated(context.Background(), uuid.New(), dto.RecommendationQuery{Limit: -1})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestRecommendationService_GetForUser_Full(t *testing.T) {
+	svc, mockRepo, _ := setupRecommendationService(t)
+	userID := uuid.New()
+	recommended := []models.Book{{ID: uuid.New()}, {ID: uuid.New()}}
+
+	mockRepo.EXPECT().GetForUser(gomock.Any(), userID, 2).Return(recommended, nil)
+
+	books, err := svc.GetForUser(context.Background(), userID, dto.RecommendationQuery{Limit: 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, recommended, books)
+}
+
+func TestRecommendationService_GetForUser_FillsWithBestsellers(t *testing.T) {
+	svc, mockRepo, _ := setupRecommendationService(t)
+	userID := uuid.New()
+	first, second, third := models.Book{ID: uuid.New()}, models.Book{ID: uuid.New()}, models.Book{ID: uuid.New()}
+
+	mockRepo.EXPECT().GetForUser(gomock.Any(), userID, 3).Return([]models.Book{first}, nil)
+	mockRepo.EXPECT().GetBestsellers(gomock.Any(), userID, 3).Return([]models.Book{first, second, third}, nil)
+
+	books, err := svc.GetForUser(context.Background(), userID, dto.RecommendationQuery{Limit: 3})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Book{first, second, third}, books)
+}
+
+func TestRecommendationService_GetForUser_LimitCapped(t *testing.T) {
+	svc, mockRepo, _ := setupRecommendationService(t)
+	userID := uuid.New()
+
+	mockRepo.EXPECT().GetForUser(gomock.Any(), userID, 50).Return([]models.Book{}, nil)
+	mockRepo.EXPECT().GetBestsellers(gomock.Any(), userID, 50).Return([]models.Book{}, nil)
+
+	books, err := svc.GetForUser(context.Background(), userID, dto.RecommendationQuery{Limit: 1000})
+
+	assert.NoError(t, err)
+	assert.Empty(t, books)
+}
+
+func TestRecommendationService_Refresh(t *testing.T) {
+	svc, mockRepo, _ := setupRecommendationService(t)
+
+	mockRepo.EXPECT().Rebuild(gomock.Any(), 20).Return(nil)
+
+	assert.NoError(t, svc.Refresh(context.Background()))
+}
@@ -0,0 +1,194 @@
+package services_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/actor"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupReviewService(t *testing.T) (*services.ReviewService, *mocks.MockReviewRepositoryInterface, *mocks.MockBookRepositoryInterface) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockReviewRepositoryInterface(ctrl)
+	mockBooks := mocks.NewMockBookRepositoryInterface(ctrl)
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	svc := services.NewReviewService(mockRepo, mockBooks, mockAudit)
+	return svc, mockRepo, mockBooks
+}
+
+func TestReviewService_Create_Success(t *testing.T) {
+	svc, mockRepo, mockBooks := setupReviewService(t)
+	userID, bookID := uuid.New(), uuid.New()
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(&models.Book{ID: bookID}, nil)
+	mockRepo.EXPECT().HasDeliveredPurchase(gomock.Any(), userID, bookID).Return(true, nil)
+	mockRepo.EXPECT().ExistsForUser(gomock.Any(), bookID, userID).Return(false, nil)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	review, err := svc.Create(context.Background(), userID, bookID, dto.ReviewInput{Rating: 5, Text: "  Отличная книга  "})
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReviewStatusPending, review.Status)
+	assert.Equal(t, "Отличная книга", review.Text)
+}
+
+func TestReviewService_Create_InvalidRating(t *testing.T) {
+	svc, _, _ := setupReviewService(t)
+
+	for _, rating := range []int{0, 6} {
+		_, err := svc.Create(context.Background(), uuid.New(), uuid.New(), dto.ReviewInput{Rating: rating})
+
+		assertAppErrorCode(t, err, 400)
+	}
+}
+
+func TestReviewService_Create_WithoutDeliveredPurchase(t *testing.T) {
+	svc, mockRepo, mockBooks := setupReviewService(t)
+	userID, bookID := uuid.New(), uuid.New()
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(&models.Book{ID: bookID}, nil)
+	mockRepo.EXPECT().HasDeliveredPurchase(gomock.Any(), userID, bookID).Return(false, nil)
+
+	_, err := svc.Create(context.Background(), userID, bookID, dto.ReviewInput{Rating: 4})
+
+	assertAppErrorCode(t, err, 403)
+}
+
+func TestReviewService_Create_Duplicate(t *testing.T) {
+	svc, mockRepo, mockBooks := setupReviewService(t)
+	userID, bookID := uuid.New(), uuid.New()
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(&models.Book{ID: bookID}, nil)
+	mockRepo.EXPECT().HasDeliveredPurchase(gomock.Any(), userID, bookID).Return(true, nil)
+	mockRepo.EXPECT().ExistsForUser(gomock.Any(), bookID, userID).Return(true, nil)
+
+	_, err := svc.Create(context.Background(), userID, bookID, dto.ReviewInput{Rating: 4})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestReviewService_Create_ConcurrentDuplicate(t *testing.T) {
+	svc, mockRepo, mockBooks := setupReviewService(t)
+	userID, bookID := uuid.New(), uuid.New()
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(&models.Book{ID: bookID}, nil)
+	mockRepo.EXPECT().HasDeliveredPurchase(gomock.Any(), userID, bookID).Return(true, nil)
+	mockRepo.EXPECT().ExistsForUser(gomock.Any(), bookID, userID).Return(false, nil)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(fmt.Errorf("failed to create review: %w", apperrors.ErrDuplicate))
+
+	_, err := svc.Create(context.Background(), userID, bookID, dto.ReviewInput{Rating: 4})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestReviewService_GetForBook_OnlyApproved(t *testing.T) {
+	svc, mockRepo, mockBooks := setupReviewService(t)
+	bookID := uuid.New()
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(&models.Book{ID: bookID}, nil)
+	mockRepo.EXPECT().List(gomock.Any(), &bookID, nil, models.ReviewStatusApproved, dto.ReviewQuery{Sort: "rating_desc", Limit: 100}).
+		Return([]models.Review{{Rating: 5}}, 1, nil)
+
+	page, err := svc.GetForBook(context.Background(), bookID, dto.ReviewQuery{Sort: "rating_desc", Limit: 500})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, page.Total)
+	assert.Equal(t, 100, page.Limit)
+}
+
+func TestReviewService_GetForBook_UnknownSort(t *testing.T) {
+	svc, _, mockBooks := setupReviewService(t)
+	bookID := uuid.New()
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(&models.Book{ID: bookID}, nil)
+
+	_, err := svc.GetForBook(context.Background(), bookID, dto.ReviewQuery{Sort: "helpful"})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestReviewService_Update_ReturnsToModeration(t *testing.T) {
+	svc, mockRepo, _ := setupReviewService(t)
+	userID, id := uuid.New(), uuid.New()
+	reason := "spoilers"
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Review{
+		ID: id, UserID: userID, Rating: 2, Status: models.ReviewStatusRejected, ModerationReason: &reason, Version: 3,
+	}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	review, err := svc.Update(context.Background(), userID, id, 3, dto.ReviewInput{Rating: 3, Text: "Без спойлеров"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReviewStatusPending, review.Status)
+	assert.Nil(t, review.ModerationReason)
+}
+
+func TestReviewService_Update_OtherUsersReview(t *testing.T) {
+	svc, mockRepo, _ := setupReviewService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Review{ID: id, UserID: uuid.New(), Version: 1}, nil)
+
+	_, err := svc.Update(context.Background(), uuid.New(), id, 1, dto.ReviewInput{Rating: 3})
+
+	assertAppErrorCode(t, err, 404)
+}
+
+func TestReviewService_Delete_StaleVersion(t *testing.T) {
+	svc, mockRepo, _ := setupReviewService(t)
+	userID, id := uuid.New(), uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Review{ID: id, UserID: userID, Version: 2}, nil)
+
+	err := svc.Delete(context.Background(), userID, id, 1)
+
+	assertAppErrorCode(t, err, 412)
+}
+
+func TestReviewService_Moderate_Approve(t *testing.T) {
+	svc, mockRepo, _ := setupReviewService(t)
+	id, moderatorID := uuid.New(), uuid.New()
+	ctx := actor.WithActor(context.Background(), actor.Actor{UserID: moderatorID, Role: "support"})
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Review{ID: id, Status: models.ReviewStatusPending, Version: 1}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	review, err := svc.Moderate(ctx, id, 1, dto.ModerationInput{Action: services.ModerationApprove})
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReviewStatusApproved, review.Status)
+	if assert.NotNil(t, review.ModeratedBy) {
+		assert.Equal(t, moderatorID, *review.ModeratedBy)
+	}
+	assert.NotNil(t, review.ModeratedAt)
+}
+
+func TestReviewService_Moderate_RejectRequiresReason(t *testing.T) {
+	svc, _, _ := setupReviewService(t)
+
+	_, err := svc.Moderate(context.Background(), uuid.New(), 1, dto.ModerationInput{Action: services.ModerationReject, Reason: strPtr(" ")})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestReviewService_Moderate_HidePendingReview(t *testing.T) {
+	svc, mockRepo, _ := setupReviewService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Review{ID: id, Status: models.ReviewStatusPending, Version: 1}, nil)
+
+	_, err := svc.Moderate(context.Background(), id, 1, dto.ModerationInput{Action: services.ModerationHide})
+
+	assertAppErrorCode(t, err, 409)
+}
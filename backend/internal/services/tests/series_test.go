@@ -0,0 +1,118 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupSeriesService(t *testing.T) (*services.SeriesService, *mocks.MockSeriesRepositoryInterface) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockSeriesRepositoryInterface(ctrl)
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	svc := services.NewSeriesService(mockRepo, mockAudit)
+	return svc, mockRepo
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+func TestSeriesService_Create_RequiresName(t *testing.T) {
+	svc, _ := setupSeriesService(t)
+
+	result, err := svc.Create(context.Background(), dto.SeriesInput{Name: "  "})
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestSeriesService_Delete_WithBooks(t *testing.T) {
+	svc, mockRepo := setupSeriesService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Series{ID: id, Version: 1, Books: []*models.Book{{ID: uuid.New()}}}, nil)
+
+	err := svc.Delete(context.Background(), id, 1)
+
+	assertAppErrorCode(t, err, 409)
+}
+
+// --- Book series info ---
+
+func TestBookService_GetDetails_SeriesNeighbours(t *testing.T) {
+	svc, mockRepo, mockSeries := setupBookServiceWithSeries(t)
+	seriesID := uuid.New()
+	first := &models.Book{ID: uuid.New(), Title: "Первая", SeriesID: &seriesID, SeriesPosition: floatPtr(1)}
+	novella := &models.Book{ID: uuid.New(), Title: "Новелла", SeriesID: &seriesID, SeriesPosition: floatPtr(1.5)}
+	second := &models.Book{ID: uuid.New(), Title: "Вторая", SeriesID: &seriesID, SeriesPosition: floatPtr(2)}
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), novella.ID).Return(novella, nil)
+	mockSeries.EXPECT().GetByID(gomock.Any(), seriesID).Return(&models.Series{
+		ID:    seriesID,
+		Name:  "Цикл",
+		Books: []*models.Book{first, novella, second},
+	}, nil)
+
+	details, err := svc.GetDetails(context.Background(), novella.ID)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, details.Series) {
+		assert.Equal(t, "Цикл", details.Series.Name)
+		assert.Equal(t, 1.5, *details.Series.Position)
+		assert.Equal(t, first.ID, details.Series.Previous.ID)
+		assert.Equal(t, second.ID, details.Series.Next.ID)
+	}
+}
+
+func TestBookService_GetDetails_NoSeries(t *testing.T) {
+	svc, mockRepo, _ := setupBookServiceWithSeries(t)
+	book := &models.Book{ID: uuid.New(), Title: "Одиночка"}
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), book.ID).Return(book, nil)
+
+	details, err := svc.GetDetails(context.Background(), book.ID)
+
+	assert.NoError(t, err)
+	assert.Nil(t, details.Series)
+}
+
+func TestBookService_Create_PositionWithoutSeries(t *testing.T) {
+	svc, _, _ := setupBookServiceWithSeries(t)
+
+	input := dto.BookInput{
+		Title:          "Книга",
+		Contributors:   []dto.ContributorInput{{AuthorID: uuid.New()}},
+		SeriesPosition: floatPtr(2.5),
+	}
+	result, err := svc.Create(context.Background(), input)
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestBookService_Create_UnknownSeries(t *testing.T) {
+	svc, _, mockSeries := setupBookServiceWithSeries(t)
+	seriesID := uuid.New()
+
+	mockSeries.EXPECT().GetByID(gomock.Any(), seriesID).Return(nil, assert.AnError)
+
+	input := dto.BookInput{
+		Title:          "Книга",
+		Contributors:   []dto.ContributorInput{{AuthorID: uuid.New()}},
+		SeriesID:       &seriesID,
+		SeriesPosition: floatPtr(2.5),
+	}
+	result, err := svc.Create(context.Background(), input)
+
+	assert.Nil(t, result)
+	assertAppErrorCode(t, err, 400)
+}
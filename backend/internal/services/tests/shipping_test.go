@@ -0,0 +1,292 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/tax"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type shippingMocks struct {
+	repo  *mocks.MockShippingRepositoryInterface
+	carts *mocks.MockCartServiceInterface
+}
+
+func setupShippingService(t *testing.T) (*services.ShippingService, shippingMocks) {
+	ctrl := gomock.NewController(t)
+	m := shippingMocks{
+		repo:  mocks.NewMockShippingRepositoryInterface(ctrl),
+		carts: mocks.NewMockCartServiceInterface(ctrl),
+	}
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	return services.NewShippingService(m.repo, m.carts, tax.DefaultRates, mockAudit), m
+}
+
+// --- QuoteCart ---
+
+func TestShippingService_QuoteCart(t *testing.T) {
+	svc, m := setupShippingService(t)
+	moscow := models.ShippingZone{ID: uuid.New(), Name: "Moscow", PostalPrefixes: []string{"10"}}
+	courier := models.ShippingMethod{ID: uuid.New(), Name: "Courier", Kind: models.ShippingKindCourier, Rates: []models.ShippingRate{
+		{ZoneID: &moscow.ID, Cost: 30000},
+	}, FreeOver: amountPtr(300000)}
+	post := models.ShippingMethod{ID: uuid.New(), Name: "Post", Kind: models.ShippingKindPost, Rates: []models.ShippingRate{
+		{MaxWeight: intPtr(1000), Cost: 20000},
+	}}
+	pickup := models.ShippingMethod{ID: uuid.New(), Name: "Pickup point", Kind: models.ShippingKindPickupPoint, Rates: []models.ShippingRate{
+		{MaxWeight: intPtr(500), Cost: 10000},
+	}}
+	weight := 400
+	cart := &dto.Cart{
+		Items: []dto.CartLine{
+			{Edition: &models.Edition{Format: models.EditionFormatPaperback, Weight: &weight}, Quantity: 2},
+			{Edition: &models.Edition{Format: models.EditionFormatEbook}, Quantity: 1},
+		},
+		Currency: "USD", Total: 3000, Rate: money.Rate(12_500),
+	}
+
+	m.repo.EXPECT().GetMethods(gomock.Any(), dto.ShippingMethodFilter{Active: true}).Return([]models.ShippingMethod{courier, pickup, post}, nil)
+	m.repo.EXPECT().GetZones(gomock.Any()).Return([]models.ShippingZone{moscow}, nil)
+
+	quotes, err := svc.QuoteCart(context.Background(), cart, "101 000")
+
+	assert.NoError(t, err)
+	if assert.Len(t, quotes, 2) {
+		assert.Equal(t, post.ID, quotes[0].MethodID)
+		assert.Equal(t, money.Amount(250), quotes[0].Cost)
+		assert.Equal(t, 800, quotes[0].Weight)
+		assert.Equal(t, "USD", quotes[0].Currency)
+		assert.Equal(t, money.Amount(45), quotes[0].Tax)
+		assert.Equal(t, courier.ID, quotes[1].MethodID)
+		assert.Equal(t, money.Amount(375), quotes[1].Cost)
+		assert.Equal(t, money.Amount(3750), *quotes[1].FreeOver)
+	}
+}
+
+func TestShippingService_QuoteCart_FreeOverThreshold(t *testing.T) {
+	svc, m := setupShippingService(t)
+	courier := models.ShippingMethod{ID: uuid.New(), Name: "Courier", Rates: []models.ShippingRate{{Cost: 30000}}, FreeOver: amountPtr(300000)}
+	cart := &dto.Cart{Items: []dto.CartLine{{Edition: &models.Edition{}, Quantity: 1}}, Currency: "RUB", Total: 300000, Rate: money.One}
+
+	m.repo.EXPECT().GetMethods(gomock.Any(), gomock.Any()).Return([]models.ShippingMethod{courier}, nil)
+	m.repo.EXPECT().GetZones(gomock.Any()).Return(nil, nil)
+
+	quotes, err := svc.QuoteCart(context.Background(), cart, "690000")
+
+	assert.NoError(t, err)
+	if assert.Len(t, quotes, 1) {
+		assert.Equal(t, money.Amount(0), quotes[0].Cost)
+		assert.Equal(t, money.Amount(0), quotes[0].Tax)
+	}
+}
+
+func TestShippingService_QuoteCart_PostalCodeRequired(t *testing.T) {
+	svc, _ := setupShippingService(t)
+
+	_, err := svc.QuoteCart(context.Background(), &dto.Cart{}, "  ")
+
+	assertAppErrorCode(t, err, 400)
+}
+
+// --- CreateMethod ---
+
+func TestShippingService_CreateMethod(t *testing.T) {
+	svc, m := setupShippingService(t)
+	zoneID := uuid.New()
+
+	m.repo.EXPECT().GetZoneByID(gomock.Any(), zoneID).Return(&models.ShippingZone{ID: zoneID}, nil)
+	m.repo.EXPECT().CreateMethod(gomock.Any(), gomock.Any()).Return(nil)
+
+	method, err := svc.CreateMethod(context.Background(), dto.ShippingMethodInput{
+		Name: " Courier ", Kind: "Courier",
+		Rates: []dto.ShippingRateInput{
+			{ZoneID: &zoneID, MaxWeight: intPtr(1000), Cost: 25000},
+			{ZoneID: &zoneID, Cost: 40000},
+			{Cost: 50000},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Courier", method.Name)
+	assert.Equal(t, models.ShippingKindCourier, method.Kind)
+	assert.Len(t, method.Rates, 3)
+	assert.True(t, method.Active)
+}
+
+func TestShippingService_CreateMethod_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input dto.ShippingMethodInput
+	}{
+		{"no name", dto.ShippingMethodInput{Kind: "post", Rates: []dto.ShippingRateInput{{Cost: 100}}}},
+		{"unknown kind", dto.ShippingMethodInput{Name: "Drone", Kind: "drone", Rates: []dto.ShippingRateInput{{Cost: 100}}}},
+		{"no rates", dto.ShippingMethodInput{Name: "Post", Kind: "post"}},
+		{"negative cost", dto.ShippingMethodInput{Name: "Post", Kind: "post", Rates: []dto.ShippingRateInput{{Cost: -1}}}},
+		{"zero max weight", dto.ShippingMethodInput{Name: "Post", Kind: "post", Rates: []dto.ShippingRateInput{{MaxWeight: intPtr(0), Cost: 100}}}},
+		{"repeated band", dto.ShippingMethodInput{Name: "Post", Kind: "post", Rates: []dto.ShippingRateInput{{Cost: 100}, {Cost: 200}}}},
+		{"negative free_over", dto.ShippingMethodInput{Name: "Post", Kind: "post", Rates: []dto.ShippingRateInput{{Cost: 100}}, FreeOver: amountPtr(-1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := setupShippingService(t)
+
+			_, err := svc.CreateMethod(context.Background(), tt.input)
+
+			assertAppErrorCode(t, err, 400)
+		})
+	}
+}
+
+func TestShippingService_CreateMethod_UnknownZone(t *testing.T) {
+	svc, m := setupShippingService(t)
+	zoneID := uuid.New()
+
+	m.repo.EXPECT().GetZoneByID(gomock.Any(), zoneID).Return(nil, errors.New("not found"))
+
+	_, err := svc.CreateMethod(context.Background(), dto.ShippingMethodInput{
+		Name: "Post", Kind: "post", Rates: []dto.ShippingRateInput{{ZoneID: &zoneID, Cost: 100}},
+	})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+// --- Zones ---
+
+func TestShippingService_CreateZone_NormalizesPrefixes(t *testing.T) {
+	svc, m := setupShippingService(t)
+
+	m.repo.EXPECT().CreateZone(gomock.Any(), gomock.Any()).Return(nil)
+
+	zone, err := svc.CreateZone(context.Background(), dto.ShippingZoneInput{Name: "Moscow", PostalPrefixes: []string{"10", " 1 1", "10"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10", "11"}, zone.PostalPrefixes)
+}
+
+func TestShippingService_CreateZone_InvalidPrefix(t *testing.T) {
+	svc, _ := setupShippingService(t)
+
+	_, err := svc.CreateZone(context.Background(), dto.ShippingZoneInput{Name: "Moscow", PostalPrefixes: []string{"10-"}})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestShippingService_DeleteZone_InUse(t *testing.T) {
+	svc, m := setupShippingService(t)
+	zone := &models.ShippingZone{ID: uuid.New(), Version: 2}
+
+	m.repo.EXPECT().GetZoneByID(gomock.Any(), zone.ID).Return(zone, nil)
+	m.repo.EXPECT().GetMethods(gomock.Any(), dto.ShippingMethodFilter{}).Return([]models.ShippingMethod{
+		{Name: "Courier", Rates: []models.ShippingRate{{ZoneID: &zone.ID, Cost: 100}}},
+	}, nil)
+
+	err := svc.DeleteZone(context.Background(), zone.ID, 2)
+
+	assertAppErrorCode(t, err, 409)
+}
+
+// --- Pickup points ---
+
+func TestShippingService_CreatePickupPoint(t *testing.T) {
+	svc, m := setupShippingService(t)
+
+	m.repo.EXPECT().CreatePickupPoint(gomock.Any(), gomock.Any()).Return(nil)
+
+	point, err := svc.CreatePickupPoint(context.Background(), dto.PickupPointInput{
+		Name: " Tverskaya shop ", Address: "Moscow, Tverskaya 1", PostalCode: "125 009", Hours: "Mon–Sun 10:00–22:00",
+		Latitude: floatPtr(55.7575), Longitude: floatPtr(37.6136),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Tverskaya shop", point.Name)
+	assert.Equal(t, "125009", point.PostalCode)
+	assert.Equal(t, 55.7575, point.Latitude)
+	assert.True(t, point.Active)
+}
+
+func TestShippingService_CreatePickupPoint_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input dto.PickupPointInput
+	}{
+		{"no name", dto.PickupPointInput{Address: "Moscow", Latitude: floatPtr(55), Longitude: floatPtr(37)}},
+		{"no address", dto.PickupPointInput{Name: "Shop", Latitude: floatPtr(55), Longitude: floatPtr(37)}},
+		{"no coordinates", dto.PickupPointInput{Name: "Shop", Address: "Moscow"}},
+		{"latitude out of range", dto.PickupPointInput{Name: "Shop", Address: "Moscow", Latitude: floatPtr(91), Longitude: floatPtr(37)}},
+		{"longitude out of range", dto.PickupPointInput{Name: "Shop", Address: "Moscow", Latitude: floatPtr(55), Longitude: floatPtr(-181)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := setupShippingService(t)
+
+			_, err := svc.CreatePickupPoint(context.Background(), tt.input)
+
+			assertAppErrorCode(t, err, 400)
+		})
+	}
+}
+
+func TestShippingService_GetPickupPoints_NearestFirst(t *testing.T) {
+	svc, m := setupShippingService(t)
+	points := []models.PickupPoint{
+		{Name: "Kazan", Latitude: 55.7887, Longitude: 49.1221},
+		{Name: "Nevsky", Latitude: 59.9343, Longitude: 30.3351},
+		{Name: "Tverskaya", Latitude: 55.7575, Longitude: 37.6136},
+	}
+
+	m.repo.EXPECT().GetPickupPoints(gomock.Any(), true).Return(points, nil)
+
+	// Near Saint Petersburg's Moscow railway station.
+	sorted, err := svc.GetPickupPoints(context.Background(), dto.PickupPointQuery{Latitude: floatPtr(59.9311), Longitude: floatPtr(30.3609)})
+
+	assert.NoError(t, err)
+	names := []string{}
+	for _, point := range sorted {
+		names = append(names, point.Name)
+	}
+	assert.Equal(t, []string{"Nevsky", "Tverskaya", "Kazan"}, names)
+}
+
+func TestShippingService_GetPickupPoints_LatitudeWithoutLongitude(t *testing.T) {
+	svc, _ := setupShippingService(t)
+
+	_, err := svc.GetPickupPoints(context.Background(), dto.PickupPointQuery{Latitude: floatPtr(55.75)})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestShippingService_DeletePickupPoint_HasOrders(t *testing.T) {
+	svc, m := setupShippingService(t)
+	point := &models.PickupPoint{ID: uuid.New(), Version: 3}
+
+	m.repo.EXPECT().GetPickupPointByID(gomock.Any(), point.ID).Return(point, nil)
+	m.repo.EXPECT().DeletePickupPoint(gomock.Any(), point.ID, int64(3)).Return(fmt.Errorf("failed to delete pickup point: %w", apperrors.ErrForeignKey))
+
+	err := svc.DeletePickupPoint(context.Background(), point.ID, 3)
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestShippingService_DeletePickupPoint(t *testing.T) {
+	svc, m := setupShippingService(t)
+	point := &models.PickupPoint{ID: uuid.New(), Version: 3}
+
+	m.repo.EXPECT().GetPickupPointByID(gomock.Any(), point.ID).Return(point, nil)
+	m.repo.EXPECT().DeletePickupPoint(gomock.Any(), point.ID, int64(3)).Return(nil)
+
+	err := svc.DeletePickupPoint(context.Background(), point.ID, 3)
+
+	assert.NoError(t, err)
+}
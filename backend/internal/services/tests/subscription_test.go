@@ -0,0 +1,187 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func amountPtr(a money.Amount) *money.Amount { return &a }
+
+func setupSubscriptionService(t *testing.T) (*services.SubscriptionService, *mocks.MockSubscriptionRepositoryInterface, *mocks.MockBookRepositoryInterface) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockSubscriptionRepositoryInterface(ctrl)
+	mockBooks := mocks.NewMockBookRepositoryInterface(ctrl)
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	svc := services.NewSubscriptionService(mockRepo, mockBooks, "https://shop.example/unsubscribe/", mockAudit)
+	return svc, mockRepo, mockBooks
+}
+
+func TestSubscriptionService_Subscribe_PriceBelowNeedsThreshold(t *testing.T) {
+	svc, _, _ := setupSubscriptionService(t)
+
+	_, err := svc.Subscribe(context.Background(), uuid.New(), uuid.New(), dto.SubscriptionInput{Kind: models.SubscriptionPriceBelow})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+func TestSubscriptionService_Subscribe_DisarmedWhileInStock(t *testing.T) {
+	svc, mockRepo, mockBooks := setupSubscriptionService(t)
+	userID, bookID := uuid.New(), uuid.New()
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(&models.Book{ID: bookID}, nil)
+	mockRepo.EXPECT().Exists(gomock.Any(), userID, bookID, models.SubscriptionBackInStock).Return(false, nil)
+	mockRepo.EXPECT().CountByUser(gomock.Any(), userID).Return(0, nil)
+	mockRepo.EXPECT().GetBookStock(gomock.Any(), bookID).Return(&dto.BookStock{InStock: 4, MinPrice: amountPtr(500)}, nil)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	subscription, err := svc.Subscribe(context.Background(), userID, bookID, dto.SubscriptionInput{Kind: models.SubscriptionBackInStock})
+
+	assert.NoError(t, err)
+	assert.False(t, subscription.Armed)
+	assert.NotEmpty(t, subscription.UnsubscribeToken)
+}
+
+func TestSubscriptionService_Subscribe_Duplicate(t *testing.T) {
+	svc, mockRepo, mockBooks := setupSubscriptionService(t)
+	userID, bookID := uuid.New(), uuid.New()
+
+	mockBooks.EXPECT().GetByID(gomock.Any(), bookID).Return(&models.Book{ID: bookID}, nil)
+	mockRepo.EXPECT().Exists(gomock.Any(), userID, bookID, models.SubscriptionPriceBelow).Return(true, nil)
+
+	_, err := svc.Subscribe(context.Background(), userID, bookID, dto.SubscriptionInput{Kind: models.SubscriptionPriceBelow, Threshold: amountPtr(300)})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestSubscriptionService_EditionChanged_FiresArmedAndRearmsOthers(t *testing.T) {
+	svc, mockRepo, _ := setupSubscriptionService(t)
+	bookID := uuid.New()
+	restock := models.StockSubscription{ID: uuid.New(), UserID: uuid.New(), BookID: bookID, Kind: models.SubscriptionBackInStock, UnsubscribeToken: "tok", Armed: true}
+	cheap := models.StockSubscription{ID: uuid.New(), BookID: bookID, Kind: models.SubscriptionPriceBelow, Threshold: amountPtr(300), Armed: false}
+	edition := &models.Edition{ID: uuid.New(), BookID: bookID, Price: 450, Stock: 2}
+
+	mockRepo.EXPECT().GetByBook(gomock.Any(), bookID).Return([]models.StockSubscription{restock, cheap}, nil)
+	mockRepo.EXPECT().GetBookStock(gomock.Any(), bookID).Return(&dto.BookStock{InStock: 2, MinPrice: amountPtr(450)}, nil)
+	mockRepo.EXPECT().Fire(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, subscription *models.StockSubscription, message *models.OutboxMessage) error {
+			assert.Equal(t, restock.ID, subscription.ID)
+			assert.True(t, strings.HasPrefix(message.DedupKey, "subscription:"+restock.ID.String()+":"))
+			var notification dto.Notification
+			assert.NoError(t, json.Unmarshal(message.Payload, &notification))
+			assert.Equal(t, "https://shop.example/unsubscribe/tok", notification.UnsubscribeURL)
+			assert.Equal(t, money.Amount(450), notification.Price)
+			return nil
+		})
+	mockRepo.EXPECT().Rearm(gomock.Any(), []uuid.UUID{cheap.ID}).Return(nil)
+
+	svc.EditionChanged(context.Background(), &models.Edition{ID: edition.ID, BookID: bookID, Price: 450, Stock: 0}, edition)
+}
+
+func TestSubscriptionService_EditionChanged_DisarmedDoesNotFireAgain(t *testing.T) {
+	svc, mockRepo, _ := setupSubscriptionService(t)
+	bookID := uuid.New()
+	subscription := models.StockSubscription{ID: uuid.New(), BookID: bookID, Kind: models.SubscriptionBackInStock, Armed: false}
+
+	mockRepo.EXPECT().GetByBook(gomock.Any(), bookID).Return([]models.StockSubscription{subscription}, nil)
+	mockRepo.EXPECT().GetBookStock(gomock.Any(), bookID).Return(&dto.BookStock{InStock: 1, MinPrice: amountPtr(450)}, nil)
+	mockRepo.EXPECT().Rearm(gomock.Any(), gomock.Len(0)).Return(nil)
+
+	svc.EditionChanged(context.Background(), nil, &models.Edition{ID: uuid.New(), BookID: bookID, Price: 450, Stock: 1})
+}
+
+func TestSubscriptionService_EditionChanged_IgnoresOtherFields(t *testing.T) {
+	svc, _, _ := setupSubscriptionService(t)
+	edition := &models.Edition{ID: uuid.New(), BookID: uuid.New(), Price: 450, Stock: 1}
+	renamed := *edition
+	renamed.Format = models.EditionFormatEbook
+
+	svc.EditionChanged(context.Background(), edition, &renamed)
+}
+
+func TestSubscriptionService_Unsubscribe_OtherUser(t *testing.T) {
+	svc, mockRepo, _ := setupSubscriptionService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.StockSubscription{ID: id, UserID: uuid.New()}, nil)
+
+	err := svc.Unsubscribe(context.Background(), uuid.New(), id)
+
+	assertAppErrorCode(t, err, 404)
+}
+
+func TestSubscriptionService_UnsubscribeByToken(t *testing.T) {
+	svc, mockRepo, _ := setupSubscriptionService(t)
+	id := uuid.New()
+
+	mockRepo.EXPECT().GetByToken(gomock.Any(), "tok").Return(&models.StockSubscription{ID: id}, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), id).Return(nil)
+
+	assert.NoError(t, svc.UnsubscribeByToken(context.Background(), "tok"))
+}
+
+// --- Outbox ---
+
+func TestOutboxNotifier_DedupKeyPerDay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockOutboxRepositoryInterface(ctrl)
+	notifier := services.NewOutboxNotifier(mockRepo)
+	notification := dto.Notification{Kind: dto.NotificationPriceDrop, UserID: uuid.New(), EditionID: uuid.New(), Price: 700}
+
+	var keys []string
+	mockRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, message *models.OutboxMessage) error {
+		keys = append(keys, message.DedupKey)
+		return nil
+	}).Times(2)
+
+	assert.NoError(t, notifier.Notify(context.Background(), notification))
+	assert.NoError(t, notifier.Notify(context.Background(), notification))
+
+	assert.Equal(t, keys[0], keys[1])
+	assert.True(t, strings.HasSuffix(keys[0], time.Now().UTC().Format(time.DateOnly)))
+}
+
+func TestOutboxDispatcher_Dispatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockOutboxRepositoryInterface(ctrl)
+	mockSender := mocks.NewMockNotifierInterface(ctrl)
+	dispatcher := services.NewOutboxDispatcher(mockRepo, mockSender)
+	ok := &models.OutboxMessage{Payload: json.RawMessage(`{"kind":"back_in_stock"}`), Status: models.OutboxStatusPending}
+	failing := &models.OutboxMessage{Payload: json.RawMessage(`{"kind":"price_below"}`), Status: models.OutboxStatusPending, Attempts: 1}
+	lastTry := &models.OutboxMessage{Payload: json.RawMessage(`{"kind":"price_below"}`), Status: models.OutboxStatusPending, Attempts: 4}
+
+	mockRepo.EXPECT().Process(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int, handle func(*models.OutboxMessage)) (int, error) {
+			for _, message := range []*models.OutboxMessage{ok, failing, lastTry} {
+				handle(message)
+			}
+			return 3, nil
+		})
+	mockSender.EXPECT().Notify(gomock.Any(), dto.Notification{Kind: "back_in_stock"}).Return(nil)
+	mockSender.EXPECT().Notify(gomock.Any(), dto.Notification{Kind: "price_below"}).Return(errors.New("smtp down")).Times(2)
+
+	processed, err := dispatcher.Dispatch(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, processed)
+	assert.Equal(t, models.OutboxStatusSent, ok.Status)
+	assert.NotNil(t, ok.SentAt)
+	assert.Equal(t, models.OutboxStatusPending, failing.Status)
+	assert.Equal(t, 2, failing.Attempts)
+	assert.True(t, failing.AvailableAt.After(time.Now()))
+	assert.Equal(t, models.OutboxStatusFailed, lastTry.Status)
+	assert.Equal(t, "smtp down", *lastTry.LastError)
+}
@@ -17,7 +17,9 @@ import (
 func setupUserService(t *testing.T) (*services.UserService, *mocks.MockUserRepositoryInterface) {
     ctrl := gomock.NewController(t)
     mockRepo := mocks.NewMockUserRepositoryInterface(ctrl)
-    svc := services.NewUserService(mockRepo)
+    mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+    mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+    svc := services.NewUserService(mockRepo, mockAudit)
     return svc, mockRepo
 }
 
@@ -176,6 +178,104 @@ func TestUpdate_RepoError(t *testing.T) {
     assert.Equal(t, 500, appErr.Code)
 }
 
+// --- Patch ---
+
+func TestPatch_NullClearsPhone(t *testing.T) {
+    svc, mockRepo := setupUserService(t)
+
+    id := uuid.New()
+    phone := "+31612345678"
+    existing := &models.User{ID: id, Username: "alice", Email: "alice@mail.com", Phone: &phone}
+
+    mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil)
+    mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+    result, err := svc.Patch(context.Background(), id, []byte(`{"phone":null}`))
+
+    assert.NoError(t, err)
+    assert.Nil(t, result.Phone)
+    assert.Equal(t, "alice", result.Username)
+    assert.Equal(t, "alice@mail.com", result.Email)
+}
+
+func TestPatch_AbsentFieldsUnchanged(t *testing.T) {
+    svc, mockRepo := setupUserService(t)
+
+    id := uuid.New()
+    phone := "+31612345678"
+    existing := &models.User{ID: id, Username: "alice", Email: "alice@mail.com", Phone: &phone}
+
+    mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil)
+    mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+    result, err := svc.Patch(context.Background(), id, []byte(`{"email":"new@mail.com"}`))
+
+    assert.NoError(t, err)
+    assert.Equal(t, "new@mail.com", result.Email)
+    assert.Equal(t, &phone, result.Phone)
+}
+
+func TestPatch_InvalidContact(t *testing.T) {
+    svc, mockRepo := setupUserService(t)
+
+    id := uuid.New()
+    existing := &models.User{ID: id, Username: "alice", Email: "alice@mail.com"}
+    mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil).Times(2)
+
+    for _, patch := range []string{`{"email":"not-an-email"}`, `{"phone":"8 999 123"}`} {
+        result, err := svc.Patch(context.Background(), id, []byte(patch))
+
+        assert.Nil(t, result, patch)
+        var appErr *apperrors.AppError
+        assert.ErrorAs(t, err, &appErr, patch)
+        assert.Equal(t, 400, appErr.Code, patch)
+    }
+}
+
+func TestPatch_NullUsername(t *testing.T) {
+    svc, mockRepo := setupUserService(t)
+
+    id := uuid.New()
+    existing := &models.User{ID: id, Username: "alice", Email: "alice@mail.com"}
+
+    mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(existing, nil)
+
+    result, err := svc.Patch(context.Background(), id, []byte(`{"username":null}`))
+
+    assert.Nil(t, result)
+    var appErr *apperrors.AppError
+    assert.ErrorAs(t, err, &appErr)
+    assert.Equal(t, 400, appErr.Code)
+}
+
+func TestPatch_InvalidPatch(t *testing.T) {
+    svc, mockRepo := setupUserService(t)
+
+    id := uuid.New()
+    mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(&models.User{ID: id}, nil)
+
+    result, err := svc.Patch(context.Background(), id, []byte(`{"password_hash":"x"}`))
+
+    assert.Nil(t, result)
+    var appErr *apperrors.AppError
+    assert.ErrorAs(t, err, &appErr)
+    assert.Equal(t, 400, appErr.Code)
+}
+
+func TestPatch_UserNotFound(t *testing.T) {
+    svc, mockRepo := setupUserService(t)
+
+    id := uuid.New()
+    mockRepo.EXPECT().GetByID(gomock.Any(), id).Return(nil, assert.AnError)
+
+    result, err := svc.Patch(context.Background(), id, []byte(`{}`))
+
+    assert.Nil(t, result)
+    var appErr *apperrors.AppError
+    assert.ErrorAs(t, err, &appErr)
+    assert.Equal(t, 404, appErr.Code)
+}
+
 // --- Delete ---
 
 func TestDelete_Success(t *testing.T) {
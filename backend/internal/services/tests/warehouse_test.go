@@ -0,0 +1,171 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type warehouseMocks struct {
+	repo         *mocks.MockWarehouseRepositoryInterface
+	editions     *mocks.MockEditionRepositoryInterface
+	pickupPoints *mocks.MockShippingRepositoryInterface
+	listener     *mocks.MockEditionListenerInterface
+}
+
+func setupWarehouseService(t *testing.T) (*services.WarehouseService, warehouseMocks) {
+	ctrl := gomock.NewController(t)
+	m := warehouseMocks{
+		repo:         mocks.NewMockWarehouseRepositoryInterface(ctrl),
+		editions:     mocks.NewMockEditionRepositoryInterface(ctrl),
+		pickupPoints: mocks.NewMockShippingRepositoryInterface(ctrl),
+		listener:     mocks.NewMockEditionListenerInterface(ctrl),
+	}
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	return services.NewWarehouseService(m.repo, m.editions, m.pickupPoints, m.listener, mockAudit), m
+}
+
+// --- Create ---
+
+func TestWarehouseService_Create_UnknownPickupPoint(t *testing.T) {
+	svc, m := setupWarehouseService(t)
+	pointID := uuid.New()
+
+	m.pickupPoints.EXPECT().GetPickupPointByID(gomock.Any(), pointID).Return(nil, errors.New("not found"))
+
+	_, err := svc.Create(context.Background(), dto.WarehouseInput{Name: "Tverskaya shop", PickupPointID: &pointID})
+
+	assertAppErrorCode(t, err, 400)
+}
+
+// --- SetStock ---
+
+func TestWarehouseService_SetStock_TellsListener(t *testing.T) {
+	svc, m := setupWarehouseService(t)
+	warehouseID, editionID := uuid.New(), uuid.New()
+	before := &models.Edition{ID: editionID, Stock: 2, Version: 3}
+	after := &models.Edition{ID: editionID, Stock: 7, Version: 4}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), warehouseID).Return(&models.Warehouse{ID: warehouseID, Active: true}, nil)
+	gomock.InOrder(
+		m.editions.EXPECT().GetByID(gomock.Any(), editionID).Return(before, nil),
+		m.editions.EXPECT().GetByID(gomock.Any(), editionID).Return(after, nil),
+	)
+	m.repo.EXPECT().SetStock(gomock.Any(), &models.EditionStock{EditionID: editionID, WarehouseID: warehouseID, Quantity: 5}).Return(nil)
+	m.listener.EXPECT().EditionChanged(gomock.Any(), before, after)
+
+	edition, err := svc.SetStock(context.Background(), warehouseID, editionID, dto.StockInput{Quantity: intPtr(5)})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 7, edition.Stock)
+}
+
+func TestWarehouseService_SetStock_InvalidQuantity(t *testing.T) {
+	svc, _ := setupWarehouseService(t)
+
+	_, err := svc.SetStock(context.Background(), uuid.New(), uuid.New(), dto.StockInput{})
+	assertAppErrorCode(t, err, 400)
+
+	_, err = svc.SetStock(context.Background(), uuid.New(), uuid.New(), dto.StockInput{Quantity: intPtr(-1)})
+	assertAppErrorCode(t, err, 400)
+}
+
+// --- Transfer ---
+
+func TestWarehouseService_Transfer_Success(t *testing.T) {
+	svc, m := setupWarehouseService(t)
+	from, to, editionID := uuid.New(), uuid.New(), uuid.New()
+	edition := &models.Edition{ID: editionID, Stock: 5}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), from).Return(&models.Warehouse{ID: from}, nil)
+	m.repo.EXPECT().GetByID(gomock.Any(), to).Return(&models.Warehouse{ID: to}, nil)
+	m.editions.EXPECT().GetByID(gomock.Any(), editionID).Return(edition, nil).Times(2)
+	m.repo.EXPECT().Transfer(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, transfer *models.StockTransfer) error {
+		assert.Equal(t, []models.StockTransferItem{{EditionID: editionID, Quantity: 2}}, transfer.Items)
+		assert.Equal(t, "restock", *transfer.Note)
+		return nil
+	})
+
+	transfer, err := svc.Transfer(context.Background(), dto.StockTransferInput{
+		FromWarehouseID: from, ToWarehouseID: to, Note: strPtr("  restock "),
+		Items: []dto.StockTransferItemInput{{EditionID: editionID, Quantity: 2}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, from, transfer.FromWarehouseID)
+}
+
+func TestWarehouseService_Transfer_InvalidInput(t *testing.T) {
+	svc, m := setupWarehouseService(t)
+	from, to, editionID := uuid.New(), uuid.New(), uuid.New()
+	m.repo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&models.Warehouse{}, nil).AnyTimes()
+	m.editions.EXPECT().GetByID(gomock.Any(), editionID).Return(&models.Edition{ID: editionID}, nil).AnyTimes()
+
+	cases := map[string]dto.StockTransferInput{
+		"same warehouse":    {FromWarehouseID: from, ToWarehouseID: from, Items: []dto.StockTransferItemInput{{EditionID: editionID, Quantity: 1}}},
+		"no items":          {FromWarehouseID: from, ToWarehouseID: to},
+		"zero quantity":     {FromWarehouseID: from, ToWarehouseID: to, Items: []dto.StockTransferItemInput{{EditionID: editionID}}},
+		"repeated editions": {FromWarehouseID: from, ToWarehouseID: to, Items: []dto.StockTransferItemInput{{EditionID: editionID, Quantity: 1}, {EditionID: editionID, Quantity: 2}}},
+	}
+	for name, input := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.Transfer(context.Background(), input)
+			assertAppErrorCode(t, err, 400)
+		})
+	}
+}
+
+func TestWarehouseService_Transfer_OutOfStock(t *testing.T) {
+	svc, m := setupWarehouseService(t)
+	from, to, editionID := uuid.New(), uuid.New(), uuid.New()
+
+	m.repo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&models.Warehouse{}, nil).Times(2)
+	m.editions.EXPECT().GetByID(gomock.Any(), editionID).Return(&models.Edition{ID: editionID}, nil)
+	m.repo.EXPECT().Transfer(gomock.Any(), gomock.Any()).Return(apperrors.ErrOutOfStock)
+
+	_, err := svc.Transfer(context.Background(), dto.StockTransferInput{
+		FromWarehouseID: from, ToWarehouseID: to,
+		Items: []dto.StockTransferItemInput{{EditionID: editionID, Quantity: 3}},
+	})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+// --- ShipFrom ---
+
+func TestWarehouseService_ShipFrom_PrefersPickupPointWarehouse(t *testing.T) {
+	svc, m := setupWarehouseService(t)
+	pointID := uuid.New()
+	central := models.Warehouse{ID: uuid.New(), Name: "Central", Active: true}
+	shop := models.Warehouse{ID: uuid.New(), Name: "Tverskaya shop", PickupPointID: &pointID, Priority: 10, Active: true}
+
+	m.repo.EXPECT().GetAll(gomock.Any(), true).Return([]models.Warehouse{central, shop}, nil)
+
+	warehouses, err := svc.ShipFrom(context.Background(), &pointID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{shop.ID, central.ID}, warehouses)
+}
+
+func TestWarehouseService_ShipFrom_ByPriority(t *testing.T) {
+	svc, m := setupWarehouseService(t)
+	central := models.Warehouse{ID: uuid.New(), Name: "Central", Active: true}
+	shop := models.Warehouse{ID: uuid.New(), Name: "Tverskaya shop", Priority: 10, Active: true}
+
+	m.repo.EXPECT().GetAll(gomock.Any(), true).Return([]models.Warehouse{central, shop}, nil)
+
+	warehouses, err := svc.ShipFrom(context.Background(), nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{central.ID, shop.ID}, warehouses)
+}
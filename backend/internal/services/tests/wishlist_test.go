@@ -0,0 +1,213 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type wishlistMocks struct {
+	repo     *mocks.MockWishlistRepositoryInterface
+	editions *mocks.MockEditionRepositoryInterface
+	notifier *mocks.MockNotifierInterface
+}
+
+func setupWishlistService(t *testing.T) (*services.WishlistService, wishlistMocks) {
+	ctrl := gomock.NewController(t)
+	m := wishlistMocks{
+		repo:     mocks.NewMockWishlistRepositoryInterface(ctrl),
+		editions: mocks.NewMockEditionRepositoryInterface(ctrl),
+		notifier: mocks.NewMockNotifierInterface(ctrl),
+	}
+	mockAudit := mocks.NewMockAuditRecorderInterface(ctrl)
+	mockAudit.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	svc := services.NewWishlistService(m.repo, m.editions, m.notifier, mockAudit)
+	return svc, m
+}
+
+func TestWishlistService_Create_DuplicateName(t *testing.T) {
+	svc, m := setupWishlistService(t)
+	userID := uuid.New()
+
+	m.repo.EXPECT().CountByUser(gomock.Any(), userID).Return(1, nil)
+	m.repo.EXPECT().GetByName(gomock.Any(), userID, "Подарки").Return(&models.Wishlist{ID: uuid.New(), UserID: userID}, nil)
+
+	_, err := svc.Create(context.Background(), userID, dto.WishlistInput{Name: " Подарки "})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestWishlistService_Create_Limit(t *testing.T) {
+	svc, m := setupWishlistService(t)
+	userID := uuid.New()
+
+	m.repo.EXPECT().CountByUser(gomock.Any(), userID).Return(20, nil)
+
+	_, err := svc.Create(context.Background(), userID, dto.WishlistInput{Name: "Ещё один"})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestWishlistService_GetByID_OtherUsersWishlist(t *testing.T) {
+	svc, m := setupWishlistService(t)
+	id := uuid.New()
+
+	m.repo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Wishlist{ID: id, UserID: uuid.New()}, nil)
+
+	_, err := svc.GetByID(context.Background(), uuid.New(), id)
+
+	assertAppErrorCode(t, err, 404)
+}
+
+func TestWishlistService_AddItem_AlreadyOnList(t *testing.T) {
+	svc, m := setupWishlistService(t)
+	userID, id, editionID := uuid.New(), uuid.New(), uuid.New()
+
+	m.repo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Wishlist{
+		ID: id, UserID: userID, Items: []*models.WishlistItem{{EditionID: editionID}},
+	}, nil)
+
+	_, err := svc.AddItem(context.Background(), userID, id, dto.WishlistItemInput{EditionID: editionID})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestWishlistService_AddItem_UnknownEdition(t *testing.T) {
+	svc, m := setupWishlistService(t)
+	userID, id, editionID := uuid.New(), uuid.New(), uuid.New()
+
+	m.repo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Wishlist{ID: id, UserID: userID}, nil)
+	m.editions.EXPECT().GetByID(gomock.Any(), editionID).Return(nil, errors.New("not found"))
+
+	_, err := svc.AddItem(context.Background(), userID, id, dto.WishlistItemInput{EditionID: editionID})
+
+	assertAppErrorCode(t, err, 404)
+}
+
+func TestWishlistService_MoveToCart(t *testing.T) {
+	svc, m := setupWishlistService(t)
+	userID, id, editionID := uuid.New(), uuid.New(), uuid.New()
+	item := &models.WishlistItem{ID: uuid.New(), EditionID: editionID, Edition: &models.Edition{ID: editionID, Stock: 3}}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Wishlist{ID: id, UserID: userID, Items: []*models.WishlistItem{item}}, nil)
+	m.repo.EXPECT().MoveToCart(gomock.Any(), item, userID, 1).Return(nil)
+	m.repo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Wishlist{ID: id, UserID: userID}, nil)
+
+	wishlist, err := svc.MoveToCart(context.Background(), userID, id, editionID, dto.MoveToCartInput{})
+
+	assert.NoError(t, err)
+	assert.Empty(t, wishlist.Items)
+}
+
+func TestWishlistService_MoveToCart_OutOfStock(t *testing.T) {
+	svc, m := setupWishlistService(t)
+	userID, id, editionID := uuid.New(), uuid.New(), uuid.New()
+	item := &models.WishlistItem{ID: uuid.New(), EditionID: editionID, Edition: &models.Edition{ID: editionID, Stock: 0}}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Wishlist{ID: id, UserID: userID, Items: []*models.WishlistItem{item}}, nil)
+
+	_, err := svc.MoveToCart(context.Background(), userID, id, editionID, dto.MoveToCartInput{Quantity: 2})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestWishlistService_MoveToCart_NotEnoughStock(t *testing.T) {
+	svc, m := setupWishlistService(t)
+	userID, id, editionID := uuid.New(), uuid.New(), uuid.New()
+	item := &models.WishlistItem{ID: uuid.New(), EditionID: editionID, Edition: &models.Edition{ID: editionID, Stock: 2}}
+
+	m.repo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Wishlist{ID: id, UserID: userID, Items: []*models.WishlistItem{item}}, nil)
+
+	_, err := svc.MoveToCart(context.Background(), userID, id, editionID, dto.MoveToCartInput{Quantity: 3})
+
+	assertAppErrorCode(t, err, 409)
+}
+
+func TestWishlistService_Share_KeepsExistingToken(t *testing.T) {
+	svc, m := setupWishlistService(t)
+	userID, id := uuid.New(), uuid.New()
+	token := "existing"
+
+	m.repo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Wishlist{ID: id, UserID: userID, ShareToken: &token, Version: 2}, nil)
+
+	wishlist, err := svc.Share(context.Background(), userID, id, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "existing", *wishlist.ShareToken)
+}
+
+func TestWishlistService_Share_GeneratesToken(t *testing.T) {
+	svc, m := setupWishlistService(t)
+	userID, id := uuid.New(), uuid.New()
+
+	m.repo.EXPECT().GetByID(gomock.Any(), id).Return(&models.Wishlist{ID: id, UserID: userID, Version: 1}, nil)
+	m.repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	wishlist, err := svc.Share(context.Background(), userID, id, 1)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, wishlist.ShareToken) {
+		assert.Len(t, *wishlist.ShareToken, 24)
+	}
+}
+
+func TestWishlistService_GetShared_HidesOwner(t *testing.T) {
+	svc, m := setupWishlistService(t)
+
+	m.repo.EXPECT().GetByShareToken(gomock.Any(), "token").Return(&models.Wishlist{ID: uuid.New(), UserID: uuid.New(), Name: "Хочу прочитать"}, nil)
+
+	shared, err := svc.GetShared(context.Background(), "token")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Хочу прочитать", shared.Name)
+}
+
+func TestWishlistService_EditionChanged_PriceDrop(t *testing.T) {
+	svc, m := setupWishlistService(t)
+	editionID, userID := uuid.New(), uuid.New()
+
+	m.repo.EXPECT().GetWatchers(gomock.Any(), editionID).Return([]uuid.UUID{userID}, nil)
+	m.notifier.EXPECT().Notify(gomock.Any(), dto.Notification{
+		Kind: dto.NotificationPriceDrop, UserID: userID, EditionID: editionID, PreviousPrice: 900, Price: 700, Stock: 5,
+	}).Return(nil)
+
+	svc.EditionChanged(context.Background(),
+		&models.Edition{ID: editionID, Price: 900, Stock: 5},
+		&models.Edition{ID: editionID, Price: 700, Stock: 5})
+}
+
+func TestWishlistService_EditionChanged_BackInStock(t *testing.T) {
+	svc, m := setupWishlistService(t)
+	editionID, userID := uuid.New(), uuid.New()
+
+	m.repo.EXPECT().GetWatchers(gomock.Any(), editionID).Return([]uuid.UUID{userID}, nil)
+	m.notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n dto.Notification) error {
+		assert.Equal(t, dto.NotificationBackInStock, n.Kind)
+		return nil
+	})
+
+	svc.EditionChanged(context.Background(),
+		&models.Edition{ID: editionID, Price: 900, Stock: 0},
+		&models.Edition{ID: editionID, Price: 900, Stock: 2})
+}
+
+func TestWishlistService_EditionChanged_Ignored(t *testing.T) {
+	svc, _ := setupWishlistService(t)
+	editionID := uuid.New()
+
+	// price rises and drops while out of stock notify nobody
+	svc.EditionChanged(context.Background(),
+		&models.Edition{ID: editionID, Price: 700, Stock: 5},
+		&models.Edition{ID: editionID, Price: 900, Stock: 5})
+	svc.EditionChanged(context.Background(),
+		&models.Edition{ID: editionID, Price: 900, Stock: 0},
+		&models.Edition{ID: editionID, Price: 700, Stock: 0})
+}
@@ -6,16 +6,23 @@ import (
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/mergepatch"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 )
 
+// contactValidator checks emails and phone numbers like the validate tags
+// of dto.UpdateUserRequest.
+var contactValidator = validator.New()
+
 type UserService struct {
 	userRepo interfaces.UserRepositoryInterface
+	audit    interfaces.AuditRecorderInterface
 }
 
-func NewUserService(userRepo interfaces.UserRepositoryInterface) *UserService {
-	return &UserService{userRepo: userRepo}
+func NewUserService(userRepo interfaces.UserRepositoryInterface, audit interfaces.AuditRecorderInterface) *UserService {
+	return &UserService{userRepo: userRepo, audit: audit}
 }
 
 func (s *UserService) GetAllCustomers(ctx context.Context) ([]models.User, error) {
@@ -47,6 +54,10 @@ func (s *UserService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateUs
     if err != nil {
         return nil, apperrors.ErrNotFound("user not found")
     }
+	before := *user
+	if err := validateContact(req.Email, req.Phone); err != nil {
+		return nil, err
+	}
 
 	if req.Username != "" {
 		user.Username = req.Username
@@ -61,9 +72,60 @@ func (s *UserService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateUs
 	if err := s.userRepo.Update(ctx, user); err != nil {
 		return nil, apperrors.ErrInternal(err)
 	}
+	s.audit.Record(ctx, AuditActionUpdate, "user", user.ID, &before, user)
 	return user, nil
 }
 
+// Patch applies a JSON merge patch to the user. Unlike Update an explicit
+// null clears the phone number.
+func (s *UserService) Patch(ctx context.Context, id uuid.UUID, patch []byte) (*models.User, error) {
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("user not found")
+	}
+	before := *user
+
+	input := dto.UpdateUserRequest{
+		Username: user.Username,
+		Email:    user.Email,
+		Phone:    user.Phone,
+	}
+	if err := mergepatch.ApplyTo(&input, patch); err != nil {
+		return nil, apperrors.ErrBadRequest(err.Error())
+	}
+	if input.Username == "" {
+		return nil, apperrors.ErrBadRequest("username cannot be empty")
+	}
+	if input.Email == "" {
+		return nil, apperrors.ErrBadRequest("email cannot be empty")
+	}
+	if err := validateContact(input.Email, input.Phone); err != nil {
+		return nil, err
+	}
+
+	user.Username = input.Username
+	user.Email = input.Email
+	user.Phone = input.Phone
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "user", user.ID, &before, user)
+	return user, nil
+}
+
+// validateContact checks the email and phone number a user is saved with;
+// an empty email or a nil phone is not checked.
+func validateContact(email string, phone *string) error {
+	if email != "" && contactValidator.Var(email, "email") != nil {
+		return apperrors.ErrBadRequest("invalid email: " + email)
+	}
+	if phone != nil && contactValidator.Var(*phone, "e164") != nil {
+		return apperrors.ErrBadRequest("phone must be in E.164 format, e.g. +79991234567")
+	}
+	return nil
+}
+
 func (s *UserService) Delete(ctx context.Context, id uuid.UUID) error {
 	user, err := s.userRepo.GetByID(ctx, id)
     if err != nil {
@@ -73,5 +135,6 @@ func (s *UserService) Delete(ctx context.Context, id uuid.UUID) error {
 	if err := s.userRepo.Delete(ctx, user); err != nil {
 		return apperrors.ErrInternal(err)
 	}
+	s.audit.Record(ctx, AuditActionDelete, "user", user.ID, user, nil)
 	return nil
 }
\ No newline at end of file
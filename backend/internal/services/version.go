@@ -0,0 +1,28 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+)
+
+func errModified(entity string) error {
+	return apperrors.ErrPreconditionFailed(entity + " has been modified, reload it and try again")
+}
+
+// checkVersion compares the version the client has seen with the stored one.
+func checkVersion(entity string, current int64, expected int64) error {
+	if current != expected {
+		return errModified(entity)
+	}
+	return nil
+}
+
+// versionedWriteError maps a repository error of a guarded update or delete
+// to an application error.
+func versionedWriteError(entity string, err error) error {
+	if errors.Is(err, apperrors.ErrVersionConflict) {
+		return errModified(entity)
+	}
+	return apperrors.ErrInternal(err)
+}
@@ -0,0 +1,285 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+const (
+	maxWarehouseNameLength    = 200
+	maxWarehouseAddressLength = 500
+	maxTransferNoteLength     = 1000
+)
+
+// WarehouseService keeps the stock of each warehouse, moves it between
+// them and decides which warehouses ship an order. An edition's stock is
+// the total of the active warehouses; the listener is told whenever it
+// changes.
+type WarehouseService struct {
+	repo         interfaces.WarehouseRepositoryInterface
+	editions     interfaces.EditionRepositoryInterface
+	pickupPoints interfaces.ShippingRepositoryInterface
+	listener     interfaces.EditionListenerInterface
+	audit        interfaces.AuditRecorderInterface
+}
+
+func NewWarehouseService(repo interfaces.WarehouseRepositoryInterface, editions interfaces.EditionRepositoryInterface, pickupPoints interfaces.ShippingRepositoryInterface, listener interfaces.EditionListenerInterface, audit interfaces.AuditRecorderInterface) *WarehouseService {
+	return &WarehouseService{repo: repo, editions: editions, pickupPoints: pickupPoints, listener: listener, audit: audit}
+}
+
+func (s *WarehouseService) Create(ctx context.Context, input dto.WarehouseInput) (*models.Warehouse, error) {
+	warehouse := &models.Warehouse{}
+	if err := s.applyWarehouse(ctx, warehouse, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, warehouse); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "warehouse", warehouse.ID, nil, warehouse)
+	return warehouse, nil
+}
+
+// GetAll lists the warehouses, the inactive ones included, in the order
+// orders are shipped from them.
+func (s *WarehouseService) GetAll(ctx context.Context) ([]models.Warehouse, error) {
+	warehouses, err := s.repo.GetAll(ctx, false)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return warehouses, nil
+}
+
+func (s *WarehouseService) GetByID(ctx context.Context, id uuid.UUID) (*models.Warehouse, error) {
+	warehouse, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("warehouse not found")
+	}
+	return warehouse, nil
+}
+
+// Update saves the warehouse. Warehouses are not deleted, as orders and
+// transfers refer to them; making one inactive takes its copies out of the
+// editions' stock until it is made active again.
+func (s *WarehouseService) Update(ctx context.Context, id uuid.UUID, version int64, input dto.WarehouseInput) (*models.Warehouse, error) {
+	warehouse, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion("warehouse", warehouse.Version, version); err != nil {
+		return nil, err
+	}
+	before := *warehouse
+
+	if err := s.applyWarehouse(ctx, warehouse, input); err != nil {
+		return nil, err
+	}
+	var stock []models.EditionStock
+	if warehouse.Active != before.Active {
+		if stock, err = s.repo.GetStock(ctx, id); err != nil {
+			return nil, apperrors.ErrInternal(err)
+		}
+	}
+	if err := s.repo.Update(ctx, warehouse); err != nil {
+		return nil, versionedWriteError("warehouse", err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "warehouse", id, &before, warehouse)
+	for _, line := range stock {
+		s.notifyStock(ctx, line.Edition)
+	}
+	return warehouse, nil
+}
+
+// GetStock lists the editions the warehouse has copies of.
+func (s *WarehouseService) GetStock(ctx context.Context, warehouseID uuid.UUID) ([]models.EditionStock, error) {
+	if _, err := s.GetByID(ctx, warehouseID); err != nil {
+		return nil, err
+	}
+	stock, err := s.repo.GetStock(ctx, warehouseID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return stock, nil
+}
+
+// GetEditionStock lists the copies of the edition each warehouse has.
+func (s *WarehouseService) GetEditionStock(ctx context.Context, editionID uuid.UUID) ([]models.EditionStock, error) {
+	if _, err := s.editions.GetByID(ctx, editionID); err != nil {
+		return nil, apperrors.ErrNotFound("edition not found")
+	}
+	stock, err := s.repo.GetEditionStock(ctx, editionID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return stock, nil
+}
+
+// SetStock sets how many copies of the edition the warehouse has and
+// returns the edition with its new stock.
+func (s *WarehouseService) SetStock(ctx context.Context, warehouseID, editionID uuid.UUID, input dto.StockInput) (*models.Edition, error) {
+	switch {
+	case input.Quantity == nil:
+		return nil, apperrors.ErrBadRequest("quantity is required")
+	case *input.Quantity < 0:
+		return nil, apperrors.ErrBadRequest("quantity must not be negative")
+	}
+	if _, err := s.GetByID(ctx, warehouseID); err != nil {
+		return nil, err
+	}
+	edition, err := s.editions.GetByID(ctx, editionID)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("edition not found")
+	}
+
+	if err := s.repo.SetStock(ctx, &models.EditionStock{EditionID: editionID, WarehouseID: warehouseID, Quantity: *input.Quantity}); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	after, err := s.editions.GetByID(ctx, editionID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.stockChanged(ctx, edition, after)
+	return after, nil
+}
+
+// Transfer moves copies of editions from one warehouse to another. It
+// fails with a conflict if the warehouse they come from does not have
+// them all.
+func (s *WarehouseService) Transfer(ctx context.Context, input dto.StockTransferInput) (*models.StockTransfer, error) {
+	if input.FromWarehouseID == input.ToWarehouseID {
+		return nil, apperrors.ErrBadRequest("from_warehouse_id and to_warehouse_id must differ")
+	}
+	if len(input.Items) == 0 {
+		return nil, apperrors.ErrBadRequest("items are required")
+	}
+	for _, id := range []uuid.UUID{input.FromWarehouseID, input.ToWarehouseID} {
+		if _, err := s.repo.GetByID(ctx, id); err != nil {
+			return nil, apperrors.ErrBadRequest("warehouse not found: " + id.String())
+		}
+	}
+	var note *string
+	if input.Note != nil {
+		trimmed := strings.TrimSpace(*input.Note)
+		if utf8.RuneCountInString(trimmed) > maxTransferNoteLength {
+			return nil, apperrors.ErrBadRequest("note is too long")
+		}
+		if trimmed != "" {
+			note = &trimmed
+		}
+	}
+
+	transfer := &models.StockTransfer{
+		FromWarehouseID: input.FromWarehouseID,
+		ToWarehouseID:   input.ToWarehouseID,
+		Items:           make([]models.StockTransferItem, 0, len(input.Items)),
+		Note:            note,
+	}
+	editions := make([]*models.Edition, 0, len(input.Items))
+	for _, item := range input.Items {
+		if item.Quantity <= 0 {
+			return nil, apperrors.ErrBadRequest("quantity must be positive")
+		}
+		if slices.ContainsFunc(transfer.Items, func(seen models.StockTransferItem) bool { return seen.EditionID == item.EditionID }) {
+			return nil, apperrors.ErrBadRequest("items must not repeat an edition")
+		}
+		edition, err := s.editions.GetByID(ctx, item.EditionID)
+		if err != nil {
+			return nil, apperrors.ErrBadRequest("edition not found: " + item.EditionID.String())
+		}
+		transfer.Items = append(transfer.Items, models.StockTransferItem{EditionID: item.EditionID, Quantity: item.Quantity})
+		editions = append(editions, edition)
+	}
+
+	if err := s.repo.Transfer(ctx, transfer); err != nil {
+		if errors.Is(err, apperrors.ErrOutOfStock) {
+			return nil, apperrors.ErrConflict("the warehouse does not have enough copies to transfer")
+		}
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "stock_transfer", transfer.ID, nil, transfer)
+	for _, edition := range editions {
+		s.notifyStock(ctx, edition)
+	}
+	return transfer, nil
+}
+
+func (s *WarehouseService) GetTransfers(ctx context.Context, filter dto.StockTransferFilter) ([]models.StockTransfer, error) {
+	if filter.Limit < 0 || filter.Offset < 0 {
+		return nil, apperrors.ErrBadRequest("limit and offset must not be negative")
+	}
+	transfers, err := s.repo.GetTransfers(ctx, filter)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return transfers, nil
+}
+
+// ShipFrom returns the active warehouses orders are shipped from, in
+// order of preference: the warehouse of the pickup point the order is
+// collected at comes first, then the others by priority.
+func (s *WarehouseService) ShipFrom(ctx context.Context, pickupPointID *uuid.UUID) ([]uuid.UUID, error) {
+	warehouses, err := s.repo.GetAll(ctx, true)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	order := make([]uuid.UUID, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		if pickupPointID != nil && warehouse.PickupPointID != nil && *warehouse.PickupPointID == *pickupPointID {
+			order = slices.Insert(order, 0, warehouse.ID)
+		} else {
+			order = append(order, warehouse.ID)
+		}
+	}
+	return order, nil
+}
+
+// notifyStock reloads the edition after its copies were moved and passes
+// it on to stockChanged.
+func (s *WarehouseService) notifyStock(ctx context.Context, before *models.Edition) {
+	if after, err := s.editions.GetByID(ctx, before.ID); err == nil {
+		s.stockChanged(ctx, before, after)
+	}
+}
+
+// stockChanged tells the listener about the edition and records the change
+// if its stock is not what it was.
+func (s *WarehouseService) stockChanged(ctx context.Context, before, after *models.Edition) {
+	if after.Stock != before.Stock {
+		s.listener.EditionChanged(ctx, before, after)
+		s.audit.Record(ctx, AuditActionUpdate, "edition", after.ID, before, after)
+	}
+}
+
+// applyWarehouse validates the input and copies it onto the warehouse.
+func (s *WarehouseService) applyWarehouse(ctx context.Context, warehouse *models.Warehouse, input dto.WarehouseInput) error {
+	name := strings.TrimSpace(input.Name)
+	address := strings.TrimSpace(input.Address)
+	switch {
+	case name == "":
+		return apperrors.ErrBadRequest("name is required")
+	case utf8.RuneCountInString(name) > maxWarehouseNameLength:
+		return apperrors.ErrBadRequest("name is too long")
+	case utf8.RuneCountInString(address) > maxWarehouseAddressLength:
+		return apperrors.ErrBadRequest("address is too long")
+	}
+	if input.PickupPointID != nil {
+		if _, err := s.pickupPoints.GetPickupPointByID(ctx, *input.PickupPointID); err != nil {
+			return apperrors.ErrBadRequest("pickup point not found")
+		}
+	}
+
+	warehouse.Name = name
+	warehouse.Address = address
+	warehouse.PickupPointID = input.PickupPointID
+	warehouse.Priority = input.Priority
+	warehouse.Active = input.Active == nil || *input.Active
+	return nil
+}
@@ -0,0 +1,308 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+const (
+	maxWishlistsPerUser   = 20
+	maxWishlistItems      = 200
+	maxWishlistNameLength = 100
+	maxMoveToCartQuantity = 99
+)
+
+// WishlistService manages customers' wishlists. Wishlists are private to
+// their owner; other users get 404 for them, as if they did not exist.
+type WishlistService struct {
+	repo     interfaces.WishlistRepositoryInterface
+	editions interfaces.EditionRepositoryInterface
+	notifier interfaces.NotifierInterface
+	audit    interfaces.AuditRecorderInterface
+}
+
+func NewWishlistService(repo interfaces.WishlistRepositoryInterface, editions interfaces.EditionRepositoryInterface, notifier interfaces.NotifierInterface, audit interfaces.AuditRecorderInterface) *WishlistService {
+	return &WishlistService{repo: repo, editions: editions, notifier: notifier, audit: audit}
+}
+
+func (s *WishlistService) Create(ctx context.Context, userID uuid.UUID, input dto.WishlistInput) (*models.Wishlist, error) {
+	count, err := s.repo.CountByUser(ctx, userID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	if count >= maxWishlistsPerUser {
+		return nil, apperrors.ErrConflict("wishlist limit reached")
+	}
+	wishlist := &models.Wishlist{UserID: userID, Items: []*models.WishlistItem{}}
+	if err := s.setName(ctx, wishlist, input.Name); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, wishlist); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	s.audit.Record(ctx, AuditActionCreate, "wishlist", wishlist.ID, nil, wishlist)
+	return wishlist, nil
+}
+
+func (s *WishlistService) GetAll(ctx context.Context, userID uuid.UUID) ([]models.Wishlist, error) {
+	wishlists, err := s.repo.GetAllByUser(ctx, userID)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return wishlists, nil
+}
+
+func (s *WishlistService) GetByID(ctx context.Context, userID, id uuid.UUID) (*models.Wishlist, error) {
+	return s.own(ctx, userID, id)
+}
+
+// GetShared returns a wishlist by its share token.
+func (s *WishlistService) GetShared(ctx context.Context, token string) (*dto.SharedWishlist, error) {
+	wishlist, err := s.repo.GetByShareToken(ctx, token)
+	if err != nil {
+		return nil, apperrors.ErrNotFound("wishlist not found")
+	}
+	return &dto.SharedWishlist{Name: wishlist.Name, Items: wishlist.Items}, nil
+}
+
+// Update renames the wishlist.
+func (s *WishlistService) Update(ctx context.Context, userID, id uuid.UUID, version int64, input dto.WishlistInput) (*models.Wishlist, error) {
+	wishlist, err := s.own(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion("wishlist", wishlist.Version, version); err != nil {
+		return nil, err
+	}
+	before := *wishlist
+
+	if err := s.setName(ctx, wishlist, input.Name); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, wishlist); err != nil {
+		return nil, versionedWriteError("wishlist", err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "wishlist", id, &before, wishlist)
+	return wishlist, nil
+}
+
+func (s *WishlistService) Delete(ctx context.Context, userID, id uuid.UUID, version int64) error {
+	wishlist, err := s.own(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion("wishlist", wishlist.Version, version); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id, version); err != nil {
+		return versionedWriteError("wishlist", err)
+	}
+	s.audit.Record(ctx, AuditActionDelete, "wishlist", id, wishlist, nil)
+	return nil
+}
+
+func (s *WishlistService) AddItem(ctx context.Context, userID, id uuid.UUID, input dto.WishlistItemInput) (*models.Wishlist, error) {
+	wishlist, err := s.own(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if findWishlistItem(wishlist, input.EditionID) != nil {
+		return nil, apperrors.ErrConflict("edition is already on this wishlist")
+	}
+	if len(wishlist.Items) >= maxWishlistItems {
+		return nil, apperrors.ErrConflict("wishlist is full")
+	}
+	if _, err := s.editions.GetByID(ctx, input.EditionID); err != nil {
+		return nil, apperrors.ErrNotFound("edition not found")
+	}
+
+	if err := s.repo.AddItem(ctx, &models.WishlistItem{WishlistID: id, EditionID: input.EditionID}); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return s.reload(ctx, id)
+}
+
+func (s *WishlistService) RemoveItem(ctx context.Context, userID, id, editionID uuid.UUID) (*models.Wishlist, error) {
+	wishlist, err := s.own(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	item := findWishlistItem(wishlist, editionID)
+	if item == nil {
+		return nil, apperrors.ErrNotFound("edition is not on this wishlist")
+	}
+
+	if err := s.repo.RemoveItem(ctx, item.ID); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return s.reload(ctx, id)
+}
+
+// MoveToCart puts the edition into the user's cart and takes it off the
+// wishlist. Editions that are out of stock stay on the wishlist.
+func (s *WishlistService) MoveToCart(ctx context.Context, userID, id, editionID uuid.UUID, input dto.MoveToCartInput) (*models.Wishlist, error) {
+	quantity := input.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+	if quantity < 0 || quantity > maxMoveToCartQuantity {
+		return nil, apperrors.ErrBadRequest("quantity must be between 1 and 99")
+	}
+	wishlist, err := s.own(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	item := findWishlistItem(wishlist, editionID)
+	if item == nil {
+		return nil, apperrors.ErrNotFound("edition is not on this wishlist")
+	}
+	if item.Edition == nil || item.Edition.Stock <= 0 {
+		return nil, apperrors.ErrConflict("edition is out of stock")
+	}
+	if item.Edition.Stock < quantity {
+		return nil, apperrors.ErrConflict("not enough copies in stock")
+	}
+
+	if err := s.repo.MoveToCart(ctx, item, userID, quantity); err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return s.reload(ctx, id)
+}
+
+// Share gives the wishlist a share token, keeping the current one if the
+// wishlist is already shared.
+func (s *WishlistService) Share(ctx context.Context, userID, id uuid.UUID, version int64) (*models.Wishlist, error) {
+	return s.setShareToken(ctx, userID, id, version, true)
+}
+
+// Unshare revokes the share token; links handed out before stop working.
+func (s *WishlistService) Unshare(ctx context.Context, userID, id uuid.UUID, version int64) (*models.Wishlist, error) {
+	return s.setShareToken(ctx, userID, id, version, false)
+}
+
+// EditionChanged notifies everyone who has the edition on a wishlist when
+// its price drops or it comes back in stock. Failures are logged so they
+// never undo the edition change.
+func (s *WishlistService) EditionChanged(ctx context.Context, before, after *models.Edition) {
+	if before == nil || after == nil {
+		return
+	}
+	var kind string
+	switch {
+	case before.Stock <= 0 && after.Stock > 0:
+		kind = dto.NotificationBackInStock
+	case after.Price < before.Price && after.Stock > 0:
+		kind = dto.NotificationPriceDrop
+	default:
+		return
+	}
+
+	users, err := s.repo.GetWatchers(ctx, after.ID)
+	if err != nil {
+		log.Printf("failed to find wishlist watchers of edition %s: %v", after.ID, err)
+		return
+	}
+	for _, userID := range users {
+		notification := dto.Notification{
+			Kind:          kind,
+			UserID:        userID,
+			EditionID:     after.ID,
+			BookID:        after.BookID,
+			PreviousPrice: before.Price,
+			Price:         after.Price,
+			Stock:         after.Stock,
+		}
+		if err := s.notifier.Notify(ctx, notification); err != nil {
+			log.Printf("failed to notify user %s about edition %s: %v", userID, after.ID, err)
+		}
+	}
+}
+
+func (s *WishlistService) setShareToken(ctx context.Context, userID, id uuid.UUID, version int64, shared bool) (*models.Wishlist, error) {
+	wishlist, err := s.own(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion("wishlist", wishlist.Version, version); err != nil {
+		return nil, err
+	}
+	if (wishlist.ShareToken != nil) == shared {
+		return wishlist, nil
+	}
+	before := *wishlist
+
+	wishlist.ShareToken = nil
+	if shared {
+		token, err := newToken()
+		if err != nil {
+			return nil, apperrors.ErrInternal(err)
+		}
+		wishlist.ShareToken = &token
+	}
+	if err := s.repo.Update(ctx, wishlist); err != nil {
+		return nil, versionedWriteError("wishlist", err)
+	}
+	s.audit.Record(ctx, AuditActionUpdate, "wishlist", id, &before, wishlist)
+	return wishlist, nil
+}
+
+func (s *WishlistService) setName(ctx context.Context, wishlist *models.Wishlist, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return apperrors.ErrBadRequest("name is required")
+	}
+	if utf8.RuneCountInString(name) > maxWishlistNameLength {
+		return apperrors.ErrBadRequest("name is too long")
+	}
+	if existing, err := s.repo.GetByName(ctx, wishlist.UserID, name); err == nil && existing.ID != wishlist.ID {
+		return apperrors.ErrConflict("you already have a wishlist with this name")
+	}
+	wishlist.Name = name
+	return nil
+}
+
+func (s *WishlistService) own(ctx context.Context, userID, id uuid.UUID) (*models.Wishlist, error) {
+	wishlist, err := s.repo.GetByID(ctx, id)
+	if err != nil || wishlist.UserID != userID {
+		return nil, apperrors.ErrNotFound("wishlist not found")
+	}
+	return wishlist, nil
+}
+
+func (s *WishlistService) reload(ctx context.Context, id uuid.UUID) (*models.Wishlist, error) {
+	wishlist, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.ErrInternal(err)
+	}
+	return wishlist, nil
+}
+
+func findWishlistItem(wishlist *models.Wishlist, editionID uuid.UUID) *models.WishlistItem {
+	for _, item := range wishlist.Items {
+		if item.EditionID == editionID {
+			return item
+		}
+	}
+	return nil
+}
+
+// newToken returns 144 random bits, URL-safe encoded, for share and
+// unsubscribe links.
+func newToken() (string, error) {
+	buf := make([]byte, 18)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
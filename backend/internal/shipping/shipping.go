@@ -0,0 +1,94 @@
+// Package shipping works out which rate of a shipping method applies to a
+// parcel and how far customers are from pickup points.
+package shipping
+
+import (
+	"math"
+	"strings"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+// earthRadius is the mean radius of the Earth in kilometres.
+const earthRadius = 6371.0
+
+// DefaultWeight is the weight in grams assumed for a printed copy whose
+// weight has not been entered.
+const DefaultWeight = 500
+
+// Weight returns what quantity copies of an edition weigh in grams. Ebooks
+// and audiobooks are not shipped and weigh nothing.
+func Weight(edition *models.Edition, quantity int) int {
+	switch {
+	case edition.Format == models.EditionFormatEbook || edition.Format == models.EditionFormatAudiobook:
+		return 0
+	case edition.Weight != nil:
+		return *edition.Weight * quantity
+	}
+	return DefaultWeight * quantity
+}
+
+// NormalizePostalCode drops spaces from a postal code and uppercases it.
+func NormalizePostalCode(code string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(code), ""))
+}
+
+// ZoneFor returns the zone with the longest prefix of the postal code, or
+// nil if no zone has one.
+func ZoneFor(zones []models.ShippingZone, postalCode string) *models.ShippingZone {
+	postalCode = NormalizePostalCode(postalCode)
+	var found *models.ShippingZone
+	longest := 0
+	for i := range zones {
+		for _, prefix := range zones[i].PostalPrefixes {
+			if len(prefix) > longest && strings.HasPrefix(postalCode, prefix) {
+				found, longest = &zones[i], len(prefix)
+			}
+		}
+	}
+	return found
+}
+
+// RateFor returns the rate of the method for a parcel of weight grams sent
+// to the zone, which may be nil: the zone's own rates are used if it has
+// any, the rates without a zone otherwise. Of those, the one with the
+// lowest MaxWeight the parcel fits applies. It reports false if none does.
+func RateFor(method *models.ShippingMethod, zoneID *uuid.UUID, weight int) (models.ShippingRate, bool) {
+	var zoned, general []models.ShippingRate
+	for _, rate := range method.Rates {
+		switch {
+		case rate.ZoneID == nil:
+			general = append(general, rate)
+		case zoneID != nil && *rate.ZoneID == *zoneID:
+			zoned = append(zoned, rate)
+		}
+	}
+	rates := general
+	if len(zoned) > 0 {
+		rates = zoned
+	}
+
+	var best *models.ShippingRate
+	for i, rate := range rates {
+		if rate.MaxWeight != nil && *rate.MaxWeight < weight {
+			continue
+		}
+		if best == nil || (rate.MaxWeight != nil && (best.MaxWeight == nil || *rate.MaxWeight < *best.MaxWeight)) {
+			best = &rates[i]
+		}
+	}
+	if best == nil {
+		return models.ShippingRate{}, false
+	}
+	return *best, true
+}
+
+// Distance returns the great-circle distance in kilometres between two
+// points given by their latitude and longitude in degrees.
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := lat1*math.Pi/180, lat2*math.Pi/180
+	dPhi, dLambda := phi2-phi1, (lon2-lon1)*math.Pi/180
+	h := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
@@ -0,0 +1,82 @@
+package shipping_test
+
+import (
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/shipping"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(n int) *int { return &n }
+
+func TestWeight(t *testing.T) {
+	tests := []struct {
+		name    string
+		edition models.Edition
+		want    int
+	}{
+		{"entered weight", models.Edition{Format: models.EditionFormatHardcover, Weight: intPtr(650)}, 1950},
+		{"unknown weight", models.Edition{Format: models.EditionFormatPaperback}, 3 * shipping.DefaultWeight},
+		{"ebooks are not shipped", models.Edition{Format: models.EditionFormatEbook, Weight: intPtr(650)}, 0},
+		{"audiobooks are not shipped", models.Edition{Format: models.EditionFormatAudiobook}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, shipping.Weight(&tt.edition, 3))
+		})
+	}
+}
+
+func TestZoneFor(t *testing.T) {
+	zones := []models.ShippingZone{
+		{Name: "Central", PostalPrefixes: []string{"1", "3"}},
+		{Name: "Moscow", PostalPrefixes: []string{"10", "11", "12"}},
+	}
+
+	assert.Equal(t, "Moscow", shipping.ZoneFor(zones, " 101 000").Name)
+	assert.Equal(t, "Central", shipping.ZoneFor(zones, "150000").Name)
+	assert.Nil(t, shipping.ZoneFor(zones, "690000"))
+}
+
+func TestRateFor(t *testing.T) {
+	moscow, far := uuid.New(), uuid.New()
+	method := &models.ShippingMethod{Rates: []models.ShippingRate{
+		{ZoneID: &moscow, MaxWeight: intPtr(1000), Cost: 25000},
+		{ZoneID: &moscow, Cost: 40000},
+		{MaxWeight: intPtr(5000), Cost: 50000},
+		{MaxWeight: intPtr(2000), Cost: 35000},
+	}}
+
+	tests := []struct {
+		name   string
+		zoneID *uuid.UUID
+		weight int
+		want   money.Amount
+		ok     bool
+	}{
+		{"lightest band that fits", &moscow, 800, 25000, true},
+		{"unbounded band for heavy parcels", &moscow, 12000, 40000, true},
+		{"rates without a zone elsewhere", &far, 1500, 35000, true},
+		{"no zone at all", nil, 3000, 50000, true},
+		{"too heavy", &far, 6000, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, ok := shipping.RateFor(method, tt.zoneID, tt.weight)
+
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, rate.Cost)
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	// Red Square to Palace Square in Saint Petersburg.
+	assert.InDelta(t, 634, shipping.Distance(55.7539, 37.6208, 59.9391, 30.3159), 2)
+	assert.Zero(t, shipping.Distance(55.7539, 37.6208, 55.7539, 37.6208))
+	// Across the antimeridian.
+	assert.InDelta(t, 111.2, shipping.Distance(0, 179.5, 0, -179.5), 0.1)
+}
@@ -0,0 +1,54 @@
+// Package slug builds URL path segments from human readable names.
+package slug
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var pattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Russian letters are transliterated, other letters outside a-z are dropped.
+var transliteration = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "",
+	'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+// Make lowercases and transliterates the name and joins the remaining
+// words with hyphens. It returns an empty string if nothing is left.
+func Make(name string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(name) {
+		var part string
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			part = string(r)
+		case transliteration[r] != "":
+			part = transliteration[r]
+		case r == 'ъ' || r == 'ь' || r == '\'' || r == '’':
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			continue
+		default:
+			pendingHyphen = b.Len() > 0
+			continue
+		}
+		if pendingHyphen {
+			b.WriteByte('-')
+			pendingHyphen = false
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
+// Valid reports whether s consists of lowercase latin letters and digits
+// separated by single hyphens.
+func Valid(s string) bool {
+	return pattern.MatchString(s)
+}
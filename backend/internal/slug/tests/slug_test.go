@@ -0,0 +1,33 @@
+package slug_test
+
+import (
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/slug"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMake(t *testing.T) {
+	cases := map[string]string{
+		"Science Fiction":         "science-fiction",
+		"  Детская литература  ":  "detskaya-literatura",
+		"Щедрость & Жадность":     "shchedrost-zhadnost",
+		"Объявления":              "obyavleniya",
+		"Sci-Fi / Fantasy 2024!":  "sci-fi-fantasy-2024",
+		"Ελληνικά":                "",
+		"Rock'n'roll":             "rocknroll",
+	}
+	for name, expected := range cases {
+		assert.Equal(t, expected, slug.Make(name), name)
+	}
+}
+
+func TestValid(t *testing.T) {
+	assert.True(t, slug.Valid("science-fiction"))
+	assert.True(t, slug.Valid("book2"))
+	assert.False(t, slug.Valid(""))
+	assert.False(t, slug.Valid("Science"))
+	assert.False(t, slug.Valid("double--hyphen"))
+	assert.False(t, slug.Valid("-leading"))
+	assert.False(t, slug.Valid("детская"))
+}
@@ -0,0 +1,72 @@
+// Package tax works out the VAT included in the store's prices.
+package tax
+
+import (
+	"slices"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+)
+
+// ReducedLanguage is the language of the books sold at the reduced rate.
+const ReducedLanguage = "ru"
+
+// Rates are the VAT rates in percent.
+type Rates struct {
+	Standard int
+	Reduced  int
+}
+
+// DefaultRates are the Russian VAT rates: books in Russian are taxed at
+// the reduced rate for printed matter, everything else at the standard one.
+var DefaultRates = Rates{Standard: 22, Reduced: 10}
+
+// For returns the rate a book is sold at.
+func (r Rates) For(book *models.Book) int {
+	if book != nil && book.Language == ReducedLanguage {
+		return r.Reduced
+	}
+	return r.Standard
+}
+
+// Included returns the VAT included in an amount taxed at rate, rounded
+// half up to the minor unit.
+func Included(amount money.Amount, rate int) money.Amount {
+	if rate <= 0 {
+		return 0
+	}
+	scaled := amount * money.Amount(rate)
+	divisor := money.Amount(100 + rate)
+	return (2*scaled + divisor) / (2 * divisor)
+}
+
+// Line is a taxed amount: what a line costs after discounts, and the VAT
+// included in it.
+type Line struct {
+	Rate  int
+	Total money.Amount
+	Tax   money.Amount
+}
+
+// Breakdown sums the lines by rate, lowest rate first. The VAT of each rate
+// is the sum of its lines' VAT, so the breakdown always matches the lines.
+func Breakdown(lines []Line) []models.TaxLine {
+	byRate := map[int]*models.TaxLine{}
+	for _, line := range lines {
+		entry, ok := byRate[line.Rate]
+		if !ok {
+			entry = &models.TaxLine{Rate: line.Rate}
+			byRate[line.Rate] = entry
+		}
+		entry.Total += line.Total
+		entry.Tax += line.Tax
+	}
+
+	breakdown := make([]models.TaxLine, 0, len(byRate))
+	for _, entry := range byRate {
+		entry.Net = entry.Total - entry.Tax
+		breakdown = append(breakdown, *entry)
+	}
+	slices.SortFunc(breakdown, func(a, b models.TaxLine) int { return a.Rate - b.Rate })
+	return breakdown
+}
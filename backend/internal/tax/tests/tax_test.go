@@ -0,0 +1,37 @@
+package tax_test
+
+import (
+	"testing"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRates_For(t *testing.T) {
+	assert.Equal(t, 10, tax.DefaultRates.For(&models.Book{Language: "ru"}))
+	assert.Equal(t, 22, tax.DefaultRates.For(&models.Book{Language: "en"}))
+	assert.Equal(t, 22, tax.DefaultRates.For(nil))
+}
+
+func TestIncluded(t *testing.T) {
+	assert.Equal(t, money.Amount(1000), tax.Included(11000, 10))
+	assert.Equal(t, money.Amount(18), tax.Included(100, 22)) // 18.03
+	assert.Equal(t, money.Amount(1), tax.Included(11, 10))   // 1.00
+	assert.Equal(t, money.Amount(1), tax.Included(6, 10))    // 0.545
+	assert.Equal(t, money.Amount(0), tax.Included(5000, 0))
+}
+
+func TestBreakdown(t *testing.T) {
+	breakdown := tax.Breakdown([]tax.Line{
+		{Rate: 22, Total: 12200, Tax: 2200},
+		{Rate: 10, Total: 5500, Tax: 500},
+		{Rate: 22, Total: 100, Tax: 18},
+	})
+
+	assert.Equal(t, []models.TaxLine{
+		{Rate: 10, Net: 5000, Tax: 500, Total: 5500},
+		{Rate: 22, Net: 10082, Tax: 2218, Total: 12300},
+	}, breakdown)
+}
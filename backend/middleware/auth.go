@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/actor"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/services"
 	"github.com/gin-gonic/gin"
 )
@@ -25,6 +26,12 @@ func AuthMiddleware(jwtService *services.JWTService) gin.HandlerFunc {
 
 		c.Set("user_id", claims.UserID)
 		c.Set("role", claims.Role)
+		c.Request = c.Request.WithContext(actor.WithActor(c.Request.Context(), actor.Actor{
+			UserID:    claims.UserID,
+			Role:      claims.Role,
+			IP:        c.ClientIP(),
+			RequestID: c.GetString("request_id"),
+		}))
 		c.Next()
 	}
 }
\ No newline at end of file
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/currency"
+	"github.com/gin-gonic/gin"
+)
+
+const AcceptCurrencyHeader = "Accept-Currency"
+
+// Currency reads the preferred currency from the "currency" query parameter
+// or the Accept-Currency header and attaches it to the request context.
+// Vary is added to rather than replaced, keeping what other handlers set.
+func Currency() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", AcceptCurrencyHeader)
+		raw := c.Query("currency")
+		if raw == "" {
+			raw = c.GetHeader(AcceptCurrencyHeader)
+		}
+		if raw == "" {
+			c.Next()
+			return
+		}
+		code, err := currency.Normalize(raw)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.Request = c.Request.WithContext(currency.WithPreferred(c.Request.Context(), code))
+		c.Next()
+	}
+}
@@ -0,0 +1,28 @@
+-- Create "audit_logs" table
+CREATE TABLE "public"."audit_logs" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "actor_id" uuid NULL,
+ "actor_role" character varying NOT NULL DEFAULT '',
+ "action" character varying NOT NULL,
+ "entity_type" character varying NOT NULL,
+ "entity_id" uuid NOT NULL,
+ "changes" jsonb NULL,
+ "ip" character varying NOT NULL DEFAULT '',
+ "request_id" character varying NOT NULL DEFAULT '',
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id")
+);
+-- Create index "audit_logs_entity_idx" to table: "audit_logs"
+CREATE INDEX "audit_logs_entity_idx" ON "public"."audit_logs" ("entity_type", "entity_id");
+-- Create index "audit_logs_actor_id_idx" to table: "audit_logs"
+CREATE INDEX "audit_logs_actor_id_idx" ON "public"."audit_logs" ("actor_id");
+-- Create index "audit_logs_created_at_idx" to table: "audit_logs"
+CREATE INDEX "audit_logs_created_at_idx" ON "public"."audit_logs" ("created_at");
+-- Make "audit_logs" append-only
+CREATE FUNCTION "public"."audit_logs_append_only"() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER "audit_logs_append_only" BEFORE UPDATE OR DELETE ON "public"."audit_logs"
+    FOR EACH ROW EXECUTE FUNCTION "public"."audit_logs_append_only"();
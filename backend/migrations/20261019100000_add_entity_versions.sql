@@ -0,0 +1,8 @@
+-- Modify "authors" table
+ALTER TABLE "public"."authors" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- Modify "books" table
+ALTER TABLE "public"."books" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- Modify "categories" table
+ALTER TABLE "public"."categories" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- Modify "publishers" table
+ALTER TABLE "public"."publishers" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
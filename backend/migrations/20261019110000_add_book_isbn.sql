@@ -0,0 +1,6 @@
+-- Modify "books" table
+ALTER TABLE "public"."books" ADD COLUMN "isbn13" character varying NULL, ADD COLUMN "isbn10" character varying NULL;
+-- Create index "books_isbn13_key" to table: "books"
+ALTER TABLE "public"."books" ADD CONSTRAINT "books_isbn13_key" UNIQUE ("isbn13");
+-- Create index "books_isbn10_key" to table: "books"
+ALTER TABLE "public"."books" ADD CONSTRAINT "books_isbn10_key" UNIQUE ("isbn10");
@@ -0,0 +1,17 @@
+-- Create "book_contributors" table
+CREATE TABLE "public"."book_contributors" (
+ "book_id" uuid NOT NULL,
+ "author_id" uuid NOT NULL,
+ "role" character varying NOT NULL,
+ "position" bigint NOT NULL DEFAULT 0,
+ PRIMARY KEY ("book_id", "author_id", "role"),
+ CONSTRAINT "book_contributors_author_id_fkey" FOREIGN KEY ("author_id") REFERENCES "public"."authors" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "book_contributors_book_id_fkey" FOREIGN KEY ("book_id") REFERENCES "public"."books" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "book_contributors_author_id_idx" to table: "book_contributors"
+CREATE INDEX "book_contributors_author_id_idx" ON "public"."book_contributors" ("author_id");
+-- Move the single author of every book to "book_contributors"
+INSERT INTO "public"."book_contributors" ("book_id", "author_id", "role", "position")
+SELECT "id", "author_id", 'author', 0 FROM "public"."books";
+-- Modify "books" table
+ALTER TABLE "public"."books" DROP CONSTRAINT "books_author_id_fkey", DROP COLUMN "author_id";
@@ -0,0 +1,37 @@
+-- Create "editions" table
+CREATE TABLE "public"."editions" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "book_id" uuid NOT NULL,
+ "format" character varying NOT NULL,
+ "isbn13" character varying NULL,
+ "isbn10" character varying NULL,
+ "publisher_id" uuid NOT NULL,
+ "publication_year" bigint NULL,
+ "page_count" bigint NULL,
+ "price" double precision NOT NULL DEFAULT 0,
+ "stock" bigint NOT NULL DEFAULT 0,
+ "version" bigint NOT NULL DEFAULT 1,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "editions_isbn10_key" UNIQUE ("isbn10"),
+ CONSTRAINT "editions_isbn13_key" UNIQUE ("isbn13"),
+ CONSTRAINT "editions_book_id_fkey" FOREIGN KEY ("book_id") REFERENCES "public"."books" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "editions_publisher_id_fkey" FOREIGN KEY ("publisher_id") REFERENCES "public"."publishers" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "editions_book_id_idx" to table: "editions"
+CREATE INDEX "editions_book_id_idx" ON "public"."editions" ("book_id");
+-- Every existing book becomes a paperback edition with the same id, so
+-- cart and order items keep pointing at the same sellable item
+INSERT INTO "public"."editions" ("id", "book_id", "format", "isbn13", "isbn10", "publisher_id", "price", "stock", "created_at", "updated_at")
+SELECT "id", "id", 'paperback', "isbn13", "isbn10", "publisher_id", "price", "stock", "created_at", "updated_at" FROM "public"."books";
+-- Modify "cart_items" table
+ALTER TABLE "public"."cart_items" DROP CONSTRAINT "cart_items_book_id_fkey";
+ALTER TABLE "public"."cart_items" RENAME COLUMN "book_id" TO "edition_id";
+ALTER TABLE "public"."cart_items" ADD CONSTRAINT "cart_items_edition_id_fkey" FOREIGN KEY ("edition_id") REFERENCES "public"."editions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Modify "order_items" table
+ALTER TABLE "public"."order_items" DROP CONSTRAINT "order_items_book_id_fkey";
+ALTER TABLE "public"."order_items" RENAME COLUMN "book_id" TO "edition_id";
+ALTER TABLE "public"."order_items" ADD CONSTRAINT "order_items_edition_id_fkey" FOREIGN KEY ("edition_id") REFERENCES "public"."editions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Modify "books" table
+ALTER TABLE "public"."books" DROP CONSTRAINT "books_publisher_id_fkey", DROP CONSTRAINT "books_isbn13_key", DROP CONSTRAINT "books_isbn10_key", DROP COLUMN "isbn13", DROP COLUMN "isbn10", DROP COLUMN "price", DROP COLUMN "stock", DROP COLUMN "publisher_id";
@@ -0,0 +1,25 @@
+-- Modify "categories" table
+ALTER TABLE "public"."categories" ADD COLUMN "slug" character varying NULL, ADD COLUMN "parent_id" uuid NULL, ADD COLUMN "sort_order" bigint NOT NULL DEFAULT 0;
+-- Existing categories get a slug the way slug.Make builds one: Russian letters
+-- are transliterated, other letters dropped and the remaining words joined
+-- with hyphens; names without any fall back to "category", duplicates get a
+-- numeric suffix
+WITH "transliterated" AS (
+ SELECT "id", translate(
+  replace(replace(replace(replace(replace(replace(replace(replace(
+   lower(translate("name", 'АБВГДЕЁЖЗИЙКЛМНОПРСТУФХЦЧШЩЪЫЬЭЮЯ', 'абвгдеёжзийклмнопрстуфхцчшщъыьэюя')),
+   'щ', 'shch'), 'ж', 'zh'), 'х', 'kh'), 'ц', 'ts'), 'ч', 'ch'), 'ш', 'sh'), 'ю', 'yu'), 'я', 'ya'),
+  'абвгдеёзийклмнопрстуфыэъь''’', 'abvgdeeziyklmnoprstufye') AS "name"
+ FROM "public"."categories"
+), "base" AS (
+ SELECT "id", COALESCE(NULLIF(trim(BOTH '-' FROM regexp_replace(regexp_replace(regexp_replace("name", '[^a-z0-9[:alpha:][:digit:]]+', '-', 'g'), '[^a-z0-9-]', '', 'g'), '-+', '-', 'g')), ''), 'category') AS "slug"
+ FROM "transliterated"
+), "numbered" AS (
+ SELECT "id", "slug", row_number() OVER (PARTITION BY "slug" ORDER BY "id") AS "n" FROM "base"
+)
+UPDATE "public"."categories" AS "c"
+SET "slug" = CASE WHEN "numbered"."n" = 1 THEN "numbered"."slug" ELSE "numbered"."slug" || '-' || "numbered"."n" END
+FROM "numbered" WHERE "numbered"."id" = "c"."id";
+ALTER TABLE "public"."categories" ALTER COLUMN "slug" SET NOT NULL, ADD CONSTRAINT "categories_slug_key" UNIQUE ("slug"), ADD CONSTRAINT "categories_parent_id_fkey" FOREIGN KEY ("parent_id") REFERENCES "public"."categories" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Create index "categories_parent_id_idx" to table: "categories"
+CREATE INDEX "categories_parent_id_idx" ON "public"."categories" ("parent_id");
@@ -0,0 +1,14 @@
+-- Create "series" table
+CREATE TABLE "public"."series" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "name" character varying NOT NULL,
+ "description" character varying NULL,
+ "version" bigint NOT NULL DEFAULT 1,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id")
+);
+-- Modify "books" table
+ALTER TABLE "public"."books" ADD COLUMN "series_id" uuid NULL, ADD COLUMN "series_position" double precision NULL, ADD CONSTRAINT "books_series_id_fkey" FOREIGN KEY ("series_id") REFERENCES "public"."series" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Create index "books_series_id_idx" to table: "books"
+CREATE INDEX "books_series_id_idx" ON "public"."books" ("series_id");
@@ -0,0 +1,4 @@
+-- Modify "authors" table
+ALTER TABLE "public"."authors" ADD COLUMN "photo" jsonb NULL;
+-- Modify "books" table
+ALTER TABLE "public"."books" ADD COLUMN "cover" jsonb NULL;
@@ -0,0 +1,26 @@
+-- Modify "books" table
+ALTER TABLE "public"."books" ADD COLUMN "rating_average" double precision NOT NULL DEFAULT 0, ADD COLUMN "rating_count" bigint NOT NULL DEFAULT 0;
+-- Create "reviews" table
+CREATE TABLE "public"."reviews" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "book_id" uuid NOT NULL,
+ "user_id" uuid NOT NULL,
+ "rating" bigint NOT NULL,
+ "text" character varying NOT NULL DEFAULT '',
+ "status" character varying NOT NULL DEFAULT 'pending',
+ "moderation_reason" character varying NULL,
+ "moderated_by" uuid NULL,
+ "moderated_at" timestamptz NULL,
+ "version" bigint NOT NULL DEFAULT 1,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "reviews_book_id_user_id_key" UNIQUE ("book_id", "user_id"),
+ CONSTRAINT "reviews_book_id_fkey" FOREIGN KEY ("book_id") REFERENCES "public"."books" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "reviews_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "reviews_rating_check" CHECK (("rating" >= 1) AND ("rating" <= 5))
+);
+-- Create index "reviews_book_id_status_idx" to table: "reviews"
+CREATE INDEX "reviews_book_id_status_idx" ON "public"."reviews" ("book_id", "status");
+-- Create index "reviews_status_created_at_idx" to table: "reviews"
+CREATE INDEX "reviews_status_created_at_idx" ON "public"."reviews" ("status", "created_at");
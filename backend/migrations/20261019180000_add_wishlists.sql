@@ -0,0 +1,27 @@
+-- Create "wishlists" table
+CREATE TABLE "public"."wishlists" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "user_id" uuid NOT NULL,
+ "name" character varying NOT NULL,
+ "share_token" character varying NULL,
+ "version" bigint NOT NULL DEFAULT 1,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "wishlists_share_token_key" UNIQUE ("share_token"),
+ CONSTRAINT "wishlists_user_id_name_key" UNIQUE ("user_id", "name"),
+ CONSTRAINT "wishlists_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create "wishlist_items" table
+CREATE TABLE "public"."wishlist_items" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "wishlist_id" uuid NOT NULL,
+ "edition_id" uuid NOT NULL,
+ "added_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "wishlist_items_wishlist_id_edition_id_key" UNIQUE ("wishlist_id", "edition_id"),
+ CONSTRAINT "wishlist_items_edition_id_fkey" FOREIGN KEY ("edition_id") REFERENCES "public"."editions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "wishlist_items_wishlist_id_fkey" FOREIGN KEY ("wishlist_id") REFERENCES "public"."wishlists" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "wishlist_items_edition_id_idx" to table: "wishlist_items"
+CREATE INDEX "wishlist_items_edition_id_idx" ON "public"."wishlist_items" ("edition_id");
@@ -0,0 +1,37 @@
+-- Create "stock_subscriptions" table
+CREATE TABLE "public"."stock_subscriptions" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "user_id" uuid NOT NULL,
+ "book_id" uuid NOT NULL,
+ "kind" character varying NOT NULL,
+ "threshold" double precision NULL,
+ "unsubscribe_token" character varying NOT NULL,
+ "armed" boolean NOT NULL DEFAULT true,
+ "notified_at" timestamptz NULL,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "stock_subscriptions_unsubscribe_token_key" UNIQUE ("unsubscribe_token"),
+ CONSTRAINT "stock_subscriptions_user_id_book_id_kind_key" UNIQUE ("user_id", "book_id", "kind"),
+ CONSTRAINT "stock_subscriptions_book_id_fkey" FOREIGN KEY ("book_id") REFERENCES "public"."books" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "stock_subscriptions_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "stock_subscriptions_book_id_idx" to table: "stock_subscriptions"
+CREATE INDEX "stock_subscriptions_book_id_idx" ON "public"."stock_subscriptions" ("book_id");
+-- Create "notification_outbox" table
+CREATE TABLE "public"."notification_outbox" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "user_id" uuid NOT NULL,
+ "kind" character varying NOT NULL,
+ "payload" jsonb NOT NULL,
+ "dedup_key" character varying NOT NULL,
+ "status" character varying NOT NULL DEFAULT 'pending',
+ "attempts" bigint NOT NULL DEFAULT 0,
+ "last_error" character varying NULL,
+ "available_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ "sent_at" timestamptz NULL,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "notification_outbox_dedup_key_key" UNIQUE ("dedup_key")
+);
+-- Create index "notification_outbox_pending_idx" to table: "notification_outbox"
+CREATE INDEX "notification_outbox_pending_idx" ON "public"."notification_outbox" ("available_at") WHERE ((status)::text = 'pending'::text);
@@ -0,0 +1,12 @@
+-- Create "related_books" table
+CREATE TABLE "public"."related_books" (
+ "book_id" uuid NOT NULL,
+ "related_book_id" uuid NOT NULL,
+ "co_purchases" bigint NOT NULL DEFAULT 0,
+ "affinity" bigint NOT NULL DEFAULT 0,
+ PRIMARY KEY ("book_id", "related_book_id"),
+ CONSTRAINT "related_books_book_id_fkey" FOREIGN KEY ("book_id") REFERENCES "public"."books" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "related_books_related_book_id_fkey" FOREIGN KEY ("related_book_id") REFERENCES "public"."books" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "related_books_related_book_id_idx" to table: "related_books"
+CREATE INDEX "related_books_related_book_id_idx" ON "public"."related_books" ("related_book_id");
@@ -0,0 +1,8 @@
+-- Modify "editions" table
+ALTER TABLE "public"."editions" ALTER COLUMN "price" TYPE bigint USING round("price" * 100)::bigint;
+-- Modify "orders" table
+ALTER TABLE "public"."orders" ALTER COLUMN "total_price" TYPE bigint USING round("total_price" * 100)::bigint;
+-- Modify "payments" table
+ALTER TABLE "public"."payments" ALTER COLUMN "amount" TYPE bigint USING round("amount" * 100)::bigint;
+-- Modify "stock_subscriptions" table
+ALTER TABLE "public"."stock_subscriptions" ALTER COLUMN "threshold" TYPE bigint USING round("threshold" * 100)::bigint;
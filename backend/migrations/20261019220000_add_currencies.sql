@@ -0,0 +1,35 @@
+-- Create "currencies" table
+CREATE TABLE "public"."currencies" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "code" character varying NOT NULL,
+ "name" character varying NOT NULL,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "currencies_code_key" UNIQUE ("code")
+);
+-- Create "exchange_rates" table
+CREATE TABLE "public"."exchange_rates" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "currency" character varying NOT NULL,
+ "rate" numeric(18,6) NOT NULL,
+ "valid_from" timestamptz NOT NULL,
+ "source" character varying NOT NULL DEFAULT 'manual',
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "exchange_rates_currency_valid_from_key" UNIQUE ("currency", "valid_from"),
+ CONSTRAINT "exchange_rates_currency_fkey" FOREIGN KEY ("currency") REFERENCES "public"."currencies" ("code") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create "edition_prices" table
+CREATE TABLE "public"."edition_prices" (
+ "edition_id" uuid NOT NULL,
+ "currency" character varying NOT NULL,
+ "price" bigint NOT NULL,
+ "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("edition_id", "currency"),
+ CONSTRAINT "edition_prices_currency_fkey" FOREIGN KEY ("currency") REFERENCES "public"."currencies" ("code") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "edition_prices_edition_id_fkey" FOREIGN KEY ("edition_id") REFERENCES "public"."editions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Modify "orders" table
+ALTER TABLE "public"."orders" ADD COLUMN "currency" character varying NOT NULL DEFAULT 'RUB', ADD COLUMN "exchange_rate" numeric(18,6) NOT NULL DEFAULT 1;
+-- Seed the currencies we sell in
+INSERT INTO "public"."currencies" ("code", "name") VALUES ('RUB', 'Russian ruble'), ('BYN', 'Belarusian ruble'), ('KZT', 'Kazakhstani tenge');
@@ -0,0 +1,42 @@
+-- Create "promotions" table
+CREATE TABLE "public"."promotions" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "name" character varying NOT NULL,
+ "kind" character varying NOT NULL,
+ "percent" bigint NOT NULL DEFAULT 0,
+ "amount" bigint NOT NULL DEFAULT 0,
+ "scope" character varying NOT NULL DEFAULT 'all',
+ "target_ids" jsonb NOT NULL DEFAULT '[]',
+ "starts_at" timestamptz NOT NULL,
+ "ends_at" timestamptz NULL,
+ "min_order_total" bigint NOT NULL DEFAULT 0,
+ "coupon_code" character varying NULL,
+ "usage_limit" bigint NULL,
+ "per_user_limit" bigint NULL,
+ "exclusive" boolean NOT NULL DEFAULT false,
+ "active" boolean NOT NULL DEFAULT true,
+ "version" bigint NOT NULL DEFAULT 1,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "promotions_coupon_code_key" UNIQUE ("coupon_code")
+);
+-- Create "coupon_redemptions" table
+CREATE TABLE "public"."coupon_redemptions" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "promotion_id" uuid NOT NULL,
+ "user_id" uuid NOT NULL,
+ "order_id" uuid NOT NULL,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "coupon_redemptions_order_id_key" UNIQUE ("order_id"),
+ CONSTRAINT "coupon_redemptions_order_id_fkey" FOREIGN KEY ("order_id") REFERENCES "public"."orders" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "coupon_redemptions_promotion_id_fkey" FOREIGN KEY ("promotion_id") REFERENCES "public"."promotions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "coupon_redemptions_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "coupon_redemptions_promotion_id_user_id_idx" to table: "coupon_redemptions"
+CREATE INDEX "coupon_redemptions_promotion_id_user_id_idx" ON "public"."coupon_redemptions" ("promotion_id", "user_id");
+-- Modify "orders" table
+ALTER TABLE "public"."orders" ADD COLUMN "discount" bigint NOT NULL DEFAULT 0, ADD COLUMN "coupon_code" character varying NULL;
+-- Modify "order_items" table
+ALTER TABLE "public"."order_items" ADD COLUMN "price" bigint NOT NULL DEFAULT 0, ADD COLUMN "discount" bigint NOT NULL DEFAULT 0, ADD COLUMN "promotions" jsonb NOT NULL DEFAULT '[]';
@@ -0,0 +1,6 @@
+-- Modify "books" table
+ALTER TABLE "public"."books" ADD COLUMN "language" character varying NOT NULL DEFAULT 'ru';
+-- Modify "orders" table
+ALTER TABLE "public"."orders" ADD COLUMN "tax" bigint NOT NULL DEFAULT 0, ADD COLUMN "taxes" jsonb NOT NULL DEFAULT '[]';
+-- Modify "order_items" table
+ALTER TABLE "public"."order_items" ADD COLUMN "tax_rate" bigint NOT NULL DEFAULT 0, ADD COLUMN "tax" bigint NOT NULL DEFAULT 0;
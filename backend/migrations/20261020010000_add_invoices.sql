@@ -0,0 +1,24 @@
+-- Create "invoice_counters" table
+CREATE TABLE "public"."invoice_counters" (
+ "year" bigint NOT NULL,
+ "last_number" bigint NOT NULL,
+ PRIMARY KEY ("year")
+);
+-- Create "invoices" table
+CREATE TABLE "public"."invoices" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "order_id" uuid NOT NULL,
+ "year" bigint NOT NULL,
+ "number" bigint NOT NULL,
+ "code" character varying NOT NULL,
+ "payment_status" character varying NOT NULL,
+ "key" character varying NOT NULL,
+ "size" bigint NOT NULL,
+ "issued_at" timestamptz NOT NULL,
+ "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "invoices_code_key" UNIQUE ("code"),
+ CONSTRAINT "invoices_order_id_key" UNIQUE ("order_id"),
+ CONSTRAINT "invoices_year_number_key" UNIQUE ("year", "number"),
+ CONSTRAINT "invoices_order_id_fkey" FOREIGN KEY ("order_id") REFERENCES "public"."orders" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
@@ -0,0 +1,25 @@
+-- Modify "orders" table
+ALTER TABLE "public"."orders" ADD COLUMN "points_redeemed" bigint NOT NULL DEFAULT 0, ADD COLUMN "points_value" bigint NOT NULL DEFAULT 0;
+-- Create "loyalty_transactions" table
+CREATE TABLE "public"."loyalty_transactions" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "user_id" uuid NOT NULL,
+ "kind" character varying NOT NULL,
+ "points" bigint NOT NULL,
+ "remaining" bigint NOT NULL DEFAULT 0,
+ "expires_at" timestamptz NULL,
+ "order_id" uuid NULL,
+ "reason" character varying NULL,
+ "created_by" uuid NULL,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "loyalty_transactions_created_by_fkey" FOREIGN KEY ("created_by") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "loyalty_transactions_order_id_fkey" FOREIGN KEY ("order_id") REFERENCES "public"."orders" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "loyalty_transactions_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "loyalty_transactions_user_id_created_at_idx" to table: "loyalty_transactions"
+CREATE INDEX "loyalty_transactions_user_id_created_at_idx" ON "public"."loyalty_transactions" ("user_id", "created_at");
+-- Create index "loyalty_transactions_order_id_idx" to table: "loyalty_transactions"
+CREATE INDEX "loyalty_transactions_order_id_idx" ON "public"."loyalty_transactions" ("order_id");
+-- Create index "loyalty_transactions_expires_at_idx" to table: "loyalty_transactions"
+CREATE INDEX "loyalty_transactions_expires_at_idx" ON "public"."loyalty_transactions" ("expires_at") WHERE (remaining > 0);
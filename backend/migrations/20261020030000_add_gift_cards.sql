@@ -0,0 +1,44 @@
+-- Modify "orders" table
+ALTER TABLE "public"."orders" ADD COLUMN "gift_card_value" bigint NOT NULL DEFAULT 0;
+-- Create "gift_cards" table
+CREATE TABLE "public"."gift_cards" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "kind" character varying NOT NULL,
+ "code_hash" character varying NULL,
+ "last_digits" character varying NULL,
+ "initial_amount" bigint NOT NULL,
+ "balance" bigint NOT NULL,
+ "expires_at" timestamptz NOT NULL,
+ "owner_id" uuid NULL,
+ "purchased_by" uuid NULL,
+ "issued_by" uuid NULL,
+ "reason" character varying NULL,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "gift_cards_code_hash_key" UNIQUE ("code_hash"),
+ CONSTRAINT "gift_cards_issued_by_fkey" FOREIGN KEY ("issued_by") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "gift_cards_owner_id_fkey" FOREIGN KEY ("owner_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "gift_cards_purchased_by_fkey" FOREIGN KEY ("purchased_by") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "gift_cards_balance_check" CHECK ("balance" >= 0)
+);
+-- Create index "gift_cards_owner_id_idx" to table: "gift_cards"
+CREATE INDEX "gift_cards_owner_id_idx" ON "public"."gift_cards" ("owner_id");
+-- Create index "gift_cards_purchased_by_idx" to table: "gift_cards"
+CREATE INDEX "gift_cards_purchased_by_idx" ON "public"."gift_cards" ("purchased_by");
+-- Create "gift_card_transactions" table
+CREATE TABLE "public"."gift_card_transactions" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "gift_card_id" uuid NOT NULL,
+ "kind" character varying NOT NULL,
+ "amount" bigint NOT NULL,
+ "order_id" uuid NULL,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "gift_card_transactions_gift_card_id_fkey" FOREIGN KEY ("gift_card_id") REFERENCES "public"."gift_cards" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "gift_card_transactions_order_id_fkey" FOREIGN KEY ("order_id") REFERENCES "public"."orders" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "gift_card_transactions_gift_card_id_idx" to table: "gift_card_transactions"
+CREATE INDEX "gift_card_transactions_gift_card_id_idx" ON "public"."gift_card_transactions" ("gift_card_id");
+-- Create index "gift_card_transactions_order_id_idx" to table: "gift_card_transactions"
+CREATE INDEX "gift_card_transactions_order_id_idx" ON "public"."gift_card_transactions" ("order_id");
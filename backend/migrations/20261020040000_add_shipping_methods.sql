@@ -0,0 +1,29 @@
+-- Modify "editions" table
+ALTER TABLE "public"."editions" ADD COLUMN "weight" bigint NULL;
+-- Create "shipping_zones" table
+CREATE TABLE "public"."shipping_zones" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "name" character varying NOT NULL,
+ "postal_prefixes" jsonb NOT NULL DEFAULT '[]',
+ "version" bigint NOT NULL DEFAULT 1,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id")
+);
+-- Create "shipping_methods" table
+CREATE TABLE "public"."shipping_methods" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "name" character varying NOT NULL,
+ "kind" character varying NOT NULL,
+ "rates" jsonb NOT NULL DEFAULT '[]',
+ "free_over" bigint NULL,
+ "active" boolean NOT NULL DEFAULT true,
+ "version" bigint NOT NULL DEFAULT 1,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id")
+);
+-- Modify "orders" table
+ALTER TABLE "public"."orders" ADD COLUMN "shipping_method_id" uuid NULL, ADD COLUMN "shipping_cost" bigint NOT NULL DEFAULT 0, ADD CONSTRAINT "orders_shipping_method_id_fkey" FOREIGN KEY ("shipping_method_id") REFERENCES "public"."shipping_methods" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "deliveries" table
+ALTER TABLE "public"."deliveries" ADD COLUMN "postal_code" character varying NOT NULL DEFAULT '', ADD COLUMN "shipping_method_id" uuid NULL, ADD COLUMN "method" character varying NOT NULL DEFAULT '', ADD COLUMN "weight" bigint NOT NULL DEFAULT 0, ADD COLUMN "cost" bigint NOT NULL DEFAULT 0, ADD CONSTRAINT "deliveries_shipping_method_id_fkey" FOREIGN KEY ("shipping_method_id") REFERENCES "public"."shipping_methods" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
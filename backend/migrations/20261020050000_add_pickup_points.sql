@@ -0,0 +1,17 @@
+-- Create "pickup_points" table
+CREATE TABLE "public"."pickup_points" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "name" character varying NOT NULL,
+ "address" character varying NOT NULL,
+ "postal_code" character varying NOT NULL DEFAULT '',
+ "hours" character varying NOT NULL DEFAULT '',
+ "latitude" double precision NOT NULL,
+ "longitude" double precision NOT NULL,
+ "active" boolean NOT NULL DEFAULT true,
+ "version" bigint NOT NULL DEFAULT 1,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id")
+);
+-- Modify "deliveries" table
+ALTER TABLE "public"."deliveries" ADD COLUMN "pickup_point_id" uuid NULL, ADD COLUMN "pickup_code" character varying NULL, ADD CONSTRAINT "deliveries_pickup_point_id_fkey" FOREIGN KEY ("pickup_point_id") REFERENCES "public"."pickup_points" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
@@ -0,0 +1,52 @@
+-- Create "warehouses" table
+CREATE TABLE "public"."warehouses" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "name" character varying NOT NULL,
+ "address" character varying NOT NULL DEFAULT '',
+ "pickup_point_id" uuid NULL,
+ "priority" bigint NOT NULL DEFAULT 0,
+ "active" boolean NOT NULL DEFAULT true,
+ "version" bigint NOT NULL DEFAULT 1,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "warehouses_pickup_point_id_fkey" FOREIGN KEY ("pickup_point_id") REFERENCES "public"."pickup_points" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create "edition_stocks" table
+CREATE TABLE "public"."edition_stocks" (
+ "edition_id" uuid NOT NULL,
+ "warehouse_id" uuid NOT NULL,
+ "quantity" bigint NOT NULL DEFAULT 0,
+ "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("edition_id", "warehouse_id"),
+ CONSTRAINT "edition_stocks_edition_id_fkey" FOREIGN KEY ("edition_id") REFERENCES "public"."editions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "edition_stocks_warehouse_id_fkey" FOREIGN KEY ("warehouse_id") REFERENCES "public"."warehouses" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create "stock_transfers" table
+CREATE TABLE "public"."stock_transfers" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "from_warehouse_id" uuid NOT NULL,
+ "to_warehouse_id" uuid NOT NULL,
+ "items" jsonb NOT NULL DEFAULT '[]',
+ "note" character varying NULL,
+ "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "stock_transfers_from_warehouse_id_fkey" FOREIGN KEY ("from_warehouse_id") REFERENCES "public"."warehouses" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "stock_transfers_to_warehouse_id_fkey" FOREIGN KEY ("to_warehouse_id") REFERENCES "public"."warehouses" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "stock_transfers_created_at_idx" to table: "stock_transfers"
+CREATE INDEX "stock_transfers_created_at_idx" ON "public"."stock_transfers" ("created_at");
+-- Create "stock_allocations" table
+CREATE TABLE "public"."stock_allocations" (
+ "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+ "order_item_id" uuid NOT NULL,
+ "warehouse_id" uuid NOT NULL,
+ "quantity" bigint NOT NULL,
+ PRIMARY KEY ("id"),
+ CONSTRAINT "stock_allocations_order_item_id_fkey" FOREIGN KEY ("order_item_id") REFERENCES "public"."order_items" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+ CONSTRAINT "stock_allocations_warehouse_id_fkey" FOREIGN KEY ("warehouse_id") REFERENCES "public"."warehouses" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Move the existing stock to the central warehouse
+INSERT INTO "public"."warehouses" ("name") VALUES ('Central warehouse');
+INSERT INTO "public"."edition_stocks" ("edition_id", "warehouse_id", "quantity")
+SELECT "editions"."id", "warehouses"."id", "editions"."stock" FROM "public"."editions" CROSS JOIN "public"."warehouses" WHERE "editions"."stock" > 0;
@@ -0,0 +1,9 @@
+-- Merge duplicate "cart_items" rows into one per cart and edition
+UPDATE "public"."cart_items" AS "kept" SET "quantity" = "dup"."quantity"
+FROM (SELECT min("id"::text)::uuid AS "id", sum("quantity") AS "quantity" FROM "public"."cart_items" GROUP BY "cart_id", "edition_id" HAVING count(*) > 1) AS "dup"
+WHERE "kept"."id" = "dup"."id";
+DELETE FROM "public"."cart_items" AS "c"
+USING "public"."cart_items" AS "other"
+WHERE "c"."cart_id" = "other"."cart_id" AND "c"."edition_id" = "other"."edition_id" AND "c"."id"::text > "other"."id"::text;
+-- Modify "cart_items" table
+ALTER TABLE "public"."cart_items" ADD CONSTRAINT "cart_items_cart_id_edition_id_key" UNIQUE ("cart_id", "edition_id");
@@ -0,0 +1,2 @@
+-- Modify "invoices" table
+ALTER TABLE "public"."invoices" ADD COLUMN "order_snapshot" jsonb NULL;
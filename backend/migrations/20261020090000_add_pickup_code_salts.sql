@@ -0,0 +1,2 @@
+-- Modify "deliveries" table
+ALTER TABLE "public"."deliveries" DROP COLUMN "pickup_code", ADD COLUMN "pickup_code_salt" character varying NULL, ADD COLUMN "pickup_attempts" bigint NOT NULL DEFAULT 0;
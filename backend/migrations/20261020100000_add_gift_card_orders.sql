@@ -0,0 +1,6 @@
+-- Modify "orders" table
+ALTER TABLE "public"."orders" ADD COLUMN "gift_cards_bought" bigint NOT NULL DEFAULT 0;
+-- Modify "gift_cards" table
+ALTER TABLE "public"."gift_cards" ADD COLUMN "order_id" uuid NULL, ADD CONSTRAINT "gift_cards_order_id_fkey" FOREIGN KEY ("order_id") REFERENCES "public"."orders" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Create index "gift_cards_order_id_idx" to table: "gift_cards"
+CREATE INDEX "gift_cards_order_id_idx" ON "public"."gift_cards" ("order_id");
@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: AuditRecorderInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockAuditRecorderInterface is a mock of AuditRecorderInterface interface.
+type MockAuditRecorderInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRecorderInterfaceMockRecorder
+}
+
+// MockAuditRecorderInterfaceMockRecorder is the mock recorder for MockAuditRecorderInterface.
+type MockAuditRecorderInterfaceMockRecorder struct {
+	mock *MockAuditRecorderInterface
+}
+
+// NewMockAuditRecorderInterface creates a new mock instance.
+func NewMockAuditRecorderInterface(ctrl *gomock.Controller) *MockAuditRecorderInterface {
+	mock := &MockAuditRecorderInterface{ctrl: ctrl}
+	mock.recorder = &MockAuditRecorderInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRecorderInterface) EXPECT() *MockAuditRecorderInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockAuditRecorderInterface) Record(arg0 context.Context, arg1, arg2 string, arg3 uuid.UUID, arg4, arg5 interface{}) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditRecorderInterfaceMockRecorder) Record(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditRecorderInterface)(nil).Record), arg0, arg1, arg2, arg3, arg4, arg5)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: AuditRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuditRepositoryInterface is a mock of AuditRepositoryInterface interface.
+type MockAuditRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepositoryInterfaceMockRecorder
+}
+
+// MockAuditRepositoryInterfaceMockRecorder is the mock recorder for MockAuditRepositoryInterface.
+type MockAuditRepositoryInterfaceMockRecorder struct {
+	mock *MockAuditRepositoryInterface
+}
+
+// NewMockAuditRepositoryInterface creates a new mock instance.
+func NewMockAuditRepositoryInterface(ctrl *gomock.Controller) *MockAuditRepositoryInterface {
+	mock := &MockAuditRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepositoryInterface) EXPECT() *MockAuditRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAuditRepositoryInterface) Create(arg0 context.Context, arg1 *models.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuditRepositoryInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditRepositoryInterface)(nil).Create), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockAuditRepositoryInterface) GetAll(arg0 context.Context, arg1 dto.AuditFilter) ([]models.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]models.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockAuditRepositoryInterfaceMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAuditRepositoryInterface)(nil).GetAll), arg0, arg1)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: AuditServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockAuditServiceInterface is a mock of AuditServiceInterface interface.
+type MockAuditServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditServiceInterfaceMockRecorder
+}
+
+// MockAuditServiceInterfaceMockRecorder is the mock recorder for MockAuditServiceInterface.
+type MockAuditServiceInterfaceMockRecorder struct {
+	mock *MockAuditServiceInterface
+}
+
+// NewMockAuditServiceInterface creates a new mock instance.
+func NewMockAuditServiceInterface(ctrl *gomock.Controller) *MockAuditServiceInterface {
+	mock := &MockAuditServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockAuditServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditServiceInterface) EXPECT() *MockAuditServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockAuditServiceInterface) GetAll(arg0 context.Context, arg1 dto.AuditFilter) ([]models.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]models.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockAuditServiceInterfaceMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAuditServiceInterface)(nil).GetAll), arg0, arg1)
+}
+
+// Record mocks base method.
+func (m *MockAuditServiceInterface) Record(arg0 context.Context, arg1, arg2 string, arg3 uuid.UUID, arg4, arg5 interface{}) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditServiceInterfaceMockRecorder) Record(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditServiceInterface)(nil).Record), arg0, arg1, arg2, arg3, arg4, arg5)
+}
@@ -51,17 +51,17 @@ func (mr *MockAuthorRepositoryInterfaceMockRecorder) Create(arg0, arg1 interface
 }
 
 // Delete mocks base method.
-func (m *MockAuthorRepositoryInterface) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+func (m *MockAuthorRepositoryInterface) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockAuthorRepositoryInterfaceMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockAuthorRepositoryInterfaceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAuthorRepositoryInterface)(nil).Delete), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAuthorRepositoryInterface)(nil).Delete), arg0, arg1, arg2)
 }
 
 // GetAll mocks base method.
@@ -53,17 +53,17 @@ func (mr *MockAuthorServiceInterfaceMockRecorder) Create(arg0, arg1 interface{})
 }
 
 // Delete mocks base method.
-func (m *MockAuthorServiceInterface) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+func (m *MockAuthorServiceInterface) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockAuthorServiceInterfaceMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockAuthorServiceInterfaceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAuthorServiceInterface)(nil).Delete), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAuthorServiceInterface)(nil).Delete), arg0, arg1, arg2)
 }
 
 // GetAll mocks base method.
@@ -96,17 +96,32 @@ func (mr *MockAuthorServiceInterfaceMockRecorder) GetByID(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAuthorServiceInterface)(nil).GetByID), arg0, arg1)
 }
 
+// Patch mocks base method.
+func (m *MockAuthorServiceInterface) Patch(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 []byte) (*models.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockAuthorServiceInterfaceMockRecorder) Patch(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockAuthorServiceInterface)(nil).Patch), arg0, arg1, arg2, arg3)
+}
+
 // Update mocks base method.
-func (m *MockAuthorServiceInterface) Update(arg0 context.Context, arg1 uuid.UUID, arg2 dto.AuthorInput) (*models.Author, error) {
+func (m *MockAuthorServiceInterface) Update(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 dto.AuthorInput) (*models.Author, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*models.Author)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockAuthorServiceInterfaceMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockAuthorServiceInterfaceMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAuthorServiceInterface)(nil).Update), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAuthorServiceInterface)(nil).Update), arg0, arg1, arg2, arg3)
 }
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: BookRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockBookRepositoryInterface is a mock of BookRepositoryInterface interface.
+type MockBookRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookRepositoryInterfaceMockRecorder
+}
+
+// MockBookRepositoryInterfaceMockRecorder is the mock recorder for MockBookRepositoryInterface.
+type MockBookRepositoryInterfaceMockRecorder struct {
+	mock *MockBookRepositoryInterface
+}
+
+// NewMockBookRepositoryInterface creates a new mock instance.
+func NewMockBookRepositoryInterface(ctrl *gomock.Controller) *MockBookRepositoryInterface {
+	mock := &MockBookRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockBookRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookRepositoryInterface) EXPECT() *MockBookRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBookRepositoryInterface) Create(arg0 context.Context, arg1 *models.Book, arg2 []models.BookContributor, arg3 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBookRepositoryInterfaceMockRecorder) Create(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBookRepositoryInterface)(nil).Create), arg0, arg1, arg2, arg3)
+}
+
+// Delete mocks base method.
+func (m *MockBookRepositoryInterface) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBookRepositoryInterfaceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBookRepositoryInterface)(nil).Delete), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockBookRepositoryInterface) GetAll(arg0 context.Context, arg1 dto.BookFilter) ([]models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockBookRepositoryInterfaceMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBookRepositoryInterface)(nil).GetAll), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockBookRepositoryInterface) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockBookRepositoryInterfaceMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBookRepositoryInterface)(nil).GetByID), arg0, arg1)
+}
+
+// GetByISBN mocks base method.
+func (m *MockBookRepositoryInterface) GetByISBN(arg0 context.Context, arg1 string) (*models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByISBN", arg0, arg1)
+	ret0, _ := ret[0].(*models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByISBN indicates an expected call of GetByISBN.
+func (mr *MockBookRepositoryInterfaceMockRecorder) GetByISBN(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByISBN", reflect.TypeOf((*MockBookRepositoryInterface)(nil).GetByISBN), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockBookRepositoryInterface) Update(arg0 context.Context, arg1 *models.Book, arg2 []models.BookContributor, arg3 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBookRepositoryInterfaceMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBookRepositoryInterface)(nil).Update), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: BookServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockBookServiceInterface is a mock of BookServiceInterface interface.
+type MockBookServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookServiceInterfaceMockRecorder
+}
+
+// MockBookServiceInterfaceMockRecorder is the mock recorder for MockBookServiceInterface.
+type MockBookServiceInterfaceMockRecorder struct {
+	mock *MockBookServiceInterface
+}
+
+// NewMockBookServiceInterface creates a new mock instance.
+func NewMockBookServiceInterface(ctrl *gomock.Controller) *MockBookServiceInterface {
+	mock := &MockBookServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockBookServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookServiceInterface) EXPECT() *MockBookServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBookServiceInterface) Create(arg0 context.Context, arg1 dto.BookInput) (*models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBookServiceInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBookServiceInterface)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockBookServiceInterface) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBookServiceInterfaceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBookServiceInterface)(nil).Delete), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockBookServiceInterface) GetAll(arg0 context.Context, arg1 dto.BookFilter) ([]models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockBookServiceInterfaceMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBookServiceInterface)(nil).GetAll), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockBookServiceInterface) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockBookServiceInterfaceMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBookServiceInterface)(nil).GetByID), arg0, arg1)
+}
+
+// GetByISBN mocks base method.
+func (m *MockBookServiceInterface) GetByISBN(arg0 context.Context, arg1 string) (*models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByISBN", arg0, arg1)
+	ret0, _ := ret[0].(*models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByISBN indicates an expected call of GetByISBN.
+func (mr *MockBookServiceInterfaceMockRecorder) GetByISBN(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByISBN", reflect.TypeOf((*MockBookServiceInterface)(nil).GetByISBN), arg0, arg1)
+}
+
+// GetDetails mocks base method.
+func (m *MockBookServiceInterface) GetDetails(arg0 context.Context, arg1 uuid.UUID) (*dto.BookDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDetails", arg0, arg1)
+	ret0, _ := ret[0].(*dto.BookDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDetails indicates an expected call of GetDetails.
+func (mr *MockBookServiceInterfaceMockRecorder) GetDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetails", reflect.TypeOf((*MockBookServiceInterface)(nil).GetDetails), arg0, arg1)
+}
+
+// Patch mocks base method.
+func (m *MockBookServiceInterface) Patch(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 []byte) (*models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockBookServiceInterfaceMockRecorder) Patch(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockBookServiceInterface)(nil).Patch), arg0, arg1, arg2, arg3)
+}
+
+// Update mocks base method.
+func (m *MockBookServiceInterface) Update(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 dto.BookInput) (*models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBookServiceInterfaceMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBookServiceInterface)(nil).Update), arg0, arg1, arg2, arg3)
+}
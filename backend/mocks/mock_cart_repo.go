@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: CartRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockCartRepositoryInterface is a mock of CartRepositoryInterface interface.
+type MockCartRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartRepositoryInterfaceMockRecorder
+}
+
+// MockCartRepositoryInterfaceMockRecorder is the mock recorder for MockCartRepositoryInterface.
+type MockCartRepositoryInterfaceMockRecorder struct {
+	mock *MockCartRepositoryInterface
+}
+
+// NewMockCartRepositoryInterface creates a new mock instance.
+func NewMockCartRepositoryInterface(ctrl *gomock.Controller) *MockCartRepositoryInterface {
+	mock := &MockCartRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockCartRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCartRepositoryInterface) EXPECT() *MockCartRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetByUser mocks base method.
+func (m *MockCartRepositoryInterface) GetByUser(arg0 context.Context, arg1 uuid.UUID) (*models.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUser", arg0, arg1)
+	ret0, _ := ret[0].(*models.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUser indicates an expected call of GetByUser.
+func (mr *MockCartRepositoryInterfaceMockRecorder) GetByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUser", reflect.TypeOf((*MockCartRepositoryInterface)(nil).GetByUser), arg0, arg1)
+}
+
+// RemoveItem mocks base method.
+func (m *MockCartRepositoryInterface) RemoveItem(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockCartRepositoryInterfaceMockRecorder) RemoveItem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockCartRepositoryInterface)(nil).RemoveItem), arg0, arg1, arg2)
+}
+
+// SetItem mocks base method.
+func (m *MockCartRepositoryInterface) SetItem(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetItem", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetItem indicates an expected call of SetItem.
+func (mr *MockCartRepositoryInterfaceMockRecorder) SetItem(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetItem", reflect.TypeOf((*MockCartRepositoryInterface)(nil).SetItem), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: CartServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockCartServiceInterface is a mock of CartServiceInterface interface.
+type MockCartServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartServiceInterfaceMockRecorder
+}
+
+// MockCartServiceInterfaceMockRecorder is the mock recorder for MockCartServiceInterface.
+type MockCartServiceInterfaceMockRecorder struct {
+	mock *MockCartServiceInterface
+}
+
+// NewMockCartServiceInterface creates a new mock instance.
+func NewMockCartServiceInterface(ctrl *gomock.Controller) *MockCartServiceInterface {
+	mock := &MockCartServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockCartServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCartServiceInterface) EXPECT() *MockCartServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockCartServiceInterface) Get(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*dto.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCartServiceInterfaceMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCartServiceInterface)(nil).Get), arg0, arg1, arg2)
+}
+
+// RemoveItem mocks base method.
+func (m *MockCartServiceInterface) RemoveItem(arg0 context.Context, arg1, arg2 uuid.UUID) (*dto.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockCartServiceInterfaceMockRecorder) RemoveItem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockCartServiceInterface)(nil).RemoveItem), arg0, arg1, arg2)
+}
+
+// SetItem mocks base method.
+func (m *MockCartServiceInterface) SetItem(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 dto.CartItemInput) (*dto.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetItem", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*dto.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetItem indicates an expected call of SetItem.
+func (mr *MockCartServiceInterfaceMockRecorder) SetItem(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetItem", reflect.TypeOf((*MockCartServiceInterface)(nil).SetItem), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,172 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: CategoryServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockCategoryServiceInterface is a mock of CategoryServiceInterface interface.
+type MockCategoryServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryServiceInterfaceMockRecorder
+}
+
+// MockCategoryServiceInterfaceMockRecorder is the mock recorder for MockCategoryServiceInterface.
+type MockCategoryServiceInterfaceMockRecorder struct {
+	mock *MockCategoryServiceInterface
+}
+
+// NewMockCategoryServiceInterface creates a new mock instance.
+func NewMockCategoryServiceInterface(ctrl *gomock.Controller) *MockCategoryServiceInterface {
+	mock := &MockCategoryServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockCategoryServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryServiceInterface) EXPECT() *MockCategoryServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCategoryServiceInterface) Create(arg0 context.Context, arg1 dto.CategoryInput) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCategoryServiceInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCategoryServiceInterface)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockCategoryServiceInterface) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCategoryServiceInterfaceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategoryServiceInterface)(nil).Delete), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockCategoryServiceInterface) GetAll(arg0 context.Context) ([]models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0)
+	ret0, _ := ret[0].([]models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCategoryServiceInterfaceMockRecorder) GetAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCategoryServiceInterface)(nil).GetAll), arg0)
+}
+
+// GetBreadcrumbs mocks base method.
+func (m *MockCategoryServiceInterface) GetBreadcrumbs(arg0 context.Context, arg1 uuid.UUID) ([]models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBreadcrumbs", arg0, arg1)
+	ret0, _ := ret[0].([]models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBreadcrumbs indicates an expected call of GetBreadcrumbs.
+func (mr *MockCategoryServiceInterfaceMockRecorder) GetBreadcrumbs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBreadcrumbs", reflect.TypeOf((*MockCategoryServiceInterface)(nil).GetBreadcrumbs), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockCategoryServiceInterface) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCategoryServiceInterfaceMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCategoryServiceInterface)(nil).GetByID), arg0, arg1)
+}
+
+// GetBySlug mocks base method.
+func (m *MockCategoryServiceInterface) GetBySlug(arg0 context.Context, arg1 string) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", arg0, arg1)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockCategoryServiceInterfaceMockRecorder) GetBySlug(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockCategoryServiceInterface)(nil).GetBySlug), arg0, arg1)
+}
+
+// GetTree mocks base method.
+func (m *MockCategoryServiceInterface) GetTree(arg0 context.Context) ([]*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTree", arg0)
+	ret0, _ := ret[0].([]*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTree indicates an expected call of GetTree.
+func (mr *MockCategoryServiceInterfaceMockRecorder) GetTree(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTree", reflect.TypeOf((*MockCategoryServiceInterface)(nil).GetTree), arg0)
+}
+
+// Patch mocks base method.
+func (m *MockCategoryServiceInterface) Patch(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 []byte) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockCategoryServiceInterfaceMockRecorder) Patch(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockCategoryServiceInterface)(nil).Patch), arg0, arg1, arg2, arg3)
+}
+
+// Update mocks base method.
+func (m *MockCategoryServiceInterface) Update(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 dto.CategoryInput) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCategoryServiceInterfaceMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategoryServiceInterface)(nil).Update), arg0, arg1, arg2, arg3)
+}
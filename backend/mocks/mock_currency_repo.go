@@ -0,0 +1,184 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: CurrencyRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockCurrencyRepositoryInterface is a mock of CurrencyRepositoryInterface interface.
+type MockCurrencyRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCurrencyRepositoryInterfaceMockRecorder
+}
+
+// MockCurrencyRepositoryInterfaceMockRecorder is the mock recorder for MockCurrencyRepositoryInterface.
+type MockCurrencyRepositoryInterfaceMockRecorder struct {
+	mock *MockCurrencyRepositoryInterface
+}
+
+// NewMockCurrencyRepositoryInterface creates a new mock instance.
+func NewMockCurrencyRepositoryInterface(ctrl *gomock.Controller) *MockCurrencyRepositoryInterface {
+	mock := &MockCurrencyRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockCurrencyRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCurrencyRepositoryInterface) EXPECT() *MockCurrencyRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddRates mocks base method.
+func (m *MockCurrencyRepositoryInterface) AddRates(arg0 context.Context, arg1 []*models.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRates", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRates indicates an expected call of AddRates.
+func (mr *MockCurrencyRepositoryInterfaceMockRecorder) AddRates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRates", reflect.TypeOf((*MockCurrencyRepositoryInterface)(nil).AddRates), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockCurrencyRepositoryInterface) Create(arg0 context.Context, arg1 *models.Currency) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCurrencyRepositoryInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCurrencyRepositoryInterface)(nil).Create), arg0, arg1)
+}
+
+// DeleteEditionPrice mocks base method.
+func (m *MockCurrencyRepositoryInterface) DeleteEditionPrice(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEditionPrice", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEditionPrice indicates an expected call of DeleteEditionPrice.
+func (mr *MockCurrencyRepositoryInterfaceMockRecorder) DeleteEditionPrice(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEditionPrice", reflect.TypeOf((*MockCurrencyRepositoryInterface)(nil).DeleteEditionPrice), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockCurrencyRepositoryInterface) GetAll(arg0 context.Context) ([]models.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0)
+	ret0, _ := ret[0].([]models.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCurrencyRepositoryInterfaceMockRecorder) GetAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCurrencyRepositoryInterface)(nil).GetAll), arg0)
+}
+
+// GetByCode mocks base method.
+func (m *MockCurrencyRepositoryInterface) GetByCode(arg0 context.Context, arg1 string) (*models.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", arg0, arg1)
+	ret0, _ := ret[0].(*models.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockCurrencyRepositoryInterfaceMockRecorder) GetByCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockCurrencyRepositoryInterface)(nil).GetByCode), arg0, arg1)
+}
+
+// GetEditionPrices mocks base method.
+func (m *MockCurrencyRepositoryInterface) GetEditionPrices(arg0 context.Context, arg1 []uuid.UUID, arg2 string) ([]models.EditionPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEditionPrices", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.EditionPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEditionPrices indicates an expected call of GetEditionPrices.
+func (mr *MockCurrencyRepositoryInterfaceMockRecorder) GetEditionPrices(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEditionPrices", reflect.TypeOf((*MockCurrencyRepositoryInterface)(nil).GetEditionPrices), arg0, arg1, arg2)
+}
+
+// GetPricesForEdition mocks base method.
+func (m *MockCurrencyRepositoryInterface) GetPricesForEdition(arg0 context.Context, arg1 uuid.UUID) ([]models.EditionPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPricesForEdition", arg0, arg1)
+	ret0, _ := ret[0].([]models.EditionPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPricesForEdition indicates an expected call of GetPricesForEdition.
+func (mr *MockCurrencyRepositoryInterfaceMockRecorder) GetPricesForEdition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPricesForEdition", reflect.TypeOf((*MockCurrencyRepositoryInterface)(nil).GetPricesForEdition), arg0, arg1)
+}
+
+// GetRate mocks base method.
+func (m *MockCurrencyRepositoryInterface) GetRate(arg0 context.Context, arg1 string, arg2 time.Time) (*models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRate indicates an expected call of GetRate.
+func (mr *MockCurrencyRepositoryInterfaceMockRecorder) GetRate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockCurrencyRepositoryInterface)(nil).GetRate), arg0, arg1, arg2)
+}
+
+// GetRates mocks base method.
+func (m *MockCurrencyRepositoryInterface) GetRates(arg0 context.Context, arg1 string) ([]models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRates", arg0, arg1)
+	ret0, _ := ret[0].([]models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRates indicates an expected call of GetRates.
+func (mr *MockCurrencyRepositoryInterfaceMockRecorder) GetRates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRates", reflect.TypeOf((*MockCurrencyRepositoryInterface)(nil).GetRates), arg0, arg1)
+}
+
+// SetEditionPrice mocks base method.
+func (m *MockCurrencyRepositoryInterface) SetEditionPrice(arg0 context.Context, arg1 *models.EditionPrice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEditionPrice", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEditionPrice indicates an expected call of SetEditionPrice.
+func (mr *MockCurrencyRepositoryInterfaceMockRecorder) SetEditionPrice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEditionPrice", reflect.TypeOf((*MockCurrencyRepositoryInterface)(nil).SetEditionPrice), arg0, arg1)
+}
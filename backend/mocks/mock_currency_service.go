@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: CurrencyServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockCurrencyServiceInterface is a mock of CurrencyServiceInterface interface.
+type MockCurrencyServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCurrencyServiceInterfaceMockRecorder
+}
+
+// MockCurrencyServiceInterfaceMockRecorder is the mock recorder for MockCurrencyServiceInterface.
+type MockCurrencyServiceInterfaceMockRecorder struct {
+	mock *MockCurrencyServiceInterface
+}
+
+// NewMockCurrencyServiceInterface creates a new mock instance.
+func NewMockCurrencyServiceInterface(ctrl *gomock.Controller) *MockCurrencyServiceInterface {
+	mock := &MockCurrencyServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockCurrencyServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCurrencyServiceInterface) EXPECT() *MockCurrencyServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddRate mocks base method.
+func (m *MockCurrencyServiceInterface) AddRate(arg0 context.Context, arg1 string, arg2 dto.ExchangeRateInput) (*models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRate indicates an expected call of AddRate.
+func (mr *MockCurrencyServiceInterfaceMockRecorder) AddRate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRate", reflect.TypeOf((*MockCurrencyServiceInterface)(nil).AddRate), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockCurrencyServiceInterface) Create(arg0 context.Context, arg1 dto.CurrencyInput) (*models.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*models.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCurrencyServiceInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCurrencyServiceInterface)(nil).Create), arg0, arg1)
+}
+
+// DeleteEditionPrice mocks base method.
+func (m *MockCurrencyServiceInterface) DeleteEditionPrice(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEditionPrice", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEditionPrice indicates an expected call of DeleteEditionPrice.
+func (mr *MockCurrencyServiceInterfaceMockRecorder) DeleteEditionPrice(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEditionPrice", reflect.TypeOf((*MockCurrencyServiceInterface)(nil).DeleteEditionPrice), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockCurrencyServiceInterface) GetAll(arg0 context.Context) ([]dto.CurrencyInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0)
+	ret0, _ := ret[0].([]dto.CurrencyInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCurrencyServiceInterfaceMockRecorder) GetAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCurrencyServiceInterface)(nil).GetAll), arg0)
+}
+
+// GetEditionPrices mocks base method.
+func (m *MockCurrencyServiceInterface) GetEditionPrices(arg0 context.Context, arg1 uuid.UUID) ([]models.EditionPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEditionPrices", arg0, arg1)
+	ret0, _ := ret[0].([]models.EditionPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEditionPrices indicates an expected call of GetEditionPrices.
+func (mr *MockCurrencyServiceInterfaceMockRecorder) GetEditionPrices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEditionPrices", reflect.TypeOf((*MockCurrencyServiceInterface)(nil).GetEditionPrices), arg0, arg1)
+}
+
+// GetRates mocks base method.
+func (m *MockCurrencyServiceInterface) GetRates(arg0 context.Context, arg1 string) ([]models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRates", arg0, arg1)
+	ret0, _ := ret[0].([]models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRates indicates an expected call of GetRates.
+func (mr *MockCurrencyServiceInterfaceMockRecorder) GetRates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRates", reflect.TypeOf((*MockCurrencyServiceInterface)(nil).GetRates), arg0, arg1)
+}
+
+// ImportRates mocks base method.
+func (m *MockCurrencyServiceInterface) ImportRates(arg0 context.Context, arg1 io.Reader) (*dto.RateImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportRates", arg0, arg1)
+	ret0, _ := ret[0].(*dto.RateImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportRates indicates an expected call of ImportRates.
+func (mr *MockCurrencyServiceInterfaceMockRecorder) ImportRates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRates", reflect.TypeOf((*MockCurrencyServiceInterface)(nil).ImportRates), arg0, arg1)
+}
+
+// SetEditionPrice mocks base method.
+func (m *MockCurrencyServiceInterface) SetEditionPrice(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 dto.EditionPriceInput) (*models.EditionPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEditionPrice", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.EditionPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEditionPrice indicates an expected call of SetEditionPrice.
+func (mr *MockCurrencyServiceInterfaceMockRecorder) SetEditionPrice(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEditionPrice", reflect.TypeOf((*MockCurrencyServiceInterface)(nil).SetEditionPrice), arg0, arg1, arg2, arg3)
+}
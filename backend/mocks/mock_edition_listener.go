@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: EditionListenerInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEditionListenerInterface is a mock of EditionListenerInterface interface.
+type MockEditionListenerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEditionListenerInterfaceMockRecorder
+}
+
+// MockEditionListenerInterfaceMockRecorder is the mock recorder for MockEditionListenerInterface.
+type MockEditionListenerInterfaceMockRecorder struct {
+	mock *MockEditionListenerInterface
+}
+
+// NewMockEditionListenerInterface creates a new mock instance.
+func NewMockEditionListenerInterface(ctrl *gomock.Controller) *MockEditionListenerInterface {
+	mock := &MockEditionListenerInterface{ctrl: ctrl}
+	mock.recorder = &MockEditionListenerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEditionListenerInterface) EXPECT() *MockEditionListenerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// EditionChanged mocks base method.
+func (m *MockEditionListenerInterface) EditionChanged(arg0 context.Context, arg1, arg2 *models.Edition) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EditionChanged", arg0, arg1, arg2)
+}
+
+// EditionChanged indicates an expected call of EditionChanged.
+func (mr *MockEditionListenerInterfaceMockRecorder) EditionChanged(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditionChanged", reflect.TypeOf((*MockEditionListenerInterface)(nil).EditionChanged), arg0, arg1, arg2)
+}
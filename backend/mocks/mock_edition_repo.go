@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: EditionRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockEditionRepositoryInterface is a mock of EditionRepositoryInterface interface.
+type MockEditionRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEditionRepositoryInterfaceMockRecorder
+}
+
+// MockEditionRepositoryInterfaceMockRecorder is the mock recorder for MockEditionRepositoryInterface.
+type MockEditionRepositoryInterfaceMockRecorder struct {
+	mock *MockEditionRepositoryInterface
+}
+
+// NewMockEditionRepositoryInterface creates a new mock instance.
+func NewMockEditionRepositoryInterface(ctrl *gomock.Controller) *MockEditionRepositoryInterface {
+	mock := &MockEditionRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockEditionRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEditionRepositoryInterface) EXPECT() *MockEditionRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockEditionRepositoryInterface) Create(arg0 context.Context, arg1 *models.Edition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockEditionRepositoryInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEditionRepositoryInterface)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockEditionRepositoryInterface) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockEditionRepositoryInterfaceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEditionRepositoryInterface)(nil).Delete), arg0, arg1, arg2)
+}
+
+// GetByID mocks base method.
+func (m *MockEditionRepositoryInterface) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.Edition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Edition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockEditionRepositoryInterfaceMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockEditionRepositoryInterface)(nil).GetByID), arg0, arg1)
+}
+
+// GetByISBN mocks base method.
+func (m *MockEditionRepositoryInterface) GetByISBN(arg0 context.Context, arg1 string) (*models.Edition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByISBN", arg0, arg1)
+	ret0, _ := ret[0].(*models.Edition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByISBN indicates an expected call of GetByISBN.
+func (mr *MockEditionRepositoryInterfaceMockRecorder) GetByISBN(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByISBN", reflect.TypeOf((*MockEditionRepositoryInterface)(nil).GetByISBN), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockEditionRepositoryInterface) Update(arg0 context.Context, arg1 *models.Edition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockEditionRepositoryInterfaceMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEditionRepositoryInterface)(nil).Update), arg0, arg1)
+}
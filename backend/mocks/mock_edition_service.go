@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: EditionServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockEditionServiceInterface is a mock of EditionServiceInterface interface.
+type MockEditionServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEditionServiceInterfaceMockRecorder
+}
+
+// MockEditionServiceInterfaceMockRecorder is the mock recorder for MockEditionServiceInterface.
+type MockEditionServiceInterfaceMockRecorder struct {
+	mock *MockEditionServiceInterface
+}
+
+// NewMockEditionServiceInterface creates a new mock instance.
+func NewMockEditionServiceInterface(ctrl *gomock.Controller) *MockEditionServiceInterface {
+	mock := &MockEditionServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockEditionServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEditionServiceInterface) EXPECT() *MockEditionServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockEditionServiceInterface) Create(arg0 context.Context, arg1 uuid.UUID, arg2 dto.EditionInput) (*models.Edition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Edition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockEditionServiceInterfaceMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEditionServiceInterface)(nil).Create), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockEditionServiceInterface) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockEditionServiceInterfaceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEditionServiceInterface)(nil).Delete), arg0, arg1, arg2)
+}
+
+// GetByID mocks base method.
+func (m *MockEditionServiceInterface) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.Edition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Edition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockEditionServiceInterfaceMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockEditionServiceInterface)(nil).GetByID), arg0, arg1)
+}
+
+// Patch mocks base method.
+func (m *MockEditionServiceInterface) Patch(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 []byte) (*models.Edition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Edition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockEditionServiceInterfaceMockRecorder) Patch(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockEditionServiceInterface)(nil).Patch), arg0, arg1, arg2, arg3)
+}
+
+// Update mocks base method.
+func (m *MockEditionServiceInterface) Update(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 dto.EditionInput) (*models.Edition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Edition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockEditionServiceInterfaceMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEditionServiceInterface)(nil).Update), arg0, arg1, arg2, arg3)
+}
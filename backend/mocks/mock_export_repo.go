@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: ExportRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExportRepositoryInterface is a mock of ExportRepositoryInterface interface.
+type MockExportRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportRepositoryInterfaceMockRecorder
+}
+
+// MockExportRepositoryInterfaceMockRecorder is the mock recorder for MockExportRepositoryInterface.
+type MockExportRepositoryInterfaceMockRecorder struct {
+	mock *MockExportRepositoryInterface
+}
+
+// NewMockExportRepositoryInterface creates a new mock instance.
+func NewMockExportRepositoryInterface(ctrl *gomock.Controller) *MockExportRepositoryInterface {
+	mock := &MockExportRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockExportRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportRepositoryInterface) EXPECT() *MockExportRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ExportAuthors mocks base method.
+func (m *MockExportRepositoryInterface) ExportAuthors(arg0 context.Context, arg1 func(models.Author) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAuthors", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportAuthors indicates an expected call of ExportAuthors.
+func (mr *MockExportRepositoryInterfaceMockRecorder) ExportAuthors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAuthors", reflect.TypeOf((*MockExportRepositoryInterface)(nil).ExportAuthors), arg0, arg1)
+}
+
+// ExportBooks mocks base method.
+func (m *MockExportRepositoryInterface) ExportBooks(arg0 context.Context, arg1 dto.BookFilter, arg2 func(dto.BookExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportBooks", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportBooks indicates an expected call of ExportBooks.
+func (mr *MockExportRepositoryInterfaceMockRecorder) ExportBooks(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBooks", reflect.TypeOf((*MockExportRepositoryInterface)(nil).ExportBooks), arg0, arg1, arg2)
+}
+
+// ExportCustomers mocks base method.
+func (m *MockExportRepositoryInterface) ExportCustomers(arg0 context.Context, arg1 func(dto.CustomerExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCustomers", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportCustomers indicates an expected call of ExportCustomers.
+func (mr *MockExportRepositoryInterfaceMockRecorder) ExportCustomers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCustomers", reflect.TypeOf((*MockExportRepositoryInterface)(nil).ExportCustomers), arg0, arg1)
+}
+
+// ExportPublishers mocks base method.
+func (m *MockExportRepositoryInterface) ExportPublishers(arg0 context.Context, arg1 func(models.Publisher) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPublishers", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportPublishers indicates an expected call of ExportPublishers.
+func (mr *MockExportRepositoryInterfaceMockRecorder) ExportPublishers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPublishers", reflect.TypeOf((*MockExportRepositoryInterface)(nil).ExportPublishers), arg0, arg1)
+}
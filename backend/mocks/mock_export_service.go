@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: ExportServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExportServiceInterface is a mock of ExportServiceInterface interface.
+type MockExportServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportServiceInterfaceMockRecorder
+}
+
+// MockExportServiceInterfaceMockRecorder is the mock recorder for MockExportServiceInterface.
+type MockExportServiceInterfaceMockRecorder struct {
+	mock *MockExportServiceInterface
+}
+
+// NewMockExportServiceInterface creates a new mock instance.
+func NewMockExportServiceInterface(ctrl *gomock.Controller) *MockExportServiceInterface {
+	mock := &MockExportServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockExportServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportServiceInterface) EXPECT() *MockExportServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ExportAuthors mocks base method.
+func (m *MockExportServiceInterface) ExportAuthors(arg0 context.Context, arg1 string, arg2 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAuthors", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportAuthors indicates an expected call of ExportAuthors.
+func (mr *MockExportServiceInterfaceMockRecorder) ExportAuthors(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAuthors", reflect.TypeOf((*MockExportServiceInterface)(nil).ExportAuthors), arg0, arg1, arg2)
+}
+
+// ExportBooks mocks base method.
+func (m *MockExportServiceInterface) ExportBooks(arg0 context.Context, arg1 string, arg2 dto.BookFilter, arg3 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportBooks", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportBooks indicates an expected call of ExportBooks.
+func (mr *MockExportServiceInterfaceMockRecorder) ExportBooks(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBooks", reflect.TypeOf((*MockExportServiceInterface)(nil).ExportBooks), arg0, arg1, arg2, arg3)
+}
+
+// ExportCustomers mocks base method.
+func (m *MockExportServiceInterface) ExportCustomers(arg0 context.Context, arg1 string, arg2 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCustomers", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportCustomers indicates an expected call of ExportCustomers.
+func (mr *MockExportServiceInterfaceMockRecorder) ExportCustomers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCustomers", reflect.TypeOf((*MockExportServiceInterface)(nil).ExportCustomers), arg0, arg1, arg2)
+}
+
+// ExportPublishers mocks base method.
+func (m *MockExportServiceInterface) ExportPublishers(arg0 context.Context, arg1 string, arg2 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPublishers", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportPublishers indicates an expected call of ExportPublishers.
+func (mr *MockExportServiceInterfaceMockRecorder) ExportPublishers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPublishers", reflect.TypeOf((*MockExportServiceInterface)(nil).ExportPublishers), arg0, arg1, arg2)
+}
@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: GiftCardRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockGiftCardRepositoryInterface is a mock of GiftCardRepositoryInterface interface.
+type MockGiftCardRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGiftCardRepositoryInterfaceMockRecorder
+}
+
+// MockGiftCardRepositoryInterfaceMockRecorder is the mock recorder for MockGiftCardRepositoryInterface.
+type MockGiftCardRepositoryInterfaceMockRecorder struct {
+	mock *MockGiftCardRepositoryInterface
+}
+
+// NewMockGiftCardRepositoryInterface creates a new mock instance.
+func NewMockGiftCardRepositoryInterface(ctrl *gomock.Controller) *MockGiftCardRepositoryInterface {
+	mock := &MockGiftCardRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockGiftCardRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGiftCardRepositoryInterface) EXPECT() *MockGiftCardRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockGiftCardRepositoryInterface) Create(arg0 context.Context, arg1 *models.GiftCard) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockGiftCardRepositoryInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGiftCardRepositoryInterface)(nil).Create), arg0, arg1)
+}
+
+// Expire mocks base method.
+func (m *MockGiftCardRepositoryInterface) Expire(arg0 context.Context, arg1 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockGiftCardRepositoryInterfaceMockRecorder) Expire(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockGiftCardRepositoryInterface)(nil).Expire), arg0, arg1)
+}
+
+// GetAllByUser mocks base method.
+func (m *MockGiftCardRepositoryInterface) GetAllByUser(arg0 context.Context, arg1 uuid.UUID) ([]models.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUser", arg0, arg1)
+	ret0, _ := ret[0].([]models.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUser indicates an expected call of GetAllByUser.
+func (mr *MockGiftCardRepositoryInterfaceMockRecorder) GetAllByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockGiftCardRepositoryInterface)(nil).GetAllByUser), arg0, arg1)
+}
+
+// GetByCode mocks base method.
+func (m *MockGiftCardRepositoryInterface) GetByCode(arg0 context.Context, arg1 string) (*models.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", arg0, arg1)
+	ret0, _ := ret[0].(*models.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockGiftCardRepositoryInterfaceMockRecorder) GetByCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockGiftCardRepositoryInterface)(nil).GetByCode), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockGiftCardRepositoryInterface) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGiftCardRepositoryInterfaceMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGiftCardRepositoryInterface)(nil).GetByID), arg0, arg1)
+}
+
+// GetByOrder mocks base method.
+func (m *MockGiftCardRepositoryInterface) GetByOrder(arg0 context.Context, arg1 uuid.UUID) ([]models.GiftCardTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOrder", arg0, arg1)
+	ret0, _ := ret[0].([]models.GiftCardTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOrder indicates an expected call of GetByOrder.
+func (mr *MockGiftCardRepositoryInterfaceMockRecorder) GetByOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOrder", reflect.TypeOf((*MockGiftCardRepositoryInterface)(nil).GetByOrder), arg0, arg1)
+}
+
+// GetStoreCredit mocks base method.
+func (m *MockGiftCardRepositoryInterface) GetStoreCredit(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) ([]models.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoreCredit", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoreCredit indicates an expected call of GetStoreCredit.
+func (mr *MockGiftCardRepositoryInterfaceMockRecorder) GetStoreCredit(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoreCredit", reflect.TypeOf((*MockGiftCardRepositoryInterface)(nil).GetStoreCredit), arg0, arg1, arg2)
+}
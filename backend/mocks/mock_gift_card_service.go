@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: GiftCardServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockGiftCardServiceInterface is a mock of GiftCardServiceInterface interface.
+type MockGiftCardServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGiftCardServiceInterfaceMockRecorder
+}
+
+// MockGiftCardServiceInterfaceMockRecorder is the mock recorder for MockGiftCardServiceInterface.
+type MockGiftCardServiceInterfaceMockRecorder struct {
+	mock *MockGiftCardServiceInterface
+}
+
+// NewMockGiftCardServiceInterface creates a new mock instance.
+func NewMockGiftCardServiceInterface(ctrl *gomock.Controller) *MockGiftCardServiceInterface {
+	mock := &MockGiftCardServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockGiftCardServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGiftCardServiceInterface) EXPECT() *MockGiftCardServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockGiftCardServiceInterface) Check(arg0 context.Context, arg1 string) (*dto.GiftCardBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", arg0, arg1)
+	ret0, _ := ret[0].(*dto.GiftCardBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockGiftCardServiceInterfaceMockRecorder) Check(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockGiftCardServiceInterface)(nil).Check), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockGiftCardServiceInterface) GetByID(arg0 context.Context, arg1, arg2 uuid.UUID) (*models.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGiftCardServiceInterfaceMockRecorder) GetByID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGiftCardServiceInterface)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// GetMine mocks base method.
+func (m *MockGiftCardServiceInterface) GetMine(arg0 context.Context, arg1 uuid.UUID) (*dto.GiftCards, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMine", arg0, arg1)
+	ret0, _ := ret[0].(*dto.GiftCards)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMine indicates an expected call of GetMine.
+func (mr *MockGiftCardServiceInterfaceMockRecorder) GetMine(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMine", reflect.TypeOf((*MockGiftCardServiceInterface)(nil).GetMine), arg0, arg1)
+}
+
+// Issue mocks base method.
+func (m *MockGiftCardServiceInterface) Issue(arg0 context.Context, arg1 uuid.UUID, arg2 dto.GiftCardIssueInput) (*dto.IssuedGiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.IssuedGiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockGiftCardServiceInterfaceMockRecorder) Issue(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockGiftCardServiceInterface)(nil).Issue), arg0, arg1, arg2)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: ImportRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockImportRepositoryInterface is a mock of ImportRepositoryInterface interface.
+type MockImportRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportRepositoryInterfaceMockRecorder
+}
+
+// MockImportRepositoryInterfaceMockRecorder is the mock recorder for MockImportRepositoryInterface.
+type MockImportRepositoryInterfaceMockRecorder struct {
+	mock *MockImportRepositoryInterface
+}
+
+// NewMockImportRepositoryInterface creates a new mock instance.
+func NewMockImportRepositoryInterface(ctrl *gomock.Controller) *MockImportRepositoryInterface {
+	mock := &MockImportRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockImportRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportRepositoryInterface) EXPECT() *MockImportRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ImportBooks mocks base method.
+func (m *MockImportRepositoryInterface) ImportBooks(arg0 context.Context, arg1 []dto.ImportBookRow, arg2 bool) ([]dto.ImportedBook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportBooks", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]dto.ImportedBook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportBooks indicates an expected call of ImportBooks.
+func (mr *MockImportRepositoryInterfaceMockRecorder) ImportBooks(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBooks", reflect.TypeOf((*MockImportRepositoryInterface)(nil).ImportBooks), arg0, arg1, arg2)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: ImportServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockImportServiceInterface is a mock of ImportServiceInterface interface.
+type MockImportServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportServiceInterfaceMockRecorder
+}
+
+// MockImportServiceInterfaceMockRecorder is the mock recorder for MockImportServiceInterface.
+type MockImportServiceInterfaceMockRecorder struct {
+	mock *MockImportServiceInterface
+}
+
+// NewMockImportServiceInterface creates a new mock instance.
+func NewMockImportServiceInterface(ctrl *gomock.Controller) *MockImportServiceInterface {
+	mock := &MockImportServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockImportServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportServiceInterface) EXPECT() *MockImportServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ImportBooks mocks base method.
+func (m *MockImportServiceInterface) ImportBooks(arg0 context.Context, arg1 io.Reader, arg2 bool) (*dto.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportBooks", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportBooks indicates an expected call of ImportBooks.
+func (mr *MockImportServiceInterfaceMockRecorder) ImportBooks(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBooks", reflect.TypeOf((*MockImportServiceInterface)(nil).ImportBooks), arg0, arg1, arg2)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: InvoiceRendererInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInvoiceRendererInterface is a mock of InvoiceRendererInterface interface.
+type MockInvoiceRendererInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceRendererInterfaceMockRecorder
+}
+
+// MockInvoiceRendererInterfaceMockRecorder is the mock recorder for MockInvoiceRendererInterface.
+type MockInvoiceRendererInterfaceMockRecorder struct {
+	mock *MockInvoiceRendererInterface
+}
+
+// NewMockInvoiceRendererInterface creates a new mock instance.
+func NewMockInvoiceRendererInterface(ctrl *gomock.Controller) *MockInvoiceRendererInterface {
+	mock := &MockInvoiceRendererInterface{ctrl: ctrl}
+	mock.recorder = &MockInvoiceRendererInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceRendererInterface) EXPECT() *MockInvoiceRendererInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockInvoiceRendererInterface) Render(arg0 *models.Invoice, arg1 *models.Order) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", arg0, arg1)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockInvoiceRendererInterfaceMockRecorder) Render(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockInvoiceRendererInterface)(nil).Render), arg0, arg1)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: InvoiceRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockInvoiceRepositoryInterface is a mock of InvoiceRepositoryInterface interface.
+type MockInvoiceRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceRepositoryInterfaceMockRecorder
+}
+
+// MockInvoiceRepositoryInterfaceMockRecorder is the mock recorder for MockInvoiceRepositoryInterface.
+type MockInvoiceRepositoryInterfaceMockRecorder struct {
+	mock *MockInvoiceRepositoryInterface
+}
+
+// NewMockInvoiceRepositoryInterface creates a new mock instance.
+func NewMockInvoiceRepositoryInterface(ctrl *gomock.Controller) *MockInvoiceRepositoryInterface {
+	mock := &MockInvoiceRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockInvoiceRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceRepositoryInterface) EXPECT() *MockInvoiceRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockInvoiceRepositoryInterface) Create(arg0 context.Context, arg1 *models.Invoice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInvoiceRepositoryInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInvoiceRepositoryInterface)(nil).Create), arg0, arg1)
+}
+
+// GetByOrder mocks base method.
+func (m *MockInvoiceRepositoryInterface) GetByOrder(arg0 context.Context, arg1 uuid.UUID) (*models.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOrder", arg0, arg1)
+	ret0, _ := ret[0].(*models.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOrder indicates an expected call of GetByOrder.
+func (mr *MockInvoiceRepositoryInterfaceMockRecorder) GetByOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOrder", reflect.TypeOf((*MockInvoiceRepositoryInterface)(nil).GetByOrder), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockInvoiceRepositoryInterface) Update(arg0 context.Context, arg1 *models.Invoice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockInvoiceRepositoryInterfaceMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockInvoiceRepositoryInterface)(nil).Update), arg0, arg1)
+}
@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: InvoiceServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	blob "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/blob"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockInvoiceServiceInterface is a mock of InvoiceServiceInterface interface.
+type MockInvoiceServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceServiceInterfaceMockRecorder
+}
+
+// MockInvoiceServiceInterfaceMockRecorder is the mock recorder for MockInvoiceServiceInterface.
+type MockInvoiceServiceInterfaceMockRecorder struct {
+	mock *MockInvoiceServiceInterface
+}
+
+// NewMockInvoiceServiceInterface creates a new mock instance.
+func NewMockInvoiceServiceInterface(ctrl *gomock.Controller) *MockInvoiceServiceInterface {
+	mock := &MockInvoiceServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockInvoiceServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceServiceInterface) EXPECT() *MockInvoiceServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Open mocks base method.
+func (m *MockInvoiceServiceInterface) Open(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 bool) (*models.Invoice, *blob.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Invoice)
+	ret1, _ := ret[1].(*blob.Object)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockInvoiceServiceInterfaceMockRecorder) Open(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockInvoiceServiceInterface)(nil).Open), arg0, arg1, arg2, arg3)
+}
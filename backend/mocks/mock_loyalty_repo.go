@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: LoyaltyRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockLoyaltyRepositoryInterface is a mock of LoyaltyRepositoryInterface interface.
+type MockLoyaltyRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyRepositoryInterfaceMockRecorder
+}
+
+// MockLoyaltyRepositoryInterfaceMockRecorder is the mock recorder for MockLoyaltyRepositoryInterface.
+type MockLoyaltyRepositoryInterfaceMockRecorder struct {
+	mock *MockLoyaltyRepositoryInterface
+}
+
+// NewMockLoyaltyRepositoryInterface creates a new mock instance.
+func NewMockLoyaltyRepositoryInterface(ctrl *gomock.Controller) *MockLoyaltyRepositoryInterface {
+	mock := &MockLoyaltyRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyRepositoryInterface) EXPECT() *MockLoyaltyRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Adjust mocks base method.
+func (m *MockLoyaltyRepositoryInterface) Adjust(arg0 context.Context, arg1 *models.LoyaltyTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Adjust", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Adjust indicates an expected call of Adjust.
+func (mr *MockLoyaltyRepositoryInterfaceMockRecorder) Adjust(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Adjust", reflect.TypeOf((*MockLoyaltyRepositoryInterface)(nil).Adjust), arg0, arg1)
+}
+
+// Expire mocks base method.
+func (m *MockLoyaltyRepositoryInterface) Expire(arg0 context.Context, arg1 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockLoyaltyRepositoryInterfaceMockRecorder) Expire(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockLoyaltyRepositoryInterface)(nil).Expire), arg0, arg1)
+}
+
+// GetByOrder mocks base method.
+func (m *MockLoyaltyRepositoryInterface) GetByOrder(arg0 context.Context, arg1 uuid.UUID) ([]models.LoyaltyTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOrder", arg0, arg1)
+	ret0, _ := ret[0].([]models.LoyaltyTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOrder indicates an expected call of GetByOrder.
+func (mr *MockLoyaltyRepositoryInterfaceMockRecorder) GetByOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOrder", reflect.TypeOf((*MockLoyaltyRepositoryInterface)(nil).GetByOrder), arg0, arg1)
+}
+
+// GetCredits mocks base method.
+func (m *MockLoyaltyRepositoryInterface) GetCredits(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) ([]models.LoyaltyTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCredits", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.LoyaltyTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCredits indicates an expected call of GetCredits.
+func (mr *MockLoyaltyRepositoryInterfaceMockRecorder) GetCredits(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredits", reflect.TypeOf((*MockLoyaltyRepositoryInterface)(nil).GetCredits), arg0, arg1, arg2)
+}
+
+// GetHistory mocks base method.
+func (m *MockLoyaltyRepositoryInterface) GetHistory(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) ([]models.LoyaltyTransaction, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.LoyaltyTransaction)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockLoyaltyRepositoryInterfaceMockRecorder) GetHistory(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockLoyaltyRepositoryInterface)(nil).GetHistory), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: LoyaltyServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockLoyaltyServiceInterface is a mock of LoyaltyServiceInterface interface.
+type MockLoyaltyServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyServiceInterfaceMockRecorder
+}
+
+// MockLoyaltyServiceInterfaceMockRecorder is the mock recorder for MockLoyaltyServiceInterface.
+type MockLoyaltyServiceInterfaceMockRecorder struct {
+	mock *MockLoyaltyServiceInterface
+}
+
+// NewMockLoyaltyServiceInterface creates a new mock instance.
+func NewMockLoyaltyServiceInterface(ctrl *gomock.Controller) *MockLoyaltyServiceInterface {
+	mock := &MockLoyaltyServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyServiceInterface) EXPECT() *MockLoyaltyServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Adjust mocks base method.
+func (m *MockLoyaltyServiceInterface) Adjust(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 dto.LoyaltyAdjustmentInput) (*models.LoyaltyTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Adjust", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.LoyaltyTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Adjust indicates an expected call of Adjust.
+func (mr *MockLoyaltyServiceInterfaceMockRecorder) Adjust(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Adjust", reflect.TypeOf((*MockLoyaltyServiceInterface)(nil).Adjust), arg0, arg1, arg2, arg3)
+}
+
+// GetBalance mocks base method.
+func (m *MockLoyaltyServiceInterface) GetBalance(arg0 context.Context, arg1 uuid.UUID) (*dto.LoyaltyBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", arg0, arg1)
+	ret0, _ := ret[0].(*dto.LoyaltyBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockLoyaltyServiceInterfaceMockRecorder) GetBalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockLoyaltyServiceInterface)(nil).GetBalance), arg0, arg1)
+}
+
+// GetHistory mocks base method.
+func (m *MockLoyaltyServiceInterface) GetHistory(arg0 context.Context, arg1 uuid.UUID, arg2 dto.LoyaltyHistoryQuery) (*dto.LoyaltyHistoryPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.LoyaltyHistoryPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockLoyaltyServiceInterfaceMockRecorder) GetHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockLoyaltyServiceInterface)(nil).GetHistory), arg0, arg1, arg2)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: MediaRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockMediaRepositoryInterface is a mock of MediaRepositoryInterface interface.
+type MockMediaRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaRepositoryInterfaceMockRecorder
+}
+
+// MockMediaRepositoryInterfaceMockRecorder is the mock recorder for MockMediaRepositoryInterface.
+type MockMediaRepositoryInterfaceMockRecorder struct {
+	mock *MockMediaRepositoryInterface
+}
+
+// NewMockMediaRepositoryInterface creates a new mock instance.
+func NewMockMediaRepositoryInterface(ctrl *gomock.Controller) *MockMediaRepositoryInterface {
+	mock := &MockMediaRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockMediaRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaRepositoryInterface) EXPECT() *MockMediaRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// SetAuthorPhoto mocks base method.
+func (m *MockMediaRepositoryInterface) SetAuthorPhoto(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 *models.Image) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAuthorPhoto", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAuthorPhoto indicates an expected call of SetAuthorPhoto.
+func (mr *MockMediaRepositoryInterfaceMockRecorder) SetAuthorPhoto(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAuthorPhoto", reflect.TypeOf((*MockMediaRepositoryInterface)(nil).SetAuthorPhoto), arg0, arg1, arg2, arg3)
+}
+
+// SetBookCover mocks base method.
+func (m *MockMediaRepositoryInterface) SetBookCover(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 *models.Image) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBookCover", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBookCover indicates an expected call of SetBookCover.
+func (mr *MockMediaRepositoryInterfaceMockRecorder) SetBookCover(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBookCover", reflect.TypeOf((*MockMediaRepositoryInterface)(nil).SetBookCover), arg0, arg1, arg2, arg3)
+}
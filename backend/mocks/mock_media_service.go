@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: MediaServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	blob "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/blob"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockMediaServiceInterface is a mock of MediaServiceInterface interface.
+type MockMediaServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaServiceInterfaceMockRecorder
+}
+
+// MockMediaServiceInterfaceMockRecorder is the mock recorder for MockMediaServiceInterface.
+type MockMediaServiceInterfaceMockRecorder struct {
+	mock *MockMediaServiceInterface
+}
+
+// NewMockMediaServiceInterface creates a new mock instance.
+func NewMockMediaServiceInterface(ctrl *gomock.Controller) *MockMediaServiceInterface {
+	mock := &MockMediaServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockMediaServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaServiceInterface) EXPECT() *MockMediaServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteAuthorPhoto mocks base method.
+func (m *MockMediaServiceInterface) DeleteAuthorPhoto(arg0 context.Context, arg1 uuid.UUID, arg2 int64) (*models.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuthorPhoto", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAuthorPhoto indicates an expected call of DeleteAuthorPhoto.
+func (mr *MockMediaServiceInterfaceMockRecorder) DeleteAuthorPhoto(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthorPhoto", reflect.TypeOf((*MockMediaServiceInterface)(nil).DeleteAuthorPhoto), arg0, arg1, arg2)
+}
+
+// DeleteBookCover mocks base method.
+func (m *MockMediaServiceInterface) DeleteBookCover(arg0 context.Context, arg1 uuid.UUID, arg2 int64) (*models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBookCover", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBookCover indicates an expected call of DeleteBookCover.
+func (mr *MockMediaServiceInterfaceMockRecorder) DeleteBookCover(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBookCover", reflect.TypeOf((*MockMediaServiceInterface)(nil).DeleteBookCover), arg0, arg1, arg2)
+}
+
+// Open mocks base method.
+func (m *MockMediaServiceInterface) Open(arg0 context.Context, arg1 string) (*blob.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0, arg1)
+	ret0, _ := ret[0].(*blob.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockMediaServiceInterfaceMockRecorder) Open(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockMediaServiceInterface)(nil).Open), arg0, arg1)
+}
+
+// UploadAuthorPhoto mocks base method.
+func (m *MockMediaServiceInterface) UploadAuthorPhoto(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 []byte) (*models.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAuthorPhoto", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAuthorPhoto indicates an expected call of UploadAuthorPhoto.
+func (mr *MockMediaServiceInterfaceMockRecorder) UploadAuthorPhoto(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAuthorPhoto", reflect.TypeOf((*MockMediaServiceInterface)(nil).UploadAuthorPhoto), arg0, arg1, arg2, arg3)
+}
+
+// UploadBookCover mocks base method.
+func (m *MockMediaServiceInterface) UploadBookCover(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 []byte) (*models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadBookCover", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadBookCover indicates an expected call of UploadBookCover.
+func (mr *MockMediaServiceInterfaceMockRecorder) UploadBookCover(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadBookCover", reflect.TypeOf((*MockMediaServiceInterface)(nil).UploadBookCover), arg0, arg1, arg2, arg3)
+}
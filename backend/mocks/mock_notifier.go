@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: NotifierInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNotifierInterface is a mock of NotifierInterface interface.
+type MockNotifierInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierInterfaceMockRecorder
+}
+
+// MockNotifierInterfaceMockRecorder is the mock recorder for MockNotifierInterface.
+type MockNotifierInterfaceMockRecorder struct {
+	mock *MockNotifierInterface
+}
+
+// NewMockNotifierInterface creates a new mock instance.
+func NewMockNotifierInterface(ctrl *gomock.Controller) *MockNotifierInterface {
+	mock := &MockNotifierInterface{ctrl: ctrl}
+	mock.recorder = &MockNotifierInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifierInterface) EXPECT() *MockNotifierInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifierInterface) Notify(arg0 context.Context, arg1 dto.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierInterfaceMockRecorder) Notify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifierInterface)(nil).Notify), arg0, arg1)
+}
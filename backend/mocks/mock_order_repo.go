@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: OrderRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockOrderRepositoryInterface is a mock of OrderRepositoryInterface interface.
+type MockOrderRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderRepositoryInterfaceMockRecorder
+}
+
+// MockOrderRepositoryInterfaceMockRecorder is the mock recorder for MockOrderRepositoryInterface.
+type MockOrderRepositoryInterfaceMockRecorder struct {
+	mock *MockOrderRepositoryInterface
+}
+
+// NewMockOrderRepositoryInterface creates a new mock instance.
+func NewMockOrderRepositoryInterface(ctrl *gomock.Controller) *MockOrderRepositoryInterface {
+	mock := &MockOrderRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockOrderRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderRepositoryInterface) EXPECT() *MockOrderRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOrderRepositoryInterface) Create(arg0 context.Context, arg1 *models.Order, arg2 []uuid.UUID, arg3 *models.CouponRedemption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrderRepositoryInterfaceMockRecorder) Create(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderRepositoryInterface)(nil).Create), arg0, arg1, arg2, arg3)
+}
+
+// GetAllByUser mocks base method.
+func (m *MockOrderRepositoryInterface) GetAllByUser(arg0 context.Context, arg1 uuid.UUID) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUser", arg0, arg1)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUser indicates an expected call of GetAllByUser.
+func (mr *MockOrderRepositoryInterfaceMockRecorder) GetAllByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockOrderRepositoryInterface)(nil).GetAllByUser), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockOrderRepositoryInterface) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockOrderRepositoryInterfaceMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOrderRepositoryInterface)(nil).GetByID), arg0, arg1)
+}
+
+// MarkPaid mocks base method.
+func (m *MockOrderRepositoryInterface) MarkPaid(arg0 context.Context, arg1 *models.Order, arg2 []*models.GiftCardTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPaid", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPaid indicates an expected call of MarkPaid.
+func (mr *MockOrderRepositoryInterfaceMockRecorder) MarkPaid(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPaid", reflect.TypeOf((*MockOrderRepositoryInterface)(nil).MarkPaid), arg0, arg1, arg2)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockOrderRepositoryInterface) UpdateStatus(arg0 context.Context, arg1 *models.Order, arg2 string, arg3 []*models.LoyaltyTransaction, arg4 []*models.GiftCardTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockOrderRepositoryInterfaceMockRecorder) UpdateStatus(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockOrderRepositoryInterface)(nil).UpdateStatus), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UsePickupAttempt mocks base method.
+func (m *MockOrderRepositoryInterface) UsePickupAttempt(arg0 context.Context, arg1 uuid.UUID, arg2 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsePickupAttempt", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UsePickupAttempt indicates an expected call of UsePickupAttempt.
+func (mr *MockOrderRepositoryInterfaceMockRecorder) UsePickupAttempt(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsePickupAttempt", reflect.TypeOf((*MockOrderRepositoryInterface)(nil).UsePickupAttempt), arg0, arg1, arg2)
+}
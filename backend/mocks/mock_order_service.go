@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: OrderServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockOrderServiceInterface is a mock of OrderServiceInterface interface.
+type MockOrderServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderServiceInterfaceMockRecorder
+}
+
+// MockOrderServiceInterfaceMockRecorder is the mock recorder for MockOrderServiceInterface.
+type MockOrderServiceInterfaceMockRecorder struct {
+	mock *MockOrderServiceInterface
+}
+
+// NewMockOrderServiceInterface creates a new mock instance.
+func NewMockOrderServiceInterface(ctrl *gomock.Controller) *MockOrderServiceInterface {
+	mock := &MockOrderServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockOrderServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderServiceInterface) EXPECT() *MockOrderServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Checkout mocks base method.
+func (m *MockOrderServiceInterface) Checkout(arg0 context.Context, arg1 uuid.UUID, arg2 dto.CheckoutInput) (*models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockOrderServiceInterfaceMockRecorder) Checkout(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockOrderServiceInterface)(nil).Checkout), arg0, arg1, arg2)
+}
+
+// GetByID mocks base method.
+func (m *MockOrderServiceInterface) GetByID(arg0 context.Context, arg1, arg2 uuid.UUID) (*models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockOrderServiceInterfaceMockRecorder) GetByID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOrderServiceInterface)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// GetMine mocks base method.
+func (m *MockOrderServiceInterface) GetMine(arg0 context.Context, arg1 uuid.UUID) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMine", arg0, arg1)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMine indicates an expected call of GetMine.
+func (mr *MockOrderServiceInterfaceMockRecorder) GetMine(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMine", reflect.TypeOf((*MockOrderServiceInterface)(nil).GetMine), arg0, arg1)
+}
+
+// HandOver mocks base method.
+func (m *MockOrderServiceInterface) HandOver(arg0 context.Context, arg1 uuid.UUID, arg2 dto.PickupInput) (*models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandOver", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandOver indicates an expected call of HandOver.
+func (mr *MockOrderServiceInterfaceMockRecorder) HandOver(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandOver", reflect.TypeOf((*MockOrderServiceInterface)(nil).HandOver), arg0, arg1, arg2)
+}
+
+// MarkPaid mocks base method.
+func (m *MockOrderServiceInterface) MarkPaid(arg0 context.Context, arg1 uuid.UUID) (*models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPaid", arg0, arg1)
+	ret0, _ := ret[0].(*models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkPaid indicates an expected call of MarkPaid.
+func (mr *MockOrderServiceInterfaceMockRecorder) MarkPaid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPaid", reflect.TypeOf((*MockOrderServiceInterface)(nil).MarkPaid), arg0, arg1)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockOrderServiceInterface) UpdateStatus(arg0 context.Context, arg1 uuid.UUID, arg2 dto.OrderStatusInput) (*models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockOrderServiceInterfaceMockRecorder) UpdateStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockOrderServiceInterface)(nil).UpdateStatus), arg0, arg1, arg2)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: OutboxRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOutboxRepositoryInterface is a mock of OutboxRepositoryInterface interface.
+type MockOutboxRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryInterfaceMockRecorder
+}
+
+// MockOutboxRepositoryInterfaceMockRecorder is the mock recorder for MockOutboxRepositoryInterface.
+type MockOutboxRepositoryInterfaceMockRecorder struct {
+	mock *MockOutboxRepositoryInterface
+}
+
+// NewMockOutboxRepositoryInterface creates a new mock instance.
+func NewMockOutboxRepositoryInterface(ctrl *gomock.Controller) *MockOutboxRepositoryInterface {
+	mock := &MockOutboxRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepositoryInterface) EXPECT() *MockOutboxRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockOutboxRepositoryInterface) Enqueue(arg0 context.Context, arg1 *models.OutboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) Enqueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).Enqueue), arg0, arg1)
+}
+
+// Process mocks base method.
+func (m *MockOutboxRepositoryInterface) Process(arg0 context.Context, arg1 int, arg2 func(*models.OutboxMessage)) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Process", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Process indicates an expected call of Process.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) Process(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Process", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).Process), arg0, arg1, arg2)
+}
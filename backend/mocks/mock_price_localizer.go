@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: PriceLocalizerInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	money "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPriceLocalizerInterface is a mock of PriceLocalizerInterface interface.
+type MockPriceLocalizerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceLocalizerInterfaceMockRecorder
+}
+
+// MockPriceLocalizerInterfaceMockRecorder is the mock recorder for MockPriceLocalizerInterface.
+type MockPriceLocalizerInterfaceMockRecorder struct {
+	mock *MockPriceLocalizerInterface
+}
+
+// NewMockPriceLocalizerInterface creates a new mock instance.
+func NewMockPriceLocalizerInterface(ctrl *gomock.Controller) *MockPriceLocalizerInterface {
+	mock := &MockPriceLocalizerInterface{ctrl: ctrl}
+	mock.recorder = &MockPriceLocalizerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceLocalizerInterface) EXPECT() *MockPriceLocalizerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Localize mocks base method.
+func (m *MockPriceLocalizerInterface) Localize(arg0 context.Context, arg1 []*models.Edition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Localize", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Localize indicates an expected call of Localize.
+func (mr *MockPriceLocalizerInterfaceMockRecorder) Localize(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Localize", reflect.TypeOf((*MockPriceLocalizerInterface)(nil).Localize), arg0, arg1)
+}
+
+// PreferredRate mocks base method.
+func (m *MockPriceLocalizerInterface) PreferredRate(arg0 context.Context) (string, money.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreferredRate", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(money.Rate)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PreferredRate indicates an expected call of PreferredRate.
+func (mr *MockPriceLocalizerInterfaceMockRecorder) PreferredRate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreferredRate", reflect.TypeOf((*MockPriceLocalizerInterface)(nil).PreferredRate), arg0)
+}
+
+// ToBase mocks base method.
+func (m *MockPriceLocalizerInterface) ToBase(arg0 context.Context, arg1 money.Amount) (money.Amount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToBase", arg0, arg1)
+	ret0, _ := ret[0].(money.Amount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToBase indicates an expected call of ToBase.
+func (mr *MockPriceLocalizerInterfaceMockRecorder) ToBase(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToBase", reflect.TypeOf((*MockPriceLocalizerInterface)(nil).ToBase), arg0, arg1)
+}
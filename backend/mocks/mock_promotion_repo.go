@@ -0,0 +1,171 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: PromotionRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockPromotionRepositoryInterface is a mock of PromotionRepositoryInterface interface.
+type MockPromotionRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromotionRepositoryInterfaceMockRecorder
+}
+
+// MockPromotionRepositoryInterfaceMockRecorder is the mock recorder for MockPromotionRepositoryInterface.
+type MockPromotionRepositoryInterfaceMockRecorder struct {
+	mock *MockPromotionRepositoryInterface
+}
+
+// NewMockPromotionRepositoryInterface creates a new mock instance.
+func NewMockPromotionRepositoryInterface(ctrl *gomock.Controller) *MockPromotionRepositoryInterface {
+	mock := &MockPromotionRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockPromotionRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromotionRepositoryInterface) EXPECT() *MockPromotionRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CountRedemptions mocks base method.
+func (m *MockPromotionRepositoryInterface) CountRedemptions(arg0 context.Context, arg1, arg2 uuid.UUID) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRedemptions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CountRedemptions indicates an expected call of CountRedemptions.
+func (mr *MockPromotionRepositoryInterfaceMockRecorder) CountRedemptions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRedemptions", reflect.TypeOf((*MockPromotionRepositoryInterface)(nil).CountRedemptions), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockPromotionRepositoryInterface) Create(arg0 context.Context, arg1 *models.Promotion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPromotionRepositoryInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPromotionRepositoryInterface)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockPromotionRepositoryInterface) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPromotionRepositoryInterfaceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPromotionRepositoryInterface)(nil).Delete), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockPromotionRepositoryInterface) GetAll(arg0 context.Context, arg1 dto.PromotionFilter) ([]models.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]models.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockPromotionRepositoryInterfaceMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPromotionRepositoryInterface)(nil).GetAll), arg0, arg1)
+}
+
+// GetByCouponCode mocks base method.
+func (m *MockPromotionRepositoryInterface) GetByCouponCode(arg0 context.Context, arg1 string) (*models.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCouponCode", arg0, arg1)
+	ret0, _ := ret[0].(*models.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCouponCode indicates an expected call of GetByCouponCode.
+func (mr *MockPromotionRepositoryInterfaceMockRecorder) GetByCouponCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCouponCode", reflect.TypeOf((*MockPromotionRepositoryInterface)(nil).GetByCouponCode), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockPromotionRepositoryInterface) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPromotionRepositoryInterfaceMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPromotionRepositoryInterface)(nil).GetByID), arg0, arg1)
+}
+
+// GetCategoryAncestors mocks base method.
+func (m *MockPromotionRepositoryInterface) GetCategoryAncestors(arg0 context.Context, arg1 []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryAncestors", arg0, arg1)
+	ret0, _ := ret[0].(map[uuid.UUID][]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryAncestors indicates an expected call of GetCategoryAncestors.
+func (mr *MockPromotionRepositoryInterfaceMockRecorder) GetCategoryAncestors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryAncestors", reflect.TypeOf((*MockPromotionRepositoryInterface)(nil).GetCategoryAncestors), arg0, arg1)
+}
+
+// GetRunning mocks base method.
+func (m *MockPromotionRepositoryInterface) GetRunning(arg0 context.Context) ([]models.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRunning", arg0)
+	ret0, _ := ret[0].([]models.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRunning indicates an expected call of GetRunning.
+func (mr *MockPromotionRepositoryInterfaceMockRecorder) GetRunning(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunning", reflect.TypeOf((*MockPromotionRepositoryInterface)(nil).GetRunning), arg0)
+}
+
+// Update mocks base method.
+func (m *MockPromotionRepositoryInterface) Update(arg0 context.Context, arg1 *models.Promotion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPromotionRepositoryInterfaceMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPromotionRepositoryInterface)(nil).Update), arg0, arg1)
+}
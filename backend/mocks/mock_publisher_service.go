@@ -53,17 +53,17 @@ func (mr *MockPublisherServiceInterfaceMockRecorder) Create(arg0, arg1 interface
 }
 
 // Delete mocks base method.
-func (m *MockPublisherServiceInterface) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+func (m *MockPublisherServiceInterface) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockPublisherServiceInterfaceMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockPublisherServiceInterfaceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPublisherServiceInterface)(nil).Delete), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPublisherServiceInterface)(nil).Delete), arg0, arg1, arg2)
 }
 
 // GetAll mocks base method.
@@ -96,17 +96,32 @@ func (mr *MockPublisherServiceInterfaceMockRecorder) GetByID(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPublisherServiceInterface)(nil).GetByID), arg0, arg1)
 }
 
+// Patch mocks base method.
+func (m *MockPublisherServiceInterface) Patch(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 []byte) (*models.Publisher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Publisher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockPublisherServiceInterfaceMockRecorder) Patch(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockPublisherServiceInterface)(nil).Patch), arg0, arg1, arg2, arg3)
+}
+
 // Update mocks base method.
-func (m *MockPublisherServiceInterface) Update(arg0 context.Context, arg1 uuid.UUID, arg2 dto.PublisherInput) (*models.Publisher, error) {
+func (m *MockPublisherServiceInterface) Update(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 dto.PublisherInput) (*models.Publisher, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*models.Publisher)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockPublisherServiceInterfaceMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockPublisherServiceInterfaceMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPublisherServiceInterface)(nil).Update), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPublisherServiceInterface)(nil).Update), arg0, arg1, arg2, arg3)
 }
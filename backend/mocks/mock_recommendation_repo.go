@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: RecommendationRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockRecommendationRepositoryInterface is a mock of RecommendationRepositoryInterface interface.
+type MockRecommendationRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecommendationRepositoryInterfaceMockRecorder
+}
+
+// MockRecommendationRepositoryInterfaceMockRecorder is the mock recorder for MockRecommendationRepositoryInterface.
+type MockRecommendationRepositoryInterfaceMockRecorder struct {
+	mock *MockRecommendationRepositoryInterface
+}
+
+// NewMockRecommendationRepositoryInterface creates a new mock instance.
+func NewMockRecommendationRepositoryInterface(ctrl *gomock.Controller) *MockRecommendationRepositoryInterface {
+	mock := &MockRecommendationRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockRecommendationRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecommendationRepositoryInterface) EXPECT() *MockRecommendationRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetBestsellers mocks base method.
+func (m *MockRecommendationRepositoryInterface) GetBestsellers(arg0 context.Context, arg1 uuid.UUID, arg2 int) ([]models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBestsellers", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBestsellers indicates an expected call of GetBestsellers.
+func (mr *MockRecommendationRepositoryInterfaceMockRecorder) GetBestsellers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBestsellers", reflect.TypeOf((*MockRecommendationRepositoryInterface)(nil).GetBestsellers), arg0, arg1, arg2)
+}
+
+// GetForUser mocks base method.
+func (m *MockRecommendationRepositoryInterface) GetForUser(arg0 context.Context, arg1 uuid.UUID, arg2 int) ([]models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUser indicates an expected call of GetForUser.
+func (mr *MockRecommendationRepositoryInterfaceMockRecorder) GetForUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUser", reflect.TypeOf((*MockRecommendationRepositoryInterface)(nil).GetForUser), arg0, arg1, arg2)
+}
+
+// GetRelated mocks base method.
+func (m *MockRecommendationRepositoryInterface) GetRelated(arg0 context.Context, arg1 uuid.UUID, arg2 int) ([]models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelated", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelated indicates an expected call of GetRelated.
+func (mr *MockRecommendationRepositoryInterfaceMockRecorder) GetRelated(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelated", reflect.TypeOf((*MockRecommendationRepositoryInterface)(nil).GetRelated), arg0, arg1, arg2)
+}
+
+// Rebuild mocks base method.
+func (m *MockRecommendationRepositoryInterface) Rebuild(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebuild", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rebuild indicates an expected call of Rebuild.
+func (mr *MockRecommendationRepositoryInterfaceMockRecorder) Rebuild(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebuild", reflect.TypeOf((*MockRecommendationRepositoryInterface)(nil).Rebuild), arg0, arg1)
+}
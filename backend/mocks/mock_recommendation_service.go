@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: RecommendationServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockRecommendationServiceInterface is a mock of RecommendationServiceInterface interface.
+type MockRecommendationServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecommendationServiceInterfaceMockRecorder
+}
+
+// MockRecommendationServiceInterfaceMockRecorder is the mock recorder for MockRecommendationServiceInterface.
+type MockRecommendationServiceInterfaceMockRecorder struct {
+	mock *MockRecommendationServiceInterface
+}
+
+// NewMockRecommendationServiceInterface creates a new mock instance.
+func NewMockRecommendationServiceInterface(ctrl *gomock.Controller) *MockRecommendationServiceInterface {
+	mock := &MockRecommendationServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockRecommendationServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecommendationServiceInterface) EXPECT() *MockRecommendationServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetForUser mocks base method.
+func (m *MockRecommendationServiceInterface) GetForUser(arg0 context.Context, arg1 uuid.UUID, arg2 dto.RecommendationQuery) ([]models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUser indicates an expected call of GetForUser.
+func (mr *MockRecommendationServiceInterfaceMockRecorder) GetForUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUser", reflect.TypeOf((*MockRecommendationServiceInterface)(nil).GetForUser), arg0, arg1, arg2)
+}
+
+// GetRelated mocks base method.
+func (m *MockRecommendationServiceInterface) GetRelated(arg0 context.Context, arg1 uuid.UUID, arg2 dto.RecommendationQuery) ([]models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelated", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelated indicates an expected call of GetRelated.
+func (mr *MockRecommendationServiceInterfaceMockRecorder) GetRelated(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelated", reflect.TypeOf((*MockRecommendationServiceInterface)(nil).GetRelated), arg0, arg1, arg2)
+}
@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: ReviewRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockReviewRepositoryInterface is a mock of ReviewRepositoryInterface interface.
+type MockReviewRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewRepositoryInterfaceMockRecorder
+}
+
+// MockReviewRepositoryInterfaceMockRecorder is the mock recorder for MockReviewRepositoryInterface.
+type MockReviewRepositoryInterfaceMockRecorder struct {
+	mock *MockReviewRepositoryInterface
+}
+
+// NewMockReviewRepositoryInterface creates a new mock instance.
+func NewMockReviewRepositoryInterface(ctrl *gomock.Controller) *MockReviewRepositoryInterface {
+	mock := &MockReviewRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockReviewRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewRepositoryInterface) EXPECT() *MockReviewRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReviewRepositoryInterface) Create(arg0 context.Context, arg1 *models.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReviewRepositoryInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReviewRepositoryInterface)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockReviewRepositoryInterface) Delete(arg0 context.Context, arg1 *models.Review, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReviewRepositoryInterfaceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReviewRepositoryInterface)(nil).Delete), arg0, arg1, arg2)
+}
+
+// ExistsForUser mocks base method.
+func (m *MockReviewRepositoryInterface) ExistsForUser(arg0 context.Context, arg1, arg2 uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsForUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsForUser indicates an expected call of ExistsForUser.
+func (mr *MockReviewRepositoryInterfaceMockRecorder) ExistsForUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsForUser", reflect.TypeOf((*MockReviewRepositoryInterface)(nil).ExistsForUser), arg0, arg1, arg2)
+}
+
+// GetByID mocks base method.
+func (m *MockReviewRepositoryInterface) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReviewRepositoryInterfaceMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReviewRepositoryInterface)(nil).GetByID), arg0, arg1)
+}
+
+// HasDeliveredPurchase mocks base method.
+func (m *MockReviewRepositoryInterface) HasDeliveredPurchase(arg0 context.Context, arg1, arg2 uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDeliveredPurchase", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDeliveredPurchase indicates an expected call of HasDeliveredPurchase.
+func (mr *MockReviewRepositoryInterfaceMockRecorder) HasDeliveredPurchase(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDeliveredPurchase", reflect.TypeOf((*MockReviewRepositoryInterface)(nil).HasDeliveredPurchase), arg0, arg1, arg2)
+}
+
+// List mocks base method.
+func (m *MockReviewRepositoryInterface) List(arg0 context.Context, arg1, arg2 *uuid.UUID, arg3 string, arg4 dto.ReviewQuery) ([]models.Review, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Review)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockReviewRepositoryInterfaceMockRecorder) List(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReviewRepositoryInterface)(nil).List), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Update mocks base method.
+func (m *MockReviewRepositoryInterface) Update(arg0 context.Context, arg1 *models.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockReviewRepositoryInterfaceMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockReviewRepositoryInterface)(nil).Update), arg0, arg1)
+}
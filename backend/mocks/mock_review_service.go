@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: ReviewServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockReviewServiceInterface is a mock of ReviewServiceInterface interface.
+type MockReviewServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewServiceInterfaceMockRecorder
+}
+
+// MockReviewServiceInterfaceMockRecorder is the mock recorder for MockReviewServiceInterface.
+type MockReviewServiceInterfaceMockRecorder struct {
+	mock *MockReviewServiceInterface
+}
+
+// NewMockReviewServiceInterface creates a new mock instance.
+func NewMockReviewServiceInterface(ctrl *gomock.Controller) *MockReviewServiceInterface {
+	mock := &MockReviewServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockReviewServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewServiceInterface) EXPECT() *MockReviewServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReviewServiceInterface) Create(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 dto.ReviewInput) (*models.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReviewServiceInterfaceMockRecorder) Create(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReviewServiceInterface)(nil).Create), arg0, arg1, arg2, arg3)
+}
+
+// Delete mocks base method.
+func (m *MockReviewServiceInterface) Delete(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReviewServiceInterfaceMockRecorder) Delete(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReviewServiceInterface)(nil).Delete), arg0, arg1, arg2, arg3)
+}
+
+// GetForBook mocks base method.
+func (m *MockReviewServiceInterface) GetForBook(arg0 context.Context, arg1 uuid.UUID, arg2 dto.ReviewQuery) (*dto.ReviewPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForBook", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.ReviewPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForBook indicates an expected call of GetForBook.
+func (mr *MockReviewServiceInterfaceMockRecorder) GetForBook(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForBook", reflect.TypeOf((*MockReviewServiceInterface)(nil).GetForBook), arg0, arg1, arg2)
+}
+
+// GetMine mocks base method.
+func (m *MockReviewServiceInterface) GetMine(arg0 context.Context, arg1 uuid.UUID, arg2 dto.ReviewQuery) (*dto.ReviewPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMine", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.ReviewPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMine indicates an expected call of GetMine.
+func (mr *MockReviewServiceInterfaceMockRecorder) GetMine(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMine", reflect.TypeOf((*MockReviewServiceInterface)(nil).GetMine), arg0, arg1, arg2)
+}
+
+// GetModerationQueue mocks base method.
+func (m *MockReviewServiceInterface) GetModerationQueue(arg0 context.Context, arg1 dto.ReviewQuery) (*dto.ReviewPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationQueue", arg0, arg1)
+	ret0, _ := ret[0].(*dto.ReviewPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationQueue indicates an expected call of GetModerationQueue.
+func (mr *MockReviewServiceInterfaceMockRecorder) GetModerationQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationQueue", reflect.TypeOf((*MockReviewServiceInterface)(nil).GetModerationQueue), arg0, arg1)
+}
+
+// Moderate mocks base method.
+func (m *MockReviewServiceInterface) Moderate(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 dto.ModerationInput) (*models.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Moderate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Moderate indicates an expected call of Moderate.
+func (mr *MockReviewServiceInterfaceMockRecorder) Moderate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Moderate", reflect.TypeOf((*MockReviewServiceInterface)(nil).Moderate), arg0, arg1, arg2, arg3)
+}
+
+// Update mocks base method.
+func (m *MockReviewServiceInterface) Update(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 int64, arg4 dto.ReviewInput) (*models.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*models.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockReviewServiceInterfaceMockRecorder) Update(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockReviewServiceInterface)(nil).Update), arg0, arg1, arg2, arg3, arg4)
+}
@@ -0,0 +1,254 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: ShippingRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockShippingRepositoryInterface is a mock of ShippingRepositoryInterface interface.
+type MockShippingRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockShippingRepositoryInterfaceMockRecorder
+}
+
+// MockShippingRepositoryInterfaceMockRecorder is the mock recorder for MockShippingRepositoryInterface.
+type MockShippingRepositoryInterfaceMockRecorder struct {
+	mock *MockShippingRepositoryInterface
+}
+
+// NewMockShippingRepositoryInterface creates a new mock instance.
+func NewMockShippingRepositoryInterface(ctrl *gomock.Controller) *MockShippingRepositoryInterface {
+	mock := &MockShippingRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockShippingRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShippingRepositoryInterface) EXPECT() *MockShippingRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateMethod mocks base method.
+func (m *MockShippingRepositoryInterface) CreateMethod(arg0 context.Context, arg1 *models.ShippingMethod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMethod", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMethod indicates an expected call of CreateMethod.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) CreateMethod(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMethod", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).CreateMethod), arg0, arg1)
+}
+
+// CreatePickupPoint mocks base method.
+func (m *MockShippingRepositoryInterface) CreatePickupPoint(arg0 context.Context, arg1 *models.PickupPoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePickupPoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePickupPoint indicates an expected call of CreatePickupPoint.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) CreatePickupPoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePickupPoint", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).CreatePickupPoint), arg0, arg1)
+}
+
+// CreateZone mocks base method.
+func (m *MockShippingRepositoryInterface) CreateZone(arg0 context.Context, arg1 *models.ShippingZone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateZone", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateZone indicates an expected call of CreateZone.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) CreateZone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateZone", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).CreateZone), arg0, arg1)
+}
+
+// DeleteMethod mocks base method.
+func (m *MockShippingRepositoryInterface) DeleteMethod(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMethod", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMethod indicates an expected call of DeleteMethod.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) DeleteMethod(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMethod", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).DeleteMethod), arg0, arg1, arg2)
+}
+
+// DeletePickupPoint mocks base method.
+func (m *MockShippingRepositoryInterface) DeletePickupPoint(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePickupPoint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePickupPoint indicates an expected call of DeletePickupPoint.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) DeletePickupPoint(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePickupPoint", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).DeletePickupPoint), arg0, arg1, arg2)
+}
+
+// DeleteZone mocks base method.
+func (m *MockShippingRepositoryInterface) DeleteZone(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteZone", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteZone indicates an expected call of DeleteZone.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) DeleteZone(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteZone", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).DeleteZone), arg0, arg1, arg2)
+}
+
+// GetMethodByID mocks base method.
+func (m *MockShippingRepositoryInterface) GetMethodByID(arg0 context.Context, arg1 uuid.UUID) (*models.ShippingMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMethodByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.ShippingMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMethodByID indicates an expected call of GetMethodByID.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) GetMethodByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMethodByID", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).GetMethodByID), arg0, arg1)
+}
+
+// GetMethods mocks base method.
+func (m *MockShippingRepositoryInterface) GetMethods(arg0 context.Context, arg1 dto.ShippingMethodFilter) ([]models.ShippingMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMethods", arg0, arg1)
+	ret0, _ := ret[0].([]models.ShippingMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMethods indicates an expected call of GetMethods.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) GetMethods(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMethods", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).GetMethods), arg0, arg1)
+}
+
+// GetPickupPointByID mocks base method.
+func (m *MockShippingRepositoryInterface) GetPickupPointByID(arg0 context.Context, arg1 uuid.UUID) (*models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickupPointByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickupPointByID indicates an expected call of GetPickupPointByID.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) GetPickupPointByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickupPointByID", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).GetPickupPointByID), arg0, arg1)
+}
+
+// GetPickupPoints mocks base method.
+func (m *MockShippingRepositoryInterface) GetPickupPoints(arg0 context.Context, arg1 bool) ([]models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickupPoints", arg0, arg1)
+	ret0, _ := ret[0].([]models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickupPoints indicates an expected call of GetPickupPoints.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) GetPickupPoints(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickupPoints", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).GetPickupPoints), arg0, arg1)
+}
+
+// GetZoneByID mocks base method.
+func (m *MockShippingRepositoryInterface) GetZoneByID(arg0 context.Context, arg1 uuid.UUID) (*models.ShippingZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetZoneByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.ShippingZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetZoneByID indicates an expected call of GetZoneByID.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) GetZoneByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneByID", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).GetZoneByID), arg0, arg1)
+}
+
+// GetZones mocks base method.
+func (m *MockShippingRepositoryInterface) GetZones(arg0 context.Context) ([]models.ShippingZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetZones", arg0)
+	ret0, _ := ret[0].([]models.ShippingZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetZones indicates an expected call of GetZones.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) GetZones(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZones", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).GetZones), arg0)
+}
+
+// UpdateMethod mocks base method.
+func (m *MockShippingRepositoryInterface) UpdateMethod(arg0 context.Context, arg1 *models.ShippingMethod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMethod", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMethod indicates an expected call of UpdateMethod.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) UpdateMethod(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMethod", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).UpdateMethod), arg0, arg1)
+}
+
+// UpdatePickupPoint mocks base method.
+func (m *MockShippingRepositoryInterface) UpdatePickupPoint(arg0 context.Context, arg1 *models.PickupPoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePickupPoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePickupPoint indicates an expected call of UpdatePickupPoint.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) UpdatePickupPoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePickupPoint", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).UpdatePickupPoint), arg0, arg1)
+}
+
+// UpdateZone mocks base method.
+func (m *MockShippingRepositoryInterface) UpdateZone(arg0 context.Context, arg1 *models.ShippingZone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateZone", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateZone indicates an expected call of UpdateZone.
+func (mr *MockShippingRepositoryInterfaceMockRecorder) UpdateZone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateZone", reflect.TypeOf((*MockShippingRepositoryInterface)(nil).UpdateZone), arg0, arg1)
+}
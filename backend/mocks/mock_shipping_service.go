@@ -0,0 +1,290 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: ShippingServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockShippingServiceInterface is a mock of ShippingServiceInterface interface.
+type MockShippingServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockShippingServiceInterfaceMockRecorder
+}
+
+// MockShippingServiceInterfaceMockRecorder is the mock recorder for MockShippingServiceInterface.
+type MockShippingServiceInterfaceMockRecorder struct {
+	mock *MockShippingServiceInterface
+}
+
+// NewMockShippingServiceInterface creates a new mock instance.
+func NewMockShippingServiceInterface(ctrl *gomock.Controller) *MockShippingServiceInterface {
+	mock := &MockShippingServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockShippingServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShippingServiceInterface) EXPECT() *MockShippingServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateMethod mocks base method.
+func (m *MockShippingServiceInterface) CreateMethod(arg0 context.Context, arg1 dto.ShippingMethodInput) (*models.ShippingMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMethod", arg0, arg1)
+	ret0, _ := ret[0].(*models.ShippingMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMethod indicates an expected call of CreateMethod.
+func (mr *MockShippingServiceInterfaceMockRecorder) CreateMethod(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMethod", reflect.TypeOf((*MockShippingServiceInterface)(nil).CreateMethod), arg0, arg1)
+}
+
+// CreatePickupPoint mocks base method.
+func (m *MockShippingServiceInterface) CreatePickupPoint(arg0 context.Context, arg1 dto.PickupPointInput) (*models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePickupPoint", arg0, arg1)
+	ret0, _ := ret[0].(*models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePickupPoint indicates an expected call of CreatePickupPoint.
+func (mr *MockShippingServiceInterfaceMockRecorder) CreatePickupPoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePickupPoint", reflect.TypeOf((*MockShippingServiceInterface)(nil).CreatePickupPoint), arg0, arg1)
+}
+
+// CreateZone mocks base method.
+func (m *MockShippingServiceInterface) CreateZone(arg0 context.Context, arg1 dto.ShippingZoneInput) (*models.ShippingZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateZone", arg0, arg1)
+	ret0, _ := ret[0].(*models.ShippingZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateZone indicates an expected call of CreateZone.
+func (mr *MockShippingServiceInterfaceMockRecorder) CreateZone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateZone", reflect.TypeOf((*MockShippingServiceInterface)(nil).CreateZone), arg0, arg1)
+}
+
+// DeleteMethod mocks base method.
+func (m *MockShippingServiceInterface) DeleteMethod(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMethod", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMethod indicates an expected call of DeleteMethod.
+func (mr *MockShippingServiceInterfaceMockRecorder) DeleteMethod(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMethod", reflect.TypeOf((*MockShippingServiceInterface)(nil).DeleteMethod), arg0, arg1, arg2)
+}
+
+// DeletePickupPoint mocks base method.
+func (m *MockShippingServiceInterface) DeletePickupPoint(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePickupPoint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePickupPoint indicates an expected call of DeletePickupPoint.
+func (mr *MockShippingServiceInterfaceMockRecorder) DeletePickupPoint(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePickupPoint", reflect.TypeOf((*MockShippingServiceInterface)(nil).DeletePickupPoint), arg0, arg1, arg2)
+}
+
+// DeleteZone mocks base method.
+func (m *MockShippingServiceInterface) DeleteZone(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteZone", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteZone indicates an expected call of DeleteZone.
+func (mr *MockShippingServiceInterfaceMockRecorder) DeleteZone(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteZone", reflect.TypeOf((*MockShippingServiceInterface)(nil).DeleteZone), arg0, arg1, arg2)
+}
+
+// GetAllPickupPoints mocks base method.
+func (m *MockShippingServiceInterface) GetAllPickupPoints(arg0 context.Context) ([]models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPickupPoints", arg0)
+	ret0, _ := ret[0].([]models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPickupPoints indicates an expected call of GetAllPickupPoints.
+func (mr *MockShippingServiceInterfaceMockRecorder) GetAllPickupPoints(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPickupPoints", reflect.TypeOf((*MockShippingServiceInterface)(nil).GetAllPickupPoints), arg0)
+}
+
+// GetMethodByID mocks base method.
+func (m *MockShippingServiceInterface) GetMethodByID(arg0 context.Context, arg1 uuid.UUID) (*models.ShippingMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMethodByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.ShippingMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMethodByID indicates an expected call of GetMethodByID.
+func (mr *MockShippingServiceInterfaceMockRecorder) GetMethodByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMethodByID", reflect.TypeOf((*MockShippingServiceInterface)(nil).GetMethodByID), arg0, arg1)
+}
+
+// GetMethods mocks base method.
+func (m *MockShippingServiceInterface) GetMethods(arg0 context.Context, arg1 dto.ShippingMethodFilter) ([]models.ShippingMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMethods", arg0, arg1)
+	ret0, _ := ret[0].([]models.ShippingMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMethods indicates an expected call of GetMethods.
+func (mr *MockShippingServiceInterfaceMockRecorder) GetMethods(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMethods", reflect.TypeOf((*MockShippingServiceInterface)(nil).GetMethods), arg0, arg1)
+}
+
+// GetPickupPointByID mocks base method.
+func (m *MockShippingServiceInterface) GetPickupPointByID(arg0 context.Context, arg1 uuid.UUID) (*models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickupPointByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickupPointByID indicates an expected call of GetPickupPointByID.
+func (mr *MockShippingServiceInterfaceMockRecorder) GetPickupPointByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickupPointByID", reflect.TypeOf((*MockShippingServiceInterface)(nil).GetPickupPointByID), arg0, arg1)
+}
+
+// GetPickupPoints mocks base method.
+func (m *MockShippingServiceInterface) GetPickupPoints(arg0 context.Context, arg1 dto.PickupPointQuery) ([]models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickupPoints", arg0, arg1)
+	ret0, _ := ret[0].([]models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickupPoints indicates an expected call of GetPickupPoints.
+func (mr *MockShippingServiceInterfaceMockRecorder) GetPickupPoints(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickupPoints", reflect.TypeOf((*MockShippingServiceInterface)(nil).GetPickupPoints), arg0, arg1)
+}
+
+// GetZones mocks base method.
+func (m *MockShippingServiceInterface) GetZones(arg0 context.Context) ([]models.ShippingZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetZones", arg0)
+	ret0, _ := ret[0].([]models.ShippingZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetZones indicates an expected call of GetZones.
+func (mr *MockShippingServiceInterfaceMockRecorder) GetZones(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZones", reflect.TypeOf((*MockShippingServiceInterface)(nil).GetZones), arg0)
+}
+
+// Quote mocks base method.
+func (m *MockShippingServiceInterface) Quote(arg0 context.Context, arg1 uuid.UUID, arg2 dto.ShippingQuoteQuery) ([]dto.ShippingQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]dto.ShippingQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockShippingServiceInterfaceMockRecorder) Quote(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockShippingServiceInterface)(nil).Quote), arg0, arg1, arg2)
+}
+
+// QuoteCart mocks base method.
+func (m *MockShippingServiceInterface) QuoteCart(arg0 context.Context, arg1 *dto.Cart, arg2 string) ([]dto.ShippingQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteCart", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]dto.ShippingQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteCart indicates an expected call of QuoteCart.
+func (mr *MockShippingServiceInterfaceMockRecorder) QuoteCart(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteCart", reflect.TypeOf((*MockShippingServiceInterface)(nil).QuoteCart), arg0, arg1, arg2)
+}
+
+// UpdateMethod mocks base method.
+func (m *MockShippingServiceInterface) UpdateMethod(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 dto.ShippingMethodInput) (*models.ShippingMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMethod", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.ShippingMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMethod indicates an expected call of UpdateMethod.
+func (mr *MockShippingServiceInterfaceMockRecorder) UpdateMethod(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMethod", reflect.TypeOf((*MockShippingServiceInterface)(nil).UpdateMethod), arg0, arg1, arg2, arg3)
+}
+
+// UpdatePickupPoint mocks base method.
+func (m *MockShippingServiceInterface) UpdatePickupPoint(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 dto.PickupPointInput) (*models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePickupPoint", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePickupPoint indicates an expected call of UpdatePickupPoint.
+func (mr *MockShippingServiceInterfaceMockRecorder) UpdatePickupPoint(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePickupPoint", reflect.TypeOf((*MockShippingServiceInterface)(nil).UpdatePickupPoint), arg0, arg1, arg2, arg3)
+}
+
+// UpdateZone mocks base method.
+func (m *MockShippingServiceInterface) UpdateZone(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 dto.ShippingZoneInput) (*models.ShippingZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateZone", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.ShippingZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateZone indicates an expected call of UpdateZone.
+func (mr *MockShippingServiceInterfaceMockRecorder) UpdateZone(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateZone", reflect.TypeOf((*MockShippingServiceInterface)(nil).UpdateZone), arg0, arg1, arg2, arg3)
+}
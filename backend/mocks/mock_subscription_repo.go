@@ -0,0 +1,199 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: SubscriptionRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockSubscriptionRepositoryInterface is a mock of SubscriptionRepositoryInterface interface.
+type MockSubscriptionRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionRepositoryInterfaceMockRecorder
+}
+
+// MockSubscriptionRepositoryInterfaceMockRecorder is the mock recorder for MockSubscriptionRepositoryInterface.
+type MockSubscriptionRepositoryInterfaceMockRecorder struct {
+	mock *MockSubscriptionRepositoryInterface
+}
+
+// NewMockSubscriptionRepositoryInterface creates a new mock instance.
+func NewMockSubscriptionRepositoryInterface(ctrl *gomock.Controller) *MockSubscriptionRepositoryInterface {
+	mock := &MockSubscriptionRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptionRepositoryInterface) EXPECT() *MockSubscriptionRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CountByUser mocks base method.
+func (m *MockSubscriptionRepositoryInterface) CountByUser(arg0 context.Context, arg1 uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByUser", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByUser indicates an expected call of CountByUser.
+func (mr *MockSubscriptionRepositoryInterfaceMockRecorder) CountByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUser", reflect.TypeOf((*MockSubscriptionRepositoryInterface)(nil).CountByUser), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockSubscriptionRepositoryInterface) Create(arg0 context.Context, arg1 *models.StockSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSubscriptionRepositoryInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSubscriptionRepositoryInterface)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockSubscriptionRepositoryInterface) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSubscriptionRepositoryInterfaceMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSubscriptionRepositoryInterface)(nil).Delete), arg0, arg1)
+}
+
+// Exists mocks base method.
+func (m *MockSubscriptionRepositoryInterface) Exists(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockSubscriptionRepositoryInterfaceMockRecorder) Exists(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockSubscriptionRepositoryInterface)(nil).Exists), arg0, arg1, arg2, arg3)
+}
+
+// Fire mocks base method.
+func (m *MockSubscriptionRepositoryInterface) Fire(arg0 context.Context, arg1 *models.StockSubscription, arg2 *models.OutboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fire", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Fire indicates an expected call of Fire.
+func (mr *MockSubscriptionRepositoryInterfaceMockRecorder) Fire(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fire", reflect.TypeOf((*MockSubscriptionRepositoryInterface)(nil).Fire), arg0, arg1, arg2)
+}
+
+// GetBookStock mocks base method.
+func (m *MockSubscriptionRepositoryInterface) GetBookStock(arg0 context.Context, arg1 uuid.UUID) (*dto.BookStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookStock", arg0, arg1)
+	ret0, _ := ret[0].(*dto.BookStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookStock indicates an expected call of GetBookStock.
+func (mr *MockSubscriptionRepositoryInterfaceMockRecorder) GetBookStock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookStock", reflect.TypeOf((*MockSubscriptionRepositoryInterface)(nil).GetBookStock), arg0, arg1)
+}
+
+// GetByBook mocks base method.
+func (m *MockSubscriptionRepositoryInterface) GetByBook(arg0 context.Context, arg1 uuid.UUID) ([]models.StockSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByBook", arg0, arg1)
+	ret0, _ := ret[0].([]models.StockSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByBook indicates an expected call of GetByBook.
+func (mr *MockSubscriptionRepositoryInterfaceMockRecorder) GetByBook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByBook", reflect.TypeOf((*MockSubscriptionRepositoryInterface)(nil).GetByBook), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockSubscriptionRepositoryInterface) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.StockSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.StockSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSubscriptionRepositoryInterfaceMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSubscriptionRepositoryInterface)(nil).GetByID), arg0, arg1)
+}
+
+// GetByToken mocks base method.
+func (m *MockSubscriptionRepositoryInterface) GetByToken(arg0 context.Context, arg1 string) (*models.StockSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByToken", arg0, arg1)
+	ret0, _ := ret[0].(*models.StockSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByToken indicates an expected call of GetByToken.
+func (mr *MockSubscriptionRepositoryInterfaceMockRecorder) GetByToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByToken", reflect.TypeOf((*MockSubscriptionRepositoryInterface)(nil).GetByToken), arg0, arg1)
+}
+
+// GetByUser mocks base method.
+func (m *MockSubscriptionRepositoryInterface) GetByUser(arg0 context.Context, arg1 uuid.UUID) ([]models.StockSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUser", arg0, arg1)
+	ret0, _ := ret[0].([]models.StockSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUser indicates an expected call of GetByUser.
+func (mr *MockSubscriptionRepositoryInterfaceMockRecorder) GetByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUser", reflect.TypeOf((*MockSubscriptionRepositoryInterface)(nil).GetByUser), arg0, arg1)
+}
+
+// Rearm mocks base method.
+func (m *MockSubscriptionRepositoryInterface) Rearm(arg0 context.Context, arg1 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rearm", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rearm indicates an expected call of Rearm.
+func (mr *MockSubscriptionRepositoryInterfaceMockRecorder) Rearm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rearm", reflect.TypeOf((*MockSubscriptionRepositoryInterface)(nil).Rearm), arg0, arg1)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: SubscriptionServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockSubscriptionServiceInterface is a mock of SubscriptionServiceInterface interface.
+type MockSubscriptionServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionServiceInterfaceMockRecorder
+}
+
+// MockSubscriptionServiceInterfaceMockRecorder is the mock recorder for MockSubscriptionServiceInterface.
+type MockSubscriptionServiceInterfaceMockRecorder struct {
+	mock *MockSubscriptionServiceInterface
+}
+
+// NewMockSubscriptionServiceInterface creates a new mock instance.
+func NewMockSubscriptionServiceInterface(ctrl *gomock.Controller) *MockSubscriptionServiceInterface {
+	mock := &MockSubscriptionServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptionServiceInterface) EXPECT() *MockSubscriptionServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetMine mocks base method.
+func (m *MockSubscriptionServiceInterface) GetMine(arg0 context.Context, arg1 uuid.UUID) ([]models.StockSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMine", arg0, arg1)
+	ret0, _ := ret[0].([]models.StockSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMine indicates an expected call of GetMine.
+func (mr *MockSubscriptionServiceInterfaceMockRecorder) GetMine(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMine", reflect.TypeOf((*MockSubscriptionServiceInterface)(nil).GetMine), arg0, arg1)
+}
+
+// Subscribe mocks base method.
+func (m *MockSubscriptionServiceInterface) Subscribe(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 dto.SubscriptionInput) (*models.StockSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.StockSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockSubscriptionServiceInterfaceMockRecorder) Subscribe(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSubscriptionServiceInterface)(nil).Subscribe), arg0, arg1, arg2, arg3)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockSubscriptionServiceInterface) Unsubscribe(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockSubscriptionServiceInterfaceMockRecorder) Unsubscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockSubscriptionServiceInterface)(nil).Unsubscribe), arg0, arg1, arg2)
+}
+
+// UnsubscribeByToken mocks base method.
+func (m *MockSubscriptionServiceInterface) UnsubscribeByToken(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeByToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsubscribeByToken indicates an expected call of UnsubscribeByToken.
+func (mr *MockSubscriptionServiceInterfaceMockRecorder) UnsubscribeByToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeByToken", reflect.TypeOf((*MockSubscriptionServiceInterface)(nil).UnsubscribeByToken), arg0, arg1)
+}
@@ -96,6 +96,21 @@ func (mr *MockUserServiceInterfaceMockRecorder) GetByID(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserServiceInterface)(nil).GetByID), arg0, arg1)
 }
 
+// Patch mocks base method.
+func (m *MockUserServiceInterface) Patch(arg0 context.Context, arg1 uuid.UUID, arg2 []byte) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockUserServiceInterfaceMockRecorder) Patch(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockUserServiceInterface)(nil).Patch), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
 func (m *MockUserServiceInterface) Update(arg0 context.Context, arg1 uuid.UUID, arg2 dto.UpdateUserRequest) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,169 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: WarehouseRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockWarehouseRepositoryInterface is a mock of WarehouseRepositoryInterface interface.
+type MockWarehouseRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWarehouseRepositoryInterfaceMockRecorder
+}
+
+// MockWarehouseRepositoryInterfaceMockRecorder is the mock recorder for MockWarehouseRepositoryInterface.
+type MockWarehouseRepositoryInterfaceMockRecorder struct {
+	mock *MockWarehouseRepositoryInterface
+}
+
+// NewMockWarehouseRepositoryInterface creates a new mock instance.
+func NewMockWarehouseRepositoryInterface(ctrl *gomock.Controller) *MockWarehouseRepositoryInterface {
+	mock := &MockWarehouseRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockWarehouseRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWarehouseRepositoryInterface) EXPECT() *MockWarehouseRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWarehouseRepositoryInterface) Create(arg0 context.Context, arg1 *models.Warehouse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWarehouseRepositoryInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWarehouseRepositoryInterface)(nil).Create), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockWarehouseRepositoryInterface) GetAll(arg0 context.Context, arg1 bool) ([]models.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]models.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockWarehouseRepositoryInterfaceMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockWarehouseRepositoryInterface)(nil).GetAll), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockWarehouseRepositoryInterface) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWarehouseRepositoryInterfaceMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWarehouseRepositoryInterface)(nil).GetByID), arg0, arg1)
+}
+
+// GetEditionStock mocks base method.
+func (m *MockWarehouseRepositoryInterface) GetEditionStock(arg0 context.Context, arg1 uuid.UUID) ([]models.EditionStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEditionStock", arg0, arg1)
+	ret0, _ := ret[0].([]models.EditionStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEditionStock indicates an expected call of GetEditionStock.
+func (mr *MockWarehouseRepositoryInterfaceMockRecorder) GetEditionStock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEditionStock", reflect.TypeOf((*MockWarehouseRepositoryInterface)(nil).GetEditionStock), arg0, arg1)
+}
+
+// GetStock mocks base method.
+func (m *MockWarehouseRepositoryInterface) GetStock(arg0 context.Context, arg1 uuid.UUID) ([]models.EditionStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStock", arg0, arg1)
+	ret0, _ := ret[0].([]models.EditionStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStock indicates an expected call of GetStock.
+func (mr *MockWarehouseRepositoryInterfaceMockRecorder) GetStock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStock", reflect.TypeOf((*MockWarehouseRepositoryInterface)(nil).GetStock), arg0, arg1)
+}
+
+// GetTransfers mocks base method.
+func (m *MockWarehouseRepositoryInterface) GetTransfers(arg0 context.Context, arg1 dto.StockTransferFilter) ([]models.StockTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfers", arg0, arg1)
+	ret0, _ := ret[0].([]models.StockTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfers indicates an expected call of GetTransfers.
+func (mr *MockWarehouseRepositoryInterfaceMockRecorder) GetTransfers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfers", reflect.TypeOf((*MockWarehouseRepositoryInterface)(nil).GetTransfers), arg0, arg1)
+}
+
+// SetStock mocks base method.
+func (m *MockWarehouseRepositoryInterface) SetStock(arg0 context.Context, arg1 *models.EditionStock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStock", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStock indicates an expected call of SetStock.
+func (mr *MockWarehouseRepositoryInterfaceMockRecorder) SetStock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStock", reflect.TypeOf((*MockWarehouseRepositoryInterface)(nil).SetStock), arg0, arg1)
+}
+
+// Transfer mocks base method.
+func (m *MockWarehouseRepositoryInterface) Transfer(arg0 context.Context, arg1 *models.StockTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockWarehouseRepositoryInterfaceMockRecorder) Transfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockWarehouseRepositoryInterface)(nil).Transfer), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockWarehouseRepositoryInterface) Update(arg0 context.Context, arg1 *models.Warehouse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWarehouseRepositoryInterfaceMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWarehouseRepositoryInterface)(nil).Update), arg0, arg1)
+}
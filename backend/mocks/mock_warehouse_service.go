@@ -0,0 +1,188 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: WarehouseServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockWarehouseServiceInterface is a mock of WarehouseServiceInterface interface.
+type MockWarehouseServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWarehouseServiceInterfaceMockRecorder
+}
+
+// MockWarehouseServiceInterfaceMockRecorder is the mock recorder for MockWarehouseServiceInterface.
+type MockWarehouseServiceInterfaceMockRecorder struct {
+	mock *MockWarehouseServiceInterface
+}
+
+// NewMockWarehouseServiceInterface creates a new mock instance.
+func NewMockWarehouseServiceInterface(ctrl *gomock.Controller) *MockWarehouseServiceInterface {
+	mock := &MockWarehouseServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockWarehouseServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWarehouseServiceInterface) EXPECT() *MockWarehouseServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWarehouseServiceInterface) Create(arg0 context.Context, arg1 dto.WarehouseInput) (*models.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*models.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWarehouseServiceInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWarehouseServiceInterface)(nil).Create), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockWarehouseServiceInterface) GetAll(arg0 context.Context) ([]models.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0)
+	ret0, _ := ret[0].([]models.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockWarehouseServiceInterfaceMockRecorder) GetAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockWarehouseServiceInterface)(nil).GetAll), arg0)
+}
+
+// GetByID mocks base method.
+func (m *MockWarehouseServiceInterface) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWarehouseServiceInterfaceMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWarehouseServiceInterface)(nil).GetByID), arg0, arg1)
+}
+
+// GetEditionStock mocks base method.
+func (m *MockWarehouseServiceInterface) GetEditionStock(arg0 context.Context, arg1 uuid.UUID) ([]models.EditionStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEditionStock", arg0, arg1)
+	ret0, _ := ret[0].([]models.EditionStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEditionStock indicates an expected call of GetEditionStock.
+func (mr *MockWarehouseServiceInterfaceMockRecorder) GetEditionStock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEditionStock", reflect.TypeOf((*MockWarehouseServiceInterface)(nil).GetEditionStock), arg0, arg1)
+}
+
+// GetStock mocks base method.
+func (m *MockWarehouseServiceInterface) GetStock(arg0 context.Context, arg1 uuid.UUID) ([]models.EditionStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStock", arg0, arg1)
+	ret0, _ := ret[0].([]models.EditionStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStock indicates an expected call of GetStock.
+func (mr *MockWarehouseServiceInterfaceMockRecorder) GetStock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStock", reflect.TypeOf((*MockWarehouseServiceInterface)(nil).GetStock), arg0, arg1)
+}
+
+// GetTransfers mocks base method.
+func (m *MockWarehouseServiceInterface) GetTransfers(arg0 context.Context, arg1 dto.StockTransferFilter) ([]models.StockTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfers", arg0, arg1)
+	ret0, _ := ret[0].([]models.StockTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfers indicates an expected call of GetTransfers.
+func (mr *MockWarehouseServiceInterfaceMockRecorder) GetTransfers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfers", reflect.TypeOf((*MockWarehouseServiceInterface)(nil).GetTransfers), arg0, arg1)
+}
+
+// SetStock mocks base method.
+func (m *MockWarehouseServiceInterface) SetStock(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 dto.StockInput) (*models.Edition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStock", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Edition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStock indicates an expected call of SetStock.
+func (mr *MockWarehouseServiceInterfaceMockRecorder) SetStock(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStock", reflect.TypeOf((*MockWarehouseServiceInterface)(nil).SetStock), arg0, arg1, arg2, arg3)
+}
+
+// ShipFrom mocks base method.
+func (m *MockWarehouseServiceInterface) ShipFrom(arg0 context.Context, arg1 *uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShipFrom", arg0, arg1)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShipFrom indicates an expected call of ShipFrom.
+func (mr *MockWarehouseServiceInterfaceMockRecorder) ShipFrom(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShipFrom", reflect.TypeOf((*MockWarehouseServiceInterface)(nil).ShipFrom), arg0, arg1)
+}
+
+// Transfer mocks base method.
+func (m *MockWarehouseServiceInterface) Transfer(arg0 context.Context, arg1 dto.StockTransferInput) (*models.StockTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", arg0, arg1)
+	ret0, _ := ret[0].(*models.StockTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockWarehouseServiceInterfaceMockRecorder) Transfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockWarehouseServiceInterface)(nil).Transfer), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockWarehouseServiceInterface) Update(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 dto.WarehouseInput) (*models.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWarehouseServiceInterfaceMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWarehouseServiceInterface)(nil).Update), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,211 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: WishlistRepositoryInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockWishlistRepositoryInterface is a mock of WishlistRepositoryInterface interface.
+type MockWishlistRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWishlistRepositoryInterfaceMockRecorder
+}
+
+// MockWishlistRepositoryInterfaceMockRecorder is the mock recorder for MockWishlistRepositoryInterface.
+type MockWishlistRepositoryInterfaceMockRecorder struct {
+	mock *MockWishlistRepositoryInterface
+}
+
+// NewMockWishlistRepositoryInterface creates a new mock instance.
+func NewMockWishlistRepositoryInterface(ctrl *gomock.Controller) *MockWishlistRepositoryInterface {
+	mock := &MockWishlistRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockWishlistRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWishlistRepositoryInterface) EXPECT() *MockWishlistRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockWishlistRepositoryInterface) AddItem(arg0 context.Context, arg1 *models.WishlistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockWishlistRepositoryInterfaceMockRecorder) AddItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockWishlistRepositoryInterface)(nil).AddItem), arg0, arg1)
+}
+
+// CountByUser mocks base method.
+func (m *MockWishlistRepositoryInterface) CountByUser(arg0 context.Context, arg1 uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByUser", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByUser indicates an expected call of CountByUser.
+func (mr *MockWishlistRepositoryInterfaceMockRecorder) CountByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUser", reflect.TypeOf((*MockWishlistRepositoryInterface)(nil).CountByUser), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockWishlistRepositoryInterface) Create(arg0 context.Context, arg1 *models.Wishlist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWishlistRepositoryInterfaceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWishlistRepositoryInterface)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockWishlistRepositoryInterface) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWishlistRepositoryInterfaceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWishlistRepositoryInterface)(nil).Delete), arg0, arg1, arg2)
+}
+
+// GetAllByUser mocks base method.
+func (m *MockWishlistRepositoryInterface) GetAllByUser(arg0 context.Context, arg1 uuid.UUID) ([]models.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUser", arg0, arg1)
+	ret0, _ := ret[0].([]models.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUser indicates an expected call of GetAllByUser.
+func (mr *MockWishlistRepositoryInterfaceMockRecorder) GetAllByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockWishlistRepositoryInterface)(nil).GetAllByUser), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockWishlistRepositoryInterface) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWishlistRepositoryInterfaceMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWishlistRepositoryInterface)(nil).GetByID), arg0, arg1)
+}
+
+// GetByName mocks base method.
+func (m *MockWishlistRepositoryInterface) GetByName(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*models.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockWishlistRepositoryInterfaceMockRecorder) GetByName(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockWishlistRepositoryInterface)(nil).GetByName), arg0, arg1, arg2)
+}
+
+// GetByShareToken mocks base method.
+func (m *MockWishlistRepositoryInterface) GetByShareToken(arg0 context.Context, arg1 string) (*models.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByShareToken", arg0, arg1)
+	ret0, _ := ret[0].(*models.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByShareToken indicates an expected call of GetByShareToken.
+func (mr *MockWishlistRepositoryInterfaceMockRecorder) GetByShareToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByShareToken", reflect.TypeOf((*MockWishlistRepositoryInterface)(nil).GetByShareToken), arg0, arg1)
+}
+
+// GetWatchers mocks base method.
+func (m *MockWishlistRepositoryInterface) GetWatchers(arg0 context.Context, arg1 uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchers", arg0, arg1)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWatchers indicates an expected call of GetWatchers.
+func (mr *MockWishlistRepositoryInterfaceMockRecorder) GetWatchers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchers", reflect.TypeOf((*MockWishlistRepositoryInterface)(nil).GetWatchers), arg0, arg1)
+}
+
+// MoveToCart mocks base method.
+func (m *MockWishlistRepositoryInterface) MoveToCart(arg0 context.Context, arg1 *models.WishlistItem, arg2 uuid.UUID, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToCart", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveToCart indicates an expected call of MoveToCart.
+func (mr *MockWishlistRepositoryInterfaceMockRecorder) MoveToCart(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToCart", reflect.TypeOf((*MockWishlistRepositoryInterface)(nil).MoveToCart), arg0, arg1, arg2, arg3)
+}
+
+// RemoveItem mocks base method.
+func (m *MockWishlistRepositoryInterface) RemoveItem(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockWishlistRepositoryInterfaceMockRecorder) RemoveItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockWishlistRepositoryInterface)(nil).RemoveItem), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockWishlistRepositoryInterface) Update(arg0 context.Context, arg1 *models.Wishlist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWishlistRepositoryInterfaceMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWishlistRepositoryInterface)(nil).Update), arg0, arg1)
+}
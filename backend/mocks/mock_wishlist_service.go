@@ -0,0 +1,202 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TheMatrix2/Bookstore-Info-System/backend/internal/interfaces (interfaces: WishlistServiceInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	models "github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockWishlistServiceInterface is a mock of WishlistServiceInterface interface.
+type MockWishlistServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWishlistServiceInterfaceMockRecorder
+}
+
+// MockWishlistServiceInterfaceMockRecorder is the mock recorder for MockWishlistServiceInterface.
+type MockWishlistServiceInterfaceMockRecorder struct {
+	mock *MockWishlistServiceInterface
+}
+
+// NewMockWishlistServiceInterface creates a new mock instance.
+func NewMockWishlistServiceInterface(ctrl *gomock.Controller) *MockWishlistServiceInterface {
+	mock := &MockWishlistServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockWishlistServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWishlistServiceInterface) EXPECT() *MockWishlistServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockWishlistServiceInterface) AddItem(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 dto.WishlistItemInput) (*models.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockWishlistServiceInterfaceMockRecorder) AddItem(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockWishlistServiceInterface)(nil).AddItem), arg0, arg1, arg2, arg3)
+}
+
+// Create mocks base method.
+func (m *MockWishlistServiceInterface) Create(arg0 context.Context, arg1 uuid.UUID, arg2 dto.WishlistInput) (*models.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWishlistServiceInterfaceMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWishlistServiceInterface)(nil).Create), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockWishlistServiceInterface) Delete(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWishlistServiceInterfaceMockRecorder) Delete(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWishlistServiceInterface)(nil).Delete), arg0, arg1, arg2, arg3)
+}
+
+// GetAll mocks base method.
+func (m *MockWishlistServiceInterface) GetAll(arg0 context.Context, arg1 uuid.UUID) ([]models.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]models.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockWishlistServiceInterfaceMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockWishlistServiceInterface)(nil).GetAll), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockWishlistServiceInterface) GetByID(arg0 context.Context, arg1, arg2 uuid.UUID) (*models.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWishlistServiceInterfaceMockRecorder) GetByID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWishlistServiceInterface)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// GetShared mocks base method.
+func (m *MockWishlistServiceInterface) GetShared(arg0 context.Context, arg1 string) (*dto.SharedWishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShared", arg0, arg1)
+	ret0, _ := ret[0].(*dto.SharedWishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShared indicates an expected call of GetShared.
+func (mr *MockWishlistServiceInterfaceMockRecorder) GetShared(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShared", reflect.TypeOf((*MockWishlistServiceInterface)(nil).GetShared), arg0, arg1)
+}
+
+// MoveToCart mocks base method.
+func (m *MockWishlistServiceInterface) MoveToCart(arg0 context.Context, arg1, arg2, arg3 uuid.UUID, arg4 dto.MoveToCartInput) (*models.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToCart", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*models.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveToCart indicates an expected call of MoveToCart.
+func (mr *MockWishlistServiceInterfaceMockRecorder) MoveToCart(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToCart", reflect.TypeOf((*MockWishlistServiceInterface)(nil).MoveToCart), arg0, arg1, arg2, arg3, arg4)
+}
+
+// RemoveItem mocks base method.
+func (m *MockWishlistServiceInterface) RemoveItem(arg0 context.Context, arg1, arg2, arg3 uuid.UUID) (*models.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockWishlistServiceInterfaceMockRecorder) RemoveItem(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockWishlistServiceInterface)(nil).RemoveItem), arg0, arg1, arg2, arg3)
+}
+
+// Share mocks base method.
+func (m *MockWishlistServiceInterface) Share(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 int64) (*models.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Share", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Share indicates an expected call of Share.
+func (mr *MockWishlistServiceInterfaceMockRecorder) Share(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Share", reflect.TypeOf((*MockWishlistServiceInterface)(nil).Share), arg0, arg1, arg2, arg3)
+}
+
+// Unshare mocks base method.
+func (m *MockWishlistServiceInterface) Unshare(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 int64) (*models.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unshare", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unshare indicates an expected call of Unshare.
+func (mr *MockWishlistServiceInterfaceMockRecorder) Unshare(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unshare", reflect.TypeOf((*MockWishlistServiceInterface)(nil).Unshare), arg0, arg1, arg2, arg3)
+}
+
+// Update mocks base method.
+func (m *MockWishlistServiceInterface) Update(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 int64, arg4 dto.WishlistInput) (*models.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*models.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWishlistServiceInterfaceMockRecorder) Update(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWishlistServiceInterface)(nil).Update), arg0, arg1, arg2, arg3, arg4)
+}
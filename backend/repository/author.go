@@ -38,17 +38,26 @@ func (r *AuthorRepository) GetAll(ctx context.Context) ([]models.Author, error)
 }
 
 func (r *AuthorRepository) Update(ctx context.Context, author *models.Author) error {
-	_, err := r.db.NewUpdate().Model(author).Where("id = ?", author.ID).Exec(ctx)
+	expected := author.Version
+	author.Version++
+	res, err := r.db.NewUpdate().Model(author).Where("id = ?", author.ID).Where("version = ?", expected).Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to update author: %W", err)
+		author.Version = expected
+		return fmt.Errorf("failed to update author: %w", err)
 	}
 	return nil
 }
 
-func (r *AuthorRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	_, err := r.db.NewDelete().Model((*models.Author)(nil)).Where("id = ?", id).Exec(ctx)
+func (r *AuthorRepository) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	res, err := r.db.NewDelete().Model((*models.Author)(nil)).Where("id = ?", id).Where("version = ?", version).Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to delete author: %W", err)
+		return fmt.Errorf("failed to delete author: %w", err)
 	}
 	return nil
 }
\ No newline at end of file
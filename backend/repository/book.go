@@ -6,10 +6,17 @@ import (
 
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/money"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 )
 
+// localEditionPrice is the price of edition e in a currency: its fixed price
+// there, or its base price converted at a rate and rounded the way
+// money.Rate.Convert rounds. It takes the currency, the rate in millionths
+// and money.RateScale.
+const localEditionPrice = "coalesce((SELECT ep.price FROM edition_prices AS ep WHERE ep.edition_id = e.id AND ep.currency = ?), round(e.price * ?::numeric / ?))"
+
 type BookRepository struct {
 	db *bun.DB
 }
@@ -18,12 +25,16 @@ func NewBookRepository(db *bun.DB) *BookRepository {
 	return &BookRepository{db: db}
 }
 
-func (r *BookRepository) Create(ctx context.Context, book *models.Book, categoryIDs []uuid.UUID) error {
+func (r *BookRepository) Create(ctx context.Context, book *models.Book, contributors []models.BookContributor, categoryIDs []uuid.UUID) error {
 	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		_, err := r.db.NewInsert().Model(book).Exec(ctx)
+		_, err := tx.NewInsert().Model(book).Exec(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to create book: %W", err)
 		}
+
+		if err := insertContributors(ctx, tx, book.ID, contributors); err != nil {
+			return err
+		}
 		
 		if len(categoryIDs) > 0 {
 			relations := make([]models.BookToCategory, len(categoryIDs))
@@ -33,7 +44,7 @@ func (r *BookRepository) Create(ctx context.Context, book *models.Book, category
 					CategoryID: catID,
 				}
 			}
-			_, err := r.db.NewInsert().Model(&relations).Exec(ctx)
+			_, err := tx.NewInsert().Model(&relations).Exec(ctx)
 			if err != nil {
 				return fmt.Errorf("failed to create book-category relations: %W", err)
 			}
@@ -44,11 +55,8 @@ func (r *BookRepository) Create(ctx context.Context, book *models.Book, category
 
 func (r *BookRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Book, error) {
 	book := new(models.Book)
-	err := r.db.NewSelect().Model(book).
-		Relation("Author").
-		Relation("Publisher").
-		Relation("Categories").
-		Where("id = ?", id).
+	err := withBookRelations(r.db.NewSelect().Model(book)).
+		Where("book.id = ?", id).
 		Scan(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("book not found: %W", err)
@@ -56,41 +64,131 @@ func (r *BookRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Boo
 	return book, nil
 }
 
+func (r *BookRepository) GetByISBN(ctx context.Context, isbn13 string) (*models.Book, error) {
+	book := new(models.Book)
+	err := withBookRelations(r.db.NewSelect().Model(book)).
+		Where("EXISTS (SELECT 1 FROM editions AS e WHERE e.book_id = book.id AND e.isbn13 = ?)", isbn13).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("book not found: %w", err)
+	}
+	return book, nil
+}
+
 func (r *BookRepository) GetAll(ctx context.Context, filter dto.BookFilter) ([]models.Book, error) {
 	var books []models.Book
-	query := r.db.NewSelect().
-			Model(&books).
-			Relation("Author").
-			Relation("Publisher").
-			Relation("Categories")
+	query := withBookRelations(r.db.NewSelect().Model(&books))
+
+	err := applyBookFilter(query, filter).Scan(ctx)
 
+	return books, err
+}
+
+// withBookRelations loads contributors in their order together with the
+// categories and the editions of the book.
+func withBookRelations(query *bun.SelectQuery) *bun.SelectQuery {
+	return query.
+		Relation("Contributors", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("position")
+		}).
+		Relation("Contributors.Author").
+		Relation("Categories").
+		Relation("Editions", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("format", "created_at")
+		}).
+		Relation("Editions.Publisher")
+}
+
+// insertContributors stores the contributors of a book in the given order.
+func insertContributors(ctx context.Context, tx bun.Tx, bookID uuid.UUID, contributors []models.BookContributor) error {
+	if len(contributors) == 0 {
+		return nil
+	}
+	relations := make([]models.BookContributor, len(contributors))
+	for i, contributor := range contributors {
+		relations[i] = models.BookContributor{
+			BookID:   bookID,
+			AuthorID: contributor.AuthorID,
+			Role:     contributor.Role,
+			Position: i,
+		}
+	}
+	if _, err := tx.NewInsert().Model(&relations).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create book contributors: %w", err)
+	}
+	return nil
+}
+
+// applyBookFilter adds the conditions of the filter to a query over books aliased as "book".
+func applyBookFilter(query *bun.SelectQuery, filter dto.BookFilter) *bun.SelectQuery {
 	if filter.AuthorID != nil {
-		query = query.Where("book.author_id = ?", filter.AuthorID)
+		query = query.Where("EXISTS (SELECT 1 FROM book_contributors AS bc WHERE bc.book_id = book.id AND bc.author_id = ?)", *filter.AuthorID)
 	}
 	if filter.CategoryID != nil {
-		query = query.Join("JOIN book_to_category btc ON btc.book_id = book.id").
-			Where("btc.category_id = ?", *filter.CategoryID)
+		categories := query.NewSelect().TableExpr("categories").Column("id").Where("id = ?", *filter.CategoryID)
+		if filter.IncludeDescendants {
+			categories = query.NewSelect().
+				WithRecursive("subtree", categorySubtree(query.NewSelect(), *filter.CategoryID)).
+				Table("subtree").
+				Column("id")
+		}
+		query = query.Where("EXISTS (SELECT 1 FROM book_to_category AS btc WHERE btc.book_id = book.id AND btc.category_id IN (?))", categories)
 	}
-	if filter.MinPrice != nil {
-		query = query.Where("book.price >= ?", *filter.MinPrice)
+	if filter.MinPrice != nil || filter.MaxPrice != nil || filter.ISBN != nil || filter.Format != nil {
+		editions := query.NewSelect().
+			TableExpr("editions AS e").
+			ColumnExpr("1").
+			Where("e.book_id = book.id")
+		bound := func(op string, amount money.Amount) {
+			if filter.PriceCurrency == "" {
+				editions = editions.Where("e.price "+op+" ?", amount)
+				return
+			}
+			editions = editions.Where(localEditionPrice+" "+op+" ?",
+				filter.PriceCurrency, int64(filter.PriceRate), money.RateScale, amount)
+		}
+		if filter.MinPrice != nil {
+			bound(">=", *filter.MinPrice)
+		}
+		if filter.MaxPrice != nil {
+			bound("<=", *filter.MaxPrice)
+		}
+		if filter.ISBN != nil {
+			editions = editions.Where("e.isbn13 = ?", *filter.ISBN)
+		}
+		if filter.Format != nil {
+			editions = editions.Where("e.format = ?", *filter.Format)
+		}
+		query = query.Where("EXISTS (?)", editions)
 	}
-	if filter.MaxPrice != nil {
-		query = query.Where("book.price <= ?", *filter.MaxPrice)
+	if filter.SeriesID != nil {
+		query = query.Where("book.series_id = ?", *filter.SeriesID)
 	}
 	if filter.Search != nil {
 		searchTerm := "%" + *filter.Search + "%"
 		query = query.Where("book.title ILIKE ? OR book.description ILIKE ?", searchTerm, searchTerm)
 	}
-
-	err := query.Scan(ctx)
-
-	return books, err
+	return query
 }
 
-func (r *BookRepository) Update(ctx context.Context, book *models.Book, categoryIDs []uuid.UUID) error {
-	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		if _, err := r.db.NewUpdate().Model(book).WherePK().Exec(ctx); err != nil {
-			return fmt.Errorf("failed to update book: %W", err)
+func (r *BookRepository) Update(ctx context.Context, book *models.Book, contributors []models.BookContributor, categoryIDs []uuid.UUID) error {
+	expected := book.Version
+	book.Version++
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// the rating columns are maintained by the review repository
+		res, err := tx.NewUpdate().Model(book).ExcludeColumn("rating_average", "rating_count").WherePK().Where("version = ?", expected).Exec(ctx)
+		if err == nil {
+			err = checkVersionedWrite(res)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update book: %w", err)
+		}
+
+		if _, err := tx.NewDelete().Model(&models.BookContributor{}).Where("book_id = ?", book.ID).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete old book contributors: %w", err)
+		}
+		if err := insertContributors(ctx, tx, book.ID, contributors); err != nil {
+			return err
 		}
 
 		if _, err := tx.NewDelete().Model(&models.BookToCategory{}).Where("book_id = ?", book.ID).Exec(ctx); err != nil {
@@ -112,15 +210,48 @@ func (r *BookRepository) Update(ctx context.Context, book *models.Book, category
 		}
 		return nil
 	})
+	if err != nil {
+		book.Version = expected
+	}
+	return err
 }
 
-func (r *BookRepository) Delete(ctx context.Context, id uuid.UUID) error {
+func (r *BookRepository) Delete(ctx context.Context, id uuid.UUID, version int64) error {
 	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().Model(&models.BookContributor{}).Where("book_id = ?", id).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete book contributors: %w", err)
+		}
+		if _, err := tx.NewDelete().Model(&models.Review{}).Where("book_id = ?", id).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete book reviews: %w", err)
+		}
+		if _, err := tx.NewDelete().Model(&models.StockSubscription{}).Where("book_id = ?", id).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete book subscriptions: %w", err)
+		}
+		if _, err := tx.NewDelete().Model(&models.RelatedBook{}).Where("book_id = ? OR related_book_id = ?", id, id).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete related books: %w", err)
+		}
+		editions := tx.NewSelect().Model((*models.Edition)(nil)).Column("id").Where("book_id = ?", id)
+		if _, err := tx.NewDelete().Model(&models.WishlistItem{}).Where("edition_id IN (?)", editions).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete wishlist items: %w", err)
+		}
+		if _, err := tx.NewDelete().Model(&models.EditionPrice{}).Where("edition_id IN (?)", editions).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete edition prices: %w", err)
+		}
+		if _, err := tx.NewDelete().Model(&models.EditionStock{}).Where("edition_id IN (?)", editions).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete edition stock: %w", err)
+		}
+		if _, err := tx.NewDelete().Model(&models.Edition{}).Where("book_id = ?", id).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete book editions: %w", constraintError(err))
+		}
 		if _, err := tx.NewDelete().Model(&models.BookToCategory{}).Where("book_id = ?", id).Exec(ctx); err != nil {
 			return fmt.Errorf("failed to delete book-category relations: %W", err)
 		}
-		if _, err := tx.NewDelete().Model(&models.Book{}).Where("id = ?", id).Exec(ctx); err != nil {
-			return fmt.Errorf("failed to delete book: %W", err)
+		res, err := tx.NewDelete().Model(&models.Book{}).Where("id = ?", id).Where("version = ?", version).Exec(ctx)
+		if err == nil {
+			err = checkVersionedWrite(res)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete book: %w", err)
 		}
 		return nil
 	})
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type CartRepository struct {
+	db *bun.DB
+}
+
+func NewCartRepository(db *bun.DB) *CartRepository {
+	return &CartRepository{db: db}
+}
+
+// GetByUser returns the user's cart with its editions and what promotions
+// need to know about their books. A user without a cart gets an empty one.
+func (r *CartRepository) GetByUser(ctx context.Context, userID uuid.UUID) (*models.Cart, error) {
+	cart := new(models.Cart)
+	err := r.db.NewSelect().
+		Model(cart).
+		Relation("Items", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("cart_item.id")
+		}).
+		Relation("Items.Edition").
+		Relation("Items.Edition.Book").
+		Relation("Items.Edition.Book.Contributors").
+		Relation("Items.Edition.Book.Categories").
+		Where("cart.user_id = ?", userID).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &models.Cart{UserID: userID, Items: []*models.CartItem{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cart: %w", err)
+	}
+	return cart, nil
+}
+
+// SetItem puts quantity copies of the edition into the user's cart,
+// creating the cart if needed.
+func (r *CartRepository) SetItem(ctx context.Context, userID, editionID uuid.UUID, quantity int) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		cart := &models.Cart{UserID: userID}
+		if _, err := tx.NewInsert().Model(cart).On("CONFLICT (user_id) DO NOTHING").Returning("NULL").Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create cart: %w", err)
+		}
+		if err := tx.NewSelect().Model(cart).Where("user_id = ?", userID).Scan(ctx); err != nil {
+			return fmt.Errorf("failed to load cart: %w", err)
+		}
+		item := &models.CartItem{CartID: cart.ID, EditionID: editionID, Quantity: quantity}
+		_, err := tx.NewInsert().
+			Model(item).
+			On("CONFLICT (cart_id, edition_id) DO UPDATE").
+			Set("quantity = EXCLUDED.quantity").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to set cart item: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *CartRepository) RemoveItem(ctx context.Context, userID, editionID uuid.UUID) error {
+	_, err := r.db.NewDelete().
+		Model((*models.CartItem)(nil)).
+		Where("cart_id IN (SELECT id FROM carts WHERE user_id = ?)", userID).
+		Where("edition_id = ?", editionID).
+		Exec(ctx)
+	return err
+}
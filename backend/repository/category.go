@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
 	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -31,21 +32,104 @@ func (r *CategoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*models
 	return category, nil
 }
 
+func (r *CategoryRepository) GetBySlug(ctx context.Context, slug string) (*models.Category, error) {
+	category := new(models.Category)
+	err := r.db.NewSelect().Model(category).Where("slug = ?", slug).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("category not found: %w", err)
+	}
+	return category, nil
+}
+
 func (r *CategoryRepository) GetAll(ctx context.Context) ([]models.Category, error) {
 	var categories []models.Category
-	err := r.db.NewSelect().Model(&categories).Scan(ctx)
+	err := r.db.NewSelect().Model(&categories).OrderExpr("sort_order, name").Scan(ctx)
 	return categories, err
 }
 
+// GetAncestors returns the path from the root down to the category itself.
+func (r *CategoryRepository) GetAncestors(ctx context.Context, id uuid.UUID) ([]models.Category, error) {
+	var categories []models.Category
+	path := r.db.NewSelect().
+		TableExpr("categories").
+		ColumnExpr("id, parent_id, 0 AS depth").
+		Where("id = ?", id).
+		UnionAll(r.db.NewSelect().
+			TableExpr("categories AS c").
+			ColumnExpr("c.id, c.parent_id, path.depth + 1").
+			Join("JOIN path ON c.id = path.parent_id"))
+	err := r.db.NewSelect().
+		WithRecursive("path", path).
+		Model(&categories).
+		Join("JOIN path ON path.id = category.id").
+		OrderExpr("path.depth DESC").
+		Scan(ctx)
+	return categories, err
+}
+
+func (r *CategoryRepository) HasChildren(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.db.NewSelect().Model((*models.Category)(nil)).Where("parent_id = ?", id).Exists(ctx)
+}
+
+// categoryTreeLock is the advisory lock key taken while a category is saved,
+// so that two concurrent moves cannot each place one category under the other.
+const categoryTreeLock = 7_340_001
+
+// Update saves the category. Under the tree lock it re-checks that the new
+// parent is not the category itself or one of its subcategories.
 func (r *CategoryRepository) Update(ctx context.Context, category *models.Category) error {
-	_, err := r.db.NewUpdate().Model(category).WherePK().Exec(ctx)
-	return err
+	expected := category.Version
+	category.Version++
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(?)", categoryTreeLock); err != nil {
+			return err
+		}
+		if category.ParentID != nil {
+			cycle, err := tx.NewSelect().
+				WithRecursive("subtree", categorySubtree(tx.NewSelect(), category.ID)).
+				TableExpr("subtree").
+				Where("id = ?", *category.ParentID).
+				Exists(ctx)
+			if err != nil {
+				return err
+			}
+			if cycle {
+				return apperrors.ErrCategoryCycle
+			}
+		}
+		res, err := tx.NewUpdate().Model(category).WherePK().Where("version = ?", expected).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return checkVersionedWrite(res)
+	})
+	if err != nil {
+		category.Version = expected
+		return fmt.Errorf("failed to update category: %w", err)
+	}
+	return nil
 }
 
-func (r *CategoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	_, err := r.db.NewDelete().Model(&models.Category{}).Where("id = ?", id).Exec(ctx)
+func (r *CategoryRepository) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	res, err := r.db.NewDelete().Model(&models.Category{}).Where("id = ?", id).Where("version = ?", version).Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to delete category: %W", err)
+		return fmt.Errorf("failed to delete category: %w", err)
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+// categorySubtree selects the ids of a category and all of its descendants
+// for use as a recursive CTE named "subtree".
+func categorySubtree(query *bun.SelectQuery, id uuid.UUID) *bun.SelectQuery {
+	return query.
+		TableExpr("categories").
+		Column("id").
+		Where("id = ?", id).
+		UnionAll(query.NewSelect().
+			TableExpr("categories AS c").
+			ColumnExpr("c.id").
+			Join("JOIN subtree ON c.parent_id = subtree.id"))
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/lib/pq"
+)
+
+// Postgres error codes of the constraint violations services react to.
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// constraintError marks a foreign key or unique violation reported by
+// Postgres with apperrors.ErrForeignKey or apperrors.ErrDuplicate, keeping
+// the original error. Other errors are returned as they are.
+func constraintError(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+	switch pqErr.Code {
+	case foreignKeyViolation:
+		return fmt.Errorf("%w: %w", apperrors.ErrForeignKey, err)
+	case uniqueViolation:
+		return fmt.Errorf("%w: %w", apperrors.ErrDuplicate, err)
+	}
+	return err
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type CurrencyRepository struct {
+	db *bun.DB
+}
+
+func NewCurrencyRepository(db *bun.DB) *CurrencyRepository {
+	return &CurrencyRepository{db: db}
+}
+
+func (r *CurrencyRepository) GetAll(ctx context.Context) ([]models.Currency, error) {
+	currencies := []models.Currency{}
+	err := r.db.NewSelect().Model(&currencies).Order("code").Scan(ctx)
+	return currencies, err
+}
+
+func (r *CurrencyRepository) GetByCode(ctx context.Context, code string) (*models.Currency, error) {
+	currency := new(models.Currency)
+	err := r.db.NewSelect().Model(currency).Where("code = ?", code).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("currency not found: %w", err)
+	}
+	return currency, nil
+}
+
+func (r *CurrencyRepository) Create(ctx context.Context, currency *models.Currency) error {
+	_, err := r.db.NewInsert().Model(currency).Returning("*").Exec(ctx)
+	return err
+}
+
+// GetRate returns the rate of the currency in effect at the given time.
+func (r *CurrencyRepository) GetRate(ctx context.Context, code string, at time.Time) (*models.ExchangeRate, error) {
+	rate := new(models.ExchangeRate)
+	err := r.db.NewSelect().
+		Model(rate).
+		Where("currency = ?", code).
+		Where("valid_from <= ?", at).
+		Order("valid_from DESC").
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("exchange rate not found: %w", err)
+	}
+	return rate, nil
+}
+
+func (r *CurrencyRepository) GetRates(ctx context.Context, code string) ([]models.ExchangeRate, error) {
+	rates := []models.ExchangeRate{}
+	err := r.db.NewSelect().Model(&rates).Where("currency = ?", code).Order("valid_from DESC").Scan(ctx)
+	return rates, err
+}
+
+// AddRates saves the rates in one transaction. A rate for a currency and
+// time that already has one replaces it.
+func (r *CurrencyRepository) AddRates(ctx context.Context, rates []*models.ExchangeRate) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, rate := range rates {
+			_, err := tx.NewInsert().
+				Model(rate).
+				On("CONFLICT (currency, valid_from) DO UPDATE").
+				Set("rate = EXCLUDED.rate").
+				Set("source = EXCLUDED.source").
+				Returning("*").
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to save %s rate: %w", rate.Currency, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *CurrencyRepository) GetEditionPrices(ctx context.Context, editionIDs []uuid.UUID, code string) ([]models.EditionPrice, error) {
+	prices := []models.EditionPrice{}
+	if len(editionIDs) == 0 {
+		return prices, nil
+	}
+	err := r.db.NewSelect().
+		Model(&prices).
+		Where("edition_id IN (?)", bun.In(editionIDs)).
+		Where("currency = ?", code).
+		Scan(ctx)
+	return prices, err
+}
+
+func (r *CurrencyRepository) GetPricesForEdition(ctx context.Context, editionID uuid.UUID) ([]models.EditionPrice, error) {
+	prices := []models.EditionPrice{}
+	err := r.db.NewSelect().Model(&prices).Where("edition_id = ?", editionID).Order("currency").Scan(ctx)
+	return prices, err
+}
+
+func (r *CurrencyRepository) SetEditionPrice(ctx context.Context, price *models.EditionPrice) error {
+	_, err := r.db.NewInsert().
+		Model(price).
+		On("CONFLICT (edition_id, currency) DO UPDATE").
+		Set("price = EXCLUDED.price").
+		Set("updated_at = current_timestamp").
+		Returning("*").
+		Exec(ctx)
+	return err
+}
+
+func (r *CurrencyRepository) DeleteEditionPrice(ctx context.Context, editionID uuid.UUID, code string) error {
+	_, err := r.db.NewDelete().
+		Model((*models.EditionPrice)(nil)).
+		Where("edition_id = ?", editionID).
+		Where("currency = ?", code).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type EditionRepository struct {
+	db *bun.DB
+}
+
+func NewEditionRepository(db *bun.DB) *EditionRepository {
+	return &EditionRepository{db: db}
+}
+
+func (r *EditionRepository) Create(ctx context.Context, edition *models.Edition) error {
+	_, err := r.db.NewInsert().Model(edition).Returning("*").Exec(ctx)
+	return constraintError(err)
+}
+
+func (r *EditionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Edition, error) {
+	edition := new(models.Edition)
+	err := r.db.NewSelect().Model(edition).Relation("Publisher").Where("edition.id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("edition not found: %w", err)
+	}
+	return edition, nil
+}
+
+func (r *EditionRepository) GetByISBN(ctx context.Context, isbn13 string) (*models.Edition, error) {
+	edition := new(models.Edition)
+	err := r.db.NewSelect().Model(edition).Relation("Publisher").Where("edition.isbn13 = ?", isbn13).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("edition not found: %w", err)
+	}
+	return edition, nil
+}
+
+func (r *EditionRepository) Update(ctx context.Context, edition *models.Edition) error {
+	expected := edition.Version
+	edition.Version++
+	res, err := r.db.NewUpdate().Model(edition).Where("id = ?", edition.ID).Where("version = ?", expected).Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		edition.Version = expected
+		return fmt.Errorf("failed to update edition: %w", constraintError(err))
+	}
+	return nil
+}
+
+func (r *EditionRepository) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().Model((*models.WishlistItem)(nil)).Where("edition_id = ?", id).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete wishlist items: %w", err)
+		}
+		if _, err := tx.NewDelete().Model((*models.EditionPrice)(nil)).Where("edition_id = ?", id).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete edition prices: %w", err)
+		}
+		if _, err := tx.NewDelete().Model((*models.EditionStock)(nil)).Where("edition_id = ?", id).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete edition stock: %w", err)
+		}
+		res, err := tx.NewDelete().Model((*models.Edition)(nil)).Where("id = ?", id).Where("version = ?", version).Exec(ctx)
+		if err == nil {
+			err = checkVersionedWrite(res)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete edition: %w", constraintError(err))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// ExportRepository reads rows one by one and hands them to a callback
+// instead of loading the whole result set.
+type ExportRepository struct {
+	db *bun.DB
+}
+
+func NewExportRepository(db *bun.DB) *ExportRepository {
+	return &ExportRepository{db: db}
+}
+
+// ExportBooks streams one row per edition of the books matching the filter.
+func (r *ExportRepository) ExportBooks(ctx context.Context, filter dto.BookFilter, fn func(dto.BookExportRow) error) error {
+	query := r.db.NewSelect().
+		TableExpr("books AS book").
+		ColumnExpr("edition.id, book.id AS book_id, edition.isbn13, book.title, book.description").
+		ColumnExpr("edition.format, edition.publication_year, edition.page_count, edition.price, edition.stock, edition.created_at").
+		ColumnExpr(`coalesce((
+			SELECT string_agg(concat_ws(' ', a.surname, a.name, NULLIF(a.patronymic, '')), '|' ORDER BY ct.position)
+			FROM book_contributors AS ct
+			JOIN authors AS a ON a.id = ct.author_id
+			WHERE ct.book_id = book.id AND ct.role = ?
+		), '') AS authors`, models.ContributorRoleAuthor).
+		ColumnExpr(`coalesce((
+			SELECT string_agg(concat_ws(' ', a.surname, a.name, NULLIF(a.patronymic, '')) || ' (' || ct.role || ')', '|' ORDER BY ct.position)
+			FROM book_contributors AS ct
+			JOIN authors AS a ON a.id = ct.author_id
+			WHERE ct.book_id = book.id AND ct.role <> ?
+		), '') AS contributors`, models.ContributorRoleAuthor).
+		ColumnExpr("publisher.name AS publisher").
+		ColumnExpr(`coalesce((
+			SELECT string_agg(c.name, '|' ORDER BY c.name)
+			FROM book_to_category AS bc
+			JOIN categories AS c ON c.id = bc.category_id
+			WHERE bc.book_id = book.id
+		), '') AS categories`).
+		Join("JOIN editions AS edition ON edition.book_id = book.id").
+		Join("JOIN publishers AS publisher ON publisher.id = edition.publisher_id")
+	query = applyBookFilter(query, filter).OrderExpr("book.created_at, book.id, edition.format, edition.id")
+
+	return streamRows(ctx, r.db, query, fn)
+}
+
+func (r *ExportRepository) ExportAuthors(ctx context.Context, fn func(models.Author) error) error {
+	query := r.db.NewSelect().Model((*models.Author)(nil)).OrderExpr("surname, name, id")
+	return streamRows(ctx, r.db, query, fn)
+}
+
+func (r *ExportRepository) ExportPublishers(ctx context.Context, fn func(models.Publisher) error) error {
+	query := r.db.NewSelect().Model((*models.Publisher)(nil)).OrderExpr("name, id")
+	return streamRows(ctx, r.db, query, fn)
+}
+
+func (r *ExportRepository) ExportCustomers(ctx context.Context, fn func(dto.CustomerExportRow) error) error {
+	query := r.db.NewSelect().
+		TableExpr(`users AS "user"`).
+		ColumnExpr(`"user".id, "user".username, "user".email, "user".phone`).
+		Join(`JOIN roles AS role ON role.id = "user".role_id`).
+		Where("role.name = ?", CUSTOMER_ROLE).
+		OrderExpr(`"user".username, "user".id`)
+	return streamRows(ctx, r.db, query, fn)
+}
+
+func streamRows[T any](ctx context.Context, db *bun.DB, query *bun.SelectQuery, fn func(T) error) error {
+	rows, err := query.Rows(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query export rows: %w", err)
+	}
+	defer func(rows *sql.Rows) { _ = rows.Close() }(rows)
+
+	for rows.Next() {
+		var row T
+		if err := db.ScanRow(ctx, rows, &row); err != nil {
+			return fmt.Errorf("failed to scan export row: %w", err)
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
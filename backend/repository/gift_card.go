@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type GiftCardRepository struct {
+	db *bun.DB
+}
+
+func NewGiftCardRepository(db *bun.DB) *GiftCardRepository {
+	return &GiftCardRepository{db: db}
+}
+
+// Create issues the card with its initial amount as balance and records
+// the issue in its ledger.
+func (r *GiftCardRepository) Create(ctx context.Context, card *models.GiftCard) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return issueGiftCard(ctx, tx, &models.GiftCardTransaction{
+			Kind:     models.GiftCardTransactionIssue,
+			Amount:   card.InitialAmount,
+			GiftCard: card,
+		})
+	})
+}
+
+func (r *GiftCardRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.GiftCard, error) {
+	card := new(models.GiftCard)
+	err := r.db.NewSelect().
+		Model(card).
+		Relation("Transactions", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.OrderExpr("created_at, id")
+		}).
+		Where("?TableAlias.id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gift card not found: %w", err)
+	}
+	return card, nil
+}
+
+func (r *GiftCardRepository) GetByCode(ctx context.Context, codeHash string) (*models.GiftCard, error) {
+	card := new(models.GiftCard)
+	if err := r.db.NewSelect().Model(card).Where("code_hash = ?", codeHash).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("gift card not found: %w", err)
+	}
+	return card, nil
+}
+
+// GetAllByUser returns the gift cards the user bought and the store credit
+// they own, newest first.
+func (r *GiftCardRepository) GetAllByUser(ctx context.Context, userID uuid.UUID) ([]models.GiftCard, error) {
+	cards := []models.GiftCard{}
+	err := r.db.NewSelect().
+		Model(&cards).
+		Where("owner_id = ?", userID).
+		WhereOr("purchased_by = ?", userID).
+		OrderExpr("created_at DESC").
+		Scan(ctx)
+	return cards, err
+}
+
+// GetStoreCredit returns the user's store credit with a balance that has
+// not expired by now, the credit expiring first first.
+func (r *GiftCardRepository) GetStoreCredit(ctx context.Context, userID uuid.UUID, now time.Time) ([]models.GiftCard, error) {
+	cards := []models.GiftCard{}
+	err := r.db.NewSelect().
+		Model(&cards).
+		Where("kind = ?", models.GiftCardKindStoreCredit).
+		Where("owner_id = ?", userID).
+		Where("balance > 0").
+		Where("expires_at > ?", now).
+		OrderExpr("expires_at, created_at").
+		Scan(ctx)
+	return cards, err
+}
+
+// GetByOrder returns the ledger entries of the cards an order was paid
+// with or refunded to, with their cards.
+func (r *GiftCardRepository) GetByOrder(ctx context.Context, orderID uuid.UUID) ([]models.GiftCardTransaction, error) {
+	entries := []models.GiftCardTransaction{}
+	err := r.db.NewSelect().
+		Model(&entries).
+		Relation("GiftCard").
+		Where("?TableAlias.order_id = ?", orderID).
+		OrderExpr("?TableAlias.created_at, ?TableAlias.id").
+		Scan(ctx)
+	return entries, err
+}
+
+// Expire empties the cards that expired by now, recording the amount
+// written off in their ledgers, and returns how many it emptied.
+func (r *GiftCardRepository) Expire(ctx context.Context, now time.Time) (int, error) {
+	expired := 0
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		cards := []models.GiftCard{}
+		err := tx.NewSelect().
+			Model(&cards).
+			Where("balance > 0").
+			Where("expires_at <= ?", now).
+			For("UPDATE SKIP LOCKED").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load expired gift cards: %w", err)
+		}
+		for _, card := range cards {
+			entry := &models.GiftCardTransaction{GiftCardID: card.ID, Kind: models.GiftCardTransactionExpire, Amount: -card.Balance}
+			if err := applyGiftCardTransaction(ctx, tx, entry); err != nil {
+				return err
+			}
+		}
+		expired = len(cards)
+		return nil
+	})
+	return expired, err
+}
+
+// issueGiftCard saves the card of a first ledger entry and the entry.
+func issueGiftCard(ctx context.Context, db bun.IDB, entry *models.GiftCardTransaction) error {
+	card := entry.GiftCard
+	card.Balance = entry.Amount
+	if _, err := db.NewInsert().Model(card).Returning("*").Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create gift card: %w", err)
+	}
+	entry.GiftCardID = card.ID
+	if _, err := db.NewInsert().Model(entry).Returning("*").Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record gift card transaction: %w", err)
+	}
+	return nil
+}
+
+// applyGiftCardTransaction records an entry and adds its amount to the
+// card's balance. A debit fails with apperrors.ErrGiftCardUsedUp if the
+// card has expired or its balance no longer covers it.
+func applyGiftCardTransaction(ctx context.Context, db bun.IDB, entry *models.GiftCardTransaction) error {
+	query := db.NewUpdate().
+		Model((*models.GiftCard)(nil)).
+		Set("balance = balance + ?", entry.Amount).
+		Set("updated_at = current_timestamp").
+		Where("id = ?", entry.GiftCardID)
+	if entry.Amount < 0 && entry.Kind != models.GiftCardTransactionExpire {
+		query = query.Where("balance >= ?", -entry.Amount).Where("expires_at > current_timestamp")
+	}
+	res, err := query.Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update gift card balance: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		return apperrors.ErrGiftCardUsedUp
+	}
+	if _, err := db.NewInsert().Model(entry).Returning("*").Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record gift card transaction: %w", err)
+	}
+	return nil
+}
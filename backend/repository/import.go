@@ -0,0 +1,372 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/slug"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// errImportDryRun rolls back the import transaction in dry-run mode.
+var errImportDryRun = errors.New("dry run")
+
+type ImportRepository struct {
+	db *bun.DB
+}
+
+func NewImportRepository(db *bun.DB) *ImportRepository {
+	return &ImportRepository{db: db}
+}
+
+// ImportBooks writes all rows in one transaction, resolving authors,
+// publishers and categories by name and creating the missing ones. Every
+// row is an edition; rows with an ISBN update the edition that already has
+// it, the others are added to the book with the same title and author.
+// A row's stock is the number of copies in the main warehouse, the first
+// active one orders are shipped from; editions matched by ISBN keep their
+// stock if the row has none. In dry-run mode the transaction is
+// rolled back at the end.
+func (r *ImportRepository) ImportBooks(ctx context.Context, rows []dto.ImportBookRow, dryRun bool) ([]dto.ImportedBook, error) {
+	var result []dto.ImportedBook
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		warehouse := new(models.Warehouse)
+		if err := tx.NewSelect().Model(warehouse).Where("active").Order("priority", "name").Limit(1).Scan(ctx); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return apperrors.ErrNoWarehouse
+			}
+			return fmt.Errorf("failed to find the main warehouse: %w", err)
+		}
+		resolver := &importResolver{
+			tx:         tx,
+			warehouse:  warehouse.ID,
+			books:      make(map[string]*models.Book),
+			authors:    make(map[string]*models.Author),
+			publishers: make(map[string]*models.Publisher),
+			categories: make(map[string]*models.Category),
+		}
+
+		result = make([]dto.ImportedBook, 0, len(rows))
+		for _, row := range rows {
+			imported, err := resolver.importRow(ctx, row)
+			if err != nil {
+				return fmt.Errorf("row %d: %w", row.Row, err)
+			}
+			result = append(result, imported)
+		}
+
+		if dryRun {
+			return errImportDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errImportDryRun) {
+		return nil, err
+	}
+	return result, nil
+}
+
+type importResolver struct {
+	tx         bun.Tx
+	warehouse  uuid.UUID
+	books      map[string]*models.Book
+	authors    map[string]*models.Author
+	publishers map[string]*models.Publisher
+	categories map[string]*models.Category
+}
+
+func (r *importResolver) importRow(ctx context.Context, row dto.ImportBookRow) (dto.ImportedBook, error) {
+	var imported dto.ImportedBook
+
+	author, created, err := r.author(ctx, row)
+	if err != nil {
+		return imported, err
+	}
+	if created {
+		imported.CreatedAuthor = author
+	}
+
+	publisher, created, err := r.publisher(ctx, row.PublisherName)
+	if err != nil {
+		return imported, err
+	}
+	if created {
+		imported.CreatedPublisher = publisher
+	}
+
+	edition, err := r.existingEdition(ctx, row.ISBN13)
+	if err != nil {
+		return imported, err
+	}
+	var bookID uuid.UUID
+	if edition != nil {
+		previous := *edition
+		imported.Previous = &previous
+		bookID = edition.BookID
+		if imported.PreviousBook, imported.UpdatedBook, err = r.updateBook(ctx, bookID, row); err != nil {
+			return imported, err
+		}
+	} else {
+		book, created, err := r.book(ctx, row, author)
+		if err != nil {
+			return imported, err
+		}
+		if created {
+			imported.CreatedBook = book
+		}
+		bookID = book.ID
+		edition = &models.Edition{BookID: bookID}
+	}
+	edition.Format = row.Format
+	edition.ISBN13 = row.ISBN13
+	edition.ISBN10 = row.ISBN10
+	edition.PublisherID = publisher.ID
+	edition.PublicationYear = row.PublicationYear
+	edition.PageCount = row.PageCount
+	edition.Price = row.Price
+
+	if imported.Previous != nil {
+		edition.Version++
+		if _, err := r.tx.NewUpdate().Model(edition).WherePK().Exec(ctx); err != nil {
+			return imported, fmt.Errorf("failed to update edition: %w", err)
+		}
+	} else if _, err := r.tx.NewInsert().Model(edition).Returning("*").Exec(ctx); err != nil {
+		return imported, fmt.Errorf("failed to create edition: %w", err)
+	}
+	// an edition matched by ISBN keeps its stock unless the row gives one
+	if row.Stock != nil || imported.Previous == nil {
+		quantity := 0
+		if row.Stock != nil {
+			quantity = *row.Stock
+		}
+		if err := r.setStock(ctx, edition, quantity); err != nil {
+			return imported, err
+		}
+	}
+	imported.Edition = edition
+
+	relations := make([]models.BookToCategory, 0, len(row.Categories))
+	for _, name := range row.Categories {
+		category, created, err := r.category(ctx, name)
+		if err != nil {
+			return imported, err
+		}
+		if created {
+			imported.CreatedCategories = append(imported.CreatedCategories, category)
+		}
+		relations = append(relations, models.BookToCategory{BookID: bookID, CategoryID: category.ID})
+	}
+	if len(relations) > 0 {
+		if _, err := r.tx.NewInsert().Model(&relations).On("CONFLICT DO NOTHING").Exec(ctx); err != nil {
+			return imported, fmt.Errorf("failed to create book-category relations: %w", err)
+		}
+	}
+	return imported, nil
+}
+
+// updateBook gives the book of an edition matched by ISBN the row's title
+// and, if the row has one, description. It returns the book before and
+// after, or nils if nothing changed.
+func (r *importResolver) updateBook(ctx context.Context, id uuid.UUID, row dto.ImportBookRow) (*models.Book, *models.Book, error) {
+	book := new(models.Book)
+	if err := r.tx.NewSelect().Model(book).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to find book: %w", err)
+	}
+	previous := *book
+	describe := row.Description != nil && (book.Description == nil || *book.Description != *row.Description)
+	if book.Title == row.Title && !describe {
+		return nil, nil, nil
+	}
+	book.Title = row.Title
+	if describe {
+		book.Description = row.Description
+	}
+	book.Version++
+	_, err := r.tx.NewUpdate().
+		Model(book).
+		Column("title", "description", "version").
+		Set("updated_at = current_timestamp").
+		WherePK().
+		Returning("updated_at").
+		Exec(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update book: %w", err)
+	}
+	return &previous, book, nil
+}
+
+// setStock sets the copies of the edition in the main warehouse and reads
+// back the edition's stock and version.
+func (r *importResolver) setStock(ctx context.Context, edition *models.Edition, quantity int) error {
+	_, err := r.tx.NewInsert().
+		Model(&models.EditionStock{EditionID: edition.ID, WarehouseID: r.warehouse, Quantity: quantity}).
+		On("CONFLICT (edition_id, warehouse_id) DO UPDATE").
+		Set("quantity = EXCLUDED.quantity").
+		Set("updated_at = current_timestamp").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set stock: %w", err)
+	}
+	if err := refreshStock(ctx, r.tx, bun.In([]uuid.UUID{edition.ID})); err != nil {
+		return err
+	}
+	if err := r.tx.NewSelect().Model(edition).Column("stock", "version").WherePK().Scan(ctx); err != nil {
+		return fmt.Errorf("failed to read edition stock: %w", err)
+	}
+	return nil
+}
+
+// existingEdition returns the edition with the given ISBN-13 or nil if there is none.
+func (r *importResolver) existingEdition(ctx context.Context, isbn13 *string) (*models.Edition, error) {
+	if isbn13 == nil {
+		return nil, nil
+	}
+	edition := new(models.Edition)
+	err := r.tx.NewSelect().Model(edition).Where("isbn13 = ?", *isbn13).For("UPDATE").Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find edition by ISBN: %w", err)
+	}
+	return edition, nil
+}
+
+// book finds the book with the same title written by the author, so that
+// rows describing other editions of it are grouped, or creates it.
+func (r *importResolver) book(ctx context.Context, row dto.ImportBookRow, author *models.Author) (*models.Book, bool, error) {
+	key := strings.ToLower(row.Title) + "|" + author.ID.String()
+	if book, ok := r.books[key]; ok {
+		return book, false, nil
+	}
+
+	book := new(models.Book)
+	err := r.tx.NewSelect().Model(book).
+		Where("lower(book.title) = lower(?)", row.Title).
+		Where("EXISTS (SELECT 1 FROM book_contributors AS bc WHERE bc.book_id = book.id AND bc.author_id = ? AND bc.role = ?)",
+			author.ID, models.ContributorRoleAuthor).
+		OrderExpr("book.created_at").
+		Limit(1).
+		Scan(ctx)
+	created := false
+	if errors.Is(err, sql.ErrNoRows) {
+		book = &models.Book{Title: row.Title, Description: row.Description}
+		if _, err := r.tx.NewInsert().Model(book).Returning("*").Exec(ctx); err != nil {
+			return nil, false, fmt.Errorf("failed to create book: %w", err)
+		}
+		contributor := []models.BookContributor{{AuthorID: author.ID, Role: models.ContributorRoleAuthor}}
+		if err := insertContributors(ctx, r.tx, book.ID, contributor); err != nil {
+			return nil, false, err
+		}
+		created = true
+	} else if err != nil {
+		return nil, false, fmt.Errorf("failed to find book: %w", err)
+	}
+
+	r.books[key] = book
+	return book, created, nil
+}
+
+func (r *importResolver) author(ctx context.Context, row dto.ImportBookRow) (*models.Author, bool, error) {
+	key := strings.ToLower(row.AuthorSurname + "|" + row.AuthorName + "|" + row.AuthorPatronymic)
+	if author, ok := r.authors[key]; ok {
+		return author, false, nil
+	}
+
+	author := new(models.Author)
+	err := r.tx.NewSelect().Model(author).
+		Where("lower(surname) = lower(?)", row.AuthorSurname).
+		Where("lower(name) = lower(?)", row.AuthorName).
+		Where("lower(patronymic) = lower(?)", row.AuthorPatronymic).
+		Limit(1).
+		Scan(ctx)
+	created := false
+	if errors.Is(err, sql.ErrNoRows) {
+		author = &models.Author{Surname: row.AuthorSurname, Name: row.AuthorName, Patronymic: row.AuthorPatronymic}
+		if _, err := r.tx.NewInsert().Model(author).Returning("*").Exec(ctx); err != nil {
+			return nil, false, fmt.Errorf("failed to create author: %w", err)
+		}
+		created = true
+	} else if err != nil {
+		return nil, false, fmt.Errorf("failed to find author: %w", err)
+	}
+
+	r.authors[key] = author
+	return author, created, nil
+}
+
+func (r *importResolver) publisher(ctx context.Context, name string) (*models.Publisher, bool, error) {
+	key := strings.ToLower(name)
+	if publisher, ok := r.publishers[key]; ok {
+		return publisher, false, nil
+	}
+
+	publisher := new(models.Publisher)
+	err := r.tx.NewSelect().Model(publisher).Where("lower(name) = lower(?)", name).Limit(1).Scan(ctx)
+	created := false
+	if errors.Is(err, sql.ErrNoRows) {
+		publisher = &models.Publisher{Name: name}
+		if _, err := r.tx.NewInsert().Model(publisher).Returning("*").Exec(ctx); err != nil {
+			return nil, false, fmt.Errorf("failed to create publisher: %w", err)
+		}
+		created = true
+	} else if err != nil {
+		return nil, false, fmt.Errorf("failed to find publisher: %w", err)
+	}
+
+	r.publishers[key] = publisher
+	return publisher, created, nil
+}
+
+func (r *importResolver) category(ctx context.Context, name string) (*models.Category, bool, error) {
+	key := strings.ToLower(name)
+	if category, ok := r.categories[key]; ok {
+		return category, false, nil
+	}
+
+	category := new(models.Category)
+	err := r.tx.NewSelect().Model(category).Where("lower(name) = lower(?)", name).Limit(1).Scan(ctx)
+	created := false
+	if errors.Is(err, sql.ErrNoRows) {
+		category = &models.Category{Name: name}
+		if category.Slug, err = r.categorySlug(ctx, name); err != nil {
+			return nil, false, err
+		}
+		if _, err := r.tx.NewInsert().Model(category).Returning("*").Exec(ctx); err != nil {
+			return nil, false, fmt.Errorf("failed to create category: %w", err)
+		}
+		created = true
+	} else if err != nil {
+		return nil, false, fmt.Errorf("failed to find category: %w", err)
+	}
+
+	r.categories[key] = category
+	return category, created, nil
+}
+
+// categorySlug generates a slug for an imported root category that does not
+// collide with any existing one.
+func (r *importResolver) categorySlug(ctx context.Context, name string) (string, error) {
+	base := slug.Make(name)
+	if base == "" {
+		base = "category"
+	}
+	candidate := base
+	for n := 2; ; n++ {
+		taken, err := r.tx.NewSelect().Model((*models.Category)(nil)).Where("slug = ?", candidate).Exists(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to check category slug: %w", err)
+		}
+		if !taken {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s-%d", base, n)
+	}
+}
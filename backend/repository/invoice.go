@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type InvoiceRepository struct {
+	db *bun.DB
+}
+
+func NewInvoiceRepository(db *bun.DB) *InvoiceRepository {
+	return &InvoiceRepository{db: db}
+}
+
+func (r *InvoiceRepository) GetByOrder(ctx context.Context, orderID uuid.UUID) (*models.Invoice, error) {
+	invoice := new(models.Invoice)
+	if err := r.db.NewSelect().Model(invoice).Where("order_id = ?", orderID).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("invoice not found: %w", err)
+	}
+	return invoice, nil
+}
+
+// Create takes the next number from the year's counter in the same
+// transaction that saves the invoice, so a failed insert rolls its number
+// back instead of leaving a gap. The counter row stays locked until the
+// transaction ends; the document is stored afterwards with Update, so the
+// lock is never held while it renders or uploads.
+func (r *InvoiceRepository) Create(ctx context.Context, invoice *models.Invoice) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		invoice.Year = invoice.IssuedAt.Year()
+		err := tx.NewRaw(
+			"INSERT INTO invoice_counters (year, last_number) VALUES (?, 1) "+
+				"ON CONFLICT (year) DO UPDATE SET last_number = invoice_counters.last_number + 1 "+
+				"RETURNING last_number",
+			invoice.Year,
+		).Scan(ctx, &invoice.Number)
+		if err != nil {
+			return fmt.Errorf("failed to number invoice: %w", err)
+		}
+		invoice.Code = fmt.Sprintf("%d-%06d", invoice.Year, invoice.Number)
+
+		if _, err := tx.NewInsert().Model(invoice).Returning("*").Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create invoice: %w", constraintError(err))
+		}
+		return nil
+	})
+}
+
+func (r *InvoiceRepository) Update(ctx context.Context, invoice *models.Invoice) error {
+	_, err := r.db.NewUpdate().
+		Model(invoice).
+		Column("payment_status", "key", "size").
+		Set("updated_at = current_timestamp").
+		WherePK().
+		Returning("updated_at").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update invoice: %w", err)
+	}
+	return nil
+}
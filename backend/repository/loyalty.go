@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type LoyaltyRepository struct {
+	db *bun.DB
+}
+
+func NewLoyaltyRepository(db *bun.DB) *LoyaltyRepository {
+	return &LoyaltyRepository{db: db}
+}
+
+// GetCredits returns the user's credits with points left that have not
+// expired by now, the ones expiring first first.
+func (r *LoyaltyRepository) GetCredits(ctx context.Context, userID uuid.UUID, now time.Time) ([]models.LoyaltyTransaction, error) {
+	credits := []models.LoyaltyTransaction{}
+	err := liveCredits(r.db.NewSelect().Model(&credits), userID, now).Scan(ctx)
+	return credits, err
+}
+
+func (r *LoyaltyRepository) GetHistory(ctx context.Context, userID uuid.UUID, limit, offset int) ([]models.LoyaltyTransaction, int, error) {
+	entries := []models.LoyaltyTransaction{}
+	total, err := r.db.NewSelect().
+		Model(&entries).
+		Where("user_id = ?", userID).
+		OrderExpr("created_at DESC, id").
+		Limit(limit).
+		Offset(offset).
+		ScanAndCount(ctx)
+	return entries, total, err
+}
+
+func (r *LoyaltyRepository) GetByOrder(ctx context.Context, orderID uuid.UUID) ([]models.LoyaltyTransaction, error) {
+	entries := []models.LoyaltyTransaction{}
+	err := r.db.NewSelect().Model(&entries).Where("order_id = ?", orderID).OrderExpr("created_at").Scan(ctx)
+	return entries, err
+}
+
+// Adjust records an admin's correction: a credit for positive points, a
+// debit for negative ones, which fails with apperrors.ErrNotEnoughPoints
+// if the user does not have them.
+func (r *LoyaltyRepository) Adjust(ctx context.Context, entry *models.LoyaltyTransaction) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if entry.Points > 0 {
+			return creditPoints(ctx, tx, entry)
+		}
+		return spendPoints(ctx, tx, entry, false)
+	})
+}
+
+// Expire writes off what is left of the credits that expired by now, one
+// expire entry per credit, and returns how many credits it wrote off.
+func (r *LoyaltyRepository) Expire(ctx context.Context, now time.Time) (int, error) {
+	expired := 0
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		credits := []models.LoyaltyTransaction{}
+		err := tx.NewSelect().
+			Model(&credits).
+			Where("remaining > 0").
+			Where("expires_at <= ?", now).
+			For("UPDATE SKIP LOCKED").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load expired points: %w", err)
+		}
+		for _, credit := range credits {
+			entry := &models.LoyaltyTransaction{
+				UserID:  credit.UserID,
+				Kind:    models.LoyaltyKindExpire,
+				Points:  -credit.Remaining,
+				OrderID: credit.OrderID,
+			}
+			if _, err := tx.NewInsert().Model(entry).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to expire points: %w", err)
+			}
+			if _, err := tx.NewUpdate().Model(&credit).Set("remaining = 0").WherePK().Exec(ctx); err != nil {
+				return fmt.Errorf("failed to expire points: %w", err)
+			}
+		}
+		expired = len(credits)
+		return nil
+	})
+	return expired, err
+}
+
+// liveCredits selects the user's credits with points left, the ones
+// expiring first first.
+func liveCredits(query *bun.SelectQuery, userID uuid.UUID, now time.Time) *bun.SelectQuery {
+	return query.
+		Where("user_id = ?", userID).
+		Where("remaining > 0").
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		OrderExpr("expires_at NULLS LAST, created_at")
+}
+
+// creditPoints records a credit with all of its points left.
+func creditPoints(ctx context.Context, db bun.IDB, entry *models.LoyaltyTransaction) error {
+	entry.Remaining = entry.Points
+	if _, err := db.NewInsert().Model(entry).Returning("*").Exec(ctx); err != nil {
+		return fmt.Errorf("failed to credit points: %w", err)
+	}
+	return nil
+}
+
+// spendPoints records a debit and takes its points from the user's credits,
+// locking them so concurrent debits are counted one after another. The
+// credits expiring first are used first, except that a reversal takes the
+// points of its order's credit before any other. With partial, a debit
+// larger than the balance takes what there is instead of failing with
+// apperrors.ErrNotEnoughPoints; if there is nothing, no entry is recorded.
+func spendPoints(ctx context.Context, db bun.IDB, entry *models.LoyaltyTransaction, partial bool) error {
+	credits := []models.LoyaltyTransaction{}
+	query := db.NewSelect().Model(&credits)
+	if entry.Kind == models.LoyaltyKindReverse && entry.OrderID != nil {
+		query = query.OrderExpr("CASE WHEN kind = ? AND order_id = ? THEN 0 ELSE 1 END", models.LoyaltyKindEarn, *entry.OrderID)
+	}
+	err := liveCredits(query, entry.UserID, time.Now()).For("UPDATE").Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load loyalty points: %w", err)
+	}
+
+	needed, available := -entry.Points, 0
+	for _, credit := range credits {
+		available += credit.Remaining
+	}
+	if available < needed {
+		if !partial {
+			return apperrors.ErrNotEnoughPoints
+		}
+		needed = available
+	}
+	entry.Points = -needed
+	if needed == 0 {
+		return nil
+	}
+
+	for i := 0; needed > 0; i++ {
+		taken := min(credits[i].Remaining, needed)
+		needed -= taken
+		_, err := db.NewUpdate().
+			Model(&credits[i]).
+			Set("remaining = remaining - ?", taken).
+			WherePK().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to spend points: %w", err)
+		}
+	}
+	if _, err := db.NewInsert().Model(entry).Returning("*").Exec(ctx); err != nil {
+		return fmt.Errorf("failed to debit points: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// MediaRepository records which uploaded images belong to books and authors.
+type MediaRepository struct {
+	db *bun.DB
+}
+
+func NewMediaRepository(db *bun.DB) *MediaRepository {
+	return &MediaRepository{db: db}
+}
+
+// SetBookCover replaces the book's cover; a nil cover removes it.
+func (r *MediaRepository) SetBookCover(ctx context.Context, id uuid.UUID, version int64, cover *models.Image) error {
+	res, err := r.db.NewUpdate().
+		Model((*models.Book)(nil)).
+		Set("cover = ?::jsonb", cover).
+		Set("version = version + 1").
+		Set("updated_at = current_timestamp").
+		Where("id = ?", id).
+		Where("version = ?", version).
+		Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update book cover: %w", err)
+	}
+	return nil
+}
+
+// SetAuthorPhoto replaces the author's photo; a nil photo removes it.
+func (r *MediaRepository) SetAuthorPhoto(ctx context.Context, id uuid.UUID, version int64, photo *models.Image) error {
+	res, err := r.db.NewUpdate().
+		Model((*models.Author)(nil)).
+		Set("photo = ?::jsonb", photo).
+		Set("version = version + 1").
+		Where("id = ?", id).
+		Where("version = ?", version).
+		Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update author photo: %w", err)
+	}
+	return nil
+}
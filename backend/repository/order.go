@@ -0,0 +1,343 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// deliveryStatuses are the delivery statuses that go with order statuses.
+var deliveryStatuses = map[string]string{
+	models.OrderStatusReadyForPickup: models.DeliveryStatusReadyForPickup,
+	models.OrderStatusDelivered:      models.DeliveryStatusDelivered,
+}
+
+type OrderRepository struct {
+	db *bun.DB
+}
+
+func NewOrderRepository(db *bun.DB) *OrderRepository {
+	return &OrderRepository{db: db}
+}
+
+// Create places the order with its items, payment and delivery in one
+// transaction: it allocates the items to the warehouses, given in order of
+// preference, and takes the copies out of them, records the coupon
+// redemption, if any, spends the loyalty points redeemed, takes the gift
+// card payments off the cards, saves the gift cards bought, which MarkPaid
+// funds, and empties the user's cart. It fails with
+// apperrors.ErrOutOfStock, apperrors.ErrCouponUsedUp,
+// apperrors.ErrNotEnoughPoints or apperrors.ErrGiftCardUsedUp if an
+// edition, the coupon, the points or a card ran out in the meantime.
+func (r *OrderRepository) Create(ctx context.Context, order *models.Order, warehouses []uuid.UUID, redemption *models.CouponRedemption) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if redemption != nil {
+			if err := checkCouponLimits(ctx, tx, redemption); err != nil {
+				return err
+			}
+		}
+
+		if len(order.Items) > 0 {
+			if err := allocateStock(ctx, tx, order.Items, warehouses); err != nil {
+				return err
+			}
+			if err := refreshStock(ctx, tx, bun.In(orderEditions(order))); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.NewInsert().Model(order).Returning("*").Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create order: %w", err)
+		}
+		for _, item := range order.Items {
+			item.OrderID = order.ID
+		}
+		if len(order.Items) > 0 {
+			if _, err := tx.NewInsert().Model(&order.Items).Returning("*").Exec(ctx); err != nil {
+				return fmt.Errorf("failed to create order items: %w", err)
+			}
+		}
+		var allocations []*models.StockAllocation
+		for _, item := range order.Items {
+			for _, allocation := range item.Allocations {
+				allocation.OrderItemID = item.ID
+				allocations = append(allocations, allocation)
+			}
+		}
+		if len(allocations) > 0 {
+			if _, err := tx.NewInsert().Model(&allocations).Returning("*").Exec(ctx); err != nil {
+				return fmt.Errorf("failed to record stock allocations: %w", err)
+			}
+		}
+		if order.Payment != nil {
+			order.Payment.OrderID = order.ID
+			if _, err := tx.NewInsert().Model(order.Payment).Returning("*").Exec(ctx); err != nil {
+				return fmt.Errorf("failed to create payment: %w", err)
+			}
+		}
+		if order.Delivery != nil {
+			order.Delivery.OrderID = order.ID
+			if _, err := tx.NewInsert().Model(order.Delivery).Returning("*").Exec(ctx); err != nil {
+				return fmt.Errorf("failed to create delivery: %w", err)
+			}
+		}
+		if redemption != nil {
+			redemption.OrderID = order.ID
+			if _, err := tx.NewInsert().Model(redemption).Returning("*").Exec(ctx); err != nil {
+				return fmt.Errorf("failed to record coupon redemption: %w", err)
+			}
+		}
+		if order.PointsRedeemed > 0 {
+			entry := &models.LoyaltyTransaction{
+				UserID:  order.UserID,
+				Kind:    models.LoyaltyKindRedeem,
+				Points:  -order.PointsRedeemed,
+				OrderID: &order.ID,
+			}
+			if err := spendPoints(ctx, tx, entry, false); err != nil {
+				return err
+			}
+		}
+		for _, entry := range order.GiftCardTransactions {
+			entry.OrderID = &order.ID
+			if err := applyGiftCardTransaction(ctx, tx, entry); err != nil {
+				return err
+			}
+		}
+		for _, card := range order.GiftCards {
+			card.OrderID = &order.ID
+		}
+		if len(order.GiftCards) > 0 {
+			if _, err := tx.NewInsert().Model(&order.GiftCards).Returning("*").Exec(ctx); err != nil {
+				return fmt.Errorf("failed to create gift cards: %w", err)
+			}
+		}
+
+		_, err := tx.NewDelete().
+			Model((*models.CartItem)(nil)).
+			Where("cart_id IN (SELECT id FROM carts WHERE user_id = ?)", order.UserID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to empty cart: %w", err)
+		}
+		return nil
+	})
+}
+
+// UpdateStatus moves the order from the status from to order.Status,
+// moving its delivery along with it and saving its pickup code, and records the loyalty
+// points and gift card refunds of the change in the same transaction.
+// Returned orders put their copies back in the warehouses they were
+// allocated from.
+// Point credits are recorded in full; debits take at most what the user
+// has left. Gift card entries with a GiftCard issue it, e.g. store credit.
+// It fails with apperrors.ErrVersionConflict if the order is no longer in
+// from.
+func (r *OrderRepository) UpdateStatus(ctx context.Context, order *models.Order, from string, points []*models.LoyaltyTransaction, giftCards []*models.GiftCardTransaction) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewUpdate().
+			Model(order).
+			Column("status").
+			Set("updated_at = current_timestamp").
+			WherePK().
+			Where("status = ?", from).
+			Returning("updated_at").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update order status: %w", err)
+		}
+		if rows, err := res.RowsAffected(); err != nil {
+			return err
+		} else if rows == 0 {
+			return apperrors.ErrVersionConflict
+		}
+
+		if order.Status == models.OrderStatusReturned && len(order.Items) > 0 {
+			for _, item := range order.Items {
+				for _, allocation := range item.Allocations {
+					if err := putStock(ctx, tx, item.EditionID, allocation.WarehouseID, allocation.Quantity); err != nil {
+						return err
+					}
+				}
+			}
+			if err := refreshStock(ctx, tx, bun.In(orderEditions(order))); err != nil {
+				return err
+			}
+		}
+
+		if status, ok := deliveryStatuses[order.Status]; ok && order.Delivery != nil {
+			order.Delivery.Status = status
+			_, err := tx.NewUpdate().
+				Model(order.Delivery).
+				Column("status", "pickup_code_salt", "pickup_attempts").
+				Set("updated_at = current_timestamp").
+				WherePK().
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to update delivery status: %w", err)
+			}
+		}
+
+		for _, entry := range points {
+			if entry.Points > 0 {
+				err = creditPoints(ctx, tx, entry)
+			} else {
+				err = spendPoints(ctx, tx, entry, true)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		for _, entry := range giftCards {
+			entry.OrderID = &order.ID
+			if entry.GiftCard != nil {
+				err = issueGiftCard(ctx, tx, entry)
+			} else {
+				err = applyGiftCardTransaction(ctx, tx, entry)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// MarkPaid marks the order's payment paid and records the gift card
+// entries that fund the cards bought with it in the same transaction. It
+// fails with apperrors.ErrVersionConflict if the payment is no longer
+// unpaid.
+func (r *OrderRepository) MarkPaid(ctx context.Context, order *models.Order, giftCards []*models.GiftCardTransaction) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		order.Payment.Status = models.PaymentStatusPaid
+		res, err := tx.NewUpdate().
+			Model(order.Payment).
+			Column("status").
+			Set("updated_at = current_timestamp").
+			WherePK().
+			Where("status = ?", models.PaymentStatusNotPaid).
+			Returning("updated_at").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update payment status: %w", err)
+		}
+		if rows, err := res.RowsAffected(); err != nil {
+			return err
+		} else if rows == 0 {
+			return apperrors.ErrVersionConflict
+		}
+
+		for _, entry := range giftCards {
+			entry.OrderID = &order.ID
+			if err := applyGiftCardTransaction(ctx, tx, entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UsePickupAttempt counts a try of the pickup code of an order. It reports
+// false, counting nothing, once max codes have been tried.
+func (r *OrderRepository) UsePickupAttempt(ctx context.Context, orderID uuid.UUID, max int) (bool, error) {
+	res, err := r.db.NewUpdate().
+		Model((*models.Delivery)(nil)).
+		Set("pickup_attempts = pickup_attempts + 1").
+		Set("updated_at = current_timestamp").
+		Where("order_id = ?", orderID).
+		Where("pickup_attempts < ?", max).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to count pickup attempt: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *OrderRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	order := new(models.Order)
+	err := withOrderRelations(r.db.NewSelect().Model(order)).Where("?TableAlias.id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("order not found: %w", err)
+	}
+	return order, nil
+}
+
+func (r *OrderRepository) GetAllByUser(ctx context.Context, userID uuid.UUID) ([]models.Order, error) {
+	orders := []models.Order{}
+	err := withOrderRelations(r.db.NewSelect().Model(&orders)).
+		Where("?TableAlias.user_id = ?", userID).
+		OrderExpr("?TableAlias.created_at DESC").
+		Scan(ctx)
+	return orders, err
+}
+
+// checkCouponLimits locks the coupon's promotion, so concurrent checkouts
+// with the same coupon are counted one after another, and fails if the
+// coupon has been used up.
+func checkCouponLimits(ctx context.Context, tx bun.Tx, redemption *models.CouponRedemption) error {
+	promotion := new(models.Promotion)
+	if err := tx.NewSelect().Model(promotion).Where("id = ?", redemption.PromotionID).For("UPDATE").Scan(ctx); err != nil {
+		return fmt.Errorf("failed to lock promotion: %w", err)
+	}
+	if promotion.UsageLimit != nil {
+		used, err := tx.NewSelect().Model((*models.CouponRedemption)(nil)).Where("promotion_id = ?", promotion.ID).Count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count coupon redemptions: %w", err)
+		}
+		if used >= *promotion.UsageLimit {
+			return apperrors.ErrCouponUsedUp
+		}
+	}
+	if promotion.PerUserLimit != nil {
+		used, err := tx.NewSelect().
+			Model((*models.CouponRedemption)(nil)).
+			Where("promotion_id = ?", promotion.ID).
+			Where("user_id = ?", redemption.UserID).
+			Count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count coupon redemptions: %w", err)
+		}
+		if used >= *promotion.PerUserLimit {
+			return apperrors.ErrCouponUsedUp
+		}
+	}
+	return nil
+}
+
+// orderEditions returns the IDs of the editions of the order's items.
+func orderEditions(order *models.Order) []uuid.UUID {
+	editionIDs := make([]uuid.UUID, 0, len(order.Items))
+	for _, item := range order.Items {
+		editionIDs = append(editionIDs, item.EditionID)
+	}
+	return editionIDs
+}
+
+// withOrderRelations loads the items with their editions, books and
+// warehouse allocations, the payment, the delivery, the gift card
+// payments and refunds and the gift cards bought.
+func withOrderRelations(query *bun.SelectQuery) *bun.SelectQuery {
+	return query.
+		Relation("Items", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("order_item.id")
+		}).
+		Relation("Items.Edition").
+		Relation("Items.Edition.Book").
+		Relation("Items.Allocations").
+		Relation("Payment").
+		Relation("Delivery").
+		Relation("GiftCardTransactions", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.OrderExpr("created_at, id")
+		}).
+		Relation("GiftCards", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.OrderExpr("created_at, id")
+		})
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/uptrace/bun"
+)
+
+type OutboxRepository struct {
+	db *bun.DB
+}
+
+func NewOutboxRepository(db *bun.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// Enqueue stores the message unless one with the same dedup key exists.
+func (r *OutboxRepository) Enqueue(ctx context.Context, message *models.OutboxMessage) error {
+	return enqueue(ctx, r.db, message)
+}
+
+// Process locks up to limit pending messages that are due, passes each to
+// handle and saves the delivery state handle leaves on it. Locked rows are
+// skipped, so several dispatchers can run side by side.
+func (r *OutboxRepository) Process(ctx context.Context, limit int, handle func(message *models.OutboxMessage)) (int, error) {
+	var messages []*models.OutboxMessage
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().
+			Model(&messages).
+			Where("status = ?", models.OutboxStatusPending).
+			Where("available_at <= current_timestamp").
+			Order("created_at").
+			Limit(limit).
+			For("UPDATE SKIP LOCKED").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load outbox: %w", err)
+		}
+		for _, message := range messages {
+			handle(message)
+			_, err := tx.NewUpdate().
+				Model(message).
+				Column("status", "attempts", "last_error", "available_at", "sent_at").
+				WherePK().
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to update outbox message: %w", err)
+			}
+		}
+		return nil
+	})
+	return len(messages), err
+}
+
+func enqueue(ctx context.Context, db bun.IDB, message *models.OutboxMessage) error {
+	_, err := db.NewInsert().
+		Model(message).
+		On("CONFLICT (dedup_key) DO NOTHING").
+		Returning("NULL").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue notification: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type PromotionRepository struct {
+	db *bun.DB
+}
+
+func NewPromotionRepository(db *bun.DB) *PromotionRepository {
+	return &PromotionRepository{db: db}
+}
+
+func (r *PromotionRepository) Create(ctx context.Context, promotion *models.Promotion) error {
+	_, err := r.db.NewInsert().Model(promotion).Returning("*").Exec(ctx)
+	return err
+}
+
+func (r *PromotionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Promotion, error) {
+	promotion := new(models.Promotion)
+	err := r.db.NewSelect().Model(promotion).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("promotion not found: %w", err)
+	}
+	return promotion, nil
+}
+
+func (r *PromotionRepository) GetByCouponCode(ctx context.Context, code string) (*models.Promotion, error) {
+	promotion := new(models.Promotion)
+	err := r.db.NewSelect().Model(promotion).Where("coupon_code = ?", code).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("promotion not found: %w", err)
+	}
+	return promotion, nil
+}
+
+func (r *PromotionRepository) GetAll(ctx context.Context, filter dto.PromotionFilter) ([]models.Promotion, error) {
+	promotions := []models.Promotion{}
+	query := r.db.NewSelect().Model(&promotions).Order("starts_at DESC", "created_at DESC")
+	if filter.Current {
+		query = running(query)
+	}
+	err := query.Scan(ctx)
+	return promotions, err
+}
+
+// GetRunning returns the promotions that apply without a coupon now, oldest
+// first.
+func (r *PromotionRepository) GetRunning(ctx context.Context) ([]models.Promotion, error) {
+	promotions := []models.Promotion{}
+	err := running(r.db.NewSelect().Model(&promotions)).
+		Where("coupon_code IS NULL").
+		Order("starts_at", "created_at").
+		Scan(ctx)
+	return promotions, err
+}
+
+func (r *PromotionRepository) Update(ctx context.Context, promotion *models.Promotion) error {
+	expected := promotion.Version
+	promotion.Version++
+	res, err := r.db.NewUpdate().
+		Model(promotion).
+		ExcludeColumn("created_at").
+		Set("updated_at = current_timestamp").
+		WherePK().
+		Where("version = ?", expected).
+		Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		promotion.Version = expected
+		return fmt.Errorf("failed to update promotion: %w", err)
+	}
+	return nil
+}
+
+func (r *PromotionRepository) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	res, err := r.db.NewDelete().Model((*models.Promotion)(nil)).Where("id = ?", id).Where("version = ?", version).Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete promotion: %w", constraintError(err))
+	}
+	return nil
+}
+
+// CountRedemptions counts the orders placed with the promotion's coupon, in
+// total and by the user.
+func (r *PromotionRepository) CountRedemptions(ctx context.Context, promotionID, userID uuid.UUID) (int, int, error) {
+	var counts struct {
+		Total  int `bun:"total"`
+		ByUser int `bun:"by_user"`
+	}
+	err := r.db.NewSelect().
+		Model((*models.CouponRedemption)(nil)).
+		ColumnExpr("count(*) AS total").
+		ColumnExpr("count(*) FILTER (WHERE user_id = ?) AS by_user", userID).
+		Where("promotion_id = ?", promotionID).
+		Scan(ctx, &counts)
+	return counts.Total, counts.ByUser, err
+}
+
+// GetCategoryAncestors maps each category to itself and all categories
+// above it.
+func (r *PromotionRepository) GetCategoryAncestors(ctx context.Context, categoryIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	ancestors := make(map[uuid.UUID][]uuid.UUID, len(categoryIDs))
+	if len(categoryIDs) == 0 {
+		return ancestors, nil
+	}
+	var rows []struct {
+		CategoryID uuid.UUID `bun:"category_id"`
+		AncestorID uuid.UUID `bun:"ancestor_id"`
+	}
+	path := r.db.NewSelect().
+		TableExpr("categories").
+		ColumnExpr("id AS category_id, id AS ancestor_id, parent_id").
+		Where("id IN (?)", bun.In(categoryIDs)).
+		UnionAll(r.db.NewSelect().
+			TableExpr("categories AS c").
+			ColumnExpr("path.category_id, c.id, c.parent_id").
+			Join("JOIN path ON c.id = path.parent_id"))
+	err := r.db.NewSelect().
+		WithRecursive("path", path).
+		Table("path").
+		Column("category_id", "ancestor_id").
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		ancestors[row.CategoryID] = append(ancestors[row.CategoryID], row.AncestorID)
+	}
+	return ancestors, nil
+}
+
+// running limits a query over promotions to those switched on and within
+// their dates.
+func running(query *bun.SelectQuery) *bun.SelectQuery {
+	return query.
+		Where("active").
+		Where("starts_at <= current_timestamp").
+		Where("ends_at IS NULL OR ends_at > current_timestamp")
+}
@@ -38,17 +38,26 @@ func (r *PublisherRepository) GetAll(ctx context.Context) ([]models.Publisher, e
 }
 
 func (r *PublisherRepository) Update(ctx context.Context, publisher *models.Publisher) error {
-	_, err := r.db.NewUpdate().Model(publisher).Where("id = ?", publisher.ID).Exec(ctx)
+	expected := publisher.Version
+	publisher.Version++
+	res, err := r.db.NewUpdate().Model(publisher).Where("id = ?", publisher.ID).Where("version = ?", expected).Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to update publisher: %W", err)
+		publisher.Version = expected
+		return fmt.Errorf("failed to update publisher: %w", err)
 	}
 	return nil
 }
 
-func (r *PublisherRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	_, err := r.db.NewDelete().Model((*models.Publisher)(nil)).Where("id = ?", id).Exec(ctx)
+func (r *PublisherRepository) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	res, err := r.db.NewDelete().Model((*models.Publisher)(nil)).Where("id = ?", id).Where("version = ?", version).Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to delete publisher: %W", err)
+		return fmt.Errorf("failed to delete publisher: %w", err)
 	}
 	return nil
 }
\ No newline at end of file
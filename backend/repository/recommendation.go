@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// lapsedOrderStatuses are the statuses of orders that no longer count as
+// purchases.
+var lapsedOrderStatuses = []string{models.OrderStatusReturned}
+
+// recommendationsLock is the advisory lock key held while related books are
+// rebuilt, so that concurrent rebuilds run one after the other.
+const recommendationsLock = 7_340_002
+
+// coPurchaseQuery ranks, for every book, the books bought in the same
+// orders and keeps the top ones.
+const coPurchaseQuery = `
+WITH order_books AS (
+	SELECT DISTINCT oi.order_id, e.book_id
+	FROM order_items AS oi
+	JOIN orders AS o ON o.id = oi.order_id
+	JOIN editions AS e ON e.id = oi.edition_id
+	WHERE o.status NOT IN (?)
+), pairs AS (
+	SELECT a.book_id, b.book_id AS related_book_id, count(*) AS co_purchases,
+		row_number() OVER (PARTITION BY a.book_id ORDER BY count(*) DESC, b.book_id) AS rank
+	FROM order_books AS a
+	JOIN order_books AS b ON b.order_id = a.order_id AND b.book_id <> a.book_id
+	GROUP BY a.book_id, b.book_id
+)
+INSERT INTO related_books (book_id, related_book_id, co_purchases)
+SELECT book_id, related_book_id, co_purchases FROM pairs WHERE rank <= ?`
+
+// affinityQuery tops up books that have fewer than the wanted number of
+// co-purchased books with books sharing their authors or categories.
+const affinityQuery = `
+WITH taken AS (
+	SELECT book_id, count(*) AS count FROM related_books GROUP BY book_id
+), shared AS (
+	SELECT a.book_id, b.book_id AS related_book_id, 2 AS weight
+	FROM book_contributors AS a
+	JOIN book_contributors AS b ON b.author_id = a.author_id AND b.book_id <> a.book_id
+	WHERE a.role = ? AND b.role = ?
+	UNION ALL
+	SELECT a.book_id, b.book_id, 1
+	FROM book_to_category AS a
+	JOIN book_to_category AS b ON b.category_id = a.category_id AND b.book_id <> a.book_id
+), ranked AS (
+	SELECT s.book_id, s.related_book_id, sum(s.weight) AS affinity,
+		row_number() OVER (PARTITION BY s.book_id ORDER BY sum(s.weight) DESC, s.related_book_id) AS rank
+	FROM shared AS s
+	WHERE NOT EXISTS (
+		SELECT 1 FROM related_books AS rb
+		WHERE rb.book_id = s.book_id AND rb.related_book_id = s.related_book_id
+	)
+	GROUP BY s.book_id, s.related_book_id
+)
+INSERT INTO related_books (book_id, related_book_id, affinity)
+SELECT r.book_id, r.related_book_id, r.affinity
+FROM ranked AS r
+LEFT JOIN taken AS t ON t.book_id = r.book_id
+WHERE r.rank <= ? - coalesce(t.count, 0)`
+
+type RecommendationRepository struct {
+	db *bun.DB
+}
+
+func NewRecommendationRepository(db *bun.DB) *RecommendationRepository {
+	return &RecommendationRepository{db: db}
+}
+
+// Rebuild recomputes all related books from orders that were not returned,
+// keeping up to perBook for each book. Readers see the previous set until
+// the rebuild commits.
+func (r *RecommendationRepository) Rebuild(ctx context.Context, perBook int) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(?)", recommendationsLock); err != nil {
+			return fmt.Errorf("failed to lock related books: %w", err)
+		}
+		if _, err := tx.NewDelete().Model((*models.RelatedBook)(nil)).Where("TRUE").Exec(ctx); err != nil {
+			return fmt.Errorf("failed to clear related books: %w", err)
+		}
+		if _, err := tx.NewRaw(coPurchaseQuery, bun.In(lapsedOrderStatuses), perBook).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to compute co-purchases: %w", err)
+		}
+		if _, err := tx.NewRaw(affinityQuery, models.ContributorRoleAuthor, models.ContributorRoleAuthor, perBook).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to compute affinities: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *RecommendationRepository) GetRelated(ctx context.Context, bookID uuid.UUID, limit int) ([]models.Book, error) {
+	var ids []uuid.UUID
+	err := r.db.NewSelect().
+		Model((*models.RelatedBook)(nil)).
+		Column("related_book_id").
+		Where("book_id = ?", bookID).
+		Order("co_purchases DESC", "affinity DESC", "related_book_id").
+		Limit(limit).
+		Scan(ctx, &ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find related books: %w", err)
+	}
+	return r.booksInOrder(ctx, ids)
+}
+
+// GetForUser ranks the books related to what the user has ordered (counted
+// twice) or wishlisted, leaving out those books themselves.
+func (r *RecommendationRepository) GetForUser(ctx context.Context, userID uuid.UUID, limit int) ([]models.Book, error) {
+	var ids []uuid.UUID
+	err := r.db.NewSelect().
+		With("seeds", r.userBooks(userID)).
+		Model((*models.RelatedBook)(nil)).
+		Join("JOIN seeds AS s ON s.book_id = related_book.book_id").
+		ColumnExpr("related_book.related_book_id").
+		Where("related_book.related_book_id NOT IN (SELECT book_id FROM seeds)").
+		GroupExpr("related_book.related_book_id").
+		OrderExpr("sum(s.weight * related_book.co_purchases) DESC, sum(s.weight * related_book.affinity) DESC, related_book.related_book_id").
+		Limit(limit).
+		Scan(ctx, &ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find recommendations: %w", err)
+	}
+	return r.booksInOrder(ctx, ids)
+}
+
+// GetBestsellers returns the most ordered books the user has neither
+// ordered nor wishlisted, for users without enough history.
+func (r *RecommendationRepository) GetBestsellers(ctx context.Context, userID uuid.UUID, limit int) ([]models.Book, error) {
+	var ids []uuid.UUID
+	err := r.db.NewSelect().
+		With("seeds", r.userBooks(userID)).
+		Model((*models.OrderItem)(nil)).
+		Join("JOIN orders AS o ON o.id = order_item.order_id").
+		Join("JOIN editions AS e ON e.id = order_item.edition_id").
+		ColumnExpr("e.book_id").
+		Where("o.status NOT IN (?)", bun.In(lapsedOrderStatuses)).
+		Where("e.book_id NOT IN (SELECT book_id FROM seeds)").
+		GroupExpr("e.book_id").
+		OrderExpr("sum(order_item.quantity) DESC, e.book_id").
+		Limit(limit).
+		Scan(ctx, &ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find bestsellers: %w", err)
+	}
+	return r.booksInOrder(ctx, ids)
+}
+
+// userBooks selects the books the user has ordered, with weight 2, and
+// those on the user's wishlists, with weight 1.
+func (r *RecommendationRepository) userBooks(userID uuid.UUID) *bun.SelectQuery {
+	wishlisted := r.db.NewSelect().
+		TableExpr("wishlist_items AS wi").
+		Join("JOIN wishlists AS w ON w.id = wi.wishlist_id").
+		Join("JOIN editions AS e ON e.id = wi.edition_id").
+		ColumnExpr("DISTINCT e.book_id, 1 AS weight").
+		Where("w.user_id = ?", userID)
+	return r.db.NewSelect().
+		TableExpr("order_items AS oi").
+		Join("JOIN orders AS o ON o.id = oi.order_id").
+		Join("JOIN editions AS e ON e.id = oi.edition_id").
+		ColumnExpr("DISTINCT e.book_id, 2 AS weight").
+		Where("o.user_id = ?", userID).
+		UnionAll(wishlisted)
+}
+
+// booksInOrder loads the books with their relations in the order of ids.
+func (r *RecommendationRepository) booksInOrder(ctx context.Context, ids []uuid.UUID) ([]models.Book, error) {
+	books := []models.Book{}
+	if len(ids) == 0 {
+		return books, nil
+	}
+	err := withBookRelations(r.db.NewSelect().Model(&books)).Where("book.id IN (?)", bun.In(ids)).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load books: %w", err)
+	}
+	byID := make(map[uuid.UUID]models.Book, len(books))
+	for _, book := range books {
+		byID[book.ID] = book
+	}
+	ordered := make([]models.Book, 0, len(books))
+	for _, id := range ids {
+		if book, ok := byID[id]; ok {
+			ordered = append(ordered, book)
+		}
+	}
+	return ordered, nil
+}
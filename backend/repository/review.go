@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+var reviewOrders = map[string]string{
+	"":            "review.created_at DESC",
+	"newest":      "review.created_at DESC",
+	"oldest":      "review.created_at ASC",
+	"rating_desc": "review.rating DESC, review.created_at DESC",
+	"rating_asc":  "review.rating ASC, review.created_at DESC",
+}
+
+type ReviewRepository struct {
+	db *bun.DB
+}
+
+func NewReviewRepository(db *bun.DB) *ReviewRepository {
+	return &ReviewRepository{db: db}
+}
+
+func (r *ReviewRepository) Create(ctx context.Context, review *models.Review) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(review).Returning("*").Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create review: %w", constraintError(err))
+		}
+		return refreshBookRating(ctx, tx, review.BookID)
+	})
+}
+
+func (r *ReviewRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Review, error) {
+	review := new(models.Review)
+	err := withReviewer(r.db.NewSelect().Model(review)).Where("review.id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("review not found: %w", err)
+	}
+	return review, nil
+}
+
+// ExistsForUser reports whether the user has already reviewed the book.
+func (r *ReviewRepository) ExistsForUser(ctx context.Context, bookID, userID uuid.UUID) (bool, error) {
+	return r.db.NewSelect().
+		Model((*models.Review)(nil)).
+		Where("book_id = ?", bookID).
+		Where("user_id = ?", userID).
+		Exists(ctx)
+}
+
+// HasDeliveredPurchase reports whether the user has received an order
+// containing any edition of the book.
+func (r *ReviewRepository) HasDeliveredPurchase(ctx context.Context, userID, bookID uuid.UUID) (bool, error) {
+	return r.db.NewSelect().
+		TableExpr("orders AS o").
+		Join("JOIN order_items AS oi ON oi.order_id = o.id").
+		Join("JOIN editions AS e ON e.id = oi.edition_id").
+		Join("LEFT JOIN deliveries AS d ON d.order_id = o.id").
+		Where("o.user_id = ?", userID).
+		Where("e.book_id = ?", bookID).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("o.status = ?", models.OrderStatusDelivered).
+				WhereOr("d.status = ?", models.DeliveryStatusDelivered)
+		}).
+		Exists(ctx)
+}
+
+// List returns a page of reviews and the total number of matches. A nil
+// bookID or userID does not restrict the list; an empty status neither.
+func (r *ReviewRepository) List(ctx context.Context, bookID, userID *uuid.UUID, status string, query dto.ReviewQuery) ([]models.Review, int, error) {
+	order, ok := reviewOrders[query.Sort]
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown review sort: %s", query.Sort)
+	}
+	reviews := []models.Review{}
+	q := withReviewer(r.db.NewSelect().Model(&reviews))
+	if bookID != nil {
+		q = q.Where("review.book_id = ?", *bookID)
+	}
+	if userID != nil {
+		q = q.Where("review.user_id = ?", *userID)
+	}
+	if status != "" {
+		q = q.Where("review.status = ?", status)
+	}
+	total, err := q.OrderExpr(order).Limit(query.Limit).Offset(query.Offset).ScanAndCount(ctx)
+	return reviews, total, err
+}
+
+func (r *ReviewRepository) Update(ctx context.Context, review *models.Review) error {
+	expected := review.Version
+	review.Version++
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewUpdate().
+			Model(review).
+			ExcludeColumn("created_at", "updated_at").
+			Set("updated_at = current_timestamp").
+			WherePK().
+			Where("version = ?", expected).
+			Exec(ctx)
+		if err == nil {
+			err = checkVersionedWrite(res)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update review: %w", err)
+		}
+		return refreshBookRating(ctx, tx, review.BookID)
+	})
+	if err != nil {
+		review.Version = expected
+	}
+	return err
+}
+
+func (r *ReviewRepository) Delete(ctx context.Context, review *models.Review, version int64) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewDelete().Model((*models.Review)(nil)).Where("id = ?", review.ID).Where("version = ?", version).Exec(ctx)
+		if err == nil {
+			err = checkVersionedWrite(res)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete review: %w", err)
+		}
+		return refreshBookRating(ctx, tx, review.BookID)
+	})
+}
+
+// withReviewer adds the reviewer's username to the selected reviews.
+func withReviewer(query *bun.SelectQuery) *bun.SelectQuery {
+	return query.
+		ColumnExpr("review.*").
+		ColumnExpr("u.username").
+		Join("JOIN users AS u ON u.id = review.user_id")
+}
+
+// refreshBookRating recomputes the book's rating from its approved reviews.
+// The book's version is left alone: ratings change without an editor
+// touching the book.
+func refreshBookRating(ctx context.Context, tx bun.Tx, bookID uuid.UUID) error {
+	stats := tx.NewSelect().
+		Model((*models.Review)(nil)).
+		ColumnExpr("coalesce(avg(rating), 0) AS average, count(*) AS count").
+		Where("book_id = ?", bookID).
+		Where("status = ?", models.ReviewStatusApproved)
+	_, err := tx.NewUpdate().
+		Model((*models.Book)(nil)).
+		With("stats", stats).
+		TableExpr("stats").
+		Set("rating_average = stats.average").
+		Set("rating_count = stats.count").
+		Where("book.id = ?", bookID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update book rating: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type SeriesRepository struct {
+	db *bun.DB
+}
+
+func NewSeriesRepository(db *bun.DB) *SeriesRepository {
+	return &SeriesRepository{db: db}
+}
+
+func (r *SeriesRepository) Create(ctx context.Context, series *models.Series) error {
+	_, err := r.db.NewInsert().Model(series).Returning("*").Exec(ctx)
+	return err
+}
+
+// GetByID returns the series with its books in reading order. Books without
+// a position come last.
+func (r *SeriesRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Series, error) {
+	series := new(models.Series)
+	err := r.db.NewSelect().
+		Model(series).
+		Relation("Books", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.OrderExpr("book.series_position ASC NULLS LAST, book.title ASC")
+		}).
+		Relation("Books.Contributors", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.OrderExpr("book_contributor.position ASC")
+		}).
+		Relation("Books.Contributors.Author").
+		Where("series.id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("series not found: %w", err)
+	}
+	return series, nil
+}
+
+func (r *SeriesRepository) GetAll(ctx context.Context) ([]models.Series, error) {
+	var series []models.Series
+	err := r.db.NewSelect().Model(&series).OrderExpr("name").Scan(ctx)
+	return series, err
+}
+
+func (r *SeriesRepository) Update(ctx context.Context, series *models.Series) error {
+	expected := series.Version
+	series.Version++
+	res, err := r.db.NewUpdate().Model(series).WherePK().Where("version = ?", expected).Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		series.Version = expected
+		return fmt.Errorf("failed to update series: %w", err)
+	}
+	return nil
+}
+
+func (r *SeriesRepository) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	res, err := r.db.NewDelete().Model((*models.Series)(nil)).Where("id = ?", id).Where("version = ?", version).Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete series: %w", err)
+	}
+	return nil
+}
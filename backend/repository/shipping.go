@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ShippingRepository struct {
+	db *bun.DB
+}
+
+func NewShippingRepository(db *bun.DB) *ShippingRepository {
+	return &ShippingRepository{db: db}
+}
+
+func (r *ShippingRepository) CreateZone(ctx context.Context, zone *models.ShippingZone) error {
+	_, err := r.db.NewInsert().Model(zone).Returning("*").Exec(ctx)
+	return err
+}
+
+func (r *ShippingRepository) GetZoneByID(ctx context.Context, id uuid.UUID) (*models.ShippingZone, error) {
+	zone := new(models.ShippingZone)
+	if err := r.db.NewSelect().Model(zone).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("shipping zone not found: %w", err)
+	}
+	return zone, nil
+}
+
+func (r *ShippingRepository) GetZones(ctx context.Context) ([]models.ShippingZone, error) {
+	zones := []models.ShippingZone{}
+	err := r.db.NewSelect().Model(&zones).Order("name").Scan(ctx)
+	return zones, err
+}
+
+func (r *ShippingRepository) UpdateZone(ctx context.Context, zone *models.ShippingZone) error {
+	expected := zone.Version
+	zone.Version++
+	res, err := r.db.NewUpdate().
+		Model(zone).
+		ExcludeColumn("created_at").
+		Set("updated_at = current_timestamp").
+		WherePK().
+		Where("version = ?", expected).
+		Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		zone.Version = expected
+		return fmt.Errorf("failed to update shipping zone: %w", err)
+	}
+	return nil
+}
+
+func (r *ShippingRepository) DeleteZone(ctx context.Context, id uuid.UUID, version int64) error {
+	res, err := r.db.NewDelete().Model((*models.ShippingZone)(nil)).Where("id = ?", id).Where("version = ?", version).Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete shipping zone: %w", err)
+	}
+	return nil
+}
+
+func (r *ShippingRepository) CreateMethod(ctx context.Context, method *models.ShippingMethod) error {
+	_, err := r.db.NewInsert().Model(method).Returning("*").Exec(ctx)
+	return err
+}
+
+func (r *ShippingRepository) GetMethodByID(ctx context.Context, id uuid.UUID) (*models.ShippingMethod, error) {
+	method := new(models.ShippingMethod)
+	if err := r.db.NewSelect().Model(method).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("shipping method not found: %w", err)
+	}
+	return method, nil
+}
+
+func (r *ShippingRepository) GetMethods(ctx context.Context, filter dto.ShippingMethodFilter) ([]models.ShippingMethod, error) {
+	methods := []models.ShippingMethod{}
+	query := r.db.NewSelect().Model(&methods).Order("name")
+	if filter.Active {
+		query = query.Where("active")
+	}
+	err := query.Scan(ctx)
+	return methods, err
+}
+
+func (r *ShippingRepository) UpdateMethod(ctx context.Context, method *models.ShippingMethod) error {
+	expected := method.Version
+	method.Version++
+	res, err := r.db.NewUpdate().
+		Model(method).
+		ExcludeColumn("created_at").
+		Set("updated_at = current_timestamp").
+		WherePK().
+		Where("version = ?", expected).
+		Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		method.Version = expected
+		return fmt.Errorf("failed to update shipping method: %w", err)
+	}
+	return nil
+}
+
+// DeleteMethod removes the method; orders shipped with it keep its name on
+// their delivery.
+func (r *ShippingRepository) DeleteMethod(ctx context.Context, id uuid.UUID, version int64) error {
+	res, err := r.db.NewDelete().Model((*models.ShippingMethod)(nil)).Where("id = ?", id).Where("version = ?", version).Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete shipping method: %w", err)
+	}
+	return nil
+}
+
+func (r *ShippingRepository) CreatePickupPoint(ctx context.Context, point *models.PickupPoint) error {
+	_, err := r.db.NewInsert().Model(point).Returning("*").Exec(ctx)
+	return err
+}
+
+func (r *ShippingRepository) GetPickupPointByID(ctx context.Context, id uuid.UUID) (*models.PickupPoint, error) {
+	point := new(models.PickupPoint)
+	if err := r.db.NewSelect().Model(point).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("pickup point not found: %w", err)
+	}
+	return point, nil
+}
+
+func (r *ShippingRepository) GetPickupPoints(ctx context.Context, activeOnly bool) ([]models.PickupPoint, error) {
+	points := []models.PickupPoint{}
+	query := r.db.NewSelect().Model(&points).Order("name")
+	if activeOnly {
+		query = query.Where("active")
+	}
+	err := query.Scan(ctx)
+	return points, err
+}
+
+func (r *ShippingRepository) UpdatePickupPoint(ctx context.Context, point *models.PickupPoint) error {
+	expected := point.Version
+	point.Version++
+	res, err := r.db.NewUpdate().
+		Model(point).
+		ExcludeColumn("created_at").
+		Set("updated_at = current_timestamp").
+		WherePK().
+		Where("version = ?", expected).
+		Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		point.Version = expected
+		return fmt.Errorf("failed to update pickup point: %w", err)
+	}
+	return nil
+}
+
+func (r *ShippingRepository) DeletePickupPoint(ctx context.Context, id uuid.UUID, version int64) error {
+	res, err := r.db.NewDelete().Model((*models.PickupPoint)(nil)).Where("id = ?", id).Where("version = ?", version).Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete pickup point: %w", constraintError(err))
+	}
+	return nil
+}
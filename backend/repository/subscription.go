@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type SubscriptionRepository struct {
+	db *bun.DB
+}
+
+func NewSubscriptionRepository(db *bun.DB) *SubscriptionRepository {
+	return &SubscriptionRepository{db: db}
+}
+
+func (r *SubscriptionRepository) Create(ctx context.Context, subscription *models.StockSubscription) error {
+	_, err := r.db.NewInsert().Model(subscription).Returning("*").Exec(ctx)
+	return err
+}
+
+func (r *SubscriptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.StockSubscription, error) {
+	subscription := new(models.StockSubscription)
+	err := r.db.NewSelect().Model(subscription).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("subscription not found: %w", err)
+	}
+	return subscription, nil
+}
+
+func (r *SubscriptionRepository) GetByToken(ctx context.Context, token string) (*models.StockSubscription, error) {
+	subscription := new(models.StockSubscription)
+	err := r.db.NewSelect().Model(subscription).Where("unsubscribe_token = ?", token).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("subscription not found: %w", err)
+	}
+	return subscription, nil
+}
+
+func (r *SubscriptionRepository) GetByUser(ctx context.Context, userID uuid.UUID) ([]models.StockSubscription, error) {
+	subscriptions := []models.StockSubscription{}
+	err := r.db.NewSelect().
+		Model(&subscriptions).
+		Relation("Book").
+		Where("stock_subscription.user_id = ?", userID).
+		Order("stock_subscription.created_at DESC").
+		Scan(ctx)
+	return subscriptions, err
+}
+
+func (r *SubscriptionRepository) GetByBook(ctx context.Context, bookID uuid.UUID) ([]models.StockSubscription, error) {
+	subscriptions := []models.StockSubscription{}
+	err := r.db.NewSelect().Model(&subscriptions).Where("book_id = ?", bookID).Scan(ctx)
+	return subscriptions, err
+}
+
+func (r *SubscriptionRepository) Exists(ctx context.Context, userID, bookID uuid.UUID, kind string) (bool, error) {
+	return r.db.NewSelect().
+		Model((*models.StockSubscription)(nil)).
+		Where("user_id = ?", userID).
+		Where("book_id = ?", bookID).
+		Where("kind = ?", kind).
+		Exists(ctx)
+}
+
+func (r *SubscriptionRepository) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	return r.db.NewSelect().Model((*models.StockSubscription)(nil)).Where("user_id = ?", userID).Count(ctx)
+}
+
+func (r *SubscriptionRepository) GetBookStock(ctx context.Context, bookID uuid.UUID) (*dto.BookStock, error) {
+	stock := new(dto.BookStock)
+	err := r.db.NewSelect().
+		Model((*models.Edition)(nil)).
+		ColumnExpr("coalesce(sum(stock), 0) AS in_stock").
+		ColumnExpr("min(price) FILTER (WHERE stock > 0) AS min_price").
+		Where("book_id = ?", bookID).
+		Scan(ctx, stock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum up book stock: %w", err)
+	}
+	return stock, nil
+}
+
+// Fire enqueues the alert and disarms the subscription in one transaction.
+// An alert whose dedup key was used before is dropped.
+func (r *SubscriptionRepository) Fire(ctx context.Context, subscription *models.StockSubscription, message *models.OutboxMessage) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := enqueue(ctx, tx, message); err != nil {
+			return err
+		}
+		_, err := tx.NewUpdate().
+			Model((*models.StockSubscription)(nil)).
+			Set("armed = false").
+			Set("notified_at = current_timestamp").
+			Where("id = ?", subscription.ID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to disarm subscription: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *SubscriptionRepository) Rearm(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := r.db.NewUpdate().
+		Model((*models.StockSubscription)(nil)).
+		Set("armed = true").
+		Where("id IN (?)", bun.In(ids)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to rearm subscriptions: %w", err)
+	}
+	return nil
+}
+
+func (r *SubscriptionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewDelete().Model((*models.StockSubscription)(nil)).Where("id = ?", id).Exec(ctx)
+	return err
+}
@@ -11,6 +11,8 @@ import (
 
 var CUSTOMER_ROLE = "user"
 var EMPLOYEE_ROLES = []string{"admin", "manager", "delivery", "support"}
+var ADMIN_ROLES = []string{"admin"}
+var MODERATOR_ROLES = []string{"admin", "support"}
 
 type UserRepository struct {
 	db *bun.DB
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+)
+
+// checkVersionedWrite reports a version conflict when an update or delete
+// guarded by "version = ?" did not touch any row.
+func checkVersionedWrite(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return apperrors.ErrVersionConflict
+	}
+	return nil
+}
@@ -0,0 +1,307 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/apperrors"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/dto"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/inventory"
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+const defaultTransferLimit = 100
+
+type WarehouseRepository struct {
+	db *bun.DB
+}
+
+func NewWarehouseRepository(db *bun.DB) *WarehouseRepository {
+	return &WarehouseRepository{db: db}
+}
+
+func (r *WarehouseRepository) Create(ctx context.Context, warehouse *models.Warehouse) error {
+	_, err := r.db.NewInsert().Model(warehouse).Returning("*").Exec(ctx)
+	return err
+}
+
+func (r *WarehouseRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Warehouse, error) {
+	warehouse := new(models.Warehouse)
+	if err := r.db.NewSelect().Model(warehouse).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("warehouse not found: %w", err)
+	}
+	return warehouse, nil
+}
+
+// GetAll lists the warehouses in the order orders are shipped from them.
+func (r *WarehouseRepository) GetAll(ctx context.Context, activeOnly bool) ([]models.Warehouse, error) {
+	warehouses := []models.Warehouse{}
+	query := r.db.NewSelect().Model(&warehouses).Order("priority", "name")
+	if activeOnly {
+		query = query.Where("active")
+	}
+	err := query.Scan(ctx)
+	return warehouses, err
+}
+
+// Update saves the warehouse. Switching it on or off changes the stock of
+// the editions it has, which is updated along with it.
+func (r *WarehouseRepository) Update(ctx context.Context, warehouse *models.Warehouse) error {
+	expected := warehouse.Version
+	warehouse.Version++
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewUpdate().
+			Model(warehouse).
+			ExcludeColumn("created_at").
+			Set("updated_at = current_timestamp").
+			WherePK().
+			Where("version = ?", expected).
+			Exec(ctx)
+		if err == nil {
+			err = checkVersionedWrite(res)
+		}
+		if err != nil {
+			return err
+		}
+		editions := tx.NewSelect().Model((*models.EditionStock)(nil)).Column("edition_id").Where("warehouse_id = ?", warehouse.ID)
+		return refreshStock(ctx, tx, editions)
+	})
+	if err != nil {
+		warehouse.Version = expected
+		return fmt.Errorf("failed to update warehouse: %w", err)
+	}
+	return nil
+}
+
+// GetStock lists the editions the warehouse has, with their books, by
+// title.
+func (r *WarehouseRepository) GetStock(ctx context.Context, warehouseID uuid.UUID) ([]models.EditionStock, error) {
+	stock := []models.EditionStock{}
+	err := r.db.NewSelect().
+		Model(&stock).
+		Relation("Edition").
+		Relation("Edition.Book").
+		Where("edition_stock.warehouse_id = ?", warehouseID).
+		Where("edition_stock.quantity > 0").
+		OrderExpr("edition__book.title, edition_stock.edition_id").
+		Scan(ctx)
+	return stock, err
+}
+
+// GetEditionStock lists what each warehouse has of the edition, in the
+// order orders are shipped from them.
+func (r *WarehouseRepository) GetEditionStock(ctx context.Context, editionID uuid.UUID) ([]models.EditionStock, error) {
+	stock := []models.EditionStock{}
+	err := r.db.NewSelect().
+		Model(&stock).
+		Relation("Warehouse").
+		Where("edition_stock.edition_id = ?", editionID).
+		OrderExpr("warehouse.priority, warehouse.name").
+		Scan(ctx)
+	return stock, err
+}
+
+// SetStock sets how many copies of the edition the warehouse has and
+// updates the edition's stock.
+func (r *WarehouseRepository) SetStock(ctx context.Context, stock *models.EditionStock) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().
+			Model(stock).
+			On("CONFLICT (edition_id, warehouse_id) DO UPDATE").
+			Set("quantity = EXCLUDED.quantity").
+			Set("updated_at = current_timestamp").
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to set stock: %w", err)
+		}
+		return refreshStock(ctx, tx, bun.In([]uuid.UUID{stock.EditionID}))
+	})
+}
+
+// Transfer moves the copies from one warehouse to the other and records
+// the transfer. It fails with apperrors.ErrOutOfStock if the warehouse
+// they come from does not have them. The stock rows are locked up front in
+// the order allocateStock locks them, so concurrent transfers and orders
+// cannot deadlock.
+func (r *WarehouseRepository) Transfer(ctx context.Context, transfer *models.StockTransfer) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		editionIDs := make([]uuid.UUID, 0, len(transfer.Items))
+		for _, item := range transfer.Items {
+			editionIDs = append(editionIDs, item.EditionID)
+		}
+		_, err := tx.NewSelect().
+			Model((*models.EditionStock)(nil)).
+			Column("edition_id").
+			Where("edition_id IN (?)", bun.In(editionIDs)).
+			Where("warehouse_id IN (?)", bun.In([]uuid.UUID{transfer.FromWarehouseID, transfer.ToWarehouseID})).
+			Order("edition_id", "warehouse_id").
+			For("UPDATE").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to lock stock: %w", err)
+		}
+
+		for _, item := range transfer.Items {
+			if err := takeStock(ctx, tx, item.EditionID, transfer.FromWarehouseID, item.Quantity); err != nil {
+				return err
+			}
+			if err := putStock(ctx, tx, item.EditionID, transfer.ToWarehouseID, item.Quantity); err != nil {
+				return err
+			}
+		}
+		if _, err := tx.NewInsert().Model(transfer).Returning("*").Exec(ctx); err != nil {
+			return fmt.Errorf("failed to record stock transfer: %w", err)
+		}
+		return refreshStock(ctx, tx, bun.In(editionIDs))
+	})
+}
+
+func (r *WarehouseRepository) GetTransfers(ctx context.Context, filter dto.StockTransferFilter) ([]models.StockTransfer, error) {
+	transfers := []models.StockTransfer{}
+	query := r.db.NewSelect().Model(&transfers)
+	if filter.WarehouseID != nil {
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("from_warehouse_id = ?", *filter.WarehouseID).WhereOr("to_warehouse_id = ?", *filter.WarehouseID)
+		})
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultTransferLimit
+	}
+
+	err := query.Order("created_at DESC").Limit(limit).Offset(filter.Offset).Scan(ctx)
+	return transfers, err
+}
+
+// allocateStock decides which of the warehouses, in order of preference,
+// ship the items, sets the items' allocations and takes the copies out of
+// the warehouses. The stock rows are locked while it is read, so concurrent
+// orders allocate one after another. It fails with apperrors.ErrOutOfStock
+// if the active warehouses among them do not have enough copies.
+func allocateStock(ctx context.Context, db bun.IDB, items []*models.OrderItem, warehouses []uuid.UUID) error {
+	lines := make([]inventory.Line, 0, len(items))
+	editionIDs := make([]uuid.UUID, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, inventory.Line{EditionID: item.EditionID, Quantity: item.Quantity})
+		editionIDs = append(editionIDs, item.EditionID)
+	}
+	if len(warehouses) == 0 {
+		return apperrors.ErrOutOfStock
+	}
+
+	rows := []models.EditionStock{}
+	err := db.NewSelect().
+		Model(&rows).
+		Join("JOIN warehouses AS w ON w.id = edition_stock.warehouse_id").
+		Where("edition_stock.edition_id IN (?)", bun.In(editionIDs)).
+		Where("edition_stock.warehouse_id IN (?)", bun.In(warehouses)).
+		Where("w.active").
+		OrderExpr("edition_stock.edition_id, edition_stock.warehouse_id").
+		For("UPDATE OF edition_stock").
+		Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read stock: %w", err)
+	}
+	stock := inventory.Stock{}
+	for _, row := range rows {
+		if stock[row.WarehouseID] == nil {
+			stock[row.WarehouseID] = map[uuid.UUID]int{}
+		}
+		stock[row.WarehouseID][row.EditionID] = row.Quantity
+	}
+
+	allocations, ok := inventory.Allocate(lines, stock, warehouses)
+	if !ok {
+		return apperrors.ErrOutOfStock
+	}
+	for _, item := range items {
+		item.Allocations = nil
+	}
+	for _, allocation := range allocations {
+		item := items[allocation.Line]
+		item.Allocations = append(item.Allocations, &models.StockAllocation{WarehouseID: allocation.WarehouseID, Quantity: allocation.Quantity})
+		if err := takeStock(ctx, db, item.EditionID, allocation.WarehouseID, allocation.Quantity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// takeStock takes copies of an edition out of a warehouse. It fails with
+// apperrors.ErrOutOfStock if the warehouse does not have them.
+func takeStock(ctx context.Context, db bun.IDB, editionID, warehouseID uuid.UUID, quantity int) error {
+	res, err := db.NewUpdate().
+		Model((*models.EditionStock)(nil)).
+		Set("quantity = quantity - ?", quantity).
+		Set("updated_at = current_timestamp").
+		Where("edition_id = ?", editionID).
+		Where("warehouse_id = ?", warehouseID).
+		Where("quantity >= ?", quantity).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to take stock: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		return apperrors.ErrOutOfStock
+	}
+	return nil
+}
+
+// putStock adds copies of an edition to a warehouse.
+func putStock(ctx context.Context, db bun.IDB, editionID, warehouseID uuid.UUID, quantity int) error {
+	_, err := db.NewInsert().
+		Model(&models.EditionStock{EditionID: editionID, WarehouseID: warehouseID, Quantity: quantity}).
+		On("CONFLICT (edition_id, warehouse_id) DO UPDATE").
+		Set("quantity = edition_stock.quantity + EXCLUDED.quantity").
+		Set("updated_at = current_timestamp").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to put stock: %w", err)
+	}
+	return nil
+}
+
+// refreshStock sets the stock of the editions whose IDs editionIDs gives,
+// a bun.In list or a subquery, to the copies in the active warehouses. The
+// editions whose stock changes get a new version. The edition rows are
+// locked first so that the sum, read by the next statement, includes the
+// stock of every transaction that refreshed them before.
+func refreshStock(ctx context.Context, db bun.IDB, editionIDs any) error {
+	_, err := db.NewSelect().
+		Model((*models.Edition)(nil)).
+		Column("id").
+		Where("id IN (?)", editionIDs).
+		Order("id").
+		For("UPDATE").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to lock editions: %w", err)
+	}
+	totals := db.NewSelect().
+		TableExpr("editions AS e").
+		ColumnExpr("e.id, COALESCE(SUM(s.quantity) FILTER (WHERE w.active), 0) AS total").
+		Join("LEFT JOIN edition_stocks AS s ON s.edition_id = e.id").
+		Join("LEFT JOIN warehouses AS w ON w.id = s.warehouse_id").
+		Where("e.id IN (?)", editionIDs).
+		Group("e.id")
+	_, err = db.NewUpdate().
+		With("totals", totals).
+		Model((*models.Edition)(nil)).
+		TableExpr("totals").
+		Set("stock = totals.total").
+		Set("version = edition.version + 1").
+		Set("updated_at = current_timestamp").
+		Where("edition.id = totals.id").
+		Where("edition.stock <> totals.total").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update edition stock: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheMatrix2/Bookstore-Info-System/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type WishlistRepository struct {
+	db *bun.DB
+}
+
+func NewWishlistRepository(db *bun.DB) *WishlistRepository {
+	return &WishlistRepository{db: db}
+}
+
+func (r *WishlistRepository) Create(ctx context.Context, wishlist *models.Wishlist) error {
+	_, err := r.db.NewInsert().Model(wishlist).Returning("*").Exec(ctx)
+	return err
+}
+
+func (r *WishlistRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Wishlist, error) {
+	wishlist := new(models.Wishlist)
+	err := withWishlistItems(r.db.NewSelect().Model(wishlist)).Where("wishlist.id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("wishlist not found: %w", err)
+	}
+	return wishlist, nil
+}
+
+func (r *WishlistRepository) GetByShareToken(ctx context.Context, token string) (*models.Wishlist, error) {
+	wishlist := new(models.Wishlist)
+	err := withWishlistItems(r.db.NewSelect().Model(wishlist)).Where("wishlist.share_token = ?", token).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("wishlist not found: %w", err)
+	}
+	return wishlist, nil
+}
+
+func (r *WishlistRepository) GetByName(ctx context.Context, userID uuid.UUID, name string) (*models.Wishlist, error) {
+	wishlist := new(models.Wishlist)
+	err := r.db.NewSelect().Model(wishlist).Where("user_id = ?", userID).Where("name = ?", name).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("wishlist not found: %w", err)
+	}
+	return wishlist, nil
+}
+
+func (r *WishlistRepository) GetAllByUser(ctx context.Context, userID uuid.UUID) ([]models.Wishlist, error) {
+	wishlists := []models.Wishlist{}
+	err := withWishlistItems(r.db.NewSelect().Model(&wishlists)).
+		Where("wishlist.user_id = ?", userID).
+		Order("wishlist.created_at").
+		Scan(ctx)
+	return wishlists, err
+}
+
+func (r *WishlistRepository) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	return r.db.NewSelect().Model((*models.Wishlist)(nil)).Where("user_id = ?", userID).Count(ctx)
+}
+
+func (r *WishlistRepository) Update(ctx context.Context, wishlist *models.Wishlist) error {
+	expected := wishlist.Version
+	wishlist.Version++
+	res, err := r.db.NewUpdate().
+		Model(wishlist).
+		Column("name", "share_token", "version").
+		Set("updated_at = current_timestamp").
+		WherePK().
+		Where("version = ?", expected).
+		Exec(ctx)
+	if err == nil {
+		err = checkVersionedWrite(res)
+	}
+	if err != nil {
+		wishlist.Version = expected
+		return fmt.Errorf("failed to update wishlist: %w", err)
+	}
+	return nil
+}
+
+func (r *WishlistRepository) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().Model((*models.WishlistItem)(nil)).Where("wishlist_id = ?", id).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete wishlist items: %w", err)
+		}
+		res, err := tx.NewDelete().Model((*models.Wishlist)(nil)).Where("id = ?", id).Where("version = ?", version).Exec(ctx)
+		if err == nil {
+			err = checkVersionedWrite(res)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete wishlist: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *WishlistRepository) AddItem(ctx context.Context, item *models.WishlistItem) error {
+	_, err := r.db.NewInsert().Model(item).Returning("*").Exec(ctx)
+	return err
+}
+
+func (r *WishlistRepository) RemoveItem(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewDelete().Model((*models.WishlistItem)(nil)).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+// MoveToCart adds the item's edition to the user's cart, creating the cart
+// if needed, and removes the item from its wishlist. A copy already in the
+// cart has its quantity increased instead of being added twice.
+func (r *WishlistRepository) MoveToCart(ctx context.Context, item *models.WishlistItem, userID uuid.UUID, quantity int) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		cart := &models.Cart{UserID: userID}
+		if _, err := tx.NewInsert().Model(cart).On("CONFLICT (user_id) DO NOTHING").Returning("NULL").Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create cart: %w", err)
+		}
+		if err := tx.NewSelect().Model(cart).Where("user_id = ?", userID).Scan(ctx); err != nil {
+			return fmt.Errorf("failed to load cart: %w", err)
+		}
+
+		cartItem := &models.CartItem{CartID: cart.ID, EditionID: item.EditionID, Quantity: quantity}
+		_, err := tx.NewInsert().
+			Model(cartItem).
+			On("CONFLICT (cart_id, edition_id) DO UPDATE").
+			Set("quantity = cart_item.quantity + EXCLUDED.quantity").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add cart item: %w", err)
+		}
+
+		if _, err := tx.NewDelete().Model((*models.WishlistItem)(nil)).Where("id = ?", item.ID).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to remove wishlist item: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetWatchers returns the users who have the edition on any of their
+// wishlists.
+func (r *WishlistRepository) GetWatchers(ctx context.Context, editionID uuid.UUID) ([]uuid.UUID, error) {
+	var users []uuid.UUID
+	err := r.db.NewSelect().
+		Model((*models.WishlistItem)(nil)).
+		Join("JOIN wishlists AS w ON w.id = wishlist_item.wishlist_id").
+		ColumnExpr("DISTINCT w.user_id").
+		Where("wishlist_item.edition_id = ?", editionID).
+		Scan(ctx, &users)
+	return users, err
+}
+
+// withWishlistItems loads the items, newest first, with their editions and
+// books.
+func withWishlistItems(query *bun.SelectQuery) *bun.SelectQuery {
+	return query.
+		Relation("Items", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("wishlist_item.added_at DESC")
+		}).
+		Relation("Items.Edition").
+		Relation("Items.Edition.Book")
+}